@@ -56,9 +56,16 @@ func (c *CategoryController) CreateCategory(ctx *gin.Context) {
 	}
 	UserID := userInfo.UserID
 
+	PointsMultiplier := "1"
+	if payload.PointsMultiplier != nil {
+		PointsMultiplier = strconv.FormatFloat(*payload.PointsMultiplier, 'f', 2, 64)
+	}
+
 	args := &db.CreateCategoryParams{
-		Name:      payload.Name,
-		CreatedBy: sql.NullInt64{Int64: UserID, Valid: true},
+		Name:             payload.Name,
+		TaxRateID:        sql.NullInt64{Int64: payload.TaxRateID, Valid: payload.TaxRateID != 0},
+		PointsMultiplier: PointsMultiplier,
+		CreatedBy:        sql.NullInt64{Int64: UserID, Valid: true},
 	}
 
 	category, err := c.db.CreateCategory(ctx, *args)
@@ -72,14 +79,16 @@ func (c *CategoryController) CreateCategory(ctx *gin.Context) {
 	}
 
 	data := schemas.CategoryData{
-		ID:        category.ID,
-		Name:      category.Name,
-		CreatedBy: common.ConvertNullInt64(category.CreatedBy),
-		CreatedAt: common.ConvertNullTime(category.CreatedAt),
-		UpdatedBy: common.ConvertNullInt64(category.UpdatedBy),
-		UpdatedAt: common.ConvertNullTime(category.UpdatedAt),
-		DeletedAt: common.ConvertNullTime(category.DeletedAt),
-		DeletedBy: common.ConvertNullInt64(category.DeletedBy),
+		ID:               category.ID,
+		Name:             category.Name,
+		TaxRateID:        common.ConvertNullInt64(category.TaxRateID),
+		PointsMultiplier: categoryPointsMultiplier(category.PointsMultiplier),
+		CreatedBy:        common.ConvertNullInt64(category.CreatedBy),
+		CreatedAt:        common.ConvertNullTime(category.CreatedAt),
+		UpdatedBy:        common.ConvertNullInt64(category.UpdatedBy),
+		UpdatedAt:        common.ConvertNullTime(category.UpdatedAt),
+		DeletedAt:        common.ConvertNullTime(category.DeletedAt),
+		DeletedBy:        common.ConvertNullInt64(category.DeletedBy),
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{
@@ -132,7 +141,8 @@ func (c *CategoryController) UpdateCategory(ctx *gin.Context) {
 		return
 	}
 
-	if _, err := c.db.GetCategoryByID(ctx, id); err != nil {
+	existing, err := c.db.GetCategoryByID(ctx, id)
+	if err != nil {
 		if err == sql.ErrNoRows {
 			ctx.JSON(http.StatusNotFound, gin.H{
 				"status":  "failed",
@@ -147,10 +157,18 @@ func (c *CategoryController) UpdateCategory(ctx *gin.Context) {
 		return
 	}
 
+	// pengali poin tetap sama jika tidak dikirim
+	PointsMultiplier := existing.PointsMultiplier
+	if payload.PointsMultiplier != nil {
+		PointsMultiplier = strconv.FormatFloat(*payload.PointsMultiplier, 'f', 2, 64)
+	}
+
 	args := &db.UpdateCategoryParams{
-		ID:        id,
-		Name:      payload.Name,
-		UpdatedBy: sql.NullInt64{Int64: UserID, Valid: true},
+		ID:               id,
+		Name:             payload.Name,
+		TaxRateID:        sql.NullInt64{Int64: payload.TaxRateID, Valid: payload.TaxRateID != 0},
+		PointsMultiplier: PointsMultiplier,
+		UpdatedBy:        sql.NullInt64{Int64: UserID, Valid: true},
 	}
 
 	category, err := c.db.UpdateCategory(ctx, *args)
@@ -171,14 +189,16 @@ func (c *CategoryController) UpdateCategory(ctx *gin.Context) {
 	}
 
 	data := schemas.CategoryData{
-		ID:        category.ID,
-		Name:      category.Name,
-		CreatedBy: common.ConvertNullInt64(category.CreatedBy),
-		CreatedAt: common.ConvertNullTime(category.CreatedAt),
-		UpdatedBy: common.ConvertNullInt64(category.UpdatedBy),
-		UpdatedAt: common.ConvertNullTime(category.UpdatedAt),
-		DeletedAt: common.ConvertNullTime(category.DeletedAt),
-		DeletedBy: common.ConvertNullInt64(category.DeletedBy),
+		ID:               category.ID,
+		Name:             category.Name,
+		TaxRateID:        common.ConvertNullInt64(category.TaxRateID),
+		PointsMultiplier: categoryPointsMultiplier(category.PointsMultiplier),
+		CreatedBy:        common.ConvertNullInt64(category.CreatedBy),
+		CreatedAt:        common.ConvertNullTime(category.CreatedAt),
+		UpdatedBy:        common.ConvertNullInt64(category.UpdatedBy),
+		UpdatedAt:        common.ConvertNullTime(category.UpdatedAt),
+		DeletedAt:        common.ConvertNullTime(category.DeletedAt),
+		DeletedBy:        common.ConvertNullInt64(category.DeletedBy),
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{
@@ -227,14 +247,16 @@ func (c *CategoryController) GetCategoryById(ctx *gin.Context) {
 	}
 
 	data := schemas.CategoryData{
-		ID:        category.ID,
-		Name:      category.Name,
-		CreatedBy: common.ConvertNullInt64(category.CreatedBy),
-		CreatedAt: common.ConvertNullTime(category.CreatedAt),
-		UpdatedBy: common.ConvertNullInt64(category.UpdatedBy),
-		UpdatedAt: common.ConvertNullTime(category.UpdatedAt),
-		DeletedAt: common.ConvertNullTime(category.DeletedAt),
-		DeletedBy: common.ConvertNullInt64(category.DeletedBy),
+		ID:               category.ID,
+		Name:             category.Name,
+		TaxRateID:        common.ConvertNullInt64(category.TaxRateID),
+		PointsMultiplier: categoryPointsMultiplier(category.PointsMultiplier),
+		CreatedBy:        common.ConvertNullInt64(category.CreatedBy),
+		CreatedAt:        common.ConvertNullTime(category.CreatedAt),
+		UpdatedBy:        common.ConvertNullInt64(category.UpdatedBy),
+		UpdatedAt:        common.ConvertNullTime(category.UpdatedAt),
+		DeletedAt:        common.ConvertNullTime(category.DeletedAt),
+		DeletedBy:        common.ConvertNullInt64(category.DeletedBy),
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{
@@ -284,14 +306,16 @@ func (c *CategoryController) GetAllCategories(ctx *gin.Context) {
 	data := make([]schemas.CategoryData, len(categories))
 	for i, category := range categories {
 		data[i] = schemas.CategoryData{
-			ID:        category.ID,
-			Name:      category.Name,
-			CreatedBy: common.ConvertNullInt64(category.CreatedBy),
-			CreatedAt: common.ConvertNullTime(category.CreatedAt),
-			UpdatedBy: common.ConvertNullInt64(category.UpdatedBy),
-			UpdatedAt: common.ConvertNullTime(category.UpdatedAt),
-			DeletedAt: common.ConvertNullTime(category.DeletedAt),
-			DeletedBy: common.ConvertNullInt64(category.DeletedBy),
+			ID:               category.ID,
+			Name:             category.Name,
+			TaxRateID:        common.ConvertNullInt64(category.TaxRateID),
+			PointsMultiplier: categoryPointsMultiplier(category.PointsMultiplier),
+			CreatedBy:        common.ConvertNullInt64(category.CreatedBy),
+			CreatedAt:        common.ConvertNullTime(category.CreatedAt),
+			UpdatedBy:        common.ConvertNullInt64(category.UpdatedBy),
+			UpdatedAt:        common.ConvertNullTime(category.UpdatedAt),
+			DeletedAt:        common.ConvertNullTime(category.DeletedAt),
+			DeletedBy:        common.ConvertNullInt64(category.DeletedBy),
 		}
 	}
 
@@ -342,14 +366,16 @@ func (c *CategoryController) GetAllDeletedCategories(ctx *gin.Context) {
 	data := make([]schemas.CategoryData, len(categories))
 	for i, category := range categories {
 		data[i] = schemas.CategoryData{
-			ID:        category.ID,
-			Name:      category.Name,
-			CreatedBy: common.ConvertNullInt64(category.CreatedBy),
-			CreatedAt: common.ConvertNullTime(category.CreatedAt),
-			UpdatedBy: common.ConvertNullInt64(category.UpdatedBy),
-			UpdatedAt: common.ConvertNullTime(category.UpdatedAt),
-			DeletedAt: common.ConvertNullTime(category.DeletedAt),
-			DeletedBy: common.ConvertNullInt64(category.DeletedBy),
+			ID:               category.ID,
+			Name:             category.Name,
+			TaxRateID:        common.ConvertNullInt64(category.TaxRateID),
+			PointsMultiplier: categoryPointsMultiplier(category.PointsMultiplier),
+			CreatedBy:        common.ConvertNullInt64(category.CreatedBy),
+			CreatedAt:        common.ConvertNullTime(category.CreatedAt),
+			UpdatedBy:        common.ConvertNullInt64(category.UpdatedBy),
+			UpdatedAt:        common.ConvertNullTime(category.UpdatedAt),
+			DeletedAt:        common.ConvertNullTime(category.DeletedAt),
+			DeletedBy:        common.ConvertNullInt64(category.DeletedBy),
 		}
 	}
 
@@ -478,3 +504,8 @@ func (c *CategoryController) SoftDeleteCategoryById(ctx *gin.Context) {
 		"message": "soft deleted successfully",
 	})
 }
+
+func categoryPointsMultiplier(value string) float64 {
+	multiplier, _ := strconv.ParseFloat(value, 64)
+	return multiplier
+}
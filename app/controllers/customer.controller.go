@@ -3,17 +3,22 @@ package controllers
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"pos-api/app/schemas"
 	db "pos-api/db/sqlc"
 	"pos-api/util/common"
+	"pos-api/util/contact"
 	"pos-api/util/jwt"
 
 	"github.com/gin-gonic/gin"
 )
 
+var errAmbiguousMember = errors.New("phone number matches more than one member, use the member code")
+
 type CustomerController struct {
 	db  *db.Queries
 	ctx context.Context
@@ -113,6 +118,7 @@ func (c *CustomerController) CreateCustomer(ctx *gin.Context) {
 		Name:       customer.Name,
 		Phone:      common.ConvertNullString(customer.Phone),
 		Email:      common.ConvertNullString(customer.Email),
+		TierID:     common.ConvertNullInt64(customer.TierID),
 		CreatedBy:  common.ConvertNullInt64(customer.CreatedBy),
 		CreatedAt:  common.ConvertNullTime(customer.CreatedAt),
 		UpdatedBy:  common.ConvertNullInt64(customer.UpdatedBy),
@@ -242,6 +248,7 @@ func (c *CustomerController) UpdateCustomer(ctx *gin.Context) {
 		Name:       customer.Name,
 		Phone:      common.ConvertNullString(customer.Phone),
 		Email:      common.ConvertNullString(customer.Email),
+		TierID:     common.ConvertNullInt64(customer.TierID),
 		CreatedBy:  common.ConvertNullInt64(customer.CreatedBy),
 		CreatedAt:  common.ConvertNullTime(customer.CreatedAt),
 		UpdatedBy:  common.ConvertNullInt64(customer.UpdatedBy),
@@ -301,6 +308,7 @@ func (c *CustomerController) GetCustomerById(ctx *gin.Context) {
 		Name:       customer.Name,
 		Phone:      common.ConvertNullString(customer.Phone),
 		Email:      common.ConvertNullString(customer.Email),
+		TierID:     common.ConvertNullInt64(customer.TierID),
 		CreatedBy:  common.ConvertNullInt64(customer.CreatedBy),
 		CreatedAt:  common.ConvertNullTime(customer.CreatedAt),
 		UpdatedBy:  common.ConvertNullInt64(customer.UpdatedBy),
@@ -361,6 +369,7 @@ func (c *CustomerController) GetAllCustomers(ctx *gin.Context) {
 			Name:       customer.Name,
 			Phone:      common.ConvertNullString(customer.Phone),
 			Email:      common.ConvertNullString(customer.Email),
+			TierID:     common.ConvertNullInt64(customer.TierID),
 			CreatedBy:  common.ConvertNullInt64(customer.CreatedBy),
 			CreatedAt:  common.ConvertNullTime(customer.CreatedAt),
 			UpdatedBy:  common.ConvertNullInt64(customer.UpdatedBy),
@@ -377,6 +386,80 @@ func (c *CustomerController) GetAllCustomers(ctx *gin.Context) {
 	})
 }
 
+// SearchCustomers godoc
+// @Security BearerAuth
+// @Summary Search customers
+// @Description Fuzzy search customers by name, phone (+62/08 equivalent), email or member code. Exact member code and phone matches are listed first.
+// @Tags customers
+// @Produce json
+// @Param q query string true "Name, phone, email or member code"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Limit per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customers/search [get]
+func (c *CustomerController) SearchCustomers(ctx *gin.Context) {
+	query := strings.TrimSpace(ctx.Query("q"))
+	if query == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "search query is required",
+		})
+		return
+	}
+
+	var page = ctx.DefaultQuery("page", "1")
+	var limit = ctx.DefaultQuery("limit", "10")
+
+	reqPageID, _ := strconv.Atoi(page)
+	reqLimit, _ := strconv.Atoi(limit)
+	offset := (reqPageID - 1) * reqLimit
+
+	var Phone string
+	if contact.LooksLikePhone(query) {
+		Phone = contact.NormalizePhone(query)
+	}
+
+	args := &db.SearchCustomersParams{
+		Query:  query,
+		Phone:  Phone,
+		Limit:  int32(reqLimit),
+		Offset: int32(offset),
+	}
+
+	customers, err := c.db.SearchCustomers(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.CustomerData, len(customers))
+	for i, customer := range customers {
+		data[i] = schemas.CustomerData{
+			ID:         customer.ID,
+			MemberCode: customer.MemberCode,
+			Name:       customer.Name,
+			Phone:      common.ConvertNullString(customer.Phone),
+			Email:      common.ConvertNullString(customer.Email),
+			TierID:     common.ConvertNullInt64(customer.TierID),
+			CreatedBy:  common.ConvertNullInt64(customer.CreatedBy),
+			CreatedAt:  common.ConvertNullTime(customer.CreatedAt),
+			UpdatedBy:  common.ConvertNullInt64(customer.UpdatedBy),
+			UpdatedAt:  common.ConvertNullTime(customer.UpdatedAt),
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
 // GetAllCustomers godoc
 // @Security BearerAuth
 // @Summary Get all deleted customers
@@ -422,6 +505,7 @@ func (c *CustomerController) GetAllDeletedCustomers(ctx *gin.Context) {
 			Name:       customer.Name,
 			Phone:      common.ConvertNullString(customer.Phone),
 			Email:      common.ConvertNullString(customer.Email),
+			TierID:     common.ConvertNullInt64(customer.TierID),
 			CreatedBy:  common.ConvertNullInt64(customer.CreatedBy),
 			CreatedAt:  common.ConvertNullTime(customer.CreatedAt),
 			UpdatedBy:  common.ConvertNullInt64(customer.UpdatedBy),
@@ -441,7 +525,7 @@ func (c *CustomerController) GetAllDeletedCustomers(ctx *gin.Context) {
 // DeleteCustomerById godoc
 // @Security BearerAuth
 // @Summary Delete a customer by ID
-// @Description Delete a customer with the given ID
+// @Description Permanently delete a customer with the given ID. Customers with order history cannot be deleted and must be anonymized instead.
 // @Tags customers
 // @Produce json
 // @Param id path int true "Customer ID"
@@ -475,6 +559,23 @@ func (c *CustomerController) DeleteCustomerById(ctx *gin.Context) {
 		return
 	}
 
+	// menghapus pelanggan ikut menghapus riwayat order-nya, pelanggan dengan riwayat order dianonimkan
+	TotalOrders, err := c.db.CountCustomerOrders(ctx, sql.NullInt64{Int64: id, Valid: true})
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	if TotalOrders > 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "customer has order history, anonymize the customer instead",
+		})
+		return
+	}
+
 	_, err = c.db.DeleteCustomerByID(ctx, id)
 	if err != nil {
 		ctx.JSON(http.StatusBadGateway, gin.H{
@@ -555,3 +656,248 @@ func (c *CustomerController) SoftDeleteCustomerById(ctx *gin.Context) {
 		"message": "soft deleted successfully",
 	})
 }
+
+// GetCustomerSummary godoc
+// @Security BearerAuth
+// @Summary Get customer purchase summary
+// @Description Lifetime spend, order count, average basket, last visit, favorite products, refund count, tier and points balance of a customer
+// @Tags customers
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customers/{id}/summary [get]
+func (c *CustomerController) GetCustomerSummary(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer id",
+		})
+		return
+	}
+
+	customer, err := c.db.GetCustomerByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve customer with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	CustomerID := sql.NullInt64{Int64: customer.ID, Valid: true}
+	summary, err := c.db.GetCustomerSummary(ctx, CustomerID)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	LifetimeSpend, _ := strconv.ParseFloat(summary.LifetimeSpend, 64)
+	AverageBasket, _ := strconv.ParseFloat(summary.AverageBasket, 64)
+	data := schemas.CustomerSummaryData{
+		CustomerID:       customer.ID,
+		MemberCode:       customer.MemberCode,
+		Name:             customer.Name,
+		LifetimeSpend:    LifetimeSpend,
+		TotalOrders:      summary.TotalOrders,
+		AverageBasket:    AverageBasket,
+		TotalRefunds:     summary.TotalRefunds,
+		FavoriteProducts: []schemas.CustomerFavoriteProduct{},
+	}
+
+	lastOrder, err := c.db.GetCustomerLastOrder(ctx, CustomerID)
+	if err != nil && err != sql.ErrNoRows {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	if err == nil {
+		data.LastVisit = common.ConvertNullTime(lastOrder.OrderDate)
+		data.LastTrxNumber = lastOrder.TrxNumber
+	}
+
+	if customer.TierID.Valid {
+		customerTier, err := c.db.GetCustomerTierByID(ctx, customer.TierID.Int64)
+		if err != nil && err != sql.ErrNoRows {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		data.Tier = customerTier.Name
+	}
+
+	data.PointsBalance, err = c.db.GetCustomerPointBalance(ctx, customer.ID)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	favoriteArgs := &db.GetCustomerFavoriteProductsParams{
+		CustomerID: CustomerID,
+		Limit:      5,
+	}
+	favorites, err := c.db.GetCustomerFavoriteProducts(ctx, *favoriteArgs)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	for _, f := range favorites {
+		TotalSpent, _ := strconv.ParseFloat(f.TotalSpent, 64)
+		data.FavoriteProducts = append(data.FavoriteProducts, schemas.CustomerFavoriteProduct{
+			ProductID:     common.ConvertNullInt64(f.ProductID),
+			ProductName:   f.ProductName,
+			TotalQuantity: f.TotalQuantity,
+			TotalOrders:   f.TotalOrders,
+			TotalSpent:    TotalSpent,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "customer summary retrieved successfully",
+		"data":    data,
+	})
+}
+
+// GetCustomerOrders godoc
+// @Security BearerAuth
+// @Summary Get customer orders
+// @Description Retrieve the order history of a customer with pagination, newest first
+// @Tags customers
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Param status query string false "Order status (order, paid, pending_payment, expired, refunded)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of items per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customers/{id}/orders [get]
+func (c *CustomerController) GetCustomerOrders(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer id",
+		})
+		return
+	}
+
+	var page = ctx.DefaultQuery("page", "1")
+	var limit = ctx.DefaultQuery("limit", "10")
+
+	reqPageID, _ := strconv.Atoi(page)
+	reqLimit, _ := strconv.Atoi(limit)
+	offset := (reqPageID - 1) * reqLimit
+
+	if _, err := c.db.GetCustomerByID(ctx, id); err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve customer with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	args := &db.GetCustomerOrdersParams{
+		CustomerID: sql.NullInt64{Int64: id, Valid: true},
+		Status:     ctx.Query("status"),
+		Limit:      int32(reqLimit),
+		Offset:     int32(offset),
+	}
+
+	orders, err := c.db.GetCustomerOrders(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.OrderData, len(orders))
+	for i, order := range orders {
+		data[i] = schemas.OrderData{
+			ID:              order.ID,
+			TrxNumber:       order.TrxNumber,
+			CashierID:       common.ConvertNullInt64(order.CashierID),
+			ShiftID:         common.ConvertNullInt64(order.ShiftID),
+			CustomerID:      common.ConvertNullInt64(order.CustomerID),
+			Subtotal:        order.Subtotal,
+			DiscountAmount:  order.DiscountAmount,
+			VoucherCode:     common.ConvertNullString(order.VoucherCode),
+			VoucherDiscount: order.VoucherDiscount,
+			TaxAmount:       order.TaxAmount,
+			TotalAmount:     order.TotalAmount,
+			ChangeAmount:    order.ChangeAmount,
+			PaymentMethod:   order.PaymentMethod,
+			Status:          order.Status,
+			OrderDate:       common.ConvertNullTime(order.OrderDate),
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// findMember mencari id pelanggan member berdasarkan kode member atau nomor telepon.
+// Kode member diutamakan; nomor telepon yang dipakai lebih dari satu member ditolak.
+func findMember(ctx context.Context, q *db.Queries, member string) (int64, error) {
+	member = strings.TrimSpace(member)
+	if member == "" {
+		return 0, sql.ErrNoRows
+	}
+
+	args := &db.GetCustomersByMemberCodeOrPhoneParams{
+		MemberCode: member,
+	}
+	if contact.LooksLikePhone(member) {
+		args.Phone = contact.NormalizePhone(member)
+	}
+
+	customers, err := q.GetCustomersByMemberCodeOrPhone(ctx, *args)
+	if err != nil {
+		return 0, err
+	}
+	if len(customers) == 0 {
+		return 0, sql.ErrNoRows
+	}
+	if len(customers) > 1 && !strings.EqualFold(customers[0].MemberCode, member) {
+		return 0, errAmbiguousMember
+	}
+	return customers[0].ID, nil
+}
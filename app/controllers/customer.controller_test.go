@@ -0,0 +1,208 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/contact"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestGetCustomerSummary(t *testing.T) {
+	q, _ := testDB(t)
+	ctx := context.Background()
+	c := NewCustomerController(q, ctx)
+
+	router := gin.New()
+	router.GET("/customers/:id/summary", c.GetCustomerSummary)
+
+	customer := createTestCustomer(t, q, "Summary Customer")
+	product := createTestProduct(t, q, 10)
+
+	// dua order lunas dan satu order yang direfund, refund tidak dihitung sebagai belanja
+	orders := []db.Order{
+		createCustomerOrder(t, q, customer, "30000", "order"),
+		createCustomerOrder(t, q, customer, "10000", "paid"),
+		createCustomerOrder(t, q, customer, "50000", "refunded"),
+	}
+	for i, quantity := range []int32{3, 1, 5} {
+		_, err := q.CreateOrderItem(ctx, db.CreateOrderItemParams{
+			OrderID:        sql.NullInt64{Int64: orders[i].ID, Valid: true},
+			ProductID:      sql.NullInt64{Int64: product.ID, Valid: true},
+			Quantity:       quantity,
+			UnitPrice:      "10000",
+			DiscountAmount: "0",
+			TaxRate:        "0",
+			TaxAmount:      "0",
+			UnitCost:       "0",
+			Cogs:           "0",
+		})
+		if err != nil {
+			t.Fatalf("CreateOrderItem() error = %v", err)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/customers/%d/summary", customer.ID), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body.String())
+	}
+
+	var res struct {
+		Data schemas.CustomerSummaryData `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	got := res.Data
+	if got.TotalOrders != 2 || got.LifetimeSpend != 40000 || got.AverageBasket != 20000 || got.TotalRefunds != 1 {
+		t.Errorf("summary = %+v, want 2 orders, spend 40000, average 20000 and 1 refund", got)
+	}
+	if got.LastTrxNumber != orders[2].TrxNumber {
+		t.Errorf("last trx = %s, want %s", got.LastTrxNumber, orders[2].TrxNumber)
+	}
+	if len(got.FavoriteProducts) != 1 || got.FavoriteProducts[0].TotalQuantity != 4 || got.FavoriteProducts[0].TotalOrders != 2 {
+		t.Errorf("favorite products = %+v, want 4 units in 2 orders", got.FavoriteProducts)
+	}
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/customers/0/summary", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status for an unknown customer = %d, want 404", rec.Code)
+	}
+}
+
+func TestGetCustomerOrders(t *testing.T) {
+	q, _ := testDB(t)
+	c := NewCustomerController(q, context.Background())
+
+	router := gin.New()
+	router.GET("/customers/:id/orders", c.GetCustomerOrders)
+
+	customer := createTestCustomer(t, q, "History Customer")
+	for _, status := range []string{"order", "refunded", "order"} {
+		createCustomerOrder(t, q, customer, "10000", status)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{"first page", "?page=1&limit=2", 2},
+		{"second page", "?page=2&limit=2", 1},
+		{"status filter", "?status=refunded", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/customers/%d/orders%s", customer.ID, tt.query), nil))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body.String())
+			}
+
+			var res struct {
+				Data []schemas.OrderData `json:"data"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if len(res.Data) != tt.want {
+				t.Errorf("orders = %d, want %d", len(res.Data), tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizePhoneMatchesDatabase(t *testing.T) {
+	_, sqlDB := testDB(t)
+
+	// NormalizePhone harus sama dengan fungsi SQL normalize_phone yang dipakai pencarian
+	for _, phone := range []string{"08123456789", "+62 812-3456-789", "628123456789", "8123456789", "(021) 555 1234", ""} {
+		var got string
+		if err := sqlDB.QueryRow("SELECT normalize_phone($1)", phone).Scan(&got); err != nil {
+			t.Fatalf("normalize_phone(%q) error = %v", phone, err)
+		}
+		if want := contact.NormalizePhone(phone); got != want {
+			t.Errorf("normalize_phone(%q) = %q, NormalizePhone = %q", phone, got, want)
+		}
+	}
+}
+
+func TestSearchCustomersByPhone(t *testing.T) {
+	q, _ := testDB(t)
+	c := NewCustomerController(q, context.Background())
+
+	router := gin.New()
+	router.GET("/customers/search", c.SearchCustomers)
+
+	customer := createTestCustomer(t, q, "Phone Customer")
+	international := "+62 " + strings.TrimPrefix(customer.Phone.String, "0")
+
+	for _, query := range []string{customer.Phone.String, international, strings.ToLower(customer.MemberCode)} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/customers/search?q="+url.QueryEscape(query), nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body.String())
+		}
+
+		var res struct {
+			Data []schemas.CustomerData `json:"data"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if len(res.Data) == 0 || res.Data[0].ID != customer.ID {
+			t.Errorf("search %q did not list customer %d first: %+v", query, customer.ID, res.Data)
+		}
+	}
+}
+
+func TestFindMember(t *testing.T) {
+	q, _ := testDB(t)
+	ctx := context.Background()
+
+	customer := createTestCustomer(t, q, "Member Customer")
+	duplicate, err := q.CreateCustomer(ctx, db.CreateCustomerParams{
+		MemberCode: "M" + uniqueRef("dup"),
+		Name:       "Member Customer Duplicate",
+		Phone:      sql.NullString{String: "+62" + strings.TrimPrefix(customer.Phone.String, "0"), Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("CreateCustomer() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		member string
+		want   int64
+		err    error
+	}{
+		{"member code", customer.MemberCode, customer.ID, nil},
+		{"member code is case insensitive", strings.ToLower(duplicate.MemberCode), duplicate.ID, nil},
+		{"phone shared by two members", customer.Phone.String, 0, errAmbiguousMember},
+		{"unknown member", "M-unknown", 0, sql.ErrNoRows},
+		{"empty", " ", 0, sql.ErrNoRows},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findMember(ctx, q, tt.member)
+			if !errors.Is(err, tt.err) || got != tt.want {
+				t.Errorf("findMember(%q) = %d, %v, want %d, %v", tt.member, got, err, tt.want, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,396 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/contact"
+	"pos-api/util/jwt"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CustomerMergeController struct {
+	db    *db.Queries
+	sqlDB *sql.DB
+	ctx   context.Context
+}
+
+func NewCustomerMergeController(db *db.Queries, sqlDB *sql.DB, ctx context.Context) *CustomerMergeController {
+	return &CustomerMergeController{db, sqlDB, ctx}
+}
+
+// GetDuplicateCustomers godoc
+// @Security BearerAuth
+// @Summary Get duplicate customer candidates
+// @Description Find pairs of customers that are probably the same person, scored 0-100 from the same phone (+62/08 equivalent), the same email and name similarity. Highest score first.
+// @Tags customers
+// @Produce json
+// @Param customer_id query int false "Only candidates of this customer"
+// @Param min_score query int false "Minimum score" default(40)
+// @Param limit query int false "Number of pairs" default(50)
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customers/duplicates [get]
+func (c *CustomerMergeController) GetDuplicateCustomers(ctx *gin.Context) {
+	CustomerID, _ := strconv.ParseInt(ctx.Query("customer_id"), 10, 64)
+	MinScore, _ := strconv.Atoi(ctx.DefaultQuery("min_score", "40"))
+	reqLimit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "50"))
+
+	// kandidat diambil lebih banyak karena sebagian tersaring oleh skor minimal
+	args := &db.GetDuplicateCustomerCandidatesParams{
+		CustomerID: CustomerID,
+		Limit:      int32(reqLimit * 4),
+	}
+
+	candidates, err := c.db.GetDuplicateCustomerCandidates(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := []schemas.CustomerDuplicateData{}
+	for _, candidate := range candidates {
+		NameSimilarity, _ := strconv.ParseFloat(candidate.NameSimilarity, 64)
+		match := contact.Score(
+			contact.Contact{
+				Name:  candidate.CustomerName,
+				Phone: common.ConvertNullString(candidate.CustomerPhone),
+				Email: common.ConvertNullString(candidate.CustomerEmail),
+			},
+			contact.Contact{
+				Name:  candidate.DuplicateName,
+				Phone: common.ConvertNullString(candidate.DuplicatePhone),
+				Email: common.ConvertNullString(candidate.DuplicateEmail),
+			},
+			NameSimilarity,
+		)
+		if match.Score < MinScore {
+			continue
+		}
+
+		data = append(data, schemas.CustomerDuplicateData{
+			Customer: schemas.CustomerContactData{
+				ID:         candidate.CustomerID,
+				MemberCode: candidate.CustomerMemberCode,
+				Name:       candidate.CustomerName,
+				Phone:      common.ConvertNullString(candidate.CustomerPhone),
+				Email:      common.ConvertNullString(candidate.CustomerEmail),
+			},
+			Duplicate: schemas.CustomerContactData{
+				ID:         candidate.DuplicateID,
+				MemberCode: candidate.DuplicateMemberCode,
+				Name:       candidate.DuplicateName,
+				Phone:      common.ConvertNullString(candidate.DuplicatePhone),
+				Email:      common.ConvertNullString(candidate.DuplicateEmail),
+			},
+			Score:   match.Score,
+			Reasons: match.Reasons,
+		})
+	}
+
+	sort.SliceStable(data, func(i, j int) bool {
+		return data[i].Score > data[j].Score
+	})
+	if len(data) > reqLimit {
+		data = data[:reqLimit]
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// MergeCustomer godoc
+// @Security BearerAuth
+// @Summary Merge duplicate customer
+// @Description Merge a duplicate customer into this customer. Orders, parked orders, voucher redemptions, loyalty points, tier history, gift cards and store credit are moved to this customer, empty phone/email are filled from the duplicate, then the duplicate is soft deleted and the merge is recorded.
+// @Tags customers
+// @Accept json
+// @Produce json
+// @Param id path int true "Customer ID that is kept"
+// @Param payload body schemas.MergeCustomer true "Duplicate customer"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customers/{id}/merge [post]
+func (c *CustomerMergeController) MergeCustomer(ctx *gin.Context) {
+	var payload schemas.MergeCustomer
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if payload.DuplicateID == id {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "a customer cannot be merged into itself",
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	Customer, err := qtx.GetCustomerByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve customer with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	Duplicate, err := qtx.GetCustomerByID(ctx, payload.DuplicateID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve duplicate customer with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if Customer.DeletedAt.Valid || Duplicate.DeletedAt.Valid {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "deleted customers cannot be merged",
+		})
+		return
+	}
+
+	Merge, err := mergeCustomers(ctx, qtx, Customer, Duplicate, UserID)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "customers merged successfully",
+		"data":    customerMergeData(Merge),
+	})
+}
+
+// GetCustomerMerges godoc
+// @Security BearerAuth
+// @Summary Get customer merge history
+// @Description Audit trail of the duplicate customers merged into this customer
+// @Tags customers
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customers/{id}/merges [get]
+func (c *CustomerMergeController) GetCustomerMerges(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer id",
+		})
+		return
+	}
+
+	merges, err := c.db.GetCustomerMerges(ctx, id)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.CustomerMergeData, len(merges))
+	for i, merge := range merges {
+		data[i] = customerMergeData(merge)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// mergeCustomers memindahkan seluruh riwayat pelanggan duplikat ke pelanggan yang
+// dipertahankan, melengkapi kontak yang kosong, menghapus (soft delete) duplikat
+// dan mencatat penggabungan. Tier pelanggan diperbarui oleh job perhitungan tier.
+func mergeCustomers(ctx context.Context, q *db.Queries, customer db.Customer, duplicate db.Customer, userID int64) (db.CustomerMerge, error) {
+	OrdersMoved, err := q.ReassignCustomerOrders(ctx, db.ReassignCustomerOrdersParams{
+		CustomerID:       customer.ID,
+		MergedCustomerID: duplicate.ID,
+	})
+	if err != nil {
+		return db.CustomerMerge{}, err
+	}
+
+	if err := q.ReassignCustomerParkedOrders(ctx, db.ReassignCustomerParkedOrdersParams{
+		CustomerID:       customer.ID,
+		MergedCustomerID: duplicate.ID,
+	}); err != nil {
+		return db.CustomerMerge{}, err
+	}
+
+	if err := q.ReassignCustomerVoucherRedemptions(ctx, db.ReassignCustomerVoucherRedemptionsParams{
+		CustomerID:       customer.ID,
+		MergedCustomerID: duplicate.ID,
+	}); err != nil {
+		return db.CustomerMerge{}, err
+	}
+
+	PointsMoved, err := q.GetCustomerPointBalance(ctx, duplicate.ID)
+	if err != nil {
+		return db.CustomerMerge{}, err
+	}
+
+	if err := q.ReassignCustomerLoyaltyPoints(ctx, db.ReassignCustomerLoyaltyPointsParams{
+		CustomerID:       customer.ID,
+		MergedCustomerID: duplicate.ID,
+	}); err != nil {
+		return db.CustomerMerge{}, err
+	}
+
+	if err := q.ReassignCustomerTierChanges(ctx, db.ReassignCustomerTierChangesParams{
+		CustomerID:       customer.ID,
+		MergedCustomerID: duplicate.ID,
+	}); err != nil {
+		return db.CustomerMerge{}, err
+	}
+
+	if err := q.ReassignCustomerGiftCards(ctx, db.ReassignCustomerGiftCardsParams{
+		CustomerID:       customer.ID,
+		MergedCustomerID: duplicate.ID,
+	}); err != nil {
+		return db.CustomerMerge{}, err
+	}
+
+	if err := transferStoreCredit(ctx, q, duplicate.ID, customer.ID, "Merged from "+duplicate.MemberCode, userID); err != nil {
+		return db.CustomerMerge{}, err
+	}
+
+	Phone, Email := customer.Phone, customer.Email
+	if common.ConvertNullString(Phone) == "" {
+		Phone = duplicate.Phone
+	}
+	if common.ConvertNullString(Email) == "" {
+		Email = duplicate.Email
+	}
+	if Phone != customer.Phone || Email != customer.Email {
+		if _, err := q.UpdateCustomer(ctx, db.UpdateCustomerParams{
+			ID:         customer.ID,
+			MemberCode: customer.MemberCode,
+			Name:       customer.Name,
+			Phone:      Phone,
+			Email:      Email,
+			UpdatedBy:  sql.NullInt64{Int64: userID, Valid: true},
+		}); err != nil {
+			return db.CustomerMerge{}, err
+		}
+	}
+
+	if _, err := q.SoftDeleteCustomerByID(ctx, db.SoftDeleteCustomerByIDParams{
+		ID:        duplicate.ID,
+		DeletedBy: sql.NullInt64{Int64: userID, Valid: true},
+	}); err != nil {
+		return db.CustomerMerge{}, err
+	}
+
+	return q.CreateCustomerMerge(ctx, db.CreateCustomerMergeParams{
+		CustomerID:       customer.ID,
+		MergedCustomerID: sql.NullInt64{Int64: duplicate.ID, Valid: true},
+		MergedMemberCode: duplicate.MemberCode,
+		MergedName:       duplicate.Name,
+		MergedPhone:      duplicate.Phone,
+		MergedEmail:      duplicate.Email,
+		OrdersMoved:      int32(OrdersMoved),
+		PointsMoved:      int32(PointsMoved),
+		MergedBy:         sql.NullInt64{Int64: userID, Valid: true},
+	})
+}
+
+func customerMergeData(merge db.CustomerMerge) schemas.CustomerMergeData {
+	return schemas.CustomerMergeData{
+		ID:               merge.ID,
+		CustomerID:       merge.CustomerID,
+		MergedCustomerID: common.ConvertNullInt64(merge.MergedCustomerID),
+		MergedMemberCode: merge.MergedMemberCode,
+		MergedName:       merge.MergedName,
+		MergedPhone:      common.ConvertNullString(merge.MergedPhone),
+		MergedEmail:      common.ConvertNullString(merge.MergedEmail),
+		OrdersMoved:      merge.OrdersMoved,
+		PointsMoved:      merge.PointsMoved,
+		MergedBy:         common.ConvertNullInt64(merge.MergedBy),
+		MergedAt:         common.ConvertNullTime(merge.MergedAt),
+	}
+}
@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMergeCustomer(t *testing.T) {
+	q, sqlDB := testDB(t)
+	ctx := context.Background()
+	c := NewCustomerMergeController(q, sqlDB, ctx)
+
+	router := gin.New()
+	router.POST("/customers/:id/merge", c.MergeCustomer)
+
+	user := createTestUser(t, q)
+	duplicate := createTestCustomer(t, q, "Merge Duplicate")
+	customer, err := q.CreateCustomer(ctx, db.CreateCustomerParams{
+		MemberCode: "M" + uniqueRef("keep"),
+		Name:       "Merge Customer",
+	})
+	if err != nil {
+		t.Fatalf("CreateCustomer() error = %v", err)
+	}
+	createCustomerOrder(t, q, duplicate, "10000", "order")
+	createCustomerOrder(t, q, duplicate, "20000", "refunded")
+
+	merge := func(id int64, duplicateID int64) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(map[string]int64{"duplicate_id": duplicateID})
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/customers/%d/merge", id), bytes.NewReader(body))
+		authorize(t, req, user)
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := merge(customer.ID, customer.ID); rec.Code != http.StatusBadRequest {
+		t.Errorf("merge into itself status = %d, want 400", rec.Code)
+	}
+
+	rec := merge(customer.ID, duplicate.ID)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body.String())
+	}
+
+	// semua order pindah, kontak yang kosong diisi dari duplikat dan duplikat dihapus
+	orders, err := q.GetCustomerOrders(ctx, db.GetCustomerOrdersParams{CustomerID: sql.NullInt64{Int64: customer.ID, Valid: true}, Limit: 10})
+	if err != nil || len(orders) != 2 {
+		t.Errorf("orders after merge = %d, %v, want 2", len(orders), err)
+	}
+
+	kept, err := q.GetCustomerByID(ctx, customer.ID)
+	if err != nil {
+		t.Fatalf("GetCustomerByID() error = %v", err)
+	}
+	if kept.Phone != duplicate.Phone || kept.Email != duplicate.Email {
+		t.Errorf("contact = %v, %v, want %v, %v", kept.Phone, kept.Email, duplicate.Phone, duplicate.Email)
+	}
+
+	merged, err := q.GetCustomerByID(ctx, duplicate.ID)
+	if err != nil || !merged.DeletedAt.Valid {
+		t.Errorf("duplicate deleted_at = %v, %v, want soft deleted", merged.DeletedAt, err)
+	}
+
+	merges, err := q.GetCustomerMerges(ctx, customer.ID)
+	if err != nil || len(merges) != 1 || merges[0].OrdersMoved != 2 || merges[0].MergedMemberCode != duplicate.MemberCode {
+		t.Errorf("merges = %+v, %v, want one merge of 2 orders from %s", merges, err, duplicate.MemberCode)
+	}
+
+	if rec := merge(customer.ID, duplicate.ID); rec.Code != http.StatusBadRequest {
+		t.Errorf("merging a deleted customer status = %d, want 400", rec.Code)
+	}
+}
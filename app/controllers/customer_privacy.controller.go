@@ -0,0 +1,621 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/contact"
+	"pos-api/util/jwt"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CustomerPrivacyController struct {
+	db    *db.Queries
+	sqlDB *sql.DB
+	ctx   context.Context
+}
+
+func NewCustomerPrivacyController(db *db.Queries, sqlDB *sql.DB, ctx context.Context) *CustomerPrivacyController {
+	return &CustomerPrivacyController{db, sqlDB, ctx}
+}
+
+// GetCustomerConsent godoc
+// @Security BearerAuth
+// @Summary Get customer marketing consent
+// @Description Current email and WhatsApp marketing consent of a customer with the history of every consent given or withdrawn
+// @Tags customers
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customers/{id}/consent [get]
+func (c *CustomerPrivacyController) GetCustomerConsent(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer id",
+		})
+		return
+	}
+
+	Customer, err := c.db.GetCustomerByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve customer with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data, err := customerConsentData(ctx, c.db, Customer)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// UpdateCustomerConsent godoc
+// @Security BearerAuth
+// @Summary Update customer marketing consent
+// @Description Give or withdraw email and WhatsApp marketing consent of a customer. Channels that are not sent are left unchanged, every change is recorded with its source and time.
+// @Tags customers
+// @Accept json
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Param payload body schemas.UpdateCustomerConsent true "Consent"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customers/{id}/consent [put]
+func (c *CustomerPrivacyController) UpdateCustomerConsent(ctx *gin.Context) {
+	var payload schemas.UpdateCustomerConsent
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	Customer, err := qtx.GetCustomerByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve customer with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if Customer.AnonymizedAt.Valid {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "customer has been anonymized",
+		})
+		return
+	}
+
+	EmailMarketing, WhatsappMarketing := Customer.EmailMarketingConsent, Customer.WhatsappMarketingConsent
+	if payload.EmailMarketing != nil {
+		EmailMarketing = *payload.EmailMarketing
+	}
+	if payload.WhatsappMarketing != nil {
+		WhatsappMarketing = *payload.WhatsappMarketing
+	}
+
+	// hanya kanal yang berubah yang dicatat ke riwayat persetujuan
+	changes := map[string]bool{}
+	if EmailMarketing != Customer.EmailMarketingConsent {
+		changes[contact.ChannelEmail] = EmailMarketing
+	}
+	if WhatsappMarketing != Customer.WhatsappMarketingConsent {
+		changes[contact.ChannelWhatsapp] = WhatsappMarketing
+	}
+
+	if len(changes) > 0 {
+		args := &db.UpdateCustomerConsentParams{
+			ID:                       Customer.ID,
+			EmailMarketingConsent:    EmailMarketing,
+			WhatsappMarketingConsent: WhatsappMarketing,
+			UpdatedBy:                sql.NullInt64{Int64: UserID, Valid: true},
+		}
+		Customer, err = qtx.UpdateCustomerConsent(ctx, *args)
+		if err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		for _, channel := range []string{contact.ChannelEmail, contact.ChannelWhatsapp} {
+			granted, ok := changes[channel]
+			if !ok {
+				continue
+			}
+			if err := createCustomerConsent(ctx, qtx, Customer.ID, channel, granted, payload.Source, UserID); err != nil {
+				ctx.JSON(http.StatusBadGateway, gin.H{
+					"status":  "failed",
+					"message": err.Error(),
+				})
+				return
+			}
+		}
+	}
+
+	data, err := customerConsentData(ctx, qtx, Customer)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "consent updated successfully",
+		"data":    data,
+	})
+}
+
+// ExportCustomerData godoc
+// @Security BearerAuth
+// @Summary Export customer personal data
+// @Description Download all personal data held about a customer as JSON: profile, marketing consent, orders and items, loyalty points, tier history, gift cards, parked orders, notifications and merged duplicates
+// @Tags customers
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customers/{id}/export [get]
+func (c *CustomerPrivacyController) ExportCustomerData(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer id",
+		})
+		return
+	}
+
+	Customer, err := c.db.GetCustomerByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve customer with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data, err := customerExportData(ctx, c.db, Customer)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.Header("Content-Disposition", `attachment; filename="customer-`+Customer.MemberCode+`.json"`)
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "exported successfully",
+		"data":    data,
+	})
+}
+
+// AnonymizeCustomer godoc
+// @Security BearerAuth
+// @Summary Anonymize customer
+// @Description Erase the personal data of a customer while keeping orders, payments, points and gift card balances for bookkeeping. Name, member code, phone and email are replaced, marketing consent is withdrawn, contact data on parked orders, notifications and merge history is scrubbed, pending notifications are cancelled and the customer is soft deleted. This cannot be undone.
+// @Tags customers
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customers/{id}/anonymize [post]
+func (c *CustomerPrivacyController) AnonymizeCustomer(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer id",
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	Customer, err := qtx.GetCustomerByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve customer with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if Customer.AnonymizedAt.Valid {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "customer has already been anonymized",
+		})
+		return
+	}
+
+	Anonymized, err := anonymizeCustomer(ctx, qtx, Customer, UserID)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := schemas.CustomerData{
+		ID:         Anonymized.ID,
+		MemberCode: Anonymized.MemberCode,
+		Name:       Anonymized.Name,
+		TierID:     common.ConvertNullInt64(Anonymized.TierID),
+		CreatedBy:  common.ConvertNullInt64(Anonymized.CreatedBy),
+		CreatedAt:  common.ConvertNullTime(Anonymized.CreatedAt),
+		UpdatedBy:  common.ConvertNullInt64(Anonymized.UpdatedBy),
+		UpdatedAt:  common.ConvertNullTime(Anonymized.UpdatedAt),
+		DeletedAt:  common.ConvertNullTime(Anonymized.DeletedAt),
+		DeletedBy:  common.ConvertNullInt64(Anonymized.DeletedBy),
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "customer anonymized successfully",
+		"data":    data,
+	})
+}
+
+// anonymizeCustomer menghapus data pribadi pelanggan tanpa menyentuh order, pembayaran,
+// poin maupun saldo gift card. Persetujuan pemasaran yang masih aktif dicatat sebagai
+// dicabut agar riwayat persetujuan tetap lengkap.
+func anonymizeCustomer(ctx context.Context, q *db.Queries, customer db.Customer, userID int64) (db.Customer, error) {
+	MemberCode := contact.AnonymizedMemberCode(customer.ID)
+
+	Anonymized, err := q.AnonymizeCustomer(ctx, db.AnonymizeCustomerParams{
+		MemberCode: MemberCode,
+		Name:       contact.AnonymizedName,
+		UserID:     userID,
+		ID:         customer.ID,
+	})
+	if err != nil {
+		return db.Customer{}, err
+	}
+
+	if customer.EmailMarketingConsent {
+		if err := createCustomerConsent(ctx, q, customer.ID, contact.ChannelEmail, false, "anonymize", userID); err != nil {
+			return db.Customer{}, err
+		}
+	}
+	if customer.WhatsappMarketingConsent {
+		if err := createCustomerConsent(ctx, q, customer.ID, contact.ChannelWhatsapp, false, "anonymize", userID); err != nil {
+			return db.Customer{}, err
+		}
+	}
+
+	if err := q.AnonymizeCustomerParkedOrders(ctx, sql.NullInt64{Int64: customer.ID, Valid: true}); err != nil {
+		return db.Customer{}, err
+	}
+
+	if err := q.AnonymizeCustomerNotifications(ctx, db.AnonymizeCustomerNotificationsParams{
+		Recipient:  contact.AnonymizedRecipient,
+		CustomerID: customer.ID,
+	}); err != nil {
+		return db.Customer{}, err
+	}
+
+	if err := q.AnonymizeCustomerMerges(ctx, db.AnonymizeCustomerMergesParams{
+		MemberCode: MemberCode,
+		Name:       contact.AnonymizedName,
+		CustomerID: customer.ID,
+	}); err != nil {
+		return db.Customer{}, err
+	}
+
+	return Anonymized, nil
+}
+
+func createCustomerConsent(ctx context.Context, q *db.Queries, customerID int64, channel string, granted bool, source string, userID int64) error {
+	_, err := q.CreateCustomerConsent(ctx, db.CreateCustomerConsentParams{
+		CustomerID: customerID,
+		Channel:    channel,
+		Granted:    granted,
+		Source:     sql.NullString{String: source, Valid: source != ""},
+		CreatedBy:  sql.NullInt64{Int64: userID, Valid: true},
+	})
+	return err
+}
+
+func customerConsentData(ctx context.Context, q *db.Queries, customer db.Customer) (schemas.CustomerConsentData, error) {
+	consents, err := q.GetCustomerConsents(ctx, customer.ID)
+	if err != nil {
+		return schemas.CustomerConsentData{}, err
+	}
+
+	data := schemas.CustomerConsentData{
+		CustomerID:        customer.ID,
+		EmailMarketing:    customer.EmailMarketingConsent,
+		WhatsappMarketing: customer.WhatsappMarketingConsent,
+		UpdatedAt:         common.ConvertNullTime(customer.ConsentUpdatedAt),
+		History:           make([]schemas.CustomerConsentLogData, len(consents)),
+	}
+	for i, consent := range consents {
+		data.History[i] = schemas.CustomerConsentLogData{
+			ID:        consent.ID,
+			Channel:   consent.Channel,
+			Granted:   consent.Granted,
+			Source:    common.ConvertNullString(consent.Source),
+			CreatedBy: common.ConvertNullInt64(consent.CreatedBy),
+			CreatedAt: common.ConvertNullTime(consent.CreatedAt),
+		}
+	}
+	return data, nil
+}
+
+// customerExportData mengumpulkan seluruh data yang tersimpan tentang seorang pelanggan
+func customerExportData(ctx context.Context, q *db.Queries, customer db.Customer) (schemas.CustomerExportData, error) {
+	CustomerID := sql.NullInt64{Int64: customer.ID, Valid: true}
+	data := schemas.CustomerExportData{
+		ExportedAt: time.Now(),
+		Customer: schemas.CustomerData{
+			ID:         customer.ID,
+			MemberCode: customer.MemberCode,
+			Name:       customer.Name,
+			Phone:      common.ConvertNullString(customer.Phone),
+			Email:      common.ConvertNullString(customer.Email),
+			TierID:     common.ConvertNullInt64(customer.TierID),
+			CreatedBy:  common.ConvertNullInt64(customer.CreatedBy),
+			CreatedAt:  common.ConvertNullTime(customer.CreatedAt),
+			UpdatedBy:  common.ConvertNullInt64(customer.UpdatedBy),
+			UpdatedAt:  common.ConvertNullTime(customer.UpdatedAt),
+			DeletedAt:  common.ConvertNullTime(customer.DeletedAt),
+			DeletedBy:  common.ConvertNullInt64(customer.DeletedBy),
+		},
+	}
+
+	Consent, err := customerConsentData(ctx, q, customer)
+	if err != nil {
+		return data, err
+	}
+	data.Consent = Consent
+
+	orders, err := q.GetCustomerExportOrders(ctx, CustomerID)
+	if err != nil {
+		return data, err
+	}
+	data.Orders = make([]schemas.OrderData, len(orders))
+	for i, order := range orders {
+		data.Orders[i] = schemas.OrderData{
+			ID:              order.ID,
+			TrxNumber:       order.TrxNumber,
+			CashierID:       common.ConvertNullInt64(order.CashierID),
+			ShiftID:         common.ConvertNullInt64(order.ShiftID),
+			CustomerID:      common.ConvertNullInt64(order.CustomerID),
+			Subtotal:        order.Subtotal,
+			DiscountAmount:  order.DiscountAmount,
+			VoucherCode:     common.ConvertNullString(order.VoucherCode),
+			VoucherDiscount: order.VoucherDiscount,
+			TaxAmount:       order.TaxAmount,
+			TotalAmount:     order.TotalAmount,
+			ChangeAmount:    order.ChangeAmount,
+			PaymentMethod:   order.PaymentMethod,
+			Status:          order.Status,
+			OrderDate:       common.ConvertNullTime(order.OrderDate),
+		}
+	}
+
+	items, err := q.GetCustomerExportOrderItems(ctx, CustomerID)
+	if err != nil {
+		return data, err
+	}
+	data.OrderItems = make([]schemas.OrderItemData, len(items))
+	for i, item := range items {
+		UnitPrice, _ := strconv.ParseFloat(item.UnitPrice, 64)
+		data.OrderItems[i] = schemas.OrderItemData{
+			ID:         item.ID,
+			OrderID:    common.ConvertNullInt64(item.OrderID),
+			ProductID:  common.ConvertNullInt64(item.ProductID),
+			OldProduct: common.ConvertNullString(item.OldProduct),
+			Quantity:   item.Quantity,
+			UnitPrice:  UnitPrice,
+			CreatedBy:  common.ConvertNullInt64(item.CreatedBy),
+			CreatedAt:  common.ConvertNullTime(item.CreatedAt),
+		}
+	}
+
+	points, err := q.GetCustomerExportLoyaltyPoints(ctx, customer.ID)
+	if err != nil {
+		return data, err
+	}
+	data.LoyaltyPoints = make([]schemas.LoyaltyPointData, len(points))
+	for i, point := range points {
+		data.LoyaltyPoints[i] = loyaltyPointData(point)
+	}
+
+	changes, err := q.GetCustomerTierChanges(ctx, db.GetCustomerTierChangesParams{
+		CustomerID: customer.ID,
+		Limit:      math.MaxInt32,
+	})
+	if err != nil {
+		return data, err
+	}
+	data.TierChanges = make([]schemas.CustomerTierChangeData, len(changes))
+	for i, change := range changes {
+		RollingSpend, _ := strconv.ParseFloat(change.RollingSpend, 64)
+		data.TierChanges[i] = schemas.CustomerTierChangeData{
+			OldTierID:    common.ConvertNullInt64(change.OldTierID),
+			OldTierName:  common.ConvertNullString(change.OldTierName),
+			NewTierID:    common.ConvertNullInt64(change.NewTierID),
+			NewTierName:  common.ConvertNullString(change.NewTierName),
+			RollingSpend: RollingSpend,
+			ChangedAt:    common.ConvertNullTime(change.ChangedAt),
+		}
+	}
+
+	cards, err := q.GetCustomerExportGiftCards(ctx, CustomerID)
+	if err != nil {
+		return data, err
+	}
+	data.GiftCards = make([]schemas.GiftCardData, len(cards))
+	for i, card := range cards {
+		data.GiftCards[i] = giftCardData(card)
+	}
+
+	parked, err := q.GetCustomerExportParkedOrders(ctx, CustomerID)
+	if err != nil {
+		return data, err
+	}
+	data.ParkedOrders = make([]schemas.ParkedOrderData, len(parked))
+	for i, p := range parked {
+		data.ParkedOrders[i] = parkedOrderData(p, nil)
+	}
+
+	notifications, err := q.GetCustomerExportNotifications(ctx, CustomerID)
+	if err != nil {
+		return data, err
+	}
+	data.Notifications = make([]schemas.NotificationData, len(notifications))
+	for i, n := range notifications {
+		data.Notifications[i] = notificationData(n)
+	}
+
+	merges, err := q.GetCustomerMerges(ctx, customer.ID)
+	if err != nil {
+		return data, err
+	}
+	data.MergedCustomers = make([]schemas.CustomerMergeData, len(merges))
+	for i, merge := range merges {
+		data.MergedCustomers[i] = customerMergeData(merge)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"pos-api/util/contact"
+	"pos-api/util/notifier"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestAnonymizeCustomer(t *testing.T) {
+	q, sqlDB := testDB(t)
+	ctx := context.Background()
+	c := NewCustomerPrivacyController(q, sqlDB, ctx)
+
+	router := gin.New()
+	router.PUT("/customers/:id/consent", c.UpdateCustomerConsent)
+	router.GET("/customers/:id/export", c.ExportCustomerData)
+	router.POST("/customers/:id/anonymize", c.AnonymizeCustomer)
+
+	user := createTestUser(t, q)
+	customer := createTestCustomer(t, q, "Private Customer")
+	order := createCustomerOrder(t, q, customer, "25000", "order")
+	if _, err := queueReceipt(ctx, q, order.ID, map[string]string{notifier.ChannelEmail: customer.Email.String}, user.ID); err != nil {
+		t.Fatalf("queueReceipt() error = %v", err)
+	}
+
+	send := func(method string, path string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, fmt.Sprintf(path, customer.ID), bytes.NewBufferString(body))
+		authorize(t, req, user)
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := send(http.MethodPut, "/customers/%d/consent", `{"email_marketing": true, "whatsapp_marketing": true, "source": "kasir"}`); rec.Code != http.StatusOK {
+		t.Fatalf("consent status = %d, want 200: %s", rec.Code, rec.Body.String())
+	}
+	if rec := send(http.MethodPost, "/customers/%d/anonymize", ""); rec.Code != http.StatusOK {
+		t.Fatalf("anonymize status = %d, want 200: %s", rec.Code, rec.Body.String())
+	}
+
+	// data pribadi hilang, pelanggan dihapus (soft) dan persetujuan dicabut
+	got, err := q.GetCustomerByID(ctx, customer.ID)
+	if err != nil {
+		t.Fatalf("GetCustomerByID() error = %v", err)
+	}
+	if got.Name != contact.AnonymizedName || got.MemberCode != contact.AnonymizedMemberCode(customer.ID) || got.Phone.Valid || got.Email.Valid {
+		t.Errorf("customer = %+v, want anonymized name, member code and no contact", got)
+	}
+	if !got.AnonymizedAt.Valid || !got.DeletedAt.Valid || got.EmailMarketingConsent || got.WhatsappMarketingConsent {
+		t.Errorf("customer = %+v, want anonymized, deleted and without consent", got)
+	}
+
+	consents, err := q.GetCustomerConsents(ctx, customer.ID)
+	if err != nil {
+		t.Fatalf("GetCustomerConsents() error = %v", err)
+	}
+	withdrawn := 0
+	for _, consent := range consents {
+		if !consent.Granted && consent.Source.String == "anonymize" {
+			withdrawn++
+		}
+	}
+	if withdrawn != 2 {
+		t.Errorf("withdrawn consents = %d, want 2", withdrawn)
+	}
+
+	// order tetap tersimpan untuk pembukuan, struk yang belum terkirim dibatalkan
+	kept, err := q.GetOrderByID(ctx, order.ID)
+	if err != nil || kept.CustomerID.Int64 != customer.ID || kept.TotalAmount != order.TotalAmount {
+		t.Errorf("order = %+v, %v, want it kept for customer %d", kept, err, customer.ID)
+	}
+
+	notifications, err := q.GetNotificationsByOrderID(ctx, sql.NullInt64{Int64: order.ID, Valid: true})
+	if err != nil || len(notifications) != 1 {
+		t.Fatalf("notifications = %d, %v, want 1", len(notifications), err)
+	}
+	if n := notifications[0]; n.Recipient != contact.AnonymizedRecipient || n.Status != notifier.StatusFailed {
+		t.Errorf("notification = %+v, want a cancelled anonymized notification", n)
+	}
+
+	rec := send(http.MethodGet, "/customers/%d/export", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("export status = %d, want 200", rec.Code)
+	}
+	for _, personal := range []string{customer.Name, customer.MemberCode, customer.Phone.String, customer.Email.String} {
+		if strings.Contains(rec.Body.String(), personal) {
+			t.Errorf("export still contains %q", personal)
+		}
+	}
+
+	if rec := send(http.MethodPost, "/customers/%d/anonymize", ""); rec.Code != http.StatusBadRequest {
+		t.Errorf("second anonymize status = %d, want 400", rec.Code)
+	}
+}
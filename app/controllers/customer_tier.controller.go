@@ -0,0 +1,645 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/jwt"
+	"pos-api/util/tier"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CustomerTierController struct {
+	db         *db.Queries
+	sqlDB      *sql.DB
+	windowDays int
+	ctx        context.Context
+}
+
+func NewCustomerTierController(db *db.Queries, sqlDB *sql.DB, windowDays int, ctx context.Context) *CustomerTierController {
+	return &CustomerTierController{db, sqlDB, windowDays, ctx}
+}
+
+// GetAllCustomerTiers godoc
+// @Security BearerAuth
+// @Summary Get all customer tiers
+// @Description Retrieve the customer tiers (Silver, Gold, Platinum) with their minimum rolling spend and member discount
+// @Tags customer-tiers
+// @Produce json
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customer-tiers [get]
+func (c *CustomerTierController) GetAllCustomerTiers(ctx *gin.Context) {
+	tiers, err := c.db.GetAllCustomerTiers(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.CustomerTierData, len(tiers))
+	for i, t := range tiers {
+		data[i] = customerTierData(t)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// UpdateCustomerTier godoc
+// @Security BearerAuth
+// @Summary Update customer tier
+// @Description Update the name, minimum rolling spend and member discount percent of a tier
+// @Tags customer-tiers
+// @Accept json
+// @Produce json
+// @Param id path int true "Customer Tier ID"
+// @Param payload body schemas.UpdateCustomerTier true "Customer Tier Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customer-tiers/{id} [put]
+func (c *CustomerTierController) UpdateCustomerTier(ctx *gin.Context) {
+	var payload schemas.UpdateCustomerTier
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer tier id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	if _, err := c.db.GetCustomerTierByID(ctx, id); err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "customer tier not found",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	args := &db.UpdateCustomerTierParams{
+		ID:              id,
+		Name:            payload.Name,
+		MinSpend:        strconv.FormatFloat(*payload.MinSpend, 'f', 2, 64),
+		DiscountPercent: strconv.FormatFloat(payload.DiscountPercent, 'f', 2, 64),
+		UpdatedBy:       sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	customerTier, err := c.db.UpdateCustomerTier(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "updated successfully",
+		"data":    customerTierData(customerTier),
+	})
+}
+
+// GetCustomerTierPrices godoc
+// @Security BearerAuth
+// @Summary Get tier price list
+// @Description Retrieve the member prices of a tier, products without a member price get the tier discount percent
+// @Tags customer-tiers
+// @Produce json
+// @Param id path int true "Customer Tier ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customer-tiers/{id}/prices [get]
+func (c *CustomerTierController) GetCustomerTierPrices(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer tier id",
+		})
+		return
+	}
+
+	prices, err := c.db.GetCustomerTierPrices(ctx, id)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.CustomerTierPriceData, len(prices))
+	for i, p := range prices {
+		Price, _ := strconv.ParseFloat(p.Price, 64)
+		ProductPrice, _ := strconv.ParseFloat(p.ProductPrice, 64)
+		data[i] = schemas.CustomerTierPriceData{
+			ProductID:    p.ProductID,
+			ProductName:  p.ProductName,
+			ProductPrice: ProductPrice,
+			Price:        Price,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// SetCustomerTierPrice godoc
+// @Security BearerAuth
+// @Summary Set tier member price
+// @Description Create or update the member price of a product for a tier
+// @Tags customer-tiers
+// @Accept json
+// @Produce json
+// @Param id path int true "Customer Tier ID"
+// @Param payload body schemas.SetCustomerTierPrice true "Tier Price Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customer-tiers/{id}/prices [put]
+func (c *CustomerTierController) SetCustomerTierPrice(ctx *gin.Context) {
+	var payload schemas.SetCustomerTierPrice
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer tier id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	if _, err := c.db.GetCustomerTierByID(ctx, id); err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "customer tier not found",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	product, err := c.db.GetProductByID(ctx, payload.ProductID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "product not found",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	args := &db.UpsertCustomerTierPriceParams{
+		TierID:    id,
+		ProductID: product.ID,
+		Price:     strconv.FormatFloat(payload.Price, 'f', 2, 64),
+		CreatedBy: sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	price, err := c.db.UpsertCustomerTierPrice(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	Price, _ := strconv.ParseFloat(price.Price, 64)
+	ProductPrice, _ := strconv.ParseFloat(product.Price, 64)
+	data := schemas.CustomerTierPriceData{
+		ProductID:    product.ID,
+		ProductName:  product.Name,
+		ProductPrice: ProductPrice,
+		Price:        Price,
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "saved successfully",
+		"data":    data,
+	})
+}
+
+// DeleteCustomerTierPrice godoc
+// @Security BearerAuth
+// @Summary Delete tier member price
+// @Description Remove the member price of a product, the tier discount percent applies again
+// @Tags customer-tiers
+// @Produce json
+// @Param id path int true "Customer Tier ID"
+// @Param product_id path int true "Product ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customer-tiers/{id}/prices/{product_id} [delete]
+func (c *CustomerTierController) DeleteCustomerTierPrice(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer tier id",
+		})
+		return
+	}
+
+	productID, err := strconv.ParseInt(ctx.Param("product_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid product id",
+		})
+		return
+	}
+
+	args := &db.DeleteCustomerTierPriceParams{
+		TierID:    id,
+		ProductID: productID,
+	}
+	if _, err := c.db.DeleteCustomerTierPrice(ctx, *args); err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "tier price not found",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "deleted successfully",
+	})
+}
+
+// RecalculateTiers godoc
+// @Security BearerAuth
+// @Summary Recalculate customer tiers
+// @Description Recalculate the tier of every customer from the rolling spend now instead of waiting for the daily job
+// @Tags customer-tiers
+// @Produce json
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customer-tiers/recalculate [post]
+func (c *CustomerTierController) RecalculateTiers(ctx *gin.Context) {
+	data, err := c.recalculate(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "customer tiers recalculated successfully",
+		"data":    data,
+	})
+}
+
+// GetCustomerTier godoc
+// @Security BearerAuth
+// @Summary Get customer tier
+// @Description Show the current tier, rolling spend, spend needed for the next tier and the tier change history of a customer
+// @Tags customers
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of items per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customers/{id}/tier [get]
+func (c *CustomerTierController) GetCustomerTier(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer id",
+		})
+		return
+	}
+
+	var page = ctx.DefaultQuery("page", "1")
+	var limit = ctx.DefaultQuery("limit", "10")
+
+	reqPageID, _ := strconv.Atoi(page)
+	reqLimit, _ := strconv.Atoi(limit)
+	offset := (reqPageID - 1) * reqLimit
+
+	customer, err := c.db.GetCustomerByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "customer not found",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	spendArgs := &db.GetCustomerRollingSpendParams{
+		Since:      c.windowStart(),
+		CustomerID: customer.ID,
+	}
+	spends, err := c.db.GetCustomerRollingSpend(ctx, *spendArgs)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	RollingSpend := 0.0
+	if len(spends) > 0 {
+		RollingSpend, _ = strconv.ParseFloat(spends[0].TotalSpent, 64)
+	}
+
+	tiers, err := c.db.GetAllCustomerTiers(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := schemas.CustomerTierStatusData{
+		CustomerID:    customer.ID,
+		RollingSpend:  RollingSpend,
+		WindowDays:    c.windowDays,
+		TierUpdatedAt: common.ConvertNullTime(customer.TierUpdatedAt),
+		History:       []schemas.CustomerTierChangeData{},
+	}
+
+	// tier berikutnya dihitung dari tier yang tersimpan, tier baru berlaku setelah job berjalan
+	current := tier.Tier{MinSpend: -1}
+	for _, t := range tiers {
+		if customer.TierID.Valid && t.ID == customer.TierID.Int64 {
+			tierData := customerTierData(t)
+			data.Tier = &tierData
+			current = tierFromRow(t)
+		}
+	}
+	if next, ok := tier.Next(tiersFromRows(tiers), current); ok {
+		for _, t := range tiers {
+			if t.ID == next.ID {
+				nextData := customerTierData(t)
+				data.NextTier = &nextData
+			}
+		}
+		if next.MinSpend > RollingSpend {
+			data.SpendToNext = next.MinSpend - RollingSpend
+		}
+	}
+
+	historyArgs := &db.GetCustomerTierChangesParams{
+		CustomerID: customer.ID,
+		Limit:      int32(reqLimit),
+		Offset:     int32(offset),
+	}
+	changes, err := c.db.GetCustomerTierChanges(ctx, *historyArgs)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	for _, change := range changes {
+		RollingSpend, _ := strconv.ParseFloat(change.RollingSpend, 64)
+		data.History = append(data.History, schemas.CustomerTierChangeData{
+			OldTierID:    common.ConvertNullInt64(change.OldTierID),
+			OldTierName:  common.ConvertNullString(change.OldTierName),
+			NewTierID:    common.ConvertNullInt64(change.NewTierID),
+			NewTierName:  common.ConvertNullString(change.NewTierName),
+			RollingSpend: RollingSpend,
+			ChangedAt:    common.ConvertNullTime(change.ChangedAt),
+		})
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// RecalculateCustomerTiers menghitung ulang tier semua pelanggan, dijalankan oleh scheduler
+func (c *CustomerTierController) RecalculateCustomerTiers(ctx context.Context) error {
+	_, err := c.recalculate(ctx)
+	return err
+}
+
+// recalculate menentukan tier pelanggan dari total belanja dalam rentang hari tertentu
+// dan mencatat setiap perubahan tier
+func (c *CustomerTierController) recalculate(ctx context.Context) (schemas.RecalculateTiersData, error) {
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		return schemas.RecalculateTiersData{}, err
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	rows, err := qtx.GetAllCustomerTiers(ctx)
+	if err != nil {
+		return schemas.RecalculateTiersData{}, err
+	}
+	tiers := tiersFromRows(rows)
+
+	spendArgs := &db.GetCustomerRollingSpendParams{
+		Since: c.windowStart(),
+	}
+	spends, err := qtx.GetCustomerRollingSpend(ctx, *spendArgs)
+	if err != nil {
+		return schemas.RecalculateTiersData{}, err
+	}
+
+	data := schemas.RecalculateTiersData{Customers: len(spends)}
+	for _, spend := range spends {
+		TotalSpent, _ := strconv.ParseFloat(spend.TotalSpent, 64)
+		NewTier, found := tier.Assign(tiers, TotalSpent)
+		NewTierID := sql.NullInt64{Int64: NewTier.ID, Valid: found}
+		if NewTierID == spend.TierID {
+			continue
+		}
+
+		tierArgs := db.SetCustomerTierParams{ID: spend.CustomerID, TierID: NewTierID}
+		if err := qtx.SetCustomerTier(ctx, tierArgs); err != nil {
+			return schemas.RecalculateTiersData{}, err
+		}
+
+		changeArgs := &db.CreateCustomerTierChangeParams{
+			CustomerID:   spend.CustomerID,
+			OldTierID:    spend.TierID,
+			NewTierID:    NewTierID,
+			RollingSpend: spend.TotalSpent,
+		}
+		if _, err := qtx.CreateCustomerTierChange(ctx, *changeArgs); err != nil {
+			return schemas.RecalculateTiersData{}, err
+		}
+		data.Changed++
+	}
+	return data, tx.Commit()
+}
+
+func (c *CustomerTierController) windowStart() time.Time {
+	return time.Now().AddDate(0, 0, -c.windowDays)
+}
+
+// loadCustomerTier memuat tier pelanggan beserta daftar harga membernya
+func loadCustomerTier(ctx context.Context, q *db.Queries, customerID int64) (tier.Tier, bool, error) {
+	customer, err := q.GetCustomerByID(ctx, customerID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return tier.Tier{}, false, nil
+		}
+		return tier.Tier{}, false, err
+	}
+	if !customer.TierID.Valid {
+		return tier.Tier{}, false, nil
+	}
+
+	row, err := q.GetCustomerTierByID(ctx, customer.TierID.Int64)
+	if err != nil {
+		return tier.Tier{}, false, err
+	}
+	memberTier := tierFromRow(row)
+
+	prices, err := q.GetCustomerTierPrices(ctx, row.ID)
+	if err != nil {
+		return tier.Tier{}, false, err
+	}
+	memberTier.Prices = make(map[int64]float64, len(prices))
+	for _, p := range prices {
+		Price, _ := strconv.ParseFloat(p.Price, 64)
+		memberTier.Prices[p.ProductID] = Price
+	}
+	return memberTier, true, nil
+}
+
+func tierFromRow(row db.CustomerTier) tier.Tier {
+	MinSpend, _ := strconv.ParseFloat(row.MinSpend, 64)
+	DiscountPercent, _ := strconv.ParseFloat(row.DiscountPercent, 64)
+	return tier.Tier{
+		ID:              row.ID,
+		Code:            row.Code,
+		Name:            row.Name,
+		MinSpend:        MinSpend,
+		DiscountPercent: DiscountPercent,
+	}
+}
+
+func tiersFromRows(rows []db.CustomerTier) []tier.Tier {
+	tiers := make([]tier.Tier, len(rows))
+	for i, row := range rows {
+		tiers[i] = tierFromRow(row)
+	}
+	return tiers
+}
+
+func customerTierData(row db.CustomerTier) schemas.CustomerTierData {
+	MinSpend, _ := strconv.ParseFloat(row.MinSpend, 64)
+	DiscountPercent, _ := strconv.ParseFloat(row.DiscountPercent, 64)
+	return schemas.CustomerTierData{
+		ID:              row.ID,
+		Code:            row.Code,
+		Name:            row.Name,
+		MinSpend:        MinSpend,
+		DiscountPercent: DiscountPercent,
+		UpdatedBy:       common.ConvertNullInt64(row.UpdatedBy),
+		UpdatedAt:       common.ConvertNullTime(row.UpdatedAt),
+	}
+}
@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	db "pos-api/db/sqlc"
+)
+
+func TestRecalculateTierThresholds(t *testing.T) {
+	q, sqlDB := testDB(t)
+	ctx := context.Background()
+	c := NewCustomerTierController(q, sqlDB, 365, ctx)
+
+	tiers, err := q.GetAllCustomerTiers(ctx)
+	if err != nil {
+		t.Fatalf("GetAllCustomerTiers() error = %v", err)
+	}
+	tierID := map[string]int64{}
+	for _, row := range tiers {
+		tierID[row.Code] = row.ID
+	}
+
+	// tier bawaan: silver 1.000.000, gold 5.000.000, platinum 15.000.000
+	tests := []struct {
+		name   string
+		orders []string
+		want   string
+	}{
+		{"below the lowest threshold", []string{"999999"}, ""},
+		{"exactly the silver threshold", []string{"1000000"}, "silver"},
+		{"orders add up to gold", []string{"3000000", "2000000"}, "gold"},
+		{"above platinum", []string{"20000000"}, "platinum"},
+	}
+
+	customers := make([]db.Customer, len(tests))
+	for i, tt := range tests {
+		customers[i] = createTestCustomer(t, q, tt.name)
+		for _, total := range tt.orders {
+			createCustomerOrder(t, q, customers[i], total, "order")
+		}
+	}
+
+	if _, err := c.recalculate(ctx); err != nil {
+		t.Fatalf("recalculate() error = %v", err)
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			customer, err := q.GetCustomerByID(ctx, customers[i].ID)
+			if err != nil {
+				t.Fatalf("GetCustomerByID() error = %v", err)
+			}
+			if customer.TierID.Int64 != tierID[tt.want] || customer.TierID.Valid != (tt.want != "") {
+				t.Errorf("tier = %v, want %q", customer.TierID, tt.want)
+			}
+
+			changes, err := q.GetCustomerTierChanges(ctx, db.GetCustomerTierChangesParams{CustomerID: customer.ID, Limit: 10})
+			if err != nil {
+				t.Fatalf("GetCustomerTierChanges() error = %v", err)
+			}
+			if want := map[bool]int{true: 1, false: 0}[tt.want != ""]; len(changes) != want {
+				t.Errorf("tier changes = %d, want %d", len(changes), want)
+			}
+		})
+	}
+
+	// perhitungan ulang tanpa order baru tidak mencatat perubahan untuk pelanggan yang sama
+	if _, err := c.recalculate(ctx); err != nil {
+		t.Fatalf("recalculate() error = %v", err)
+	}
+	changes, err := q.GetCustomerTierChanges(ctx, db.GetCustomerTierChangesParams{CustomerID: customers[2].ID, Limit: 10})
+	if err != nil || len(changes) != 1 {
+		t.Errorf("tier changes after a second run = %d, %v, want 1", len(changes), err)
+	}
+}
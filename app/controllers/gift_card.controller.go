@@ -0,0 +1,706 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/giftcard"
+	"pos-api/util/payment"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GiftCardController struct {
+	db    *db.Queries
+	sqlDB *sql.DB
+	ctx   context.Context
+}
+
+func NewGiftCardController(db *db.Queries, sqlDB *sql.DB, ctx context.Context) *GiftCardController {
+	return &GiftCardController{db, sqlDB, ctx}
+}
+
+// GetAllGiftCards godoc
+// @Security BearerAuth
+// @Summary Get all gift cards
+// @Description Retrieve gift cards and store credit accounts with pagination
+// @Tags gift-cards
+// @Produce json
+// @Param type query string false "gift_card or store_credit"
+// @Param status query string false "pending, active, void or expired"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of items per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/gift-cards [get]
+func (c *GiftCardController) GetAllGiftCards(ctx *gin.Context) {
+	var page = ctx.DefaultQuery("page", "1")
+	var limit = ctx.DefaultQuery("limit", "10")
+
+	reqPageID, _ := strconv.Atoi(page)
+	reqLimit, _ := strconv.Atoi(limit)
+	offset := (reqPageID - 1) * reqLimit
+
+	args := &db.GetAllGiftCardsParams{
+		Type:   ctx.Query("type"),
+		Status: ctx.Query("status"),
+		Limit:  int32(reqLimit),
+		Offset: int32(offset),
+	}
+
+	cards, err := c.db.GetAllGiftCards(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.GiftCardData, len(cards))
+	for i, card := range cards {
+		data[i] = giftCardData(card)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// GetGiftCardById godoc
+// @Security BearerAuth
+// @Summary Get gift card by id
+// @Description Show a gift card or store credit account with its ledger of issues, redemptions, reversals and expiries
+// @Tags gift-cards
+// @Produce json
+// @Param id path int true "Gift Card ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of items per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/gift-cards/{id} [get]
+func (c *GiftCardController) GetGiftCardById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid gift card id",
+		})
+		return
+	}
+
+	var page = ctx.DefaultQuery("page", "1")
+	var limit = ctx.DefaultQuery("limit", "10")
+
+	reqPageID, _ := strconv.Atoi(page)
+	reqLimit, _ := strconv.Atoi(limit)
+	offset := (reqPageID - 1) * reqLimit
+
+	card, err := c.db.GetGiftCardByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "gift card not found",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	Transactions, err := loadGiftCardTransactions(ctx, c.db, card.ID, int32(reqLimit), int32(offset))
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := schemas.GiftCardDetailData{
+		GiftCardData: giftCardData(card),
+		Transactions: Transactions,
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// CheckGiftCardBalance godoc
+// @Security BearerAuth
+// @Summary Check gift card balance
+// @Description Check the balance, status and expiry of a gift card with its code and PIN. The card is locked for a while after too many wrong PIN attempts
+// @Tags gift-cards
+// @Accept json
+// @Produce json
+// @Param payload body schemas.CheckGiftCardBalance true "Gift Card Code and PIN"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 429 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/gift-cards/balance [post]
+func (c *GiftCardController) CheckGiftCardBalance(ctx *gin.Context) {
+	var payload schemas.CheckGiftCardBalance
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	card, err := c.db.GetGiftCardByCode(ctx, giftcard.NormalizeCode(payload.Code))
+	if err != nil && err != sql.ErrNoRows {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err == sql.ErrNoRows {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": giftcard.ErrInvalidCard.Error(),
+		})
+		return
+	}
+
+	if err := checkGiftCardPIN(ctx, c.db, card, payload.Pin); err != nil {
+		if errors.Is(err, giftcard.ErrCardLocked) {
+			ctx.JSON(http.StatusTooManyRequests, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		if errors.Is(err, giftcard.ErrInvalidCard) {
+			if err := recordGiftCardPINFailure(ctx, c.db, card.Code); err != nil {
+				ctx.JSON(http.StatusBadGateway, gin.H{
+					"status":  "failed",
+					"message": err.Error(),
+				})
+				return
+			}
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    giftCardData(card),
+	})
+}
+
+// GetCustomerStoreCredit godoc
+// @Security BearerAuth
+// @Summary Get customer store credit
+// @Description Show the store credit balance and its history of a customer
+// @Tags customers
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of items per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customers/{id}/store-credit [get]
+func (c *GiftCardController) GetCustomerStoreCredit(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer id",
+		})
+		return
+	}
+
+	var page = ctx.DefaultQuery("page", "1")
+	var limit = ctx.DefaultQuery("limit", "10")
+
+	reqPageID, _ := strconv.Atoi(page)
+	reqLimit, _ := strconv.Atoi(limit)
+	offset := (reqPageID - 1) * reqLimit
+
+	customer, err := c.db.GetCustomerByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "customer not found",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := schemas.CustomerStoreCreditData{
+		CustomerID: customer.ID,
+		MemberCode: customer.MemberCode,
+		History:    []schemas.GiftCardTransactionData{},
+	}
+
+	account, err := c.db.GetStoreCreditByCustomerID(ctx, sql.NullInt64{Int64: customer.ID, Valid: true})
+	if err != nil && err != sql.ErrNoRows {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err == nil {
+		data.Code = account.Code
+		data.Balance, _ = strconv.ParseFloat(account.Balance, 64)
+		data.History, err = loadGiftCardTransactions(ctx, c.db, account.ID, int32(reqLimit), int32(offset))
+		if err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// ExpireGiftCards menghanguskan saldo gift card yang sudah melewati masa berlaku
+func (c *GiftCardController) ExpireGiftCards(ctx context.Context) error {
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	cards, err := qtx.GetExpiredGiftCards(ctx, sql.NullTime{Time: time.Now(), Valid: true})
+	if err != nil {
+		return err
+	}
+
+	for _, card := range cards {
+		if err := closeGiftCard(ctx, qtx, card, giftcard.StatusExpired, giftcard.TxExpire, "Gift card expired", 0); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// issueGiftCard menerbitkan gift card baru dan mengembalikan PIN-nya (hanya tersedia saat ini)
+func issueGiftCard(ctx context.Context, q *db.Queries, amount float64, customerID int64, orderID int64, status string, expiryDays int, userID int64) (db.GiftCard, string, error) {
+	Code, err := giftcard.GenerateCode("GC")
+	if err != nil {
+		return db.GiftCard{}, "", err
+	}
+
+	Pin, err := giftcard.GeneratePIN()
+	if err != nil {
+		return db.GiftCard{}, "", err
+	}
+
+	PinHash, err := giftcard.HashPIN(Pin)
+	if err != nil {
+		return db.GiftCard{}, "", err
+	}
+
+	ExpiresAt, Expires := giftcard.ExpiresAt(time.Now(), expiryDays)
+	Amount := strconv.FormatFloat(giftcard.Round(amount), 'f', 2, 64)
+	args := &db.CreateGiftCardParams{
+		Code:          Code,
+		PinHash:       sql.NullString{String: PinHash, Valid: true},
+		Type:          giftcard.TypeGiftCard,
+		CustomerID:    sql.NullInt64{Int64: customerID, Valid: customerID != 0},
+		OrderID:       sql.NullInt64{Int64: orderID, Valid: orderID != 0},
+		InitialAmount: Amount,
+		Balance:       Amount,
+		Status:        status,
+		ExpiresAt:     sql.NullTime{Time: ExpiresAt, Valid: Expires},
+		CreatedBy:     sql.NullInt64{Int64: userID, Valid: userID != 0},
+	}
+
+	card, err := q.CreateGiftCard(ctx, *args)
+	if err != nil {
+		return db.GiftCard{}, "", err
+	}
+
+	Description := "Gift card issued"
+	if orderID != 0 {
+		Description = "Gift card sold"
+	}
+	if err := createGiftCardTransaction(ctx, q, card.ID, orderID, giftcard.TxIssue, amount, amount, Description, userID); err != nil {
+		return db.GiftCard{}, "", err
+	}
+	return card, Pin, nil
+}
+
+// issueStoreCredit menambah saldo store credit pelanggan. Akun store credit dibuat
+// saat pertama kali pelanggan menerima store credit dan tidak memiliki masa berlaku.
+func issueStoreCredit(ctx context.Context, q *db.Queries, customerID int64, orderID int64, amount float64, txType string, description string, userID int64) (db.GiftCard, error) {
+	account, err := q.GetStoreCreditByCustomerIDForUpdate(ctx, sql.NullInt64{Int64: customerID, Valid: true})
+	if err == sql.ErrNoRows {
+		Code, err := giftcard.GenerateCode("SC")
+		if err != nil {
+			return db.GiftCard{}, err
+		}
+
+		args := &db.CreateGiftCardParams{
+			Code:          Code,
+			Type:          giftcard.TypeStoreCredit,
+			CustomerID:    sql.NullInt64{Int64: customerID, Valid: true},
+			InitialAmount: "0",
+			Balance:       "0",
+			Status:        giftcard.StatusActive,
+			CreatedBy:     sql.NullInt64{Int64: userID, Valid: userID != 0},
+		}
+		account, err = q.CreateGiftCard(ctx, *args)
+		if err != nil {
+			return db.GiftCard{}, err
+		}
+	} else if err != nil {
+		return db.GiftCard{}, err
+	}
+
+	Balance, _ := strconv.ParseFloat(account.Balance, 64)
+	Balance = giftcard.Round(Balance + amount)
+
+	account, err = q.UpdateGiftCardBalance(ctx, db.UpdateGiftCardBalanceParams{
+		ID:      account.ID,
+		Balance: strconv.FormatFloat(Balance, 'f', 2, 64),
+		Status:  giftcard.StatusActive,
+	})
+	if err != nil {
+		return db.GiftCard{}, err
+	}
+
+	if err := createGiftCardTransaction(ctx, q, account.ID, orderID, txType, amount, Balance, description, userID); err != nil {
+		return db.GiftCard{}, err
+	}
+	return account, nil
+}
+
+// redeemStoredValue memotong saldo gift card (kode di reference dan PIN) atau store
+// credit pelanggan untuk satu pembayaran order
+func redeemStoredValue(ctx context.Context, q *db.Queries, pay payment.Payment, customerID int64, orderID int64, userID int64) error {
+	if pay.Applied <= 0 {
+		return nil
+	}
+
+	var card db.GiftCard
+	var err error
+	switch pay.Type {
+	case payment.TypeGiftCard:
+		card, err = q.GetGiftCardByCodeForUpdate(ctx, giftcard.NormalizeCode(pay.Reference))
+		if err == sql.ErrNoRows {
+			return giftcard.ErrInvalidCard
+		}
+		if err != nil {
+			return err
+		}
+		if err := checkGiftCardPIN(ctx, q, card, pay.Pin); err != nil {
+			return err
+		}
+	case payment.TypeStoreCredit:
+		if customerID == 0 {
+			return giftcard.ErrMemberRequired
+		}
+		card, err = q.GetStoreCreditByCustomerIDForUpdate(ctx, sql.NullInt64{Int64: customerID, Valid: true})
+		if err == sql.ErrNoRows {
+			return giftcard.ErrNoStoreCredit
+		}
+		if err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	Balance, _ := strconv.ParseFloat(card.Balance, 64)
+	if err := giftcard.Usable(card.Status, common.ConvertNullTime(card.ExpiresAt), Balance, pay.Applied, time.Now()); err != nil {
+		if card.Type == giftcard.TypeStoreCredit && errors.Is(err, giftcard.ErrInsufficientBalance) {
+			return giftcard.ErrNoStoreCredit
+		}
+		return err
+	}
+
+	Balance = giftcard.Round(Balance - pay.Applied)
+	if _, err := q.UpdateGiftCardBalance(ctx, db.UpdateGiftCardBalanceParams{
+		ID:      card.ID,
+		Balance: strconv.FormatFloat(Balance, 'f', 2, 64),
+		Status:  card.Status,
+	}); err != nil {
+		return err
+	}
+
+	return createGiftCardTransaction(ctx, q, card.ID, orderID, giftcard.TxRedeem, -pay.Applied, Balance, "Payment", userID)
+}
+
+// checkGiftCardPIN memeriksa PIN gift card yang tidak sedang dikunci. Hitungan PIN
+// salah direset setelah PIN benar.
+func checkGiftCardPIN(ctx context.Context, q *db.Queries, card db.GiftCard, pin string) error {
+	if giftcard.Locked(common.ConvertNullTime(card.LockedUntil), time.Now()) {
+		return giftcard.ErrCardLocked
+	}
+	if card.Type != giftcard.TypeGiftCard || !giftcard.CheckPIN(common.ConvertNullString(card.PinHash), pin) {
+		return giftcard.ErrInvalidCard
+	}
+	return q.ResetGiftCardPinAttempts(ctx, card.ID)
+}
+
+// recordGiftCardPINFailure menambah hitungan PIN salah dan mengunci gift card setelah
+// giftcard.MaxPINAttempts kali. Dipanggil di luar transaksi order supaya hitungannya
+// tidak ikut di-rollback.
+func recordGiftCardPINFailure(ctx context.Context, q *db.Queries, code string) error {
+	Code := giftcard.NormalizeCode(code)
+	Attempts, err := q.IncrementGiftCardPinAttempts(ctx, Code)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if Attempts < giftcard.MaxPINAttempts {
+		return nil
+	}
+
+	return q.LockGiftCardPin(ctx, db.LockGiftCardPinParams{
+		Code:        Code,
+		LockedUntil: sql.NullTime{Time: time.Now().Add(giftcard.PINLockout), Valid: true},
+	})
+}
+
+// reverseOrderStoredValue mengembalikan saldo gift card dan store credit yang dipakai
+// membayar order yang di-refund atau pembayarannya kedaluwarsa
+func reverseOrderStoredValue(ctx context.Context, q *db.Queries, orderID int64, userID int64) error {
+	redemptions, err := q.GetGiftCardRedemptionsByOrderID(ctx, sql.NullInt64{Int64: orderID, Valid: true})
+	if err != nil {
+		return err
+	}
+
+	for _, redemption := range redemptions {
+		card, err := q.GetGiftCardByIDForUpdate(ctx, redemption.GiftCardID)
+		if err != nil {
+			return err
+		}
+
+		Amount, _ := strconv.ParseFloat(redemption.Amount, 64)
+		Balance, _ := strconv.ParseFloat(card.Balance, 64)
+		Balance = giftcard.Round(Balance - Amount)
+
+		if _, err := q.UpdateGiftCardBalance(ctx, db.UpdateGiftCardBalanceParams{
+			ID:      card.ID,
+			Balance: strconv.FormatFloat(Balance, 'f', 2, 64),
+			Status:  card.Status,
+		}); err != nil {
+			return err
+		}
+
+		if err := createGiftCardTransaction(ctx, q, card.ID, orderID, giftcard.TxReverse, -Amount, Balance, "Order payment reversed", userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// activateOrderGiftCards mengaktifkan gift card yang dijual order setelah pembayarannya lunas
+func activateOrderGiftCards(ctx context.Context, q *db.Queries, orderID int64) error {
+	cards, err := q.GetGiftCardsByOrderID(ctx, sql.NullInt64{Int64: orderID, Valid: true})
+	if err != nil {
+		return err
+	}
+
+	for _, card := range cards {
+		if card.Status != giftcard.StatusPending {
+			continue
+		}
+		if _, err := q.UpdateGiftCardBalance(ctx, db.UpdateGiftCardBalanceParams{
+			ID:      card.ID,
+			Balance: card.Balance,
+			Status:  giftcard.StatusActive,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// voidOrderGiftCards membatalkan gift card yang dijual order yang di-refund atau
+// kedaluwarsa. Gift card yang saldonya sudah terpakai tidak bisa dibatalkan.
+func voidOrderGiftCards(ctx context.Context, q *db.Queries, orderID int64, userID int64) error {
+	cards, err := q.GetGiftCardsByOrderID(ctx, sql.NullInt64{Int64: orderID, Valid: true})
+	if err != nil {
+		return err
+	}
+
+	for _, card := range cards {
+		if card.Status == giftcard.StatusVoid {
+			continue
+		}
+		InitialAmount, _ := strconv.ParseFloat(card.InitialAmount, 64)
+		Balance, _ := strconv.ParseFloat(card.Balance, 64)
+		if giftcard.Round(Balance) < giftcard.Round(InitialAmount) {
+			return giftcard.ErrCardUsed
+		}
+		if err := closeGiftCard(ctx, q, card, giftcard.StatusVoid, giftcard.TxVoid, "Gift card voided", userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transferStoreCredit memindahkan seluruh saldo store credit pelanggan ke pelanggan lain
+func transferStoreCredit(ctx context.Context, q *db.Queries, fromCustomerID int64, toCustomerID int64, description string, userID int64) error {
+	account, err := q.GetStoreCreditByCustomerIDForUpdate(ctx, sql.NullInt64{Int64: fromCustomerID, Valid: true})
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	Balance, _ := strconv.ParseFloat(account.Balance, 64)
+	if giftcard.Round(Balance) <= 0 {
+		return nil
+	}
+
+	if _, err := q.UpdateGiftCardBalance(ctx, db.UpdateGiftCardBalanceParams{
+		ID:      account.ID,
+		Balance: "0",
+		Status:  account.Status,
+	}); err != nil {
+		return err
+	}
+	if err := createGiftCardTransaction(ctx, q, account.ID, 0, giftcard.TxTransfer, -Balance, 0, description, userID); err != nil {
+		return err
+	}
+
+	_, err = issueStoreCredit(ctx, q, toCustomerID, 0, Balance, giftcard.TxTransfer, description, userID)
+	return err
+}
+
+// closeGiftCard mengosongkan saldo kartu dengan status dan jenis mutasi tertentu
+func closeGiftCard(ctx context.Context, q *db.Queries, card db.GiftCard, status string, txType string, description string, userID int64) error {
+	if _, err := q.UpdateGiftCardBalance(ctx, db.UpdateGiftCardBalanceParams{
+		ID:      card.ID,
+		Balance: "0",
+		Status:  status,
+	}); err != nil {
+		return err
+	}
+
+	Balance, _ := strconv.ParseFloat(card.Balance, 64)
+	if giftcard.Round(Balance) <= 0 {
+		return nil
+	}
+	return createGiftCardTransaction(ctx, q, card.ID, common.ConvertNullInt64(card.OrderID), txType, -Balance, 0, description, userID)
+}
+
+func createGiftCardTransaction(ctx context.Context, q *db.Queries, cardID int64, orderID int64, txType string, amount float64, balance float64, description string, userID int64) error {
+	args := &db.CreateGiftCardTransactionParams{
+		GiftCardID:  cardID,
+		OrderID:     sql.NullInt64{Int64: orderID, Valid: orderID != 0},
+		Type:        txType,
+		Amount:      strconv.FormatFloat(giftcard.Round(amount), 'f', 2, 64),
+		Balance:     strconv.FormatFloat(giftcard.Round(balance), 'f', 2, 64),
+		Description: sql.NullString{String: description, Valid: description != ""},
+		CreatedBy:   sql.NullInt64{Int64: userID, Valid: userID != 0},
+	}
+	_, err := q.CreateGiftCardTransaction(ctx, *args)
+	return err
+}
+
+func loadGiftCardTransactions(ctx context.Context, q *db.Queries, cardID int64, limit int32, offset int32) ([]schemas.GiftCardTransactionData, error) {
+	args := &db.GetGiftCardTransactionsParams{
+		GiftCardID: cardID,
+		Limit:      limit,
+		Offset:     offset,
+	}
+	transactions, err := q.GetGiftCardTransactions(ctx, *args)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]schemas.GiftCardTransactionData, len(transactions))
+	for i, t := range transactions {
+		Amount, _ := strconv.ParseFloat(t.Amount, 64)
+		Balance, _ := strconv.ParseFloat(t.Balance, 64)
+		data[i] = schemas.GiftCardTransactionData{
+			ID:          t.ID,
+			OrderID:     common.ConvertNullInt64(t.OrderID),
+			Type:        t.Type,
+			Amount:      Amount,
+			Balance:     Balance,
+			Description: common.ConvertNullString(t.Description),
+			CreatedAt:   common.ConvertNullTime(t.CreatedAt),
+		}
+	}
+	return data, nil
+}
+
+func giftCardData(card db.GiftCard) schemas.GiftCardData {
+	InitialAmount, _ := strconv.ParseFloat(card.InitialAmount, 64)
+	Balance, _ := strconv.ParseFloat(card.Balance, 64)
+	return schemas.GiftCardData{
+		ID:            card.ID,
+		Code:          card.Code,
+		Type:          card.Type,
+		CustomerID:    common.ConvertNullInt64(card.CustomerID),
+		OrderID:       common.ConvertNullInt64(card.OrderID),
+		InitialAmount: InitialAmount,
+		Balance:       Balance,
+		Status:        card.Status,
+		ExpiresAt:     common.ConvertNullTime(card.ExpiresAt),
+		CreatedAt:     common.ConvertNullTime(card.CreatedAt),
+	}
+}
+
+func issuedGiftCardData(card db.GiftCard, pin string) schemas.IssuedGiftCardData {
+	Amount, _ := strconv.ParseFloat(card.InitialAmount, 64)
+	return schemas.IssuedGiftCardData{
+		ID:        card.ID,
+		Code:      card.Code,
+		Pin:       pin,
+		Amount:    Amount,
+		Status:    card.Status,
+		ExpiresAt: common.ConvertNullTime(card.ExpiresAt),
+	}
+}
@@ -0,0 +1,259 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/costing"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InventoryController struct {
+	db     *db.Queries
+	method string // metode penilaian persediaan (fifo atau average)
+	ctx    context.Context
+}
+
+func NewInventoryController(db *db.Queries, method string, ctx context.Context) *InventoryController {
+	return &InventoryController{db, method, ctx}
+}
+
+// GetInventoryValuation godoc
+// @Security BearerAuth
+// @Summary Get inventory valuation
+// @Description Get quantity and value of stock on hand per product as of a date, valued with the configured method (FIFO or weighted average)
+// @Tags inventory
+// @Produce json
+// @Param as_of query string false "Valuation date (YYYY-MM-DD), defaults to now"
+// @Param category_id query int false "Filter by category ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400,502 {object} schemas.Response
+// @Router /api/v1/inventory/valuation [get]
+func (c *InventoryController) GetInventoryValuation(ctx *gin.Context) {
+	AsOf := time.Now()
+	if value := ctx.Query("as_of"); value != "" {
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": "invalid as_of date, use YYYY-MM-DD",
+			})
+			return
+		}
+		// sampai akhir hari yang diminta
+		AsOf = date.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	CategoryID, _ := strconv.ParseInt(ctx.DefaultQuery("category_id", "0"), 10, 64)
+
+	args := &db.GetInventoryValuationParams{
+		AsOf:       AsOf,
+		CategoryID: CategoryID,
+	}
+
+	rows, err := c.db.GetInventoryValuation(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	report := schemas.InventoryValuationData{
+		AsOf:     AsOf,
+		Method:   c.method,
+		Products: make([]schemas.InventoryValuationItem, len(rows)),
+	}
+	for i, row := range rows {
+		Value, _ := strconv.ParseFloat(row.Value, 64)
+		UnitCost := 0.0
+		if row.Quantity != 0 {
+			UnitCost = costing.RoundCost(Value / float64(row.Quantity))
+		}
+
+		report.Products[i] = schemas.InventoryValuationItem{
+			ProductID:    row.ProductID,
+			ProductName:  row.ProductName,
+			CategoryName: common.ConvertNullString(row.CategoryName),
+			Quantity:     row.Quantity,
+			UnitCost:     UnitCost,
+			Value:        Value,
+		}
+		report.TotalQuantity += row.Quantity
+		report.TotalValue += Value
+	}
+	report.TotalValue = costing.Round(report.TotalValue)
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    report,
+	})
+}
+
+// GetProductCostLayers godoc
+// @Security BearerAuth
+// @Summary Get product cost layers
+// @Description Get the moving average cost and the open cost layers (remaining stock per receipt) of a product
+// @Tags inventory
+// @Produce json
+// @Param id path int true "Product ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400,404,502 {object} schemas.Response
+// @Router /api/v1/inventory/products/{id}/cost-layers [get]
+func (c *InventoryController) GetProductCostLayers(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid product id",
+		})
+		return
+	}
+
+	product, err := c.db.GetProductByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve product with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	layers, err := c.db.GetProductCostLayers(ctx, id)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := schemas.ProductCostData{
+		ProductID: product.ID,
+		Name:      product.Name,
+		Stock:     product.Stock,
+		Cost:      productCost(product),
+		Method:    c.method,
+		Layers:    make([]schemas.CostLayerData, len(layers)),
+	}
+	for i, layer := range layers {
+		UnitCost, _ := strconv.ParseFloat(layer.UnitCost, 64)
+		data.Layers[i] = schemas.CostLayerData{
+			ID:        layer.ID,
+			Source:    layer.Source,
+			TrxRef:    layer.TrxRef,
+			Quantity:  layer.Quantity,
+			Remaining: layer.Remaining,
+			UnitCost:  UnitCost,
+			CreatedAt: common.ConvertNullTime(layer.CreatedAt),
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// receiveStockCost mencatat stok masuk sebagai cost layer baru, memperbarui harga
+// rata-rata produk dan mencatat nilai pergerakan stok. Product adalah kondisi
+// produk sebelum stoknya bertambah.
+func receiveStockCost(ctx context.Context, q *db.Queries, product db.Product, quantity int32, unitCost float64, source string, trxRef string) error {
+	if quantity <= 0 {
+		return nil
+	}
+	unitCost = costing.RoundCost(unitCost)
+
+	layerArgs := db.CreateCostLayerParams{
+		ProductID: product.ID,
+		Source:    source,
+		TrxRef:    trxRef,
+		Quantity:  quantity,
+		UnitCost:  strconv.FormatFloat(unitCost, 'f', 4, 64),
+	}
+	if _, err := q.CreateCostLayer(ctx, layerArgs); err != nil {
+		return err
+	}
+
+	average := costing.Average(product.Stock, productCost(product), quantity, unitCost)
+	costArgs := db.UpdateProductCostParams{
+		ID:   product.ID,
+		Cost: strconv.FormatFloat(average, 'f', 4, 64),
+	}
+	if err := q.UpdateProductCost(ctx, costArgs); err != nil {
+		return err
+	}
+
+	movementArgs := db.CreateStockCostMovementParams{
+		ProductID: product.ID,
+		TrxRef:    trxRef,
+		Quantity:  quantity,
+		UnitCost:  strconv.FormatFloat(unitCost, 'f', 4, 64),
+		Amount:    strconv.FormatFloat(costing.Round(float64(quantity)*unitCost), 'f', 2, 64),
+		Method:    source,
+	}
+	_, err := q.CreateStockCostMovement(ctx, movementArgs)
+	return err
+}
+
+// issueStockCost menghitung harga pokok stok yang keluar dengan metode penilaian
+// yang dikonfigurasi, mengurangi sisa cost layer dan mencatat nilai pergerakan stok
+func issueStockCost(ctx context.Context, q *db.Queries, method string, product db.Product, quantity int32, trxRef string) (costing.Issue, error) {
+	if quantity <= 0 {
+		return costing.Issue{}, nil
+	}
+
+	openLayers, err := q.GetOpenCostLayersForUpdate(ctx, product.ID)
+	if err != nil {
+		return costing.Issue{}, err
+	}
+
+	layers := make([]costing.Layer, len(openLayers))
+	remaining := make(map[int64]int32, len(openLayers))
+	for i, layer := range openLayers {
+		UnitCost, _ := strconv.ParseFloat(layer.UnitCost, 64)
+		layers[i] = costing.Layer{ID: layer.ID, Remaining: layer.Remaining, UnitCost: UnitCost}
+		remaining[layer.ID] = layer.Remaining
+	}
+
+	issue := costing.IssueStock(method, layers, quantity, productCost(product))
+	for _, draw := range issue.Draws {
+		layerArgs := db.UpdateCostLayerRemainingParams{
+			ID:        draw.LayerID,
+			Remaining: remaining[draw.LayerID] - draw.Quantity,
+		}
+		if err := q.UpdateCostLayerRemaining(ctx, layerArgs); err != nil {
+			return issue, err
+		}
+	}
+
+	movementArgs := db.CreateStockCostMovementParams{
+		ProductID: product.ID,
+		TrxRef:    trxRef,
+		Quantity:  -quantity,
+		UnitCost:  strconv.FormatFloat(issue.UnitCost, 'f', 4, 64),
+		Amount:    strconv.FormatFloat(-issue.Total, 'f', 2, 64),
+		Method:    method,
+	}
+	if _, err := q.CreateStockCostMovement(ctx, movementArgs); err != nil {
+		return issue, err
+	}
+	return issue, nil
+}
@@ -0,0 +1,364 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/loyalty"
+
+	"github.com/gin-gonic/gin"
+)
+
+// pointsExpiringWindow adalah rentang waktu poin yang akan hangus yang ditampilkan ke pelanggan
+const pointsExpiringWindow = 30 * 24 * time.Hour
+
+type LoyaltyController struct {
+	db    *db.Queries
+	sqlDB *sql.DB
+	rules loyalty.Rules
+	ctx   context.Context
+}
+
+func NewLoyaltyController(db *db.Queries, sqlDB *sql.DB, rules loyalty.Rules, ctx context.Context) *LoyaltyController {
+	return &LoyaltyController{db, sqlDB, rules, ctx}
+}
+
+// GetCustomerPoints godoc
+// @Security BearerAuth
+// @Summary Get customer loyalty points
+// @Description Show the point balance, its value when redeemed, points expiring in the next 30 days and the point history of a customer
+// @Tags customers
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Number of items per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/customers/{id}/points [get]
+func (c *LoyaltyController) GetCustomerPoints(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid customer id",
+		})
+		return
+	}
+
+	var page = ctx.DefaultQuery("page", "1")
+	var limit = ctx.DefaultQuery("limit", "10")
+
+	reqPageID, _ := strconv.Atoi(page)
+	reqLimit, _ := strconv.Atoi(limit)
+	offset := (reqPageID - 1) * reqLimit
+
+	customer, err := c.db.GetCustomerByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "customer not found",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	Balance, err := c.db.GetCustomerPointBalance(ctx, customer.ID)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	now := time.Now()
+	expiringArgs := &db.GetCustomerExpiringPointsParams{
+		CustomerID: customer.ID,
+		Now:        now,
+		Until:      now.Add(pointsExpiringWindow),
+	}
+	Expiring, err := c.db.GetCustomerExpiringPoints(ctx, *expiringArgs)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	historyArgs := &db.GetCustomerPointHistoryParams{
+		CustomerID: customer.ID,
+		Limit:      int32(reqLimit),
+		Offset:     int32(offset),
+	}
+	history, err := c.db.GetCustomerPointHistory(ctx, *historyArgs)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	History := make([]schemas.LoyaltyPointData, len(history))
+	for i, entry := range history {
+		History[i] = loyaltyPointData(entry)
+	}
+
+	data := schemas.CustomerPointsData{
+		CustomerID:     customer.ID,
+		MemberCode:     customer.MemberCode,
+		Balance:        Balance,
+		BalanceValue:   float64(Balance) * c.rules.PointValue,
+		ExpiringPoints: Expiring,
+		History:        History,
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// ExpirePoints menghanguskan sisa poin yang sudah melewati masa berlaku
+func (c *LoyaltyController) ExpirePoints(ctx context.Context) error {
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	lots, err := qtx.GetExpiredLoyaltyLots(ctx, sql.NullTime{Time: time.Now(), Valid: true})
+	if err != nil {
+		return err
+	}
+
+	for _, lot := range lots {
+		args := &db.CreateLoyaltyPointParams{
+			CustomerID:  lot.CustomerID,
+			OrderID:     lot.OrderID,
+			Type:        loyalty.TypeExpire,
+			Points:      -lot.Remaining,
+			Description: sql.NullString{String: "Points expired", Valid: true},
+		}
+		if _, err := qtx.CreateLoyaltyPoint(ctx, *args); err != nil {
+			return err
+		}
+
+		remainingArgs := db.UpdateLoyaltyPointRemainingParams{ID: lot.ID, Remaining: 0}
+		if err := qtx.UpdateLoyaltyPointRemaining(ctx, remainingArgs); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// awardPoints mencatat poin yang didapat pelanggan dari order
+func awardPoints(ctx context.Context, q *db.Queries, rules loyalty.Rules, customerID int64, orderID int64, points int32, userID int64) error {
+	if points <= 0 {
+		return nil
+	}
+
+	ExpiresAt, Expires := rules.ExpiresAt(time.Now())
+	args := &db.CreateLoyaltyPointParams{
+		CustomerID:  customerID,
+		OrderID:     sql.NullInt64{Int64: orderID, Valid: true},
+		Type:        loyalty.TypeEarn,
+		Points:      points,
+		Remaining:   points,
+		ExpiresAt:   sql.NullTime{Time: ExpiresAt, Valid: Expires},
+		Description: sql.NullString{String: "Points earned from order", Valid: true},
+		CreatedBy:   sql.NullInt64{Int64: userID, Valid: userID != 0},
+	}
+	_, err := q.CreateLoyaltyPoint(ctx, *args)
+	return err
+}
+
+// redeemPoints memotong poin pelanggan dari lot yang paling cepat hangus
+func redeemPoints(ctx context.Context, q *db.Queries, customerID int64, orderID int64, points int32, userID int64) error {
+	lots, err := loadPointLots(ctx, q, customerID, 0)
+	if err != nil {
+		return err
+	}
+
+	usages, _, err := loyalty.Consume(lots, points, false)
+	if err != nil {
+		return err
+	}
+	if err := applyPointUsages(ctx, q, usages); err != nil {
+		return err
+	}
+
+	// poin yang dikembalikan saat refund mengikuti masa berlaku lot yang dipakai
+	ExpiresAt, Expires := loyalty.LatestExpiry(lots, usages)
+	args := &db.CreateLoyaltyPointParams{
+		CustomerID:  customerID,
+		OrderID:     sql.NullInt64{Int64: orderID, Valid: true},
+		Type:        loyalty.TypeRedeem,
+		Points:      -points,
+		ExpiresAt:   sql.NullTime{Time: ExpiresAt, Valid: Expires},
+		Description: sql.NullString{String: "Points redeemed as payment", Valid: true},
+		CreatedBy:   sql.NullInt64{Int64: userID, Valid: userID != 0},
+	}
+	_, err = q.CreateLoyaltyPoint(ctx, *args)
+	return err
+}
+
+// reverseOrderPoints menarik poin yang didapat dari order dan mengembalikan poin
+// yang dipakai untuk membayar order, dipakai saat refund dan pembayaran kedaluwarsa
+func reverseOrderPoints(ctx context.Context, q *db.Queries, orderID int64, userID int64) error {
+	entries, err := q.GetLoyaltyPointsByOrderID(ctx, sql.NullInt64{Int64: orderID, Valid: true})
+	if err != nil {
+		return err
+	}
+
+	var CustomerID int64
+	var Earned, Redeemed int32
+	var RedeemExpiry sql.NullTime
+	for _, entry := range entries {
+		CustomerID = entry.CustomerID
+		switch entry.Type {
+		case loyalty.TypeEarn:
+			Earned += entry.Points
+		case loyalty.TypeRedeem:
+			Redeemed -= entry.Points
+			RedeemExpiry = entry.ExpiresAt
+		case loyalty.TypeReverse, loyalty.TypeReturn:
+			// sudah pernah dibalik
+			return nil
+		}
+	}
+
+	if Earned > 0 {
+		// poin yang sudah terlanjur dipakai tetap ditarik sehingga saldo bisa minus
+		lots, err := loadPointLots(ctx, q, CustomerID, orderID)
+		if err != nil {
+			return err
+		}
+		usages, _, err := loyalty.Consume(lots, Earned, true)
+		if err != nil {
+			return err
+		}
+		if err := applyPointUsages(ctx, q, usages); err != nil {
+			return err
+		}
+
+		args := &db.CreateLoyaltyPointParams{
+			CustomerID:  CustomerID,
+			OrderID:     sql.NullInt64{Int64: orderID, Valid: true},
+			Type:        loyalty.TypeReverse,
+			Points:      -Earned,
+			Description: sql.NullString{String: "Points earned from order reversed", Valid: true},
+			CreatedBy:   sql.NullInt64{Int64: userID, Valid: userID != 0},
+		}
+		if _, err := q.CreateLoyaltyPoint(ctx, *args); err != nil {
+			return err
+		}
+	}
+
+	if Redeemed > 0 {
+		args := &db.CreateLoyaltyPointParams{
+			CustomerID:  CustomerID,
+			OrderID:     sql.NullInt64{Int64: orderID, Valid: true},
+			Type:        loyalty.TypeReturn,
+			Points:      Redeemed,
+			Remaining:   Redeemed,
+			ExpiresAt:   RedeemExpiry,
+			Description: sql.NullString{String: "Redeemed points returned", Valid: true},
+			CreatedBy:   sql.NullInt64{Int64: userID, Valid: userID != 0},
+		}
+		if _, err := q.CreateLoyaltyPoint(ctx, *args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadPointLots mengunci lot poin pelanggan, lot dari order tertentu didahulukan
+func loadPointLots(ctx context.Context, q *db.Queries, customerID int64, orderID int64) ([]loyalty.Lot, error) {
+	args := &db.GetLoyaltyLotsForUpdateParams{
+		CustomerID: customerID,
+		Now:        time.Now(),
+		OrderID:    orderID,
+	}
+	rows, err := q.GetLoyaltyLotsForUpdate(ctx, *args)
+	if err != nil {
+		return nil, err
+	}
+
+	lots := make([]loyalty.Lot, len(rows))
+	for i, row := range rows {
+		lots[i] = loyalty.Lot{
+			ID:        row.ID,
+			Remaining: row.Remaining,
+			ExpiresAt: common.ConvertNullTime(row.ExpiresAt),
+		}
+	}
+	return lots, nil
+}
+
+func applyPointUsages(ctx context.Context, q *db.Queries, usages []loyalty.Usage) error {
+	for _, u := range usages {
+		args := db.UpdateLoyaltyPointRemainingParams{ID: u.LotID, Remaining: u.Remaining}
+		if err := q.UpdateLoyaltyPointRemaining(ctx, args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadPointLines menyusun item order beserta pengali poin kategori produknya
+func loadPointLines(ctx context.Context, q *db.Queries, amounts []float64, categoryIDs []int64) ([]loyalty.Line, error) {
+	multipliers := map[int64]float64{}
+	lines := make([]loyalty.Line, len(amounts))
+	for i, amount := range amounts {
+		Multiplier := 1.0
+		if CategoryID := categoryIDs[i]; CategoryID != 0 {
+			cached, ok := multipliers[CategoryID]
+			if !ok {
+				category, err := q.GetCategoryByID(ctx, CategoryID)
+				if err != nil && err != sql.ErrNoRows {
+					return nil, err
+				}
+				cached = 1
+				if err == nil {
+					cached, _ = strconv.ParseFloat(category.PointsMultiplier, 64)
+				}
+				multipliers[CategoryID] = cached
+			}
+			Multiplier = cached
+		}
+		lines[i] = loyalty.Line{Amount: amount, Multiplier: Multiplier}
+	}
+	return lines, nil
+}
+
+func loyaltyPointData(p db.LoyaltyPoint) schemas.LoyaltyPointData {
+	return schemas.LoyaltyPointData{
+		ID:          p.ID,
+		OrderID:     common.ConvertNullInt64(p.OrderID),
+		Type:        p.Type,
+		Points:      p.Points,
+		Remaining:   p.Remaining,
+		ExpiresAt:   common.ConvertNullTime(p.ExpiresAt),
+		Description: common.ConvertNullString(p.Description),
+		CreatedAt:   common.ConvertNullTime(p.CreatedAt),
+	}
+}
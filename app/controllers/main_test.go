@@ -0,0 +1,199 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	db "pos-api/db/sqlc"
+	"pos-api/util/jwt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/lib/pq"
+	"github.com/spf13/viper"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	viper.Set("JWT_SECRET", "test-secret")
+	if err := jwt.InitJWT(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// authorize menambahkan token user ke request
+func authorize(t *testing.T, req *http.Request, user db.User) {
+	t.Helper()
+
+	token, err := jwt.GenerateToken(user.ID, user.Username)
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+}
+
+// testDB membuka database PostgreSQL dari TEST_DB_SOURCE lalu menjalankan migrasi.
+// Test yang membutuhkan database dilewati jika TEST_DB_SOURCE kosong.
+func testDB(t *testing.T) (*db.Queries, *sql.DB) {
+	t.Helper()
+
+	source := os.Getenv("TEST_DB_SOURCE")
+	if source == "" {
+		t.Skip("TEST_DB_SOURCE is not set")
+	}
+
+	m, err := migrate.New("file://../../db/migration", source)
+	if err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		t.Fatalf("migrate up: %v", err)
+	}
+
+	conn, err := sql.Open("postgres", source)
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return db.New(conn), conn
+}
+
+// uniqueRef membuat nomor referensi yang tidak bentrok antar test run
+func uniqueRef(prefix string) string {
+	return fmt.Sprintf("%s-%d", prefix, time.Now().UnixNano())
+}
+
+// createTestUser membuat kasir baru supaya shift dan order test tidak tercampur
+func createTestUser(t *testing.T, q *db.Queries) db.User {
+	t.Helper()
+
+	user, err := q.CreateUser(context.Background(), db.CreateUserParams{
+		Username:     uniqueRef("cashier"),
+		PasswordHash: "-",
+		Role:         "cashier",
+		FullName:     "Test Cashier",
+	})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	return user
+}
+
+// createTestProduct membuat produk dengan stok awal
+func createTestProduct(t *testing.T, q *db.Queries, stock int32) db.Product {
+	t.Helper()
+
+	product, err := q.CreateProduct(context.Background(), db.CreateProductParams{
+		Name:  uniqueRef("Test Product"),
+		Price: "10000",
+		Stock: stock,
+	})
+	if err != nil {
+		t.Fatalf("CreateProduct() error = %v", err)
+	}
+	return product
+}
+
+// createTestOrder membuat order dengan satu item. Stok produk sudah dipotong
+// sebanyak quantity seperti yang dilakukan placeOrder.
+func createTestOrder(t *testing.T, q *db.Queries, product db.Product, quantity int32, status string) db.Order {
+	t.Helper()
+	ctx := context.Background()
+
+	Total := fmt.Sprintf("%d", 10000*quantity)
+	order, err := q.CreateOrder(ctx, db.CreateOrderParams{
+		TrxNumber:       uniqueRef("TRX"),
+		Subtotal:        Total,
+		DiscountAmount:  "0",
+		VoucherDiscount: "0",
+		TaxAmount:       "0",
+		TotalAmount:     Total,
+		ChangeAmount:    "0",
+		PaymentMethod:   "qris",
+		Status:          status,
+	})
+	if err != nil {
+		t.Fatalf("CreateOrder() error = %v", err)
+	}
+
+	_, err = q.CreateOrderItem(ctx, db.CreateOrderItemParams{
+		OrderID:        sql.NullInt64{Int64: order.ID, Valid: true},
+		ProductID:      sql.NullInt64{Int64: product.ID, Valid: true},
+		Quantity:       quantity,
+		UnitPrice:      "10000",
+		DiscountAmount: "0",
+		TaxRate:        "0",
+		TaxAmount:      "0",
+		UnitCost:       "0",
+		Cogs:           "0",
+	})
+	if err != nil {
+		t.Fatalf("CreateOrderItem() error = %v", err)
+	}
+
+	_, err = q.IncrementProductStock(ctx, db.IncrementProductStockParams{Quantity: -quantity, ID: product.ID})
+	if err != nil {
+		t.Fatalf("IncrementProductStock() error = %v", err)
+	}
+	return order
+}
+
+// createTestCustomer membuat pelanggan dengan kontak yang tidak bentrok antar test run
+func createTestCustomer(t *testing.T, q *db.Queries, name string) db.Customer {
+	t.Helper()
+
+	ref := uniqueRef("")
+	customer, err := q.CreateCustomer(context.Background(), db.CreateCustomerParams{
+		MemberCode: "M" + ref,
+		Name:       name,
+		Phone:      sql.NullString{String: "0812" + ref[len(ref)-8:], Valid: true},
+		Email:      sql.NullString{String: "customer" + ref + "@example.com", Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("CreateCustomer() error = %v", err)
+	}
+	return customer
+}
+
+// createCustomerOrder membuat order tanpa item untuk pelanggan dengan total dan status tertentu
+func createCustomerOrder(t *testing.T, q *db.Queries, customer db.Customer, total string, status string) db.Order {
+	t.Helper()
+
+	order, err := q.CreateOrder(context.Background(), db.CreateOrderParams{
+		TrxNumber:       uniqueRef("TRX"),
+		CustomerID:      sql.NullInt64{Int64: customer.ID, Valid: true},
+		Subtotal:        total,
+		DiscountAmount:  "0",
+		VoucherDiscount: "0",
+		TaxAmount:       "0",
+		TotalAmount:     total,
+		ChangeAmount:    "0",
+		PaymentMethod:   "cash",
+		Status:          status,
+	})
+	if err != nil {
+		t.Fatalf("CreateOrder() error = %v", err)
+	}
+	return order
+}
+
+// createTestSupplier membuat supplier untuk purchase order
+func createTestSupplier(t *testing.T, q *db.Queries) db.Supplier {
+	t.Helper()
+
+	supplier, err := q.CreateSupplier(context.Background(), db.CreateSupplierParams{
+		Name: uniqueRef("Test Supplier"),
+	})
+	if err != nil {
+		t.Fatalf("CreateSupplier() error = %v", err)
+	}
+	return supplier
+}
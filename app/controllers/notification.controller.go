@@ -0,0 +1,419 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/jwt"
+	"pos-api/util/notifier"
+	"pos-api/util/receipt"
+	"pos-api/util/reorder"
+
+	"github.com/gin-gonic/gin"
+)
+
+// notificationBatch adalah jumlah pesan yang diproses setiap kali dispatcher berjalan
+const notificationBatch = 20
+
+// notificationLease adalah lama pesan yang diklaim tidak diambil dispatcher lain
+const notificationLease = 5 * time.Minute
+
+type NotificationController struct {
+	db        *db.Queries
+	sqlDB     *sql.DB
+	notifiers notifier.Registry
+	template  receipt.Template
+	ctx       context.Context
+}
+
+func NewNotificationController(db *db.Queries, sqlDB *sql.DB, notifiers notifier.Registry, template receipt.Template, ctx context.Context) *NotificationController {
+	return &NotificationController{db, sqlDB, notifiers, template, ctx}
+}
+
+// SendReceipt godoc
+// @Security BearerAuth
+// @Summary Send digital receipt
+// @Description Queue the receipt of an order to be sent by email and/or WhatsApp. Without channel the receipt is sent to every contact of the customer, to overrides the customer contact.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param id path int true "Order ID"
+// @Param payload body schemas.SendReceipt false "Send Receipt Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/orders/{id}/send-receipt [post]
+func (c *NotificationController) SendReceipt(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid order id",
+		})
+		return
+	}
+
+	var payload schemas.SendReceipt
+	if err := ctx.ShouldBindJSON(&payload); err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	order, err := c.db.GetOrderByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "order not found",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if order.Status == "expired" {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "order payment has expired",
+		})
+		return
+	}
+
+	Recipients := map[string]string{}
+	if order.CustomerID.Valid {
+		customer, err := c.db.GetCustomerByID(ctx, order.CustomerID.Int64)
+		if err != nil && err != sql.ErrNoRows {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		Recipients = customerContacts(customer, c.notifiers)
+	}
+
+	if payload.Channel != "" {
+		if !c.notifiers.Enabled(payload.Channel) {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": notifier.ErrUnknownChannel.Error(),
+			})
+			return
+		}
+
+		To := strings.TrimSpace(payload.To)
+		if To == "" {
+			To = Recipients[payload.Channel]
+		}
+		Recipients = map[string]string{}
+		if To != "" {
+			Recipients[payload.Channel] = To
+		}
+	} else if payload.To != "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "channel is required when to is set",
+		})
+		return
+	}
+
+	if len(Recipients) == 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": notifier.ErrNoRecipient.Error(),
+		})
+		return
+	}
+
+	notifications, err := queueReceipt(ctx, c.db, order.ID, Recipients, UserID)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.NotificationData, 0, len(notifications))
+	for _, n := range notifications {
+		data = append(data, notificationData(n))
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "receipt queued for sending",
+		"data":    data,
+	})
+}
+
+// GetOrderNotifications godoc
+// @Security BearerAuth
+// @Summary Get digital receipt deliveries
+// @Description Show the outbox status (pending, sent, failed) of every receipt sent for an order
+// @Tags orders
+// @Produce json
+// @Param id path int true "Order ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/orders/{id}/notifications [get]
+func (c *NotificationController) GetOrderNotifications(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid order id",
+		})
+		return
+	}
+
+	notifications, err := c.db.GetNotificationsByOrderID(ctx, sql.NullInt64{Int64: id, Valid: true})
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.NotificationData, 0, len(notifications))
+	for _, n := range notifications {
+		data = append(data, notificationData(n))
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "notifications retrieved successfully",
+		"data":    data,
+	})
+}
+
+// DispatchNotifications mengirim pesan outbox yang sudah jatuh tempo. Pesan yang gagal
+// dicoba lagi dengan backoff sampai batas percobaan lalu ditandai failed.
+func (c *NotificationController) DispatchNotifications(ctx context.Context) error {
+	// klaim singkat dengan SKIP LOCKED supaya beberapa instance API tidak mengirim
+	// pesan yang sama, pengiriman dilakukan di luar transaksi
+	now := time.Now()
+	notifications, err := c.db.ClaimDueNotifications(ctx, db.ClaimDueNotificationsParams{
+		LeaseUntil: now.Add(notificationLease),
+		Now:        now,
+		Limit:      notificationBatch,
+	})
+	if err != nil {
+		return err
+	}
+
+	// setiap pesan ditandai terkirim atau gagal dengan statement sendiri
+	for _, n := range notifications {
+		if err := c.deliver(ctx, c.db, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliver mengirim satu pesan outbox, error hanya dikembalikan untuk kegagalan database
+func (c *NotificationController) deliver(ctx context.Context, q *db.Queries, n db.NotificationOutbox) error {
+	if !c.notifiers.Enabled(n.Channel) {
+		return markNotificationFailed(ctx, q, n, notifier.ErrUnknownChannel, true)
+	}
+
+	if n.Kind == reorder.KindLowStock {
+		return c.deliverStockAlert(ctx, q, n)
+	}
+
+	order, err := q.GetOrderByID(ctx, n.OrderID.Int64)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return markNotificationFailed(ctx, q, n, errors.New("order not found"), true)
+		}
+		return err
+	}
+
+	// struk baru dikirim setelah pembayaran gateway lunas
+	switch order.Status {
+	case "pending_payment":
+		return q.PostponeNotification(ctx, db.PostponeNotificationParams{
+			ID:            n.ID,
+			NextAttemptAt: time.Now().Add(time.Minute),
+		})
+	case "expired":
+		return markNotificationFailed(ctx, q, n, errors.New("order payment has expired"), true)
+	}
+
+	msg, err := c.receiptMessage(ctx, q, n)
+	if err == nil {
+		err = c.notifiers.Send(ctx, n.Channel, msg)
+	}
+	if err != nil {
+		return markNotificationFailed(ctx, q, n, err, false)
+	}
+
+	_, err = q.MarkNotificationSent(ctx, db.MarkNotificationSentParams{
+		ID:      n.ID,
+		Subject: sql.NullString{String: msg.Subject, Valid: msg.Subject != ""},
+	})
+	return err
+}
+
+// receiptMessage menyusun struk order menjadi pesan email (teks dan HTML) atau WhatsApp
+func (c *NotificationController) receiptMessage(ctx context.Context, q *db.Queries, n db.NotificationOutbox) (notifier.Message, error) {
+	Receipt, err := loadReceipt(ctx, q, c.template, n.OrderID.Int64)
+	if err != nil {
+		return notifier.Message{}, err
+	}
+
+	text := receipt.Text(Receipt, c.template.Width)
+	msg := notifier.Message{
+		To:      n.Recipient,
+		Subject: "Struk " + Receipt.TrxNumber,
+		Body:    text,
+	}
+	if c.template.StoreName != "" {
+		msg.Subject += " - " + c.template.StoreName
+	}
+
+	switch n.Channel {
+	case notifier.ChannelEmail:
+		msg.HTML, err = receipt.HTML(Receipt)
+		if err != nil {
+			return notifier.Message{}, err
+		}
+	case notifier.ChannelWhatsApp:
+		// blok monospace WhatsApp supaya kolom struk tetap rata
+		msg.Body = "```\n" + text + "```"
+	}
+	return msg, nil
+}
+
+// deliverStockAlert mengirim alert stok menipis ke staf, error hanya dikembalikan
+// untuk kegagalan database
+func (c *NotificationController) deliverStockAlert(ctx context.Context, q *db.Queries, n db.NotificationOutbox) error {
+	alert, err := q.GetStockAlertByID(ctx, n.StockAlertID.Int64)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return markNotificationFailed(ctx, q, n, errors.New("stock alert not found"), true)
+		}
+		return err
+	}
+
+	subject, body := reorder.Message(c.template.StoreName, alert.ProductName, alert.Stock, alert.MinStock)
+	msg := notifier.Message{
+		To:      n.Recipient,
+		Subject: subject,
+		Body:    body,
+	}
+	if err := c.notifiers.Send(ctx, n.Channel, msg); err != nil {
+		return markNotificationFailed(ctx, q, n, err, false)
+	}
+
+	_, err = q.MarkNotificationSent(ctx, db.MarkNotificationSentParams{
+		ID:      n.ID,
+		Subject: sql.NullString{String: msg.Subject, Valid: true},
+	})
+	return err
+}
+
+// markNotificationFailed mencatat percobaan gagal, pesan dijadwalkan ulang dengan backoff
+// kecuali gagal permanen atau sudah mencapai batas percobaan
+func markNotificationFailed(ctx context.Context, q *db.Queries, n db.NotificationOutbox, cause error, permanent bool) error {
+	Attempts := int(n.Attempts) + 1
+	Status := notifier.StatusPending
+	if permanent || Attempts >= int(n.MaxAttempts) {
+		Status = notifier.StatusFailed
+	}
+
+	_, err := q.MarkNotificationAttemptFailed(ctx, db.MarkNotificationAttemptFailedParams{
+		ID:            n.ID,
+		Status:        Status,
+		NextAttemptAt: time.Now().Add(notifier.Backoff(Attempts)),
+		LastError:     sql.NullString{String: cause.Error(), Valid: true},
+	})
+	return err
+}
+
+// customerContacts mengembalikan kontak pelanggan per channel yang aktif
+func customerContacts(customer db.Customer, notifiers notifier.Registry) map[string]string {
+	contacts := map[string]string{}
+	if email := strings.TrimSpace(customer.Email.String); email != "" && notifiers.Enabled(notifier.ChannelEmail) {
+		contacts[notifier.ChannelEmail] = email
+	}
+	if phone := strings.TrimSpace(customer.Phone.String); phone != "" && notifiers.Enabled(notifier.ChannelWhatsApp) {
+		contacts[notifier.ChannelWhatsApp] = phone
+	}
+	return contacts
+}
+
+// queueReceipt memasukkan struk order ke outbox, pengiriman dilakukan oleh dispatcher di background
+func queueReceipt(ctx context.Context, q *db.Queries, orderID int64, recipients map[string]string, userID int64) ([]db.NotificationOutbox, error) {
+	notifications := make([]db.NotificationOutbox, 0, len(recipients))
+	for _, channel := range []string{notifier.ChannelEmail, notifier.ChannelWhatsApp} {
+		to, ok := recipients[channel]
+		if !ok {
+			continue
+		}
+
+		args := &db.CreateNotificationParams{
+			OrderID:     sql.NullInt64{Int64: orderID, Valid: true},
+			Kind:        "receipt",
+			Channel:     channel,
+			Recipient:   to,
+			MaxAttempts: notifier.MaxAttempts,
+			CreatedBy:   sql.NullInt64{Int64: userID, Valid: userID != 0},
+		}
+
+		n, err := q.CreateNotification(ctx, *args)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, nil
+}
+
+func notificationData(n db.NotificationOutbox) schemas.NotificationData {
+	return schemas.NotificationData{
+		ID:            n.ID,
+		OrderID:       common.ConvertNullInt64(n.OrderID),
+		StockAlertID:  common.ConvertNullInt64(n.StockAlertID),
+		Kind:          n.Kind,
+		Channel:       n.Channel,
+		Recipient:     n.Recipient,
+		Subject:       common.ConvertNullString(n.Subject),
+		Status:        n.Status,
+		Attempts:      n.Attempts,
+		MaxAttempts:   n.MaxAttempts,
+		NextAttemptAt: n.NextAttemptAt,
+		LastError:     common.ConvertNullString(n.LastError),
+		SentAt:        common.ConvertNullTime(n.SentAt),
+		CreatedAt:     common.ConvertNullTime(n.CreatedAt),
+	}
+}
@@ -0,0 +1,174 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "pos-api/db/sqlc"
+	"pos-api/util/notifier"
+	"pos-api/util/receipt"
+
+	"github.com/gin-gonic/gin"
+)
+
+// failingNotifier adalah channel email yang selalu gagal mengirim
+type failingNotifier struct{}
+
+func (failingNotifier) Channel() string {
+	return notifier.ChannelEmail
+}
+
+func (failingNotifier) Send(ctx context.Context, msg notifier.Message) error {
+	return errors.New("smtp unavailable")
+}
+
+// claimed mencari pesan dengan id tertentu di hasil klaim dispatcher
+func claimed(notifications []db.NotificationOutbox, id int64) bool {
+	for _, n := range notifications {
+		if n.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSendReceiptQueuesNotification(t *testing.T) {
+	q, sqlDB := testDB(t)
+	c := NewNotificationController(q, sqlDB, notifier.NewRegistry(failingNotifier{}), receipt.Template{Width: 32}, context.Background())
+
+	router := gin.New()
+	router.POST("/orders/:id/send-receipt", c.SendReceipt)
+
+	user := createTestUser(t, q)
+	order := createTestOrder(t, q, createTestProduct(t, q, 10), 1, "order")
+
+	tests := []struct {
+		name    string
+		orderID int64
+		body    map[string]string
+		code    int
+	}{
+		{"guest order without recipient", order.ID, nil, http.StatusBadRequest},
+		{"channel not configured", order.ID, map[string]string{"channel": notifier.ChannelWhatsApp, "to": "08123456789"}, http.StatusBadRequest},
+		{"order not found", 0, map[string]string{"channel": notifier.ChannelEmail, "to": "customer@example.com"}, http.StatusNotFound},
+		{"email override", order.ID, map[string]string{"channel": notifier.ChannelEmail, "to": "customer@example.com"}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body bytes.Buffer
+			if tt.body != nil {
+				json.NewEncoder(&body).Encode(tt.body)
+			}
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/orders/%d/send-receipt", tt.orderID), &body)
+			authorize(t, req, user)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if rec.Code != tt.code {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.code, rec.Body.String())
+			}
+		})
+	}
+
+	notifications, err := q.GetNotificationsByOrderID(context.Background(), sql.NullInt64{Int64: order.ID, Valid: true})
+	if err != nil {
+		t.Fatalf("GetNotificationsByOrderID() error = %v", err)
+	}
+	if len(notifications) != 1 {
+		t.Fatalf("notifications = %d, want 1", len(notifications))
+	}
+	n := notifications[0]
+	if n.Channel != notifier.ChannelEmail || n.Recipient != "customer@example.com" || n.Status != notifier.StatusPending {
+		t.Errorf("notification = %+v, want a pending email to customer@example.com", n)
+	}
+}
+
+func TestClaimDueNotificationsLease(t *testing.T) {
+	q, _ := testDB(t)
+	ctx := context.Background()
+
+	order := createTestOrder(t, q, createTestProduct(t, q, 10), 1, "order")
+	n, err := queueReceipt(ctx, q, order.ID, map[string]string{notifier.ChannelEmail: "customer@example.com"}, 0)
+	if err != nil {
+		t.Fatalf("queueReceipt() error = %v", err)
+	}
+	id := n[0].ID
+
+	claim := func(now time.Time) []db.NotificationOutbox {
+		t.Helper()
+		notifications, err := q.ClaimDueNotifications(ctx, db.ClaimDueNotificationsParams{
+			LeaseUntil: now.Add(notificationLease),
+			Now:        now,
+			Limit:      1000,
+		})
+		if err != nil {
+			t.Fatalf("ClaimDueNotifications() error = %v", err)
+		}
+		return notifications
+	}
+
+	// pesan yang sudah diklaim tidak diambil lagi sampai lease habis
+	now := time.Now().Add(time.Minute)
+	if !claimed(claim(now), id) {
+		t.Fatalf("first claim does not include notification %d", id)
+	}
+	if claimed(claim(now), id) {
+		t.Errorf("second claim within the lease includes notification %d", id)
+	}
+	if !claimed(claim(now.Add(notificationLease+time.Second)), id) {
+		t.Errorf("claim after the lease does not include notification %d", id)
+	}
+}
+
+func TestDeliverFailureBackoff(t *testing.T) {
+	q, sqlDB := testDB(t)
+	ctx := context.Background()
+	c := NewNotificationController(q, sqlDB, notifier.NewRegistry(failingNotifier{}), receipt.Template{Width: 32}, ctx)
+
+	order := createTestOrder(t, q, createTestProduct(t, q, 10), 1, "order")
+	queued, err := queueReceipt(ctx, q, order.ID, map[string]string{notifier.ChannelEmail: "customer@example.com"}, 0)
+	if err != nil {
+		t.Fatalf("queueReceipt() error = %v", err)
+	}
+	n := queued[0]
+
+	// setiap kegagalan menambah attempts dan menunda percobaan berikutnya sesuai Backoff,
+	// percobaan terakhir menandai pesan failed
+	for attempt := 1; attempt <= notifier.MaxAttempts; attempt++ {
+		before := time.Now()
+		if err := c.deliver(ctx, q, n); err != nil {
+			t.Fatalf("deliver() attempt %d error = %v", attempt, err)
+		}
+
+		notifications, err := q.GetNotificationsByOrderID(ctx, sql.NullInt64{Int64: order.ID, Valid: true})
+		if err != nil {
+			t.Fatalf("GetNotificationsByOrderID() error = %v", err)
+		}
+		n = notifications[0]
+
+		wantStatus := notifier.StatusPending
+		if attempt == notifier.MaxAttempts {
+			wantStatus = notifier.StatusFailed
+		}
+		if n.Status != wantStatus || int(n.Attempts) != attempt {
+			t.Fatalf("attempt %d: status = %s, attempts = %d, want %s, %d", attempt, n.Status, n.Attempts, wantStatus, attempt)
+		}
+		if n.LastError.String != "smtp unavailable" {
+			t.Errorf("attempt %d: last_error = %q", attempt, n.LastError.String)
+		}
+
+		delay := n.NextAttemptAt.Sub(before)
+		if want := notifier.Backoff(attempt); delay < want-time.Second || delay > want+5*time.Second {
+			t.Errorf("attempt %d: next attempt in %v, want about %v", attempt, delay, want)
+		}
+	}
+}
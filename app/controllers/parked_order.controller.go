@@ -0,0 +1,569 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/jwt"
+
+	"github.com/gin-gonic/gin"
+)
+
+var errParkedOrderClosed = errors.New("parked order is no longer held")
+
+type ParkedOrderController struct {
+	db     *db.Queries
+	sqlDB  *sql.DB
+	expiry time.Duration
+	ctx    context.Context
+}
+
+func NewParkedOrderController(db *db.Queries, sqlDB *sql.DB, expiry time.Duration, ctx context.Context) *ParkedOrderController {
+	return &ParkedOrderController{db, sqlDB, expiry, ctx}
+}
+
+// CreateParkedOrder godoc
+// @Security BearerAuth
+// @Summary Park (hold) an order
+// @Description Hold a basket with a label so it can be resumed later on any terminal, optionally reserving the stock while held
+// @Tags parked-orders
+// @Accept json
+// @Produce json
+// @Param payload body schemas.CreateParkedOrder true "Parked Order Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/parked-orders [post]
+func (c *ParkedOrderController) CreateParkedOrder(ctx *gin.Context) {
+	var payload schemas.CreateParkedOrder
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	args := &db.CreateParkedOrderParams{
+		ParkNumber:    fmt.Sprintf("PARK-%d-%s", UserID, time.Now().Format("20060102150405")),
+		Label:         payload.Label,
+		StoreCode:     storeCode(payload.StoreCode),
+		Terminal:      sql.NullString{String: payload.Terminal, Valid: payload.Terminal != ""},
+		CustomerType:  payload.Type,
+		CustomerID:    sql.NullInt64{Int64: payload.CustomerID, Valid: payload.CustomerID != 0},
+		CustomerName:  sql.NullString{String: payload.Customer.Name, Valid: payload.Customer.Name != ""},
+		CustomerPhone: sql.NullString{String: payload.Customer.Phone, Valid: payload.Customer.Phone != ""},
+		CustomerEmail: sql.NullString{String: payload.Customer.Email, Valid: payload.Customer.Email != ""},
+		VoucherCode:   sql.NullString{String: payload.VoucherCode, Valid: payload.VoucherCode != ""},
+		ReserveStock:  payload.ReserveStock,
+		ExpiresAt:     sql.NullTime{Time: time.Now().Add(c.expiry), Valid: c.expiry > 0},
+		CreatedBy:     sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	parked, err := qtx.CreateParkedOrder(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	for _, item := range payload.Items {
+		if _, err := qtx.GetProductByID(ctx, item.ProductID); err != nil {
+			if err == sql.ErrNoRows {
+				ctx.JSON(http.StatusNotFound, gin.H{
+					"status":  "failed",
+					"message": "failed to retrieve product with id " + strconv.FormatInt(item.ProductID, 10),
+				})
+				return
+			}
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		itemArgs := &db.CreateParkedOrderItemParams{
+			ParkedOrderID: sql.NullInt64{Int64: parked.ID, Valid: true},
+			ProductID:     sql.NullInt64{Int64: item.ProductID, Valid: true},
+			Quantity:      item.Quantity,
+		}
+		if _, err := qtx.CreateParkedOrderItem(ctx, *itemArgs); err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		if payload.ReserveStock {
+			reserveArgs := db.ReserveProductStockParams{
+				ID:       item.ProductID,
+				Quantity: item.Quantity,
+			}
+			if _, err := qtx.ReserveProductStock(ctx, reserveArgs); err != nil {
+				if err == sql.ErrNoRows {
+					ctx.JSON(http.StatusBadRequest, gin.H{
+						"status":  "failed",
+						"message": "stock not enough product with id " + strconv.FormatInt(item.ProductID, 10),
+					})
+					return
+				}
+				ctx.JSON(http.StatusBadGateway, gin.H{
+					"status":  "failed",
+					"message": err.Error(),
+				})
+				return
+			}
+		}
+	}
+
+	items, err := qtx.GetParkedOrderItems(ctx, sql.NullInt64{Int64: parked.ID, Valid: true})
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	tx.Commit()
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "order parked successfully",
+		"data":    parkedOrderData(parked, items),
+	})
+}
+
+// GetAllParkedOrders godoc
+// @Security BearerAuth
+// @Summary Get parked orders of a store
+// @Description Retrieve parked orders of a store with pagination, by default only held orders are listed
+// @Tags parked-orders
+// @Produce json
+// @Param store_code query string false "Store code" default(default)
+// @Param status query string false "Status (held, resumed, converted, cancelled, expired), empty for all" default(held)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Limit per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/parked-orders [get]
+func (c *ParkedOrderController) GetAllParkedOrders(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	offset := (page - 1) * limit
+
+	args := &db.GetAllParkedOrdersParams{
+		StoreCode: storeCode(ctx.Query("store_code")),
+		Status:    ctx.DefaultQuery("status", "held"),
+		Limit:     int32(limit),
+		Offset:    int32(offset),
+	}
+
+	parkedOrders, err := c.db.GetAllParkedOrders(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.ParkedOrderData, len(parkedOrders))
+	for i, parked := range parkedOrders {
+		data[i] = parkedOrderData(parked, nil)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// GetParkedOrderById godoc
+// @Security BearerAuth
+// @Summary Get a parked order by ID
+// @Description Retrieve a parked order and its items by ID
+// @Tags parked-orders
+// @Produce json
+// @Param id path int true "Parked Order ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/parked-orders/{id} [get]
+func (c *ParkedOrderController) GetParkedOrderById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid parked order id",
+		})
+		return
+	}
+
+	parked, err := c.db.GetParkedOrderByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve parked order with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	items, err := c.db.GetParkedOrderItems(ctx, sql.NullInt64{Int64: parked.ID, Valid: true})
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "parked order retrieved successfully",
+		"data":    parkedOrderData(parked, items),
+	})
+}
+
+// ResumeParkedOrder godoc
+// @Security BearerAuth
+// @Summary Resume a parked order
+// @Description Resume a held order on any terminal, the basket is returned with the current product prices
+// @Tags parked-orders
+// @Accept json
+// @Produce json
+// @Param id path int true "Parked Order ID"
+// @Param payload body schemas.ResumeParkedOrder false "Resume Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/parked-orders/{id}/resume [post]
+func (c *ParkedOrderController) ResumeParkedOrder(ctx *gin.Context) {
+	var payload schemas.ResumeParkedOrder
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid parked order id",
+		})
+		return
+	}
+
+	// terminal bersifat opsional sehingga body boleh kosong
+	_ = ctx.ShouldBindJSON(&payload)
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	args := &db.ResumeParkedOrderParams{
+		ID:              id,
+		ResumedBy:       sql.NullInt64{Int64: userInfo.UserID, Valid: true},
+		ResumedTerminal: sql.NullString{String: payload.Terminal, Valid: payload.Terminal != ""},
+	}
+
+	parked, err := c.db.ResumeParkedOrder(ctx, *args)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			if _, err := c.db.GetParkedOrderByID(ctx, id); err == nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{
+					"status":  "failed",
+					"message": errParkedOrderClosed.Error(),
+				})
+				return
+			}
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve parked order with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	items, err := c.db.GetParkedOrderItems(ctx, sql.NullInt64{Int64: parked.ID, Valid: true})
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "parked order resumed successfully",
+		"data":    parkedOrderData(parked, items),
+	})
+}
+
+// CancelParkedOrder godoc
+// @Security BearerAuth
+// @Summary Cancel a parked order
+// @Description Cancel a held or resumed order and release its stock reservation
+// @Tags parked-orders
+// @Produce json
+// @Param id path int true "Parked Order ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/parked-orders/{id} [delete]
+func (c *ParkedOrderController) CancelParkedOrder(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid parked order id",
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	if err := releaseParkedOrder(ctx, qtx, id, UserID); err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve parked order with this id",
+			})
+			return
+		}
+		if errors.Is(err, errParkedOrderClosed) {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	args := &db.UpdateParkedOrderStatusParams{
+		ID:        id,
+		Status:    "cancelled",
+		UpdatedBy: sql.NullInt64{Int64: UserID, Valid: true},
+	}
+	parked, err := qtx.UpdateParkedOrderStatus(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	tx.Commit()
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "parked order cancelled successfully",
+		"data":    parkedOrderData(parked, nil),
+	})
+}
+
+// ExpireParkedOrders dijalankan berkala untuk menutup order yang di-park melewati
+// batas waktu dan melepas reservasi stoknya
+func (c *ParkedOrderController) ExpireParkedOrders(ctx context.Context) error {
+	parkedOrders, err := c.db.GetExpiredParkedOrders(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, parked := range parkedOrders {
+		if err := c.expireParkedOrder(ctx, parked.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ParkedOrderController) expireParkedOrder(ctx context.Context, id int64) error {
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	if err := releaseParkedOrder(ctx, qtx, id, 0); err != nil {
+		// sudah dikonversi atau dibatalkan di terminal lain
+		if errors.Is(err, errParkedOrderClosed) {
+			return nil
+		}
+		return err
+	}
+
+	args := &db.UpdateParkedOrderStatusParams{
+		ID:     id,
+		Status: "expired",
+	}
+	if _, err := qtx.UpdateParkedOrderStatus(ctx, *args); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// releaseParkedOrder mengunci order yang di-park, memastikan masih berstatus held/resumed
+// lalu melepas reservasi stoknya. Status order diubah oleh pemanggil.
+func releaseParkedOrder(ctx context.Context, q *db.Queries, id int64, userID int64) error {
+	parked, err := q.GetParkedOrderByIDForUpdate(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if parked.Status != "held" && parked.Status != "resumed" {
+		return errParkedOrderClosed
+	}
+
+	if !parked.ReserveStock {
+		return nil
+	}
+
+	items, err := q.GetParkedOrderItems(ctx, sql.NullInt64{Int64: parked.ID, Valid: true})
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		args := db.ReleaseProductStockParams{
+			ID:       item.ProductID.Int64,
+			Quantity: item.Quantity,
+		}
+		if err := q.ReleaseProductStock(ctx, args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func storeCode(code string) string {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return "default"
+	}
+	return code
+}
+
+func parkedOrderData(parked db.ParkedOrder, items []db.GetParkedOrderItemsRow) schemas.ParkedOrderData {
+	data := schemas.ParkedOrderData{
+		ID:              parked.ID,
+		ParkNumber:      parked.ParkNumber,
+		Label:           parked.Label,
+		StoreCode:       parked.StoreCode,
+		Terminal:        common.ConvertNullString(parked.Terminal),
+		Type:            parked.CustomerType,
+		CustomerID:      common.ConvertNullInt64(parked.CustomerID),
+		VoucherCode:     common.ConvertNullString(parked.VoucherCode),
+		ReserveStock:    parked.ReserveStock,
+		Status:          parked.Status,
+		OrderID:         common.ConvertNullInt64(parked.OrderID),
+		ExpiresAt:       common.ConvertNullTime(parked.ExpiresAt),
+		ResumedBy:       common.ConvertNullInt64(parked.ResumedBy),
+		ResumedTerminal: common.ConvertNullString(parked.ResumedTerminal),
+		ResumedAt:       common.ConvertNullTime(parked.ResumedAt),
+		CreatedBy:       common.ConvertNullInt64(parked.CreatedBy),
+		CreatedAt:       common.ConvertNullTime(parked.CreatedAt),
+	}
+
+	if parked.CustomerName.Valid {
+		data.Customer = &schemas.Customer{
+			Name:  parked.CustomerName.String,
+			Phone: common.ConvertNullString(parked.CustomerPhone),
+			Email: common.ConvertNullString(parked.CustomerEmail),
+		}
+	}
+
+	for _, item := range items {
+		UnitPrice, _ := strconv.ParseFloat(common.ConvertNullString(item.ProductPrice), 64)
+		data.Items = append(data.Items, schemas.ParkedOrderItemData{
+			ProductID:   common.ConvertNullInt64(item.ProductID),
+			ProductName: common.ConvertNullString(item.ProductName),
+			Quantity:    item.Quantity,
+			UnitPrice:   UnitPrice,
+		})
+	}
+	return data
+}
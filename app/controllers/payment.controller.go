@@ -0,0 +1,506 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/costing"
+	"pos-api/util/movement"
+	"pos-api/util/notifier"
+	"pos-api/util/payment"
+
+	"github.com/gin-gonic/gin"
+)
+
+// paymentRefundBatch adalah jumlah refund gateway yang dicoba ulang setiap kali job berjalan
+const paymentRefundBatch = 20
+
+type PaymentController struct {
+	db      *db.Queries
+	sqlDB   *sql.DB
+	gateway payment.Provider
+	ctx     context.Context
+}
+
+func NewPaymentController(db *db.Queries, sqlDB *sql.DB, gateway payment.Provider, ctx context.Context) *PaymentController {
+	return &PaymentController{db, sqlDB, gateway, ctx}
+}
+
+// Webhook godoc
+// @Summary Payment gateway webhook
+// @Description Receive signed payment status notification from the payment gateway and move the order to paid or expired
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Param X-Signature header string true "Webhook signature"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/payments/webhook [post]
+func (c *PaymentController) Webhook(ctx *gin.Context) {
+	body, err := ctx.GetRawData()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	event, err := c.gateway.ParseWebhook(body, ctx.GetHeader("X-Signature"))
+	if err != nil {
+		if errors.Is(err, payment.ErrInvalidSignature) {
+			ctx.JSON(http.StatusUnauthorized, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid webhook payload",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	charge, err := c.db.GetPaymentChargeByChargeID(ctx, event.ChargeID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "charge not found",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	charge, err = c.applyChargeStatus(ctx, charge, event.Status, event.PaidAt)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "webhook processed successfully",
+		"data":    paymentChargeData(charge),
+	})
+}
+
+// GetOrderPaymentStatus godoc
+// @Security BearerAuth
+// @Summary Get payment gateway status of an order
+// @Description Query the latest charge status from the payment gateway and sync the order status
+// @Tags payments
+// @Produce json
+// @Param id path int true "Order ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/payments/orders/{id} [get]
+func (c *PaymentController) GetOrderPaymentStatus(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid order id",
+		})
+		return
+	}
+
+	charge, err := c.db.GetPaymentChargeByOrderID(ctx, sql.NullInt64{Int64: id, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "order has no gateway payment",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if !payment.IsFinal(charge.Status) {
+		remote, err := c.gateway.QueryStatus(ctx, charge.ChargeID)
+		if err != nil && !errors.Is(err, payment.ErrChargeNotFound) {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		if err == nil && remote.Status != charge.Status {
+			charge, err = c.applyChargeStatus(ctx, charge, remote.Status, remote.PaidAt)
+			if err != nil {
+				ctx.JSON(http.StatusBadGateway, gin.H{
+					"status":  "failed",
+					"message": err.Error(),
+				})
+				return
+			}
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    paymentChargeData(charge),
+	})
+}
+
+// ExpirePendingPayments dijalankan berkala untuk menutup order yang pembayarannya
+// melewati batas waktu dan mengembalikan stok yang sudah direservasi
+func (c *PaymentController) ExpirePendingPayments(ctx context.Context) error {
+	charges, err := c.db.GetExpiredPendingCharges(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, charge := range charges {
+		status := payment.StatusExpired
+		var paidAt time.Time
+
+		// pembayaran bisa saja sudah masuk tetapi webhook tidak sampai
+		remote, err := c.gateway.QueryStatus(ctx, charge.ChargeID)
+		if err == nil && remote.Status == payment.StatusPaid {
+			status = payment.StatusPaid
+			paidAt = remote.PaidAt
+		}
+
+		// charge yang gagal dicatat ke log, charge lain tetap diproses
+		if _, err := c.applyChargeStatus(ctx, charge, status, paidAt); err != nil {
+			log.Printf("expire payment charge %s failed: %v", charge.ChargeID, err)
+		}
+	}
+	return nil
+}
+
+// RetryPaymentRefunds dijalankan berkala untuk mengirim ulang refund gateway yang
+// belum berhasil. Aman dipanggil berulang karena refund dikirim dengan
+// idempotency key dari baris refund.
+func (c *PaymentController) RetryPaymentRefunds(ctx context.Context) error {
+	args := db.GetDuePaymentRefundsParams{
+		Now:   time.Now(),
+		Limit: paymentRefundBatch,
+	}
+	refunds, err := c.db.GetDuePaymentRefunds(ctx, args)
+	if err != nil {
+		return err
+	}
+
+	for _, refund := range refunds {
+		// kegagalan gateway sudah dicatat pada baris refund, lanjut ke refund berikutnya
+		if _, err := sendPaymentRefund(ctx, c.db, c.sqlDB, c.gateway, refund); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendPaymentRefund mengirim refund yang sudah tercatat ke payment gateway lalu
+// menandai refund berhasil dan charge menjadi refunded. Dipanggil setelah
+// transaksi yang mencatat refund di-commit, tanpa memegang lock apa pun. Bernilai
+// false jika gateway menolak; percobaan gagal dicatat untuk dicoba ulang oleh job.
+func sendPaymentRefund(ctx context.Context, q *db.Queries, sqlDB *sql.DB, gateway payment.Provider, refund db.PaymentRefund) (bool, error) {
+	charge, err := q.GetPaymentChargeByID(ctx, refund.PaymentChargeID)
+	if err != nil {
+		return false, err
+	}
+
+	Amount, _ := strconv.ParseFloat(refund.Amount, 64)
+	if err := gateway.Refund(ctx, charge.ChargeID, Amount, payment.RefundKey(refund.ID)); err != nil {
+		Attempts := int(refund.Attempts) + 1
+		Status := payment.RefundPending
+		if Attempts >= payment.MaxRefundAttempts {
+			Status = payment.RefundFailed
+		}
+
+		failArgs := db.MarkPaymentRefundAttemptFailedParams{
+			ID:            refund.ID,
+			Status:        Status,
+			NextAttemptAt: time.Now().Add(notifier.Backoff(Attempts)),
+			LastError:     sql.NullString{String: err.Error(), Valid: true},
+		}
+		return false, q.MarkPaymentRefundAttemptFailed(ctx, failArgs)
+	}
+
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer tx.Rollback()
+	qtx := q.WithTx(tx)
+
+	if err := qtx.MarkPaymentRefundSucceeded(ctx, refund.ID); err != nil {
+		return false, err
+	}
+	if err := qtx.MarkPaymentChargeRefunded(ctx, charge.ID); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// applyChargeStatus menyimpan status charge dan memindahkan status order yang
+// masih pending_payment menjadi paid atau expired
+func (c *PaymentController) applyChargeStatus(ctx context.Context, charge db.PaymentCharge, status string, paidAt time.Time) (db.PaymentCharge, error) {
+	switch payment.Transition(charge.Status, status) {
+	case payment.ActionIgnore:
+		return charge, nil
+	case payment.ActionRefundLate:
+		return c.refundLatePayment(ctx, charge, paidAt)
+	}
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		return charge, err
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	if status == payment.StatusPaid && paidAt.IsZero() {
+		paidAt = time.Now()
+	}
+
+	chargeArgs := &db.UpdatePaymentChargeStatusParams{
+		ID:     charge.ID,
+		Status: status,
+		PaidAt: sql.NullTime{Time: paidAt, Valid: status == payment.StatusPaid},
+	}
+	updated, err := qtx.UpdatePaymentChargeStatus(ctx, *chargeArgs)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// charge sudah diproses lebih dulu, status baru diterapkan pada status terbarunya
+			current, err := c.db.GetPaymentChargeByID(ctx, charge.ID)
+			if err != nil {
+				return charge, err
+			}
+			tx.Rollback()
+			return c.applyChargeStatus(ctx, current, status, paidAt)
+		}
+		return charge, err
+	}
+	charge = updated
+
+	order, err := qtx.GetOrderByIDForUpdate(ctx, charge.OrderID.Int64)
+	if err != nil {
+		return charge, err
+	}
+
+	if order.Status == "pending_payment" {
+		if status != payment.StatusPaid {
+			if err := expirePendingOrder(ctx, qtx, order); err != nil {
+				return charge, err
+			}
+		} else {
+			if err := activateOrderGiftCards(ctx, qtx, order.ID); err != nil {
+				return charge, err
+			}
+
+			orderArgs := &db.UpdateOrderStatusParams{
+				ID:     order.ID,
+				Status: "paid",
+			}
+			if _, err := qtx.UpdateOrderStatus(ctx, *orderArgs); err != nil {
+				return charge, err
+			}
+		}
+	}
+
+	return charge, tx.Commit()
+}
+
+// refundLatePayment mencatat pembayaran yang masuk setelah charge kedaluwarsa atau
+// gagal lalu mengembalikan dananya. Order tetap expired karena stoknya sudah dilepas;
+// refund yang ditolak gateway dicoba ulang oleh job.
+func (c *PaymentController) refundLatePayment(ctx context.Context, charge db.PaymentCharge, paidAt time.Time) (db.PaymentCharge, error) {
+	if paidAt.IsZero() {
+		paidAt = time.Now()
+	}
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		return charge, err
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	chargeArgs := db.MarkPaymentChargeLatePaidParams{
+		ID:     charge.ID,
+		PaidAt: sql.NullTime{Time: paidAt, Valid: true},
+	}
+	updated, err := qtx.MarkPaymentChargeLatePaid(ctx, chargeArgs)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// webhook berulang, pembayaran terlambat sudah dicatat
+			return c.db.GetPaymentChargeByID(ctx, charge.ID)
+		}
+		return charge, err
+	}
+
+	refundArgs := db.CreatePaymentRefundParams{
+		PaymentChargeID: updated.ID,
+		OrderID:         updated.OrderID,
+		Amount:          updated.Amount,
+	}
+	refund, err := qtx.CreatePaymentRefund(ctx, refundArgs)
+	recorded := err == nil
+	if err != nil && err != sql.ErrNoRows {
+		return charge, err
+	}
+	if err := tx.Commit(); err != nil {
+		return charge, err
+	}
+
+	if recorded {
+		if _, err := sendPaymentRefund(ctx, c.db, c.sqlDB, c.gateway, refund); err != nil {
+			return updated, err
+		}
+	}
+	return c.db.GetPaymentChargeByID(ctx, updated.ID)
+}
+
+// expirePendingOrder menutup order pending_payment yang tidak dibayar: stok,
+// voucher, poin dan saldo yang dipakai dikembalikan lalu status order menjadi expired
+func expirePendingOrder(ctx context.Context, q *db.Queries, order db.Order) error {
+	if err := releaseOrderStock(ctx, q, order, order.TrxNumber+"-EXPIRED", "Payment Expired", movement.ReasonPaymentExpired, 0); err != nil {
+		return err
+	}
+	if err := reverseOrderVoucher(ctx, q, order.ID); err != nil {
+		return err
+	}
+	if err := reverseOrderPoints(ctx, q, order.ID, 0); err != nil {
+		return err
+	}
+	if err := reverseOrderStoredValue(ctx, q, order.ID, 0); err != nil {
+		return err
+	}
+	if err := voidOrderGiftCards(ctx, q, order.ID, 0); err != nil {
+		return err
+	}
+
+	orderArgs := &db.UpdateOrderStatusParams{
+		ID:     order.ID,
+		Status: "expired",
+	}
+	_, err := q.UpdateOrderStatus(ctx, *orderArgs)
+	return err
+}
+
+// expireUnchargedOrder menutup order pending_payment yang gagal dibuatkan charge
+// di payment gateway
+func expireUnchargedOrder(ctx context.Context, q *db.Queries, sqlDB *sql.DB, orderID int64) error {
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+	qtx := q.WithTx(tx)
+
+	order, err := qtx.GetOrderByIDForUpdate(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if order.Status != "pending_payment" {
+		return nil
+	}
+	if err := expirePendingOrder(ctx, qtx, order); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// releaseOrderStock mengembalikan stok item order dan mencatatnya di product history
+func releaseOrderStock(ctx context.Context, q *db.Queries, order db.Order, trxRef string, reason string, reasonCode string, userID int64) error {
+	items, err := q.GetOrderItemsByOrderID(ctx, sql.NullInt64{Int64: order.ID, Valid: true})
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		product, err := q.GetProductByIDForUpdate(ctx, item.ProductID.Int64)
+		if err != nil {
+			return err
+		}
+
+		stockArgs := db.IncrementProductStockParams{
+			Quantity:  item.Quantity,
+			UpdatedBy: sql.NullInt64{Int64: userID, Valid: userID != 0},
+			ID:        product.ID,
+		}
+		updated, err := q.IncrementProductStock(ctx, stockArgs)
+		if err != nil {
+			return err
+		}
+		if err := trackStockLevel(ctx, q, product.Stock, updated, trxRef, userID); err != nil {
+			return err
+		}
+
+		UnitCost, _ := strconv.ParseFloat(item.UnitCost, 64)
+		if err := receiveStockCost(ctx, q, product, item.Quantity, UnitCost, costing.SourceReturn, trxRef); err != nil {
+			return err
+		}
+
+		historyArgs := &db.CreateProductHistoryParams{
+			TrxRef:         trxRef,
+			ProductID:      sql.NullInt64{Int64: product.ID, Valid: true},
+			QuantityChange: item.Quantity,
+			Type:           movement.TypeRefund,
+			Reason:         sql.NullString{String: reason, Valid: true},
+			ReasonCode:     sql.NullString{String: reasonCode, Valid: true},
+			CreatedBy:      sql.NullInt64{Int64: userID, Valid: userID != 0},
+		}
+		if _, err := q.CreateProductHistory(ctx, *historyArgs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func paymentChargeData(charge db.PaymentCharge) schemas.PaymentChargeData {
+	Amount, _ := strconv.ParseFloat(charge.Amount, 64)
+	return schemas.PaymentChargeData{
+		OrderID:       common.ConvertNullInt64(charge.OrderID),
+		Provider:      charge.Provider,
+		ChargeID:      charge.ChargeID,
+		PaymentMethod: charge.PaymentMethod,
+		Amount:        Amount,
+		Status:        charge.Status,
+		PaymentURL:    common.ConvertNullString(charge.PaymentUrl),
+		QRString:      common.ConvertNullString(charge.QrString),
+		ExpiresAt:     common.ConvertNullTime(charge.ExpiresAt),
+		PaidAt:        common.ConvertNullTime(charge.PaidAt),
+	}
+}
@@ -0,0 +1,159 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	db "pos-api/db/sqlc"
+	"pos-api/util/payment"
+
+	"github.com/gin-gonic/gin"
+)
+
+func sendWebhook(c *PaymentController, body []byte, signature string) *httptest.ResponseRecorder {
+	router := gin.New()
+	router.POST("/payments/webhook", c.Webhook)
+
+	req := httptest.NewRequest(http.MethodPost, "/payments/webhook", bytes.NewReader(body))
+	req.Header.Set("X-Signature", signature)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestWebhookRejectsBadSignature(t *testing.T) {
+	provider := payment.NewFakeProvider("secret", time.Minute)
+	c := NewPaymentController(nil, nil, provider, context.Background())
+	body := []byte(`{"charge_id":"FAKE-1","status":"paid"}`)
+
+	tests := []struct {
+		name      string
+		signature string
+	}{
+		{"missing signature", ""},
+		{"wrong secret", payment.NewFakeProvider("other", time.Minute).Sign(body)},
+		{"signature of another body", provider.Sign([]byte(`{"charge_id":"FAKE-2","status":"paid"}`))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if rec := sendWebhook(c, body, tt.signature); rec.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+			}
+		})
+	}
+}
+
+// createTestCharge membuat charge di fake provider dan mencatatnya untuk order
+func createTestCharge(t *testing.T, q *db.Queries, provider *payment.FakeProvider, order db.Order) db.PaymentCharge {
+	t.Helper()
+	ctx := context.Background()
+
+	Amount, _ := strconv.ParseFloat(order.TotalAmount, 64)
+	remote, err := provider.CreateCharge(ctx, payment.ChargeRequest{Reference: order.TrxNumber, Method: "qris", Amount: Amount})
+	if err != nil {
+		t.Fatalf("CreateCharge() error = %v", err)
+	}
+
+	charge, err := q.CreatePaymentCharge(ctx, db.CreatePaymentChargeParams{
+		OrderID:       sql.NullInt64{Int64: order.ID, Valid: true},
+		Provider:      provider.Name(),
+		ChargeID:      remote.ID,
+		PaymentMethod: "qris",
+		Amount:        order.TotalAmount,
+		Status:        payment.StatusPending,
+		ExpiresAt:     sql.NullTime{Time: remote.ExpiresAt, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("CreatePaymentCharge() error = %v", err)
+	}
+	return charge
+}
+
+func paidWebhook(chargeID string) []byte {
+	return []byte(fmt.Sprintf(`{"charge_id":%q,"status":"paid"}`, chargeID))
+}
+
+func TestWebhookRepeatedPaid(t *testing.T) {
+	q, sqlDB := testDB(t)
+	ctx := context.Background()
+	provider := payment.NewFakeProvider("secret", time.Minute)
+	c := NewPaymentController(q, sqlDB, provider, ctx)
+
+	product := createTestProduct(t, q, 10)
+	order := createTestOrder(t, q, product, 2, "pending_payment")
+	charge := createTestCharge(t, q, provider, order)
+
+	body := paidWebhook(charge.ChargeID)
+	for i := 0; i < 2; i++ {
+		if rec := sendWebhook(c, body, provider.Sign(body)); rec.Code != http.StatusOK {
+			t.Fatalf("webhook %d status = %d, body %s", i+1, rec.Code, rec.Body.String())
+		}
+	}
+
+	order, err := q.GetOrderByID(ctx, order.ID)
+	if err != nil || order.Status != "paid" {
+		t.Errorf("order status = %q, %v, want paid", order.Status, err)
+	}
+	product, err = q.GetProductByID(ctx, product.ID)
+	if err != nil || product.Stock != 8 {
+		t.Errorf("stock = %d, %v, want 8", product.Stock, err)
+	}
+}
+
+func TestExpirePendingPaymentsReleasesStock(t *testing.T) {
+	q, sqlDB := testDB(t)
+	ctx := context.Background()
+	// charge langsung kedaluwarsa di provider
+	provider := payment.NewFakeProvider("secret", -time.Minute)
+	c := NewPaymentController(q, sqlDB, provider, ctx)
+
+	product := createTestProduct(t, q, 10)
+	order := createTestOrder(t, q, product, 3, "pending_payment")
+	charge := createTestCharge(t, q, provider, order)
+
+	if err := c.ExpirePendingPayments(ctx); err != nil {
+		t.Fatalf("ExpirePendingPayments() error = %v", err)
+	}
+
+	charge, err := q.GetPaymentChargeByID(ctx, charge.ID)
+	if err != nil || charge.Status != payment.StatusExpired {
+		t.Errorf("charge status = %q, %v, want expired", charge.Status, err)
+	}
+	order, err = q.GetOrderByID(ctx, order.ID)
+	if err != nil || order.Status != "expired" {
+		t.Errorf("order status = %q, %v, want expired", order.Status, err)
+	}
+	product, err = q.GetProductByID(ctx, product.ID)
+	if err != nil || product.Stock != 10 {
+		t.Errorf("stock = %d, %v, want 10", product.Stock, err)
+	}
+
+	// pembayaran yang masuk setelah kedaluwarsa dikembalikan, stok tidak dipotong lagi
+	body := paidWebhook(charge.ChargeID)
+	for i := 0; i < 2; i++ {
+		if rec := sendWebhook(c, body, provider.Sign(body)); rec.Code != http.StatusOK {
+			t.Fatalf("late webhook %d status = %d, body %s", i+1, rec.Code, rec.Body.String())
+		}
+	}
+
+	charge, err = q.GetPaymentChargeByID(ctx, charge.ID)
+	if err != nil || charge.Status != payment.StatusRefunded || !charge.PaidAt.Valid {
+		t.Errorf("charge = %q paid_at %v, %v, want refunded with paid_at", charge.Status, charge.PaidAt, err)
+	}
+	order, err = q.GetOrderByID(ctx, order.ID)
+	if err != nil || order.Status != "expired" {
+		t.Errorf("order status = %q, %v, want expired", order.Status, err)
+	}
+	product, err = q.GetProductByID(ctx, product.ID)
+	if err != nil || product.Stock != 10 {
+		t.Errorf("stock = %d, %v, want 10", product.Stock, err)
+	}
+}
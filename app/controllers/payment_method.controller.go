@@ -0,0 +1,378 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/jwt"
+	"pos-api/util/payment"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PaymentMethodController struct {
+	db  *db.Queries
+	ctx context.Context
+}
+
+func NewPaymentMethodController(db *db.Queries, ctx context.Context) *PaymentMethodController {
+	return &PaymentMethodController{db, ctx}
+}
+
+// CreatePaymentMethod godoc
+// @Security BearerAuth
+// @Summary Create a new payment method
+// @Description Create a new payment method (cash, card, e-wallet, transfer, other) that can be used as tender
+// @Tags payment-methods
+// @Accept json
+// @Produce json
+// @Param payload body schemas.CreatePaymentMethod true "Payment Method Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/payment-methods [post]
+func (c *PaymentMethodController) CreatePaymentMethod(ctx *gin.Context) {
+	var payload schemas.CreatePaymentMethod
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	args := &db.CreatePaymentMethodParams{
+		Code:              strings.ToLower(strings.TrimSpace(payload.Code)),
+		Name:              payload.Name,
+		Type:              payload.Type,
+		RequiresReference: payload.RequiresReference,
+		Gateway:           payload.Gateway,
+		CreatedBy:         sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	method, err := c.db.CreatePaymentMethod(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "created successfully",
+		"data":    paymentMethodData(method),
+	})
+}
+
+// UpdatePaymentMethod godoc
+// @Security BearerAuth
+// @Summary Update an existing payment method
+// @Description Update a payment method with the given ID and payload
+// @Tags payment-methods
+// @Accept json
+// @Produce json
+// @Param id path int true "Payment Method ID"
+// @Param payload body schemas.UpdatePaymentMethod true "Payment Method Update Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/payment-methods/{id} [put]
+func (c *PaymentMethodController) UpdatePaymentMethod(ctx *gin.Context) {
+	var payload schemas.UpdatePaymentMethod
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid payment method id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	args := &db.UpdatePaymentMethodParams{
+		ID:                id,
+		Code:              strings.ToLower(strings.TrimSpace(payload.Code)),
+		Name:              payload.Name,
+		Type:              payload.Type,
+		RequiresReference: payload.RequiresReference,
+		Gateway:           payload.Gateway,
+		IsActive:          payload.IsActive,
+		UpdatedBy:         sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	method, err := c.db.UpdatePaymentMethod(ctx, *args)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve payment method with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "updated successfully",
+		"data":    paymentMethodData(method),
+	})
+}
+
+// GetPaymentMethodById godoc
+// @Security BearerAuth
+// @Summary Get a payment method by ID
+// @Description Retrieve a payment method by ID
+// @Tags payment-methods
+// @Produce json
+// @Param id path int true "Payment Method ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/payment-methods/{id} [get]
+func (c *PaymentMethodController) GetPaymentMethodById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid payment method id",
+		})
+		return
+	}
+
+	method, err := c.db.GetPaymentMethodByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve payment method with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "payment method retrieved successfully",
+		"data":    paymentMethodData(method),
+	})
+}
+
+// GetAllPaymentMethods godoc
+// @Security BearerAuth
+// @Summary Get all payment methods
+// @Description Retrieve all payment methods with pagination
+// @Tags payment-methods
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Limit per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/payment-methods [get]
+func (c *PaymentMethodController) GetAllPaymentMethods(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	offset := (page - 1) * limit
+
+	args := &db.GetAllPaymentMethodsParams{
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	}
+
+	methods, err := c.db.GetAllPaymentMethods(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.PaymentMethodData, len(methods))
+	for i, method := range methods {
+		data[i] = paymentMethodData(method)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// SoftDeletePaymentMethodById godoc
+// @Security BearerAuth
+// @Summary Soft delete a payment method by ID
+// @Description Soft delete a payment method with the given ID
+// @Tags payment-methods
+// @Produce json
+// @Param id path int true "Payment Method ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/payment-methods/{id}/soft [delete]
+func (c *PaymentMethodController) SoftDeletePaymentMethodById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid payment method id",
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	args := &db.SoftDeletePaymentMethodByIDParams{
+		ID:        id,
+		DeletedBy: sql.NullInt64{Int64: UserID, Valid: true},
+	}
+	if _, err = c.db.SoftDeletePaymentMethodByID(ctx, *args); err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve payment method with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "soft deleted successfully",
+	})
+}
+
+// loadTenders mencocokkan pembayaran di payload dengan metode pembayaran yang aktif.
+// Payload lama yang hanya mengirim payment_method dianggap satu tender sebesar total order,
+// tanpa kewajiban nomor referensi karena payload lama tidak pernah mengirimkannya.
+func loadTenders(ctx context.Context, q *db.Queries, payload schemas.CreateOrder, total float64) ([]payment.Tender, error) {
+	payments := payload.Payments
+	legacy := len(payments) == 0
+	if legacy {
+		if payload.PaymentMethod == "" {
+			return nil, payment.ErrNoTender
+		}
+		payments = []schemas.CreateOrderPayment{{PaymentMethod: payload.PaymentMethod, Amount: total}}
+	}
+
+	tenders := make([]payment.Tender, len(payments))
+	for i, p := range payments {
+		code := strings.ToLower(strings.TrimSpace(p.PaymentMethod))
+		method, err := q.GetPaymentMethodByCode(ctx, code)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, fmt.Errorf("%w: %s", payment.ErrUnknownMethod, code)
+			}
+			return nil, err
+		}
+		if !method.IsActive {
+			return nil, fmt.Errorf("%w: %s", payment.ErrUnknownMethod, code)
+		}
+
+		tenders[i] = payment.Tender{
+			MethodID:          method.ID,
+			Method:            method.Code,
+			Type:              method.Type,
+			Amount:            p.Amount,
+			Reference:         p.Reference,
+			Pin:               p.Pin,
+			RequiresReference: method.RequiresReference && !legacy,
+			Gateway:           method.Gateway,
+		}
+	}
+	return tenders, nil
+}
+
+func paymentMethodData(method db.PaymentMethod) schemas.PaymentMethodData {
+	return schemas.PaymentMethodData{
+		ID:                method.ID,
+		Code:              method.Code,
+		Name:              method.Name,
+		Type:              method.Type,
+		RequiresReference: method.RequiresReference,
+		Gateway:           method.Gateway,
+		IsActive:          method.IsActive,
+		CreatedBy:         common.ConvertNullInt64(method.CreatedBy),
+		CreatedAt:         common.ConvertNullTime(method.CreatedAt),
+		UpdatedBy:         common.ConvertNullInt64(method.UpdatedBy),
+		UpdatedAt:         common.ConvertNullTime(method.UpdatedAt),
+	}
+}
+
+func orderPaymentData(p db.OrderPayment) schemas.OrderPaymentData {
+	Amount, _ := strconv.ParseFloat(p.Amount, 64)
+	TenderedAmount, _ := strconv.ParseFloat(p.TenderedAmount, 64)
+	ChangeAmount, _ := strconv.ParseFloat(p.ChangeAmount, 64)
+	return schemas.OrderPaymentData{
+		PaymentMethod:  p.PaymentMethod,
+		Amount:         Amount,
+		TenderedAmount: TenderedAmount,
+		ChangeAmount:   ChangeAmount,
+		Reference:      common.ConvertNullString(p.Reference),
+	}
+}
@@ -10,6 +10,7 @@ import (
 	db "pos-api/db/sqlc"
 	"pos-api/util/common"
 	"pos-api/util/jwt"
+	"pos-api/util/stockcheck"
 
 	"github.com/gin-gonic/gin"
 )
@@ -79,11 +80,23 @@ func (p *ProductController) CreateProduct(ctx *gin.Context) {
 		return
 	}
 
+	if payload.SupplierID != 0 {
+		if err := p.checkSupplier(ctx, payload.SupplierID); err != nil {
+			return
+		}
+	}
+
 	args := &db.CreateProductParams{
-		Name:       payload.Name,
-		Price:      strconv.FormatFloat(payload.Price, 'f', 2, 64),
-		CategoryID: sql.NullInt64{Int64: payload.CategoryID, Valid: true},
-		CreatedBy:  sql.NullInt64{Int64: UserID, Valid: true},
+		Name:            payload.Name,
+		Price:           strconv.FormatFloat(payload.Price, 'f', 2, 64),
+		CategoryID:      sql.NullInt64{Int64: payload.CategoryID, Valid: true},
+		TaxRateID:       sql.NullInt64{Int64: payload.TaxRateID, Valid: payload.TaxRateID != 0},
+		IsGiftCard:      payload.IsGiftCard,
+		Barcode:         sql.NullString{String: payload.Barcode, Valid: payload.Barcode != ""},
+		MinStock:        payload.MinStock,
+		ReorderQuantity: payload.ReorderQty,
+		SupplierID:      sql.NullInt64{Int64: payload.SupplierID, Valid: payload.SupplierID != 0},
+		CreatedBy:       sql.NullInt64{Int64: UserID, Valid: true},
 	}
 
 	product, err := p.db.CreateProduct(ctx, *args)
@@ -108,13 +121,21 @@ func (p *ProductController) CreateProduct(ctx *gin.Context) {
 	}
 
 	data := schemas.ProductData{
-		ID:         product.ID,
-		Name:       product.Name,
-		Price:      price,
-		Stock:      product.Stock,
-		CategoryID: common.ConvertNullInt64(product.CategoryID),
-		CreatedBy:  common.ConvertNullInt64(product.CreatedBy),
-		CreatedAt:  common.ConvertNullTime(product.CreatedAt),
+		ID:            product.ID,
+		Name:          product.Name,
+		Barcode:       common.ConvertNullString(product.Barcode),
+		Price:         price,
+		Cost:          productCost(product),
+		Stock:         product.Stock,
+		ReservedStock: product.ReservedStock,
+		MinStock:      product.MinStock,
+		ReorderQty:    product.ReorderQuantity,
+		SupplierID:    common.ConvertNullInt64(product.SupplierID),
+		CategoryID:    common.ConvertNullInt64(product.CategoryID),
+		TaxRateID:     common.ConvertNullInt64(product.TaxRateID),
+		IsGiftCard:    product.IsGiftCard,
+		CreatedBy:     common.ConvertNullInt64(product.CreatedBy),
+		CreatedAt:     common.ConvertNullTime(product.CreatedAt),
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{
@@ -182,7 +203,8 @@ func (p *ProductController) UpdateProduct(ctx *gin.Context) {
 		return
 	}
 
-	if _, err := p.db.GetProductByID(ctx, id); err != nil {
+	existing, err := p.db.GetProductByID(ctx, id)
+	if err != nil {
 		if err == sql.ErrNoRows {
 			ctx.JSON(http.StatusNotFound, gin.H{
 				"status":  "failed",
@@ -197,6 +219,34 @@ func (p *ProductController) UpdateProduct(ctx *gin.Context) {
 		return
 	}
 
+	IsGiftCard := existing.IsGiftCard
+	if payload.IsGiftCard != nil {
+		IsGiftCard = *payload.IsGiftCard
+	}
+
+	Barcode := existing.Barcode
+	if payload.Barcode != "" {
+		Barcode = sql.NullString{String: payload.Barcode, Valid: true}
+	}
+
+	MinStock := existing.MinStock
+	if payload.MinStock != nil {
+		MinStock = *payload.MinStock
+	}
+
+	ReorderQty := existing.ReorderQuantity
+	if payload.ReorderQty != nil {
+		ReorderQty = *payload.ReorderQty
+	}
+
+	SupplierID := existing.SupplierID
+	if payload.SupplierID != 0 {
+		if err := p.checkSupplier(ctx, payload.SupplierID); err != nil {
+			return
+		}
+		SupplierID = sql.NullInt64{Int64: payload.SupplierID, Valid: true}
+	}
+
 	if payload.Price < 0 {
 		ctx.JSON(http.StatusBadRequest, gin.H{
 			"status":  "failed",
@@ -206,11 +256,17 @@ func (p *ProductController) UpdateProduct(ctx *gin.Context) {
 	}
 
 	args := &db.UpdateProductParams{
-		ID:         id,
-		Name:       payload.Name,
-		Price:      strconv.FormatFloat(payload.Price, 'f', 2, 64),
-		CategoryID: sql.NullInt64{Int64: payload.CategoryID, Valid: true},
-		UpdatedBy:  sql.NullInt64{Int64: UserID, Valid: true},
+		ID:              id,
+		Name:            payload.Name,
+		Price:           strconv.FormatFloat(payload.Price, 'f', 2, 64),
+		CategoryID:      sql.NullInt64{Int64: payload.CategoryID, Valid: true},
+		TaxRateID:       sql.NullInt64{Int64: payload.TaxRateID, Valid: payload.TaxRateID != 0},
+		IsGiftCard:      IsGiftCard,
+		Barcode:         Barcode,
+		MinStock:        MinStock,
+		ReorderQuantity: ReorderQty,
+		SupplierID:      SupplierID,
+		UpdatedBy:       sql.NullInt64{Int64: UserID, Valid: true},
 	}
 
 	product, err := p.db.UpdateProduct(ctx, *args)
@@ -235,13 +291,21 @@ func (p *ProductController) UpdateProduct(ctx *gin.Context) {
 	}
 
 	data := schemas.ProductData{
-		ID:         product.ID,
-		Name:       product.Name,
-		Price:      price,
-		Stock:      product.Stock,
-		CategoryID: common.ConvertNullInt64(product.CategoryID),
-		UpdatedBy:  common.ConvertNullInt64(product.UpdatedBy),
-		UpdatedAt:  common.ConvertNullTime(product.UpdatedAt),
+		ID:            product.ID,
+		Name:          product.Name,
+		Barcode:       common.ConvertNullString(product.Barcode),
+		Price:         price,
+		Cost:          productCost(product),
+		Stock:         product.Stock,
+		ReservedStock: product.ReservedStock,
+		MinStock:      product.MinStock,
+		ReorderQty:    product.ReorderQuantity,
+		SupplierID:    common.ConvertNullInt64(product.SupplierID),
+		CategoryID:    common.ConvertNullInt64(product.CategoryID),
+		TaxRateID:     common.ConvertNullInt64(product.TaxRateID),
+		IsGiftCard:    product.IsGiftCard,
+		UpdatedBy:     common.ConvertNullInt64(product.UpdatedBy),
+		UpdatedAt:     common.ConvertNullTime(product.UpdatedAt),
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{
@@ -302,17 +366,25 @@ func (p *ProductController) GetProductById(ctx *gin.Context) {
 	}
 
 	data := schemas.ProductData{
-		ID:         product.ID,
-		Name:       product.Name,
-		Price:      price,
-		Stock:      product.Stock,
-		CategoryID: common.ConvertNullInt64(product.CategoryID),
-		CreatedBy:  common.ConvertNullInt64(product.CreatedBy),
-		CreatedAt:  common.ConvertNullTime(product.CreatedAt),
-		UpdatedBy:  common.ConvertNullInt64(product.UpdatedBy),
-		UpdatedAt:  common.ConvertNullTime(product.UpdatedAt),
-		DeletedBy:  common.ConvertNullInt64(product.DeletedBy),
-		DeletedAt:  common.ConvertNullTime(product.DeletedAt),
+		ID:            product.ID,
+		Name:          product.Name,
+		Barcode:       common.ConvertNullString(product.Barcode),
+		Price:         price,
+		Cost:          productCost(product),
+		Stock:         product.Stock,
+		ReservedStock: product.ReservedStock,
+		MinStock:      product.MinStock,
+		ReorderQty:    product.ReorderQuantity,
+		SupplierID:    common.ConvertNullInt64(product.SupplierID),
+		CategoryID:    common.ConvertNullInt64(product.CategoryID),
+		TaxRateID:     common.ConvertNullInt64(product.TaxRateID),
+		IsGiftCard:    product.IsGiftCard,
+		CreatedBy:     common.ConvertNullInt64(product.CreatedBy),
+		CreatedAt:     common.ConvertNullTime(product.CreatedAt),
+		UpdatedBy:     common.ConvertNullInt64(product.UpdatedBy),
+		UpdatedAt:     common.ConvertNullTime(product.UpdatedAt),
+		DeletedBy:     common.ConvertNullInt64(product.DeletedBy),
+		DeletedAt:     common.ConvertNullTime(product.DeletedAt),
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{
@@ -367,17 +439,25 @@ func (p *ProductController) GetAllProducts(ctx *gin.Context) {
 		}
 
 		data[i] = schemas.ProductData{
-			ID:         product.ID,
-			Name:       product.Name,
-			Price:      price,
-			Stock:      product.Stock,
-			CategoryID: common.ConvertNullInt64(product.CategoryID),
-			CreatedBy:  common.ConvertNullInt64(product.CreatedBy),
-			CreatedAt:  common.ConvertNullTime(product.CreatedAt),
-			UpdatedBy:  common.ConvertNullInt64(product.UpdatedBy),
-			UpdatedAt:  common.ConvertNullTime(product.UpdatedAt),
-			DeletedBy:  common.ConvertNullInt64(product.DeletedBy),
-			DeletedAt:  common.ConvertNullTime(product.DeletedAt),
+			ID:            product.ID,
+			Name:          product.Name,
+			Barcode:       common.ConvertNullString(product.Barcode),
+			Price:         price,
+			Cost:          productCost(product),
+			Stock:         product.Stock,
+			ReservedStock: product.ReservedStock,
+			MinStock:      product.MinStock,
+			ReorderQty:    product.ReorderQuantity,
+			SupplierID:    common.ConvertNullInt64(product.SupplierID),
+			CategoryID:    common.ConvertNullInt64(product.CategoryID),
+			TaxRateID:     common.ConvertNullInt64(product.TaxRateID),
+			IsGiftCard:    product.IsGiftCard,
+			CreatedBy:     common.ConvertNullInt64(product.CreatedBy),
+			CreatedAt:     common.ConvertNullTime(product.CreatedAt),
+			UpdatedBy:     common.ConvertNullInt64(product.UpdatedBy),
+			UpdatedAt:     common.ConvertNullTime(product.UpdatedAt),
+			DeletedBy:     common.ConvertNullInt64(product.DeletedBy),
+			DeletedAt:     common.ConvertNullTime(product.DeletedAt),
 		}
 	}
 
@@ -433,17 +513,25 @@ func (p *ProductController) GetAllDeletedProducts(ctx *gin.Context) {
 		}
 
 		data[i] = schemas.ProductData{
-			ID:         product.ID,
-			Name:       product.Name,
-			Price:      price,
-			Stock:      product.Stock,
-			CategoryID: common.ConvertNullInt64(product.CategoryID),
-			CreatedBy:  common.ConvertNullInt64(product.CreatedBy),
-			CreatedAt:  common.ConvertNullTime(product.CreatedAt),
-			UpdatedBy:  common.ConvertNullInt64(product.UpdatedBy),
-			UpdatedAt:  common.ConvertNullTime(product.UpdatedAt),
-			DeletedBy:  common.ConvertNullInt64(product.DeletedBy),
-			DeletedAt:  common.ConvertNullTime(product.DeletedAt),
+			ID:            product.ID,
+			Name:          product.Name,
+			Barcode:       common.ConvertNullString(product.Barcode),
+			Price:         price,
+			Cost:          productCost(product),
+			Stock:         product.Stock,
+			ReservedStock: product.ReservedStock,
+			MinStock:      product.MinStock,
+			ReorderQty:    product.ReorderQuantity,
+			SupplierID:    common.ConvertNullInt64(product.SupplierID),
+			CategoryID:    common.ConvertNullInt64(product.CategoryID),
+			TaxRateID:     common.ConvertNullInt64(product.TaxRateID),
+			IsGiftCard:    product.IsGiftCard,
+			CreatedBy:     common.ConvertNullInt64(product.CreatedBy),
+			CreatedAt:     common.ConvertNullTime(product.CreatedAt),
+			UpdatedBy:     common.ConvertNullInt64(product.UpdatedBy),
+			UpdatedAt:     common.ConvertNullTime(product.UpdatedAt),
+			DeletedBy:     common.ConvertNullInt64(product.DeletedBy),
+			DeletedAt:     common.ConvertNullTime(product.DeletedAt),
 		}
 	}
 
@@ -571,3 +659,187 @@ func (p *ProductController) SoftDeleteProductById(ctx *gin.Context) {
 		"message": "soft deleted successfully",
 	})
 }
+
+// productCost adalah harga pokok rata-rata produk, nol jika belum pernah diterima dengan harga beli
+func productCost(product db.Product) float64 {
+	cost, _ := strconv.ParseFloat(product.Cost, 64)
+	return cost
+}
+
+// GetProductLedger godoc
+// @Security BearerAuth
+// @Summary Get product stock ledger
+// @Description Get the stock movements of a product oldest first with the balance before and after each movement. Balances are summed forward from 0 over the full history; the difference between the product's stock and the history total is returned as drift
+// @Tags products
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param start_date query string false "Start date (YYYY-MM-DD)"
+// @Param end_date query string false "End date (YYYY-MM-DD), inclusive"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Limit per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 400,404,502 {object} schemas.Response
+// @Router /api/v1/products/{id}/ledger [get]
+func (p *ProductController) GetProductLedger(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid product id",
+		})
+		return
+	}
+
+	StartDate, EndDate, err := historyDateRange(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	offset := (page - 1) * limit
+
+	product, err := p.db.GetProductByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve product with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	args := db.GetProductLedgerParams{
+		ProductID: id,
+		StartDate: StartDate,
+		EndDate:   EndDate,
+		Limit:     int32(limit),
+		Offset:    int32(offset),
+	}
+
+	rows, err := p.db.GetProductLedger(ctx, args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	LedgerStock, err := p.db.GetProductLedgerTotal(ctx, sql.NullInt64{Int64: id, Valid: true})
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	balance := stockcheck.Balance{ProductID: product.ID, Stock: product.Stock, Expected: LedgerStock}
+
+	data := schemas.ProductLedgerData{
+		ProductID:   product.ID,
+		Name:        product.Name,
+		Stock:       product.Stock,
+		LedgerStock: LedgerStock,
+		Drift:       balance.Difference(),
+		Entries:     make([]schemas.ProductLedgerEntry, len(rows)),
+	}
+	for i, row := range rows {
+		data.Entries[i] = schemas.ProductLedgerEntry{
+			ID:             row.ID,
+			TrxRef:         row.TrxRef,
+			QuantityChange: row.QuantityChange,
+			Type:           row.Type,
+			ReasonCode:     common.ConvertNullString(row.ReasonCode),
+			Reason:         common.ConvertNullString(row.Reason),
+			BalanceBefore:  row.BalanceBefore,
+			BalanceAfter:   row.BalanceAfter,
+			CreatedBy:      common.ConvertNullInt64(row.CreatedBy),
+			CreatedAt:      common.ConvertNullTime(row.CreatedAt),
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// GetLowStockProducts godoc
+// @Security BearerAuth
+// @Summary Get low stock products
+// @Description Get products whose stock is at or below their minimum stock, lowest relative to the minimum first. Products with min_stock 0 are not monitored
+// @Tags products
+// @Produce json
+// @Param supplier_id query int false "Filter by supplier ID"
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/products/low-stock [get]
+func (p *ProductController) GetLowStockProducts(ctx *gin.Context) {
+	SupplierID, _ := strconv.ParseInt(ctx.DefaultQuery("supplier_id", "0"), 10, 64)
+
+	products, err := p.db.GetLowStockProducts(ctx, SupplierID)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.LowStockProductData, len(products))
+	for i, product := range products {
+		data[i] = schemas.LowStockProductData{
+			ID:           product.ID,
+			Name:         product.Name,
+			Barcode:      common.ConvertNullString(product.Barcode),
+			Stock:        product.Stock,
+			MinStock:     product.MinStock,
+			ReorderQty:   product.ReorderQuantity,
+			Shortage:     product.MinStock - product.Stock,
+			SupplierID:   common.ConvertNullInt64(product.SupplierID),
+			SupplierName: common.ConvertNullString(product.SupplierName),
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// checkSupplier memastikan supplier utama produk ada dan belum dihapus,
+// response error sudah ditulis jika gagal
+func (p *ProductController) checkSupplier(ctx *gin.Context, supplierID int64) error {
+	supplier, err := p.db.GetSupplierByID(ctx, supplierID)
+	if err == nil && supplier.DeletedAt.Valid {
+		err = sql.ErrNoRows
+	}
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "supplier id not found",
+			})
+			return err
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/costing"
+	"pos-api/util/movement"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestGetProductLedger(t *testing.T) {
+	q, sqlDB := testDB(t)
+	ctx := context.Background()
+	products := NewProductController(q, ctx)
+	history := NewProductHistoryController(q, sqlDB, costing.MethodAverage, ctx)
+
+	router := gin.New()
+	router.GET("/products/:id/ledger", products.GetProductLedger)
+	router.POST("/product-history", history.CreateProductHistory)
+
+	user := createTestUser(t, q)
+	product := createTestProduct(t, q, 0)
+
+	movements := []struct {
+		typ        string
+		reasonCode string
+		quantity   int32
+	}{
+		{movement.TypeAdjustment, movement.ReasonFound, 10},
+		{movement.TypeDamage, movement.ReasonDamaged, 3},
+		{movement.TypeTransfer, movement.ReasonTransferOut, -2},
+	}
+	for _, m := range movements {
+		body, _ := json.Marshal(map[string]any{"product_id": product.ID, "quantity_change": m.quantity, "type": m.typ, "reason_code": m.reasonCode})
+		req := httptest.NewRequest(http.MethodPost, "/product-history", bytes.NewReader(body))
+		authorize(t, req, user)
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("movement %s status = %d: %s", m.typ, rec.Code, rec.Body.String())
+		}
+	}
+
+	ledger := func(query string) (int, schemas.ProductLedgerData) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/products/%d/ledger%s", product.ID, query), nil))
+
+		var res struct {
+			Data schemas.ProductLedgerData `json:"data"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &res)
+		return rec.Code, res.Data
+	}
+
+	// saldo berjalan dimulai dari 0 dan berakhir di stok produk
+	code, data := ledger("")
+	if code != http.StatusOK {
+		t.Fatalf("ledger status = %d, want 200", code)
+	}
+	want := [][2]int64{{0, 10}, {10, 7}, {7, 5}}
+	if len(data.Entries) != len(want) {
+		t.Fatalf("entries = %d, want %d", len(data.Entries), len(want))
+	}
+	for i, entry := range data.Entries {
+		if entry.BalanceBefore != want[i][0] || entry.BalanceAfter != want[i][1] {
+			t.Errorf("entry %d balance = %d -> %d, want %d -> %d", i, entry.BalanceBefore, entry.BalanceAfter, want[i][0], want[i][1])
+		}
+	}
+	if data.Stock != 5 || data.LedgerStock != 5 || data.Drift != 0 {
+		t.Errorf("stock = %d, ledger = %d, drift = %d, want 5, 5, 0", data.Stock, data.LedgerStock, data.Drift)
+	}
+
+	// halaman kedua tetap memakai saldo dari awal ledger
+	if _, data := ledger("?page=2&limit=2"); len(data.Entries) != 1 || data.Entries[0].BalanceBefore != 7 {
+		t.Errorf("second page = %+v, want the last entry starting at 7", data.Entries)
+	}
+
+	// stok yang berubah tanpa product history dilaporkan sebagai drift
+	if _, err := q.IncrementProductStock(ctx, db.IncrementProductStockParams{Quantity: 2, ID: product.ID}); err != nil {
+		t.Fatalf("IncrementProductStock() error = %v", err)
+	}
+	if _, data := ledger(""); data.Stock != 7 || data.LedgerStock != 5 || data.Drift != 2 {
+		t.Errorf("stock = %d, ledger = %d, drift = %d, want 7, 5, 2", data.Stock, data.LedgerStock, data.Drift)
+	}
+
+	for _, query := range []string{"?start_date=31-01-2024", "?start_date=2024-02-01&end_date=2024-01-31"} {
+		if code, _ := ledger(query); code != http.StatusBadRequest {
+			t.Errorf("ledger%s status = %d, want 400", query, code)
+		}
+	}
+	if _, data := ledger("?end_date=2000-01-01"); len(data.Entries) != 0 {
+		t.Errorf("entries before the movements = %d, want 0", len(data.Entries))
+	}
+}
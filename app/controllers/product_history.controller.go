@@ -11,24 +11,28 @@ import (
 	"pos-api/app/schemas"
 	db "pos-api/db/sqlc"
 	"pos-api/util/common"
+	"pos-api/util/costing"
 	"pos-api/util/jwt"
+	"pos-api/util/movement"
 
 	"github.com/gin-gonic/gin"
 )
 
 type ProductHistoryController struct {
-	db  *db.Queries
-	ctx context.Context
+	db        *db.Queries
+	sqlDB     *sql.DB
+	valuation string // metode penilaian persediaan untuk stok yang keluar
+	ctx       context.Context
 }
 
-func NewProductHistoryController(db *db.Queries, ctx context.Context) *ProductHistoryController {
-	return &ProductHistoryController{db, ctx}
+func NewProductHistoryController(db *db.Queries, sqlDB *sql.DB, valuation string, ctx context.Context) *ProductHistoryController {
+	return &ProductHistoryController{db, sqlDB, valuation, ctx}
 }
 
 // CreateProductHistory godoc
 // @Security BearerAuth
 // @Summary Create product stock history
-// @Description Create a new product stock history and update product stock
+// @Description Create a manual stock movement and update product stock. Type is one of adjustment, transfer, damage, expiry or supplier_return with a matching reason_code; adjustment and transfer take the direction from the sign of quantity_change. Legacy "in"/"out" are recorded as adjustment with reason correction. Stock in is valued at unit_cost (default the product's average cost), stock out with the configured valuation method. Stock reserved by parked orders can not be taken out
 // @Tags product-history
 // @Accept json
 // @Produce json
@@ -49,8 +53,28 @@ func (p *ProductHistoryController) CreateProductHistory(ctx *gin.Context) {
 		return
 	}
 
-	// Get product first to check stock
-	product, err := p.db.GetProductByID(ctx, payload.ProductID)
+	Movement, err := movement.Normalize(payload.Type, payload.ReasonCode, payload.QuantityChange)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	tx, err := p.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback()
+	qtx := p.db.WithTx(tx)
+
+	// Get product first to check stock, locked until commit so concurrent movements can not overdraw it
+	product, err := qtx.GetProductByIDForUpdate(ctx, payload.ProductID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			ctx.JSON(http.StatusNotFound, gin.H{
@@ -74,8 +98,8 @@ func (p *ProductHistoryController) CreateProductHistory(ctx *gin.Context) {
 		return
 	}
 
-	// Validate stock for "out" type
-	if payload.Type == "out" && product.Stock < payload.QuantityChange {
+	// Validate stock for movements that take stock out, stock reserved by parked orders is not available
+	if Movement.Quantity < 0 && product.Stock-product.ReservedStock < -Movement.Quantity {
 		ctx.JSON(http.StatusBadRequest, gin.H{
 			"status":  "failed",
 			"message": "insufficient stock",
@@ -101,13 +125,14 @@ func (p *ProductHistoryController) CreateProductHistory(ctx *gin.Context) {
 	historyArgs := db.CreateProductHistoryParams{
 		TrxRef:         trxRef,
 		ProductID:      sql.NullInt64{Int64: payload.ProductID, Valid: true},
-		QuantityChange: payload.QuantityChange,
-		Type:           payload.Type,
-		Reason:         sql.NullString{String: payload.Reason, Valid: true},
+		QuantityChange: Movement.Quantity,
+		Type:           Movement.Type,
+		Reason:         sql.NullString{String: payload.Reason, Valid: payload.Reason != ""},
+		ReasonCode:     sql.NullString{String: Movement.ReasonCode, Valid: true},
 		CreatedBy:      sql.NullInt64{Int64: UserID, Valid: true},
 	}
 
-	history, err := p.db.CreateProductHistory(ctx, historyArgs)
+	history, err := qtx.CreateProductHistory(ctx, historyArgs)
 	if err != nil {
 		ctx.JSON(http.StatusBadGateway, gin.H{
 			"status":  "failed",
@@ -118,20 +143,14 @@ func (p *ProductHistoryController) CreateProductHistory(ctx *gin.Context) {
 	}
 
 	// Update product stock
-	newStock := product.Stock
-	if payload.Type == "in" {
-		newStock += payload.QuantityChange
-	} else {
-		newStock -= payload.QuantityChange
-	}
-
-	updateArgs := db.UpdateProductStockParams{
-		ID:        payload.ProductID,
-		Stock:     newStock,
+	stockArgs := db.IncrementProductStockParams{
+		Quantity:  Movement.Quantity,
 		UpdatedBy: sql.NullInt64{Int64: UserID, Valid: true},
+		ID:        payload.ProductID,
 	}
 
-	if _, err = p.db.UpdateProductStock(ctx, updateArgs); err != nil {
+	Updated, err := qtx.IncrementProductStock(ctx, stockArgs)
+	if err != nil {
 		ctx.JSON(http.StatusBadGateway, gin.H{
 			"status":  "failed",
 			"message": "failed to update product stock",
@@ -140,17 +159,44 @@ func (p *ProductHistoryController) CreateProductHistory(ctx *gin.Context) {
 		return
 	}
 
-	data := schemas.ProductHistoryData{
-		ID:             history.ID,
-		TrxRef:         history.TrxRef,
-		ProductID:      common.ConvertNullInt64(history.ProductID),
-		QuantityChange: history.QuantityChange,
-		Type:           history.Type,
-		Reason:         common.ConvertNullString(history.Reason),
-		CreatedBy:      common.ConvertNullInt64(history.CreatedBy),
-		CreatedAt:      common.ConvertNullTime(history.CreatedAt),
+	if err := trackStockLevel(ctx, qtx, product.Stock, Updated, trxRef, UserID); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": "failed to record stock alert",
+			"error":   err.Error(),
+		})
+		return
 	}
 
+	// Nilai persediaan: stok masuk menjadi cost layer baru, stok keluar mengurangi layer
+	if Movement.Quantity > 0 {
+		UnitCost := productCost(product)
+		if payload.UnitCost != nil {
+			UnitCost = *payload.UnitCost
+		}
+		err = receiveStockCost(ctx, qtx, product, Movement.Quantity, UnitCost, costing.SourceAdjustment, trxRef)
+	} else {
+		_, err = issueStockCost(ctx, qtx, p.valuation, product, -Movement.Quantity, trxRef)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": "failed to update inventory cost",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := productHistoryData(history)
+
 	ctx.JSON(http.StatusOK, gin.H{
 		"status":  "success",
 		"message": "stock updated successfully",
@@ -161,12 +207,20 @@ func (p *ProductHistoryController) CreateProductHistory(ctx *gin.Context) {
 // GetAllProductHistory godoc
 // @Security BearerAuth
 // @Summary Get all product history
-// @Description Retrieve all product history with pagination
+// @Description Retrieve product history with pagination, newest first, optionally filtered by product, movement type, reason code, transaction reference, user and date range
 // @Tags product-history
 // @Produce json
+// @Param product_id query int false "Filter by product ID"
+// @Param type query string false "Movement type (sale, refund, purchase, adjustment, transfer, damage, expiry, supplier_return)"
+// @Param reason_code query string false "Reason code"
+// @Param trx_ref query string false "Transaction reference"
+// @Param user_id query int false "Filter by the user who made the movement"
+// @Param start_date query string false "Start date (YYYY-MM-DD)"
+// @Param end_date query string false "End date (YYYY-MM-DD), inclusive"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Limit per page" default(10)
 // @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
 // @Failure 502 {object} schemas.Response
 // @Router /api/v1/product-history [get]
 func (p *ProductHistoryController) GetAllProductHistory(ctx *gin.Context) {
@@ -174,9 +228,39 @@ func (p *ProductHistoryController) GetAllProductHistory(ctx *gin.Context) {
 	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
 	offset := (page - 1) * limit
 
+	MovementType := ctx.Query("type")
+	if MovementType != "" {
+		if err := movement.ValidateType(MovementType); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+	}
+
+	StartDate, EndDate, err := historyDateRange(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ProductID, _ := strconv.ParseInt(ctx.DefaultQuery("product_id", "0"), 10, 64)
+	UserID, _ := strconv.ParseInt(ctx.DefaultQuery("user_id", "0"), 10, 64)
+
 	args := db.GetAllProductHistoryParams{
-		Limit:  int32(limit),
-		Offset: int32(offset),
+		ProductID:    ProductID,
+		MovementType: MovementType,
+		ReasonCode:   ctx.Query("reason_code"),
+		TrxRef:       ctx.Query("trx_ref"),
+		CreatedBy:    UserID,
+		StartDate:    StartDate,
+		EndDate:      EndDate,
+		Limit:        int32(limit),
+		Offset:       int32(offset),
 	}
 
 	histories, err := p.db.GetAllProductHistory(ctx, args)
@@ -191,15 +275,58 @@ func (p *ProductHistoryController) GetAllProductHistory(ctx *gin.Context) {
 
 	data := make([]schemas.ProductHistoryData, len(histories))
 	for i, history := range histories {
-		data[i] = schemas.ProductHistoryData{
-			ID:             history.ID,
-			TrxRef:         history.TrxRef,
-			ProductID:      common.ConvertNullInt64(history.ProductID),
-			QuantityChange: history.QuantityChange,
-			Type:           history.Type,
-			Reason:         common.ConvertNullString(history.Reason),
-			CreatedBy:      common.ConvertNullInt64(history.CreatedBy),
-			CreatedAt:      common.ConvertNullTime(history.CreatedAt),
+		data[i] = productHistoryData(history)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// GetProductHistorySummary godoc
+// @Security BearerAuth
+// @Summary Get product history summary
+// @Description Get the number of movements and the quantity in, out and net per movement type and reason code
+// @Tags product-history
+// @Produce json
+// @Param type query string false "Movement type (sale, refund, purchase, adjustment, transfer, damage, expiry, supplier_return)"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/product-history/summary [get]
+func (p *ProductHistoryController) GetProductHistorySummary(ctx *gin.Context) {
+	MovementType := ctx.Query("type")
+	if MovementType != "" {
+		if err := movement.ValidateType(MovementType); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+	}
+
+	rows, err := p.db.GetProductHistorySummary(ctx, MovementType)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": "failed to retrieve product history summary",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.ProductHistorySummaryData, len(rows))
+	for i, row := range rows {
+		data[i] = schemas.ProductHistorySummaryData{
+			Type:        row.Type,
+			ReasonCode:  row.ReasonCode,
+			Movements:   row.Movements,
+			QuantityIn:  row.QuantityIn,
+			QuantityOut: row.QuantityOut,
+			NetQuantity: row.NetQuantity,
 		}
 	}
 
@@ -209,3 +336,43 @@ func (p *ProductHistoryController) GetAllProductHistory(ctx *gin.Context) {
 		"data":    data,
 	})
 }
+
+// historyDateRange membaca filter start_date dan end_date (YYYY-MM-DD). Tanggal
+// akhir ikut dihitung; filter yang kosong berarti tanpa batas.
+func historyDateRange(ctx *gin.Context) (time.Time, time.Time, error) {
+	StartDate := time.Time{}
+	EndDate := time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	if value := ctx.Query("start_date"); value != "" {
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return StartDate, EndDate, fmt.Errorf("invalid start_date, use YYYY-MM-DD")
+		}
+		StartDate = date
+	}
+	if value := ctx.Query("end_date"); value != "" {
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return StartDate, EndDate, fmt.Errorf("invalid end_date, use YYYY-MM-DD")
+		}
+		EndDate = date.AddDate(0, 0, 1)
+	}
+	if !EndDate.After(StartDate) {
+		return StartDate, EndDate, fmt.Errorf("end_date must not be before start_date")
+	}
+	return StartDate, EndDate, nil
+}
+
+func productHistoryData(history db.ProductHistory) schemas.ProductHistoryData {
+	return schemas.ProductHistoryData{
+		ID:             history.ID,
+		TrxRef:         history.TrxRef,
+		ProductID:      common.ConvertNullInt64(history.ProductID),
+		QuantityChange: history.QuantityChange,
+		Type:           history.Type,
+		ReasonCode:     common.ConvertNullString(history.ReasonCode),
+		Reason:         common.ConvertNullString(history.Reason),
+		CreatedBy:      common.ConvertNullInt64(history.CreatedBy),
+		CreatedAt:      common.ConvertNullTime(history.CreatedAt),
+	}
+}
@@ -0,0 +1,67 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "pos-api/db/sqlc"
+	"pos-api/util/costing"
+	"pos-api/util/movement"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCreateProductHistoryReservedStock(t *testing.T) {
+	q, sqlDB := testDB(t)
+	ctx := context.Background()
+	c := NewProductHistoryController(q, sqlDB, costing.MethodAverage, ctx)
+
+	router := gin.New()
+	router.POST("/product-history", c.CreateProductHistory)
+
+	user := createTestUser(t, q)
+	product := createTestProduct(t, q, 10)
+
+	// 6 dari 10 unit ditahan parked order, hanya 4 yang boleh keluar
+	if _, err := q.ReserveProductStock(ctx, db.ReserveProductStockParams{Quantity: 6, ID: product.ID}); err != nil {
+		t.Fatalf("ReserveProductStock() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		quantity int32
+		code     int
+		stock    int32
+	}{
+		{"more than the available stock", 5, http.StatusBadRequest, 10},
+		{"exactly the available stock", 4, http.StatusOK, 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(map[string]any{
+				"product_id":      product.ID,
+				"quantity_change": tt.quantity,
+				"type":            movement.TypeDamage,
+				"reason_code":     movement.ReasonDamaged,
+			})
+			req := httptest.NewRequest(http.MethodPost, "/product-history", bytes.NewReader(body))
+			authorize(t, req, user)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if rec.Code != tt.code {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.code, rec.Body.String())
+			}
+
+			got, err := q.GetProductByID(ctx, product.ID)
+			if err != nil || got.Stock != tt.stock {
+				t.Errorf("stock = %d, %v, want %d", got.Stock, err, tt.stock)
+			}
+		})
+	}
+}
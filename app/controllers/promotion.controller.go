@@ -0,0 +1,563 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/jwt"
+	"pos-api/util/promotion"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PromotionController struct {
+	db    *db.Queries
+	sqlDB *sql.DB
+	ctx   context.Context
+}
+
+func NewPromotionController(db *db.Queries, sqlDB *sql.DB, ctx context.Context) *PromotionController {
+	return &PromotionController{db, sqlDB, ctx}
+}
+
+// CreatePromotion godoc
+// @Security BearerAuth
+// @Summary Create a new promotion
+// @Description Create a new promotion rule (buy X get Y, bundle, category percentage, time window, minimum spend)
+// @Tags promotions
+// @Accept json
+// @Produce json
+// @Param payload body schemas.CreatePromotion true "Promotion Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/promotions [post]
+func (c *PromotionController) CreatePromotion(ctx *gin.Context) {
+	var payload schemas.CreatePromotion
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if err := promotion.Validate(promotionRuleFromPayload(payload)); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	args := &db.CreatePromotionParams{
+		Name:            payload.Name,
+		Type:            payload.Type,
+		Priority:        payload.Priority,
+		Stackable:       payload.Stackable,
+		CategoryID:      sql.NullInt64{Int64: payload.CategoryID, Valid: payload.CategoryID != 0},
+		BuyQuantity:     payload.BuyQuantity,
+		GetQuantity:     payload.GetQuantity,
+		BundlePrice:     strconv.FormatFloat(payload.BundlePrice, 'f', 2, 64),
+		DiscountPercent: strconv.FormatFloat(payload.DiscountPercent, 'f', 2, 64),
+		DiscountAmount:  strconv.FormatFloat(payload.DiscountAmount, 'f', 2, 64),
+		MinSpend:        strconv.FormatFloat(payload.MinSpend, 'f', 2, 64),
+		DailyStart:      sql.NullString{String: payload.DailyStart, Valid: payload.DailyStart != ""},
+		DailyEnd:        sql.NullString{String: payload.DailyEnd, Valid: payload.DailyEnd != ""},
+		DaysOfWeek:      sql.NullString{String: payload.DaysOfWeek, Valid: payload.DaysOfWeek != ""},
+		StartAt:         sql.NullTime{Time: payload.StartAt, Valid: !payload.StartAt.IsZero()},
+		EndAt:           sql.NullTime{Time: payload.EndAt, Valid: !payload.EndAt.IsZero()},
+		CreatedBy:       sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	promo, err := qtx.CreatePromotion(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	products, err := savePromotionProducts(ctx, qtx, promo.ID, payload.Products)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	tx.Commit()
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "created successfully",
+		"data":    promotionData(promo, products),
+	})
+}
+
+// UpdatePromotion godoc
+// @Security BearerAuth
+// @Summary Update an existing promotion
+// @Description Update a promotion with the given ID and payload, products list is replaced
+// @Tags promotions
+// @Accept json
+// @Produce json
+// @Param id path int true "Promotion ID"
+// @Param payload body schemas.UpdatePromotion true "Promotion Update Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/promotions/{id} [put]
+func (c *PromotionController) UpdatePromotion(ctx *gin.Context) {
+	var payload schemas.UpdatePromotion
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid promotion id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if err := promotion.Validate(promotionRuleFromPayload(payload.CreatePromotion)); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	if _, err := c.db.GetPromotionByID(ctx, id); err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve promotion with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	args := &db.UpdatePromotionParams{
+		ID:              id,
+		Name:            payload.Name,
+		Type:            payload.Type,
+		Priority:        payload.Priority,
+		Stackable:       payload.Stackable,
+		IsActive:        payload.IsActive,
+		CategoryID:      sql.NullInt64{Int64: payload.CategoryID, Valid: payload.CategoryID != 0},
+		BuyQuantity:     payload.BuyQuantity,
+		GetQuantity:     payload.GetQuantity,
+		BundlePrice:     strconv.FormatFloat(payload.BundlePrice, 'f', 2, 64),
+		DiscountPercent: strconv.FormatFloat(payload.DiscountPercent, 'f', 2, 64),
+		DiscountAmount:  strconv.FormatFloat(payload.DiscountAmount, 'f', 2, 64),
+		MinSpend:        strconv.FormatFloat(payload.MinSpend, 'f', 2, 64),
+		DailyStart:      sql.NullString{String: payload.DailyStart, Valid: payload.DailyStart != ""},
+		DailyEnd:        sql.NullString{String: payload.DailyEnd, Valid: payload.DailyEnd != ""},
+		DaysOfWeek:      sql.NullString{String: payload.DaysOfWeek, Valid: payload.DaysOfWeek != ""},
+		StartAt:         sql.NullTime{Time: payload.StartAt, Valid: !payload.StartAt.IsZero()},
+		EndAt:           sql.NullTime{Time: payload.EndAt, Valid: !payload.EndAt.IsZero()},
+		UpdatedBy:       sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	promo, err := qtx.UpdatePromotion(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := qtx.DeletePromotionProductsByPromotionID(ctx, sql.NullInt64{Int64: id, Valid: true}); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	products, err := savePromotionProducts(ctx, qtx, promo.ID, payload.Products)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	tx.Commit()
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "updated successfully",
+		"data":    promotionData(promo, products),
+	})
+}
+
+// GetPromotionById godoc
+// @Security BearerAuth
+// @Summary Get a promotion by ID
+// @Description Retrieve a promotion and its products by ID
+// @Tags promotions
+// @Produce json
+// @Param id path int true "Promotion ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/promotions/{id} [get]
+func (c *PromotionController) GetPromotionById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid promotion id",
+		})
+		return
+	}
+
+	promo, err := c.db.GetPromotionByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve promotion with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	products, err := c.db.GetPromotionProductsByPromotionID(ctx, sql.NullInt64{Int64: id, Valid: true})
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "promotion retrieved successfully",
+		"data":    promotionData(promo, products),
+	})
+}
+
+// GetAllPromotions godoc
+// @Security BearerAuth
+// @Summary Get all promotions
+// @Description Retrieve all promotions with pagination, ordered by priority
+// @Tags promotions
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Limit per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/promotions [get]
+func (c *PromotionController) GetAllPromotions(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	offset := (page - 1) * limit
+
+	args := &db.GetAllPromotionsParams{
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	}
+
+	promos, err := c.db.GetAllPromotions(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.PromotionData, len(promos))
+	for i, promo := range promos {
+		products, err := c.db.GetPromotionProductsByPromotionID(ctx, sql.NullInt64{Int64: promo.ID, Valid: true})
+		if err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		data[i] = promotionData(promo, products)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// SoftDeletePromotionById godoc
+// @Security BearerAuth
+// @Summary Soft delete a promotion by ID
+// @Description Soft delete a promotion with the given ID
+// @Tags promotions
+// @Produce json
+// @Param id path int true "Promotion ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/promotions/{id}/soft [delete]
+func (c *PromotionController) SoftDeletePromotionById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid promotion id",
+		})
+		return
+	}
+
+	if _, err := c.db.GetPromotionByID(ctx, id); err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve promotion with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	args := &db.SoftDeletePromotionByIDParams{
+		ID:        id,
+		DeletedBy: sql.NullInt64{Int64: UserID, Valid: true},
+	}
+	if _, err = c.db.SoftDeletePromotionByID(ctx, *args); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "soft deleted successfully",
+	})
+}
+
+// loadPromotionRules memuat promosi aktif beserta produk syaratnya untuk dihitung oleh engine promosi
+func loadPromotionRules(ctx context.Context, q *db.Queries, now time.Time) ([]promotion.Rule, error) {
+	promos, err := q.GetActivePromotions(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]promotion.Rule, len(promos))
+	for i, promo := range promos {
+		products, err := q.GetPromotionProductsByPromotionID(ctx, sql.NullInt64{Int64: promo.ID, Valid: true})
+		if err != nil {
+			return nil, err
+		}
+		rules[i] = promotionRule(promo, products)
+	}
+	return rules, nil
+}
+
+func savePromotionProducts(ctx context.Context, q *db.Queries, promotionID int64, items []schemas.PromotionProduct) ([]db.PromotionProduct, error) {
+	products := make([]db.PromotionProduct, 0, len(items))
+	for _, item := range items {
+		quantity := item.Quantity
+		if quantity < 1 {
+			quantity = 1
+		}
+
+		args := &db.CreatePromotionProductParams{
+			PromotionID: sql.NullInt64{Int64: promotionID, Valid: true},
+			ProductID:   sql.NullInt64{Int64: item.ProductID, Valid: true},
+			Quantity:    quantity,
+		}
+		product, err := q.CreatePromotionProduct(ctx, *args)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, product)
+	}
+	return products, nil
+}
+
+func promotionRuleFromPayload(payload schemas.CreatePromotion) promotion.Rule {
+	products := make([]promotion.RuleProduct, len(payload.Products))
+	for i, p := range payload.Products {
+		products[i] = promotion.RuleProduct{ProductID: p.ProductID, Quantity: p.Quantity}
+	}
+
+	return promotion.Rule{
+		Name:            payload.Name,
+		Type:            payload.Type,
+		Priority:        payload.Priority,
+		Stackable:       payload.Stackable,
+		CategoryID:      payload.CategoryID,
+		BuyQuantity:     payload.BuyQuantity,
+		GetQuantity:     payload.GetQuantity,
+		BundlePrice:     payload.BundlePrice,
+		DiscountPercent: payload.DiscountPercent,
+		DiscountAmount:  payload.DiscountAmount,
+		MinSpend:        payload.MinSpend,
+		DailyStart:      payload.DailyStart,
+		DailyEnd:        payload.DailyEnd,
+		DaysOfWeek:      payload.DaysOfWeek,
+		Products:        products,
+	}
+}
+
+func promotionRule(promo db.Promotion, items []db.PromotionProduct) promotion.Rule {
+	products := make([]promotion.RuleProduct, len(items))
+	for i, p := range items {
+		products[i] = promotion.RuleProduct{ProductID: common.ConvertNullInt64(p.ProductID), Quantity: p.Quantity}
+	}
+
+	BundlePrice, _ := strconv.ParseFloat(promo.BundlePrice, 64)
+	DiscountPercent, _ := strconv.ParseFloat(promo.DiscountPercent, 64)
+	DiscountAmount, _ := strconv.ParseFloat(promo.DiscountAmount, 64)
+	MinSpend, _ := strconv.ParseFloat(promo.MinSpend, 64)
+
+	return promotion.Rule{
+		ID:              promo.ID,
+		Name:            promo.Name,
+		Type:            promo.Type,
+		Priority:        promo.Priority,
+		Stackable:       promo.Stackable,
+		CategoryID:      common.ConvertNullInt64(promo.CategoryID),
+		BuyQuantity:     promo.BuyQuantity,
+		GetQuantity:     promo.GetQuantity,
+		BundlePrice:     BundlePrice,
+		DiscountPercent: DiscountPercent,
+		DiscountAmount:  DiscountAmount,
+		MinSpend:        MinSpend,
+		DailyStart:      common.ConvertNullString(promo.DailyStart),
+		DailyEnd:        common.ConvertNullString(promo.DailyEnd),
+		DaysOfWeek:      common.ConvertNullString(promo.DaysOfWeek),
+		Products:        products,
+	}
+}
+
+func promotionData(promo db.Promotion, items []db.PromotionProduct) schemas.PromotionData {
+	rule := promotionRule(promo, items)
+	products := make([]schemas.PromotionProduct, len(items))
+	for i, p := range items {
+		products[i] = schemas.PromotionProduct{ProductID: common.ConvertNullInt64(p.ProductID), Quantity: p.Quantity}
+	}
+
+	return schemas.PromotionData{
+		ID:              promo.ID,
+		Name:            promo.Name,
+		Type:            promo.Type,
+		Priority:        promo.Priority,
+		Stackable:       promo.Stackable,
+		IsActive:        promo.IsActive,
+		CategoryID:      rule.CategoryID,
+		BuyQuantity:     promo.BuyQuantity,
+		GetQuantity:     promo.GetQuantity,
+		BundlePrice:     rule.BundlePrice,
+		DiscountPercent: rule.DiscountPercent,
+		DiscountAmount:  rule.DiscountAmount,
+		MinSpend:        rule.MinSpend,
+		DailyStart:      rule.DailyStart,
+		DailyEnd:        rule.DailyEnd,
+		DaysOfWeek:      rule.DaysOfWeek,
+		StartAt:         common.ConvertNullTime(promo.StartAt),
+		EndAt:           common.ConvertNullTime(promo.EndAt),
+		Products:        products,
+		CreatedBy:       common.ConvertNullInt64(promo.CreatedBy),
+		CreatedAt:       common.ConvertNullTime(promo.CreatedAt),
+		UpdatedBy:       common.ConvertNullInt64(promo.UpdatedBy),
+		UpdatedAt:       common.ConvertNullTime(promo.UpdatedAt),
+	}
+}
@@ -0,0 +1,1105 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/jwt"
+	"pos-api/util/purchase"
+	"pos-api/util/receipt"
+	"pos-api/util/reorder"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	errSupplierNotFound = errors.New("supplier not found")
+	errProductNotFound  = errors.New("product not found")
+)
+
+type PurchaseOrderController struct {
+	db       *db.Queries
+	sqlDB    *sql.DB
+	template receipt.Template
+	ctx      context.Context
+}
+
+func NewPurchaseOrderController(db *db.Queries, sqlDB *sql.DB, template receipt.Template, ctx context.Context) *PurchaseOrderController {
+	return &PurchaseOrderController{db, sqlDB, template, ctx}
+}
+
+// CreatePurchaseOrder godoc
+// @Security BearerAuth
+// @Summary Create a purchase order
+// @Description Create a draft purchase order to a supplier with the ordered products, quantities and expected unit costs
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param payload body schemas.CreatePurchaseOrder true "Purchase Order Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/purchase-orders [post]
+func (c *PurchaseOrderController) CreatePurchaseOrder(ctx *gin.Context) {
+	var payload schemas.CreatePurchaseOrder
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	Lines, err := purchaseOrderLines(ctx, qtx, payload.SupplierID, payload.Items)
+	if err != nil {
+		purchaseOrderLineError(ctx, err)
+		return
+	}
+
+	args := &db.CreatePurchaseOrderParams{
+		PoNumber:    purchase.GenerateNumber(UserID, time.Now()),
+		SupplierID:  payload.SupplierID,
+		Status:      purchase.StatusDraft,
+		ExpectedAt:  sql.NullTime{Time: payload.ExpectedAt, Valid: !payload.ExpectedAt.IsZero()},
+		Notes:       sql.NullString{String: payload.Notes, Valid: payload.Notes != ""},
+		TotalAmount: strconv.FormatFloat(purchase.Total(Lines), 'f', 2, 64),
+		CreatedBy:   sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	Order, err := qtx.CreatePurchaseOrder(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := createPurchaseOrderItems(ctx, qtx, Order.ID, Lines); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data, err := loadPurchaseOrder(ctx, qtx, Order)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "created successfully",
+		"data":    data,
+	})
+}
+
+// UpdatePurchaseOrder godoc
+// @Security BearerAuth
+// @Summary Update a draft purchase order
+// @Description Change the supplier, expected date, notes and lines of a purchase order that has not been sent. The lines in the payload replace all existing lines.
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param id path int true "Purchase Order ID"
+// @Param payload body schemas.UpdatePurchaseOrder true "Purchase Order Update Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/purchase-orders/{id} [put]
+func (c *PurchaseOrderController) UpdatePurchaseOrder(ctx *gin.Context) {
+	var payload schemas.UpdatePurchaseOrder
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid purchase order id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	Order, err := qtx.GetPurchaseOrderByIDForUpdate(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve purchase order with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if !purchase.CanEdit(Order.Status) {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": purchase.ErrNotEditable.Error(),
+		})
+		return
+	}
+
+	Lines, err := purchaseOrderLines(ctx, qtx, payload.SupplierID, payload.Items)
+	if err != nil {
+		purchaseOrderLineError(ctx, err)
+		return
+	}
+
+	args := &db.UpdatePurchaseOrderParams{
+		ID:          Order.ID,
+		SupplierID:  payload.SupplierID,
+		ExpectedAt:  sql.NullTime{Time: payload.ExpectedAt, Valid: !payload.ExpectedAt.IsZero()},
+		Notes:       sql.NullString{String: payload.Notes, Valid: payload.Notes != ""},
+		TotalAmount: strconv.FormatFloat(purchase.Total(Lines), 'f', 2, 64),
+		UpdatedBy:   sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	Order, err = qtx.UpdatePurchaseOrder(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := qtx.DeletePurchaseOrderItems(ctx, Order.ID); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := createPurchaseOrderItems(ctx, qtx, Order.ID, Lines); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data, err := loadPurchaseOrder(ctx, qtx, Order)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "updated successfully",
+		"data":    data,
+	})
+}
+
+// GetAllPurchaseOrders godoc
+// @Security BearerAuth
+// @Summary Get all purchase orders
+// @Description Retrieve purchase orders with pagination, newest first, optionally filtered by status and supplier
+// @Tags purchase-orders
+// @Produce json
+// @Param status query string false "Status (draft, sent, partially_received, received, cancelled)"
+// @Param supplier_id query int false "Supplier ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Limit per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/purchase-orders [get]
+func (c *PurchaseOrderController) GetAllPurchaseOrders(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	offset := (page - 1) * limit
+	SupplierID, _ := strconv.ParseInt(ctx.Query("supplier_id"), 10, 64)
+
+	args := &db.GetAllPurchaseOrdersParams{
+		Status:     ctx.Query("status"),
+		SupplierID: SupplierID,
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+	}
+
+	orders, err := c.db.GetAllPurchaseOrders(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.PurchaseOrderData, len(orders))
+	for i, order := range orders {
+		data[i] = purchaseOrderData(db.PurchaseOrder{
+			ID:           order.ID,
+			PoNumber:     order.PoNumber,
+			SupplierID:   order.SupplierID,
+			Status:       order.Status,
+			ExpectedAt:   order.ExpectedAt,
+			Notes:        order.Notes,
+			TotalAmount:  order.TotalAmount,
+			SentBy:       order.SentBy,
+			SentAt:       order.SentAt,
+			CancelledBy:  order.CancelledBy,
+			CancelledAt:  order.CancelledAt,
+			CancelReason: order.CancelReason,
+			ReceivedAt:   order.ReceivedAt,
+			CreatedBy:    order.CreatedBy,
+			UpdatedBy:    order.UpdatedBy,
+			CreatedAt:    order.CreatedAt,
+			UpdatedAt:    order.UpdatedAt,
+		}, order.SupplierName, nil)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// GetPurchaseOrderById godoc
+// @Security BearerAuth
+// @Summary Get a purchase order by ID
+// @Description Retrieve a purchase order with its supplier and lines
+// @Tags purchase-orders
+// @Produce json
+// @Param id path int true "Purchase Order ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/purchase-orders/{id} [get]
+func (c *PurchaseOrderController) GetPurchaseOrderById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid purchase order id",
+		})
+		return
+	}
+
+	Order, err := c.db.GetPurchaseOrderByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve purchase order with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data, err := loadPurchaseOrder(ctx, c.db, Order)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "purchase order retrieved successfully",
+		"data":    data,
+	})
+}
+
+// SendPurchaseOrder godoc
+// @Security BearerAuth
+// @Summary Send a purchase order
+// @Description Mark a draft purchase order as sent to the supplier. Sent purchase orders can no longer be changed, only received or cancelled.
+// @Tags purchase-orders
+// @Produce json
+// @Param id path int true "Purchase Order ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/purchase-orders/{id}/send [post]
+func (c *PurchaseOrderController) SendPurchaseOrder(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid purchase order id",
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	Order, err := qtx.GetPurchaseOrderByIDForUpdate(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve purchase order with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if !purchase.CanSend(Order.Status) {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": purchase.ErrNotSendable.Error(),
+		})
+		return
+	}
+
+	args := &db.SendPurchaseOrderParams{
+		ID:     Order.ID,
+		SentBy: sql.NullInt64{Int64: UserID, Valid: true},
+	}
+	Order, err = qtx.SendPurchaseOrder(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data, err := loadPurchaseOrder(ctx, qtx, Order)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "purchase order sent successfully",
+		"data":    data,
+	})
+}
+
+// CancelPurchaseOrder godoc
+// @Security BearerAuth
+// @Summary Cancel a purchase order
+// @Description Cancel a draft or sent purchase order with a reason. Purchase orders that have been (partially) received cannot be cancelled.
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param id path int true "Purchase Order ID"
+// @Param payload body schemas.CancelPurchaseOrder true "Cancel reason"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/purchase-orders/{id}/cancel [post]
+func (c *PurchaseOrderController) CancelPurchaseOrder(ctx *gin.Context) {
+	var payload schemas.CancelPurchaseOrder
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid purchase order id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	Order, err := qtx.GetPurchaseOrderByIDForUpdate(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve purchase order with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if !purchase.CanCancel(Order.Status) {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": purchase.ErrNotCancelled.Error(),
+		})
+		return
+	}
+
+	args := &db.CancelPurchaseOrderParams{
+		ID:           Order.ID,
+		CancelReason: sql.NullString{String: payload.Reason, Valid: true},
+		CancelledBy:  sql.NullInt64{Int64: UserID, Valid: true},
+	}
+	Order, err = qtx.CancelPurchaseOrder(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data, err := loadPurchaseOrder(ctx, qtx, Order)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "purchase order cancelled successfully",
+		"data":    data,
+	})
+}
+
+// GetPurchaseOrderDocument godoc
+// @Security BearerAuth
+// @Summary Export purchase order document
+// @Description Render a purchase order as a printable html document for the supplier or as csv lines for spreadsheets
+// @Tags purchase-orders
+// @Produce html,text/csv
+// @Param id path int true "Purchase Order ID"
+// @Param format query string false "Document format (html, csv)" default(html)
+// @Success 200 {string} string "Purchase order document"
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/purchase-orders/{id}/document [get]
+func (c *PurchaseOrderController) GetPurchaseOrderDocument(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid purchase order id",
+		})
+		return
+	}
+
+	Format := ctx.DefaultQuery("format", purchase.FormatHTML)
+	if Format != purchase.FormatHTML && Format != purchase.FormatCSV {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": purchase.ErrUnknownFormat.Error(),
+		})
+		return
+	}
+
+	Document, err := loadPurchaseOrderDocument(ctx, c.db, c.template, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve purchase order with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	switch Format {
+	case purchase.FormatHTML:
+		html, err := purchase.HTML(Document)
+		if err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		ctx.Data(http.StatusOK, "text/html; charset=utf-8", []byte(html))
+	case purchase.FormatCSV:
+		csv, err := purchase.CSV(Document)
+		if err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		ctx.Header("Content-Disposition", `attachment; filename="`+Document.Number+`.csv"`)
+		ctx.Data(http.StatusOK, "text/csv; charset=utf-8", csv)
+	}
+}
+
+// purchaseOrderLines memeriksa supplier dan produk pada payload purchase order lalu
+// menyusunnya menjadi baris purchase order
+func purchaseOrderLines(ctx context.Context, q *db.Queries, supplierID int64, items []schemas.PurchaseOrderItem) ([]purchase.Line, error) {
+	supplier, err := q.GetSupplierByID(ctx, supplierID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errSupplierNotFound
+		}
+		return nil, err
+	}
+	if supplier.DeletedAt.Valid {
+		return nil, errSupplierNotFound
+	}
+
+	lines := make([]purchase.Line, len(items))
+	for i, item := range items {
+		product, err := q.GetProductByID(ctx, item.ProductID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errProductNotFound
+			}
+			return nil, err
+		}
+		if product.DeletedAt.Valid {
+			return nil, errProductNotFound
+		}
+		lines[i] = purchase.Line{
+			ProductID:   product.ID,
+			ProductName: product.Name,
+			Quantity:    item.Quantity,
+			UnitCost:    purchase.Round(item.UnitCost),
+		}
+	}
+
+	if err := purchase.ValidateLines(lines); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+func purchaseOrderLineError(ctx *gin.Context, err error) {
+	switch err {
+	case errSupplierNotFound, errProductNotFound:
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+	case purchase.ErrDuplicateLine:
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+	default:
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+	}
+}
+
+func createPurchaseOrderItems(ctx context.Context, q *db.Queries, purchaseOrderID int64, lines []purchase.Line) error {
+	for _, line := range lines {
+		if _, err := q.CreatePurchaseOrderItem(ctx, db.CreatePurchaseOrderItemParams{
+			PurchaseOrderID: purchaseOrderID,
+			ProductID:       line.ProductID,
+			Quantity:        line.Quantity,
+			UnitCost:        strconv.FormatFloat(line.UnitCost, 'f', 2, 64),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadPurchaseOrder melengkapi purchase order dengan nama supplier dan barisnya
+func loadPurchaseOrder(ctx context.Context, q *db.Queries, order db.PurchaseOrder) (schemas.PurchaseOrderData, error) {
+	supplier, err := q.GetSupplierByID(ctx, order.SupplierID)
+	if err != nil {
+		return schemas.PurchaseOrderData{}, err
+	}
+
+	items, err := q.GetPurchaseOrderItems(ctx, order.ID)
+	if err != nil {
+		return schemas.PurchaseOrderData{}, err
+	}
+
+	return purchaseOrderData(order, supplier.Name, items), nil
+}
+
+// loadPurchaseOrderDocument menyusun dokumen purchase order dari purchase order,
+// supplier, baris dan data toko pada template struk
+func loadPurchaseOrderDocument(ctx context.Context, q *db.Queries, template receipt.Template, id int64) (purchase.Document, error) {
+	order, err := q.GetPurchaseOrderByID(ctx, id)
+	if err != nil {
+		return purchase.Document{}, err
+	}
+
+	supplier, err := q.GetSupplierByID(ctx, order.SupplierID)
+	if err != nil {
+		return purchase.Document{}, err
+	}
+
+	items, err := q.GetPurchaseOrderItems(ctx, order.ID)
+	if err != nil {
+		return purchase.Document{}, err
+	}
+
+	Document := purchase.Document{
+		StoreName:       template.StoreName,
+		StoreAddress:    template.StoreAddress,
+		StorePhone:      template.StorePhone,
+		Number:          order.PoNumber,
+		Status:          order.Status,
+		Date:            common.ConvertNullTime(order.CreatedAt),
+		ExpectedAt:      common.ConvertNullTime(order.ExpectedAt),
+		SupplierName:    supplier.Name,
+		SupplierContact: common.ConvertNullString(supplier.ContactName),
+		SupplierPhone:   common.ConvertNullString(supplier.Phone),
+		SupplierEmail:   common.ConvertNullString(supplier.Email),
+		SupplierAddress: common.ConvertNullString(supplier.Address),
+		Notes:           common.ConvertNullString(order.Notes),
+		Lines:           make([]purchase.Line, len(items)),
+	}
+	if order.SentAt.Valid {
+		Document.Date = order.SentAt.Time
+	}
+	for i, item := range items {
+		UnitCost, _ := strconv.ParseFloat(item.UnitCost, 64)
+		Document.Lines[i] = purchase.Line{
+			ProductID:   item.ProductID,
+			ProductName: item.ProductName,
+			Quantity:    item.Quantity,
+			UnitCost:    UnitCost,
+		}
+	}
+	return Document, nil
+}
+
+func purchaseOrderData(order db.PurchaseOrder, supplierName string, items []db.GetPurchaseOrderItemsRow) schemas.PurchaseOrderData {
+	TotalAmount, _ := strconv.ParseFloat(order.TotalAmount, 64)
+	data := schemas.PurchaseOrderData{
+		ID:           order.ID,
+		PONumber:     order.PoNumber,
+		SupplierID:   order.SupplierID,
+		SupplierName: supplierName,
+		Status:       order.Status,
+		ExpectedAt:   common.ConvertNullTime(order.ExpectedAt),
+		Notes:        common.ConvertNullString(order.Notes),
+		TotalAmount:  TotalAmount,
+		SentBy:       common.ConvertNullInt64(order.SentBy),
+		SentAt:       common.ConvertNullTime(order.SentAt),
+		CancelledBy:  common.ConvertNullInt64(order.CancelledBy),
+		CancelledAt:  common.ConvertNullTime(order.CancelledAt),
+		CancelReason: common.ConvertNullString(order.CancelReason),
+		ReceivedAt:   common.ConvertNullTime(order.ReceivedAt),
+		CreatedBy:    common.ConvertNullInt64(order.CreatedBy),
+		CreatedAt:    common.ConvertNullTime(order.CreatedAt),
+		UpdatedBy:    common.ConvertNullInt64(order.UpdatedBy),
+		UpdatedAt:    common.ConvertNullTime(order.UpdatedAt),
+	}
+
+	for _, item := range items {
+		UnitCost, _ := strconv.ParseFloat(item.UnitCost, 64)
+		data.Items = append(data.Items, schemas.PurchaseOrderItemData{
+			ID:          item.ID,
+			ProductID:   item.ProductID,
+			ProductName: item.ProductName,
+			Quantity:    item.Quantity,
+			Received:    item.QuantityReceived,
+			UnitCost:    UnitCost,
+			Total:       purchase.Round(UnitCost * float64(item.Quantity)),
+		})
+	}
+	return data
+}
+
+// GetReorderSuggestions godoc
+// @Security BearerAuth
+// @Summary Get reorder suggestions
+// @Description Suggest purchase orders grouped by the product's supplier for products at or below their minimum stock. The suggested quantity brings stock plus quantities still on open purchase orders above the minimum, at least the product's reorder quantity. Products without a supplier are grouped under supplier_id 0
+// @Tags purchase-orders
+// @Produce json
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/purchase-orders/suggestions [get]
+func (c *PurchaseOrderController) GetReorderSuggestions(ctx *gin.Context) {
+	groups, err := reorderGroups(ctx, c.db)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.ReorderSuggestionData, len(groups))
+	for i, group := range groups {
+		data[i] = reorderSuggestionData(group)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// CreateReorderPurchaseOrders godoc
+// @Security BearerAuth
+// @Summary Create purchase orders from reorder suggestions
+// @Description Create one draft purchase order per supplier from the current reorder suggestions, priced at the product's average cost. Products without a supplier are skipped
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param payload body schemas.CreateReorderPurchaseOrders false "Reorder Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/purchase-orders/suggestions [post]
+func (c *PurchaseOrderController) CreateReorderPurchaseOrders(ctx *gin.Context) {
+	var payload schemas.CreateReorderPurchaseOrders
+
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&payload); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": "invalid request data",
+				"error":   err.Error(),
+			})
+			return
+		}
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	groups, err := reorderGroups(ctx, qtx)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	selected := make(map[int64]bool, len(payload.SupplierIDs))
+	for _, id := range payload.SupplierIDs {
+		selected[id] = true
+	}
+
+	now := time.Now()
+	orders := make([]schemas.PurchaseOrderData, 0)
+	for _, group := range groups {
+		if group.SupplierID == 0 || (len(selected) > 0 && !selected[group.SupplierID]) {
+			continue
+		}
+
+		Lines := make([]purchase.Line, len(group.Lines))
+		for i, line := range group.Lines {
+			Lines[i] = purchase.Line{
+				ProductID:   line.ProductID,
+				ProductName: line.ProductName,
+				Quantity:    line.Quantity,
+				UnitCost:    purchase.Round(line.UnitCost),
+			}
+		}
+
+		// nomor diberi urutan karena beberapa purchase order dibuat pada detik yang sama
+		args := &db.CreatePurchaseOrderParams{
+			PoNumber:    fmt.Sprintf("%s-%d", purchase.GenerateNumber(UserID, now), len(orders)+1),
+			SupplierID:  group.SupplierID,
+			Status:      purchase.StatusDraft,
+			ExpectedAt:  sql.NullTime{Time: payload.ExpectedAt, Valid: !payload.ExpectedAt.IsZero()},
+			Notes:       sql.NullString{String: payload.Notes, Valid: payload.Notes != ""},
+			TotalAmount: strconv.FormatFloat(purchase.Total(Lines), 'f', 2, 64),
+			CreatedBy:   sql.NullInt64{Int64: UserID, Valid: true},
+		}
+
+		Order, err := qtx.CreatePurchaseOrder(ctx, *args)
+		if err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		if err := createPurchaseOrderItems(ctx, qtx, Order.ID, Lines); err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		data, err := loadPurchaseOrder(ctx, qtx, Order)
+		if err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		orders = append(orders, data)
+	}
+
+	if len(orders) == 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": reorder.ErrNoSuggestions.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "created successfully",
+		"data":    orders,
+	})
+}
+
+// reorderGroups menyusun saran pemesanan ulang per supplier dari produk yang stoknya menipis
+func reorderGroups(ctx context.Context, q *db.Queries) ([]reorder.Group, error) {
+	rows, err := q.GetReorderCandidates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]reorder.Candidate, len(rows))
+	for i, row := range rows {
+		UnitCost, _ := strconv.ParseFloat(row.Cost, 64)
+		candidates[i] = reorder.Candidate{
+			ProductID:       row.ProductID,
+			ProductName:     row.ProductName,
+			SupplierID:      common.ConvertNullInt64(row.SupplierID),
+			SupplierName:    common.ConvertNullString(row.SupplierName),
+			Stock:           row.Stock,
+			OnOrder:         int32(row.OnOrder),
+			MinStock:        row.MinStock,
+			ReorderQuantity: row.ReorderQuantity,
+			UnitCost:        UnitCost,
+		}
+		// supplier yang sudah dihapus diperlakukan seperti produk tanpa supplier
+		if !row.SupplierName.Valid {
+			candidates[i].SupplierID = 0
+		}
+	}
+	return reorder.Suggest(candidates), nil
+}
+
+func reorderSuggestionData(group reorder.Group) schemas.ReorderSuggestionData {
+	data := schemas.ReorderSuggestionData{
+		SupplierID:   group.SupplierID,
+		SupplierName: group.SupplierName,
+		TotalAmount:  group.Total(),
+		Items:        make([]schemas.ReorderSuggestionLine, len(group.Lines)),
+	}
+	for i, line := range group.Lines {
+		data.Items[i] = schemas.ReorderSuggestionLine{
+			ProductID:       line.ProductID,
+			ProductName:     line.ProductName,
+			Stock:           line.Stock,
+			OnOrder:         line.OnOrder,
+			MinStock:        line.MinStock,
+			ReorderQuantity: line.ReorderQuantity,
+			Quantity:        line.Quantity,
+			UnitCost:        line.UnitCost,
+			Total:           line.Total(),
+		}
+	}
+	return data
+}
@@ -0,0 +1,94 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"pos-api/app/schemas"
+	"pos-api/util/purchase"
+	"pos-api/util/receipt"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestPurchaseOrderWorkflow(t *testing.T) {
+	q, sqlDB := testDB(t)
+	c := NewPurchaseOrderController(q, sqlDB, receipt.Template{}, context.Background())
+
+	router := gin.New()
+	router.POST("/purchase-orders", c.CreatePurchaseOrder)
+	router.PUT("/purchase-orders/:id", c.UpdatePurchaseOrder)
+	router.POST("/purchase-orders/:id/send", c.SendPurchaseOrder)
+	router.POST("/purchase-orders/:id/cancel", c.CancelPurchaseOrder)
+
+	user := createTestUser(t, q)
+	supplier := createTestSupplier(t, q)
+	product := createTestProduct(t, q, 0)
+
+	send := func(method string, path string, payload any) (int, schemas.PurchaseOrderData) {
+		t.Helper()
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest(method, path, bytes.NewReader(body))
+		authorize(t, req, user)
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		var res struct {
+			Data schemas.PurchaseOrderData `json:"data"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &res)
+		return rec.Code, res.Data
+	}
+
+	items := []schemas.PurchaseOrderItem{{ProductID: product.ID, Quantity: 10, UnitCost: 7500}}
+	code, order := send(http.MethodPost, "/purchase-orders", schemas.CreatePurchaseOrder{SupplierID: supplier.ID, Items: items})
+	if code != http.StatusOK || order.Status != purchase.StatusDraft || order.TotalAmount != 75000 {
+		t.Fatalf("create = %d, %+v, want a draft of 75000", code, order)
+	}
+
+	duplicate := append(items, items[0])
+	if code, _ := send(http.MethodPost, "/purchase-orders", schemas.CreatePurchaseOrder{SupplierID: supplier.ID, Items: duplicate}); code != http.StatusBadRequest {
+		t.Errorf("create with a duplicate product = %d, want 400", code)
+	}
+
+	path := fmt.Sprintf("/purchase-orders/%d", order.ID)
+	items[0].Quantity = 12
+	if code, updated := send(http.MethodPut, path, schemas.UpdatePurchaseOrder{SupplierID: supplier.ID, Items: items}); code != http.StatusOK || updated.TotalAmount != 90000 {
+		t.Errorf("update draft = %d, %+v, want total 90000", code, updated)
+	}
+
+	// setelah dikirim isi purchase order terkunci, hanya bisa dibatalkan
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		payload any
+		code    int
+		status  string
+	}{
+		{"send draft", http.MethodPost, path + "/send", nil, http.StatusOK, purchase.StatusSent},
+		{"send twice", http.MethodPost, path + "/send", nil, http.StatusBadRequest, ""},
+		{"update sent", http.MethodPut, path, schemas.UpdatePurchaseOrder{SupplierID: supplier.ID, Items: items}, http.StatusBadRequest, ""},
+		{"cancel without reason", http.MethodPost, path + "/cancel", map[string]string{}, http.StatusBadRequest, ""},
+		{"cancel sent", http.MethodPost, path + "/cancel", schemas.CancelPurchaseOrder{Reason: "supplier out of stock"}, http.StatusOK, purchase.StatusCancelled},
+		{"cancel twice", http.MethodPost, path + "/cancel", schemas.CancelPurchaseOrder{Reason: "again"}, http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, got := send(tt.method, tt.path, tt.payload)
+			if code != tt.code {
+				t.Fatalf("status = %d, want %d", code, tt.code)
+			}
+			if tt.status != "" && got.Status != tt.status {
+				t.Errorf("purchase order status = %s, want %s", got.Status, tt.status)
+			}
+		})
+	}
+}
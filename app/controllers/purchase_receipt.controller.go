@@ -0,0 +1,434 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/costing"
+	"pos-api/util/jwt"
+	"pos-api/util/movement"
+	"pos-api/util/purchase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PurchaseReceiptController struct {
+	db    *db.Queries
+	sqlDB *sql.DB
+	ctx   context.Context
+}
+
+func NewPurchaseReceiptController(db *db.Queries, sqlDB *sql.DB, ctx context.Context) *PurchaseReceiptController {
+	return &PurchaseReceiptController{db, sqlDB, ctx}
+}
+
+// receivedLine adalah satu produk pada pengiriman beserta baris purchase order-nya
+type receivedLine struct {
+	item          db.GetPurchaseOrderItemsRow
+	payload       schemas.ReceivePurchaseOrderItem
+	receipt       purchase.Receipt
+	discrepancies []string
+}
+
+// ReceivePurchaseOrder godoc
+// @Security BearerAuth
+// @Summary Receive goods of a purchase order
+// @Description Record a delivery against a sent purchase order with the received quantities, invoice unit costs and batch info. Stock is increased and a product history entry with the PO number as trx_ref is written for every product in one transaction. Partial or over deliveries and cost differences are flagged as discrepancies, the purchase order becomes partially_received or received.
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param id path int true "Purchase Order ID"
+// @Param payload body schemas.ReceivePurchaseOrder true "Received goods"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/purchase-orders/{id}/receive [post]
+func (c *PurchaseReceiptController) ReceivePurchaseOrder(ctx *gin.Context) {
+	var payload schemas.ReceivePurchaseOrder
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid purchase order id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	Order, err := qtx.GetPurchaseOrderByIDForUpdate(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve purchase order with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if !purchase.CanReceive(Order.Status) {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": purchase.ErrNotReceivable.Error(),
+		})
+		return
+	}
+
+	items, err := qtx.GetPurchaseOrderItems(ctx, Order.ID)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	OrderItems := make(map[int64]db.GetPurchaseOrderItemsRow, len(items))
+	for _, item := range items {
+		OrderItems[item.ProductID] = item
+	}
+
+	// selisih dihitung lebih dulu agar penerimaan langsung tercatat memiliki selisih atau tidak
+	Lines := make([]receivedLine, 0, len(payload.Items))
+	seen := make(map[int64]bool, len(payload.Items))
+	HasDiscrepancy := false
+	for _, received := range payload.Items {
+		item, ok := OrderItems[received.ProductID]
+		if !ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": purchase.ErrNotOrdered.Error(),
+				"error":   "product id " + strconv.FormatInt(received.ProductID, 10),
+			})
+			return
+		}
+		if seen[received.ProductID] {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": purchase.ErrDuplicateLine.Error(),
+			})
+			return
+		}
+		seen[received.ProductID] = true
+
+		ExpectedCost, _ := strconv.ParseFloat(item.UnitCost, 64)
+		UnitCost := ExpectedCost
+		if received.UnitCost != nil {
+			UnitCost = purchase.Round(*received.UnitCost)
+		}
+
+		line := receivedLine{
+			item:    item,
+			payload: received,
+			receipt: purchase.Receipt{
+				Ordered:      item.Quantity,
+				Received:     item.QuantityReceived,
+				Quantity:     received.Quantity,
+				ExpectedCost: ExpectedCost,
+				UnitCost:     UnitCost,
+			},
+		}
+		line.discrepancies = line.receipt.Discrepancies()
+		if len(line.discrepancies) > 0 {
+			HasDiscrepancy = true
+		}
+		Lines = append(Lines, line)
+	}
+
+	receiptArgs := &db.CreatePurchaseReceiptParams{
+		ReceiptNumber:   purchase.GenerateReceiptNumber(UserID, time.Now()),
+		PurchaseOrderID: Order.ID,
+		Notes:           sql.NullString{String: payload.Notes, Valid: payload.Notes != ""},
+		HasDiscrepancy:  HasDiscrepancy,
+		ReceivedBy:      sql.NullInt64{Int64: UserID, Valid: true},
+	}
+	Receipt, err := qtx.CreatePurchaseReceipt(ctx, *receiptArgs)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	for _, line := range Lines {
+		itemArgs := &db.CreatePurchaseReceiptItemParams{
+			PurchaseReceiptID:   Receipt.ID,
+			PurchaseOrderItemID: line.item.ID,
+			ProductID:           line.item.ProductID,
+			QuantityExpected:    line.receipt.Outstanding(),
+			QuantityReceived:    line.receipt.Quantity,
+			ExpectedUnitCost:    strconv.FormatFloat(line.receipt.ExpectedCost, 'f', 2, 64),
+			UnitCost:            strconv.FormatFloat(line.receipt.UnitCost, 'f', 2, 64),
+			BatchNumber:         sql.NullString{String: line.payload.BatchNumber, Valid: line.payload.BatchNumber != ""},
+			ExpiresAt:           sql.NullTime{Time: line.payload.ExpiresAt, Valid: !line.payload.ExpiresAt.IsZero()},
+			Discrepancy:         sql.NullString{String: strings.Join(line.discrepancies, ","), Valid: len(line.discrepancies) > 0},
+		}
+		if _, err := qtx.CreatePurchaseReceiptItem(ctx, *itemArgs); err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		receiveArgs := &db.ReceivePurchaseOrderItemParams{
+			Quantity: line.receipt.Quantity,
+			ID:       line.item.ID,
+		}
+		if _, err := qtx.ReceivePurchaseOrderItem(ctx, *receiveArgs); err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		stockArgs := &db.IncrementProductStockParams{
+			Quantity:  line.receipt.Quantity,
+			UpdatedBy: sql.NullInt64{Int64: UserID, Valid: true},
+			ID:        line.item.ProductID,
+		}
+		Product, err := qtx.IncrementProductStock(ctx, *stockArgs)
+		if err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": "failed to update product stock with id " + strconv.FormatInt(line.item.ProductID, 10),
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		if err := trackStockLevel(ctx, qtx, Product.Stock-line.receipt.Quantity, Product, Order.PoNumber, UserID); err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": "failed to record stock alert",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		// harga rata-rata dihitung dari stok sebelum penerimaan ini
+		Product.Stock -= line.receipt.Quantity
+		if err := receiveStockCost(ctx, qtx, Product, line.receipt.Quantity, line.receipt.UnitCost, costing.SourcePurchase, Order.PoNumber); err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": "failed to update inventory cost",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		historyArgs := &db.CreateProductHistoryParams{
+			TrxRef:         Order.PoNumber,
+			ProductID:      sql.NullInt64{Int64: line.item.ProductID, Valid: true},
+			QuantityChange: line.receipt.Quantity,
+			Type:           movement.TypePurchase,
+			Reason:         sql.NullString{String: "Purchase order receipt " + Receipt.ReceiptNumber, Valid: true},
+			ReasonCode:     sql.NullString{String: movement.ReasonPurchaseOrder, Valid: true},
+			CreatedBy:      sql.NullInt64{Int64: UserID, Valid: true},
+		}
+		if _, err := qtx.CreateProductHistory(ctx, *historyArgs); err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+	}
+
+	items, err = qtx.GetPurchaseOrderItems(ctx, Order.ID)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	Progress := make([]purchase.Progress, len(items))
+	for i, item := range items {
+		Progress[i] = purchase.Progress{Ordered: item.Quantity, Received: item.QuantityReceived}
+	}
+
+	statusArgs := &db.UpdatePurchaseOrderReceiveStatusParams{
+		Status:    purchase.ReceiveStatus(Progress),
+		UpdatedBy: sql.NullInt64{Int64: UserID, Valid: true},
+		ID:        Order.ID,
+	}
+	Order, err = qtx.UpdatePurchaseOrderReceiveStatus(ctx, *statusArgs)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	receiptItems, err := qtx.GetPurchaseReceiptItems(ctx, Receipt.ID)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	OrderData, err := loadPurchaseOrder(ctx, qtx, Order)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := purchaseReceiptData(Receipt, receiptItems)
+	data.PurchaseOrder = &OrderData
+
+	message := "goods received successfully"
+	if HasDiscrepancy {
+		message = "goods received with discrepancies"
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": message,
+		"data":    data,
+	})
+}
+
+// GetPurchaseReceipts godoc
+// @Security BearerAuth
+// @Summary Get purchase order receipts
+// @Description Deliveries received against a purchase order with the received quantities, costs, batches and discrepancies, oldest first
+// @Tags purchase-orders
+// @Produce json
+// @Param id path int true "Purchase Order ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/purchase-orders/{id}/receipts [get]
+func (c *PurchaseReceiptController) GetPurchaseReceipts(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid purchase order id",
+		})
+		return
+	}
+
+	receipts, err := c.db.GetPurchaseReceiptsByOrderID(ctx, id)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.PurchaseReceiptData, len(receipts))
+	for i, receipt := range receipts {
+		items, err := c.db.GetPurchaseReceiptItems(ctx, receipt.ID)
+		if err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		data[i] = purchaseReceiptData(receipt, items)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+func purchaseReceiptData(receipt db.PurchaseReceipt, items []db.GetPurchaseReceiptItemsRow) schemas.PurchaseReceiptData {
+	data := schemas.PurchaseReceiptData{
+		ID:              receipt.ID,
+		ReceiptNumber:   receipt.ReceiptNumber,
+		PurchaseOrderID: receipt.PurchaseOrderID,
+		Notes:           common.ConvertNullString(receipt.Notes),
+		HasDiscrepancy:  receipt.HasDiscrepancy,
+		ReceivedBy:      common.ConvertNullInt64(receipt.ReceivedBy),
+		ReceivedAt:      common.ConvertNullTime(receipt.ReceivedAt),
+		Items:           make([]schemas.PurchaseReceiptItemData, len(items)),
+	}
+
+	for i, item := range items {
+		ExpectedUnitCost, _ := strconv.ParseFloat(item.ExpectedUnitCost, 64)
+		UnitCost, _ := strconv.ParseFloat(item.UnitCost, 64)
+		Discrepancies := []string{}
+		if item.Discrepancy.Valid && item.Discrepancy.String != "" {
+			Discrepancies = strings.Split(item.Discrepancy.String, ",")
+		}
+		data.Items[i] = schemas.PurchaseReceiptItemData{
+			ID:               item.ID,
+			ProductID:        item.ProductID,
+			ProductName:      item.ProductName,
+			QuantityExpected: item.QuantityExpected,
+			QuantityReceived: item.QuantityReceived,
+			ExpectedUnitCost: ExpectedUnitCost,
+			UnitCost:         UnitCost,
+			BatchNumber:      common.ConvertNullString(item.BatchNumber),
+			ExpiresAt:        common.ConvertNullTime(item.ExpiresAt),
+			Discrepancies:    Discrepancies,
+		}
+	}
+	return data
+}
@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"pos-api/app/schemas"
+	"pos-api/util/purchase"
+	"pos-api/util/receipt"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestReceivePurchaseOrder(t *testing.T) {
+	q, sqlDB := testDB(t)
+	ctx := context.Background()
+	orders := NewPurchaseOrderController(q, sqlDB, receipt.Template{}, ctx)
+	receipts := NewPurchaseReceiptController(q, sqlDB, ctx)
+
+	router := gin.New()
+	router.POST("/purchase-orders", orders.CreatePurchaseOrder)
+	router.POST("/purchase-orders/:id/send", orders.SendPurchaseOrder)
+	router.POST("/purchase-orders/:id/receive", receipts.ReceivePurchaseOrder)
+
+	user := createTestUser(t, q)
+	supplier := createTestSupplier(t, q)
+	product := createTestProduct(t, q, 5)
+
+	send := func(path string, payload any, data any) int {
+		t.Helper()
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+		authorize(t, req, user)
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		json.Unmarshal(rec.Body.Bytes(), &struct {
+			Data any `json:"data"`
+		}{data})
+		return rec.Code
+	}
+
+	var order schemas.PurchaseOrderData
+	create := schemas.CreatePurchaseOrder{
+		SupplierID: supplier.ID,
+		Items:      []schemas.PurchaseOrderItem{{ProductID: product.ID, Quantity: 10, UnitCost: 7500}},
+	}
+	if code := send("/purchase-orders", create, &order); code != http.StatusOK {
+		t.Fatalf("create status = %d, want 200", code)
+	}
+	path := fmt.Sprintf("/purchase-orders/%d/receive", order.ID)
+	delivery := func(quantity int32, unitCost *float64) schemas.ReceivePurchaseOrder {
+		return schemas.ReceivePurchaseOrder{Items: []schemas.ReceivePurchaseOrderItem{{ProductID: product.ID, Quantity: quantity, UnitCost: unitCost}}}
+	}
+
+	if code := send(path, delivery(4, nil), nil); code != http.StatusBadRequest {
+		t.Errorf("receive a draft status = %d, want 400", code)
+	}
+	if code := send(fmt.Sprintf("/purchase-orders/%d/send", order.ID), nil, nil); code != http.StatusOK {
+		t.Fatalf("send status = %d, want 200", code)
+	}
+
+	other := createTestProduct(t, q, 0)
+	notOrdered := schemas.ReceivePurchaseOrder{Items: []schemas.ReceivePurchaseOrderItem{{ProductID: other.ID, Quantity: 1}}}
+	if code := send(path, notOrdered, nil); code != http.StatusBadRequest {
+		t.Errorf("receive a product not on the order status = %d, want 400", code)
+	}
+
+	// pengiriman pertama kurang dan harganya berbeda, pengiriman kedua melengkapi pesanan
+	higher := 8000.0
+	tests := []struct {
+		name          string
+		delivery      schemas.ReceivePurchaseOrder
+		discrepancies []string
+		status        string
+		stock         int32
+	}{
+		{"partial delivery at a higher cost", delivery(4, &higher), []string{purchase.DiscrepancyShort, purchase.DiscrepancyCost}, purchase.StatusPartiallyReceived, 9},
+		{"remaining delivery", delivery(6, nil), []string{}, purchase.StatusReceived, 15},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// nomor penerimaan memakai detik dan id user, setiap pengiriman dicatat petugas berbeda
+			user = createTestUser(t, q)
+
+			var got schemas.PurchaseReceiptData
+			if code := send(path, tt.delivery, &got); code != http.StatusOK {
+				t.Fatalf("receive status = %d, want 200", code)
+			}
+			if len(got.Items) != 1 || !reflect.DeepEqual(got.Items[0].Discrepancies, tt.discrepancies) {
+				t.Errorf("receipt items = %+v, want discrepancies %v", got.Items, tt.discrepancies)
+			}
+			if got.HasDiscrepancy != (len(tt.discrepancies) > 0) {
+				t.Errorf("has_discrepancy = %v", got.HasDiscrepancy)
+			}
+			if got.PurchaseOrder == nil || got.PurchaseOrder.Status != tt.status {
+				t.Errorf("purchase order = %+v, want status %s", got.PurchaseOrder, tt.status)
+			}
+
+			stocked, err := q.GetProductByID(ctx, product.ID)
+			if err != nil || stocked.Stock != tt.stock {
+				t.Errorf("stock = %d, %v, want %d", stocked.Stock, err, tt.stock)
+			}
+		})
+	}
+
+	if code := send(path, delivery(1, nil), nil); code != http.StatusBadRequest {
+		t.Errorf("receive a fully received order status = %d, want 400", code)
+	}
+}
@@ -0,0 +1,206 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/receipt"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReceiptController struct {
+	db       *db.Queries
+	template receipt.Template
+	ctx      context.Context
+}
+
+func NewReceiptController(db *db.Queries, template receipt.Template, ctx context.Context) *ReceiptController {
+	return &ReceiptController{db, template, ctx}
+}
+
+// GetReceipt godoc
+// @Security BearerAuth
+// @Summary Print order receipt
+// @Description Render the receipt of an order as html, plain text, pdf or ESC/POS commands for thermal printers. Every print is counted, reprints are marked as COPY.
+// @Tags orders
+// @Produce html,plain,application/pdf,application/octet-stream
+// @Param id path int true "Order ID"
+// @Param format query string false "Receipt format (html, text, pdf, escpos)" default(text)
+// @Success 200 {string} string "Receipt"
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/orders/{id}/receipt [get]
+func (c *ReceiptController) GetReceipt(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid order id",
+		})
+		return
+	}
+
+	Format := ctx.DefaultQuery("format", receipt.FormatText)
+	switch Format {
+	case receipt.FormatHTML, receipt.FormatText, receipt.FormatPDF, receipt.FormatESCPOS:
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": receipt.ErrUnknownFormat.Error(),
+		})
+		return
+	}
+
+	Receipt, err := loadReceipt(ctx, c.db, c.template, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "order not found",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	// setiap cetak dihitung, cetakan kedua dan seterusnya ditandai COPY
+	PrintCount, err := c.db.IncrementReceiptPrintCount(ctx, id)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	Receipt.PrintCount = PrintCount
+	Receipt.Copy = PrintCount > 1
+
+	ctx.Header("X-Receipt-Print-Count", strconv.Itoa(int(PrintCount)))
+	switch Format {
+	case receipt.FormatHTML:
+		html, err := receipt.HTML(Receipt)
+		if err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		ctx.Data(http.StatusOK, "text/html; charset=utf-8", []byte(html))
+	case receipt.FormatText:
+		ctx.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(receipt.Text(Receipt, c.template.Width)))
+	case receipt.FormatPDF:
+		ctx.Header("Content-Disposition", `inline; filename="`+Receipt.TrxNumber+`.pdf"`)
+		ctx.Data(http.StatusOK, "application/pdf", receipt.PDF(Receipt, c.template.Width))
+	case receipt.FormatESCPOS:
+		ctx.Header("Content-Disposition", `attachment; filename="`+Receipt.TrxNumber+`.bin"`)
+		ctx.Data(http.StatusOK, "application/octet-stream", receipt.ESCPOS(Receipt, c.template.Width))
+	}
+}
+
+// loadReceipt menyusun data struk dari order, item, promo, voucher dan pembayaran
+func loadReceipt(ctx context.Context, q *db.Queries, template receipt.Template, orderID int64) (receipt.Receipt, error) {
+	order, err := q.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return receipt.Receipt{}, err
+	}
+
+	OrderID := sql.NullInt64{Int64: order.ID, Valid: true}
+	Subtotal, _ := strconv.ParseFloat(order.Subtotal, 64)
+	TaxAmount, _ := strconv.ParseFloat(order.TaxAmount, 64)
+	TotalAmount, _ := strconv.ParseFloat(order.TotalAmount, 64)
+	ChangeAmount, _ := strconv.ParseFloat(order.ChangeAmount, 64)
+
+	Receipt := receipt.Receipt{
+		TrxNumber:  order.TrxNumber,
+		Status:     order.Status,
+		Date:       common.ConvertNullTime(order.OrderDate),
+		Subtotal:   Subtotal,
+		Tax:        TaxAmount,
+		Total:      TotalAmount,
+		Change:     ChangeAmount,
+		PrintCount: order.ReceiptPrintCount,
+	}
+
+	if order.CashierID.Valid {
+		if cashier, err := q.GetUserByID(ctx, order.CashierID.Int64); err == nil {
+			Receipt.Cashier = cashier.FullName
+		}
+	}
+	if order.CustomerID.Valid {
+		if customer, err := q.GetCustomerByID(ctx, order.CustomerID.Int64); err == nil {
+			Receipt.Customer = customer.Name + " (" + customer.MemberCode + ")"
+		}
+	}
+
+	items, err := q.GetOrderItemsByOrderID(ctx, OrderID)
+	if err != nil {
+		return receipt.Receipt{}, err
+	}
+	for _, item := range items {
+		UnitPrice, _ := strconv.ParseFloat(item.UnitPrice, 64)
+		TaxRate, _ := strconv.ParseFloat(item.TaxRate, 64)
+		ItemTax, _ := strconv.ParseFloat(item.TaxAmount, 64)
+		Receipt.Items = append(Receipt.Items, receipt.Item{
+			Name:         common.ConvertNullString(item.OldProduct),
+			Quantity:     item.Quantity,
+			UnitPrice:    UnitPrice,
+			TaxName:      common.ConvertNullString(item.TaxName),
+			TaxRate:      TaxRate,
+			TaxInclusive: item.TaxInclusive,
+			TaxAmount:    ItemTax,
+		})
+	}
+
+	promotions, err := q.GetOrderPromotionsByOrderID(ctx, OrderID)
+	if err != nil {
+		return receipt.Receipt{}, err
+	}
+	for _, promo := range promotions {
+		DiscountAmount, _ := strconv.ParseFloat(promo.DiscountAmount, 64)
+		Receipt.Discounts = append(Receipt.Discounts, receipt.Discount{Name: promo.PromotionName, Amount: DiscountAmount})
+	}
+
+	VoucherDiscount, _ := strconv.ParseFloat(order.VoucherDiscount, 64)
+	if order.VoucherCode.Valid && VoucherDiscount > 0 {
+		Receipt.Discounts = append(Receipt.Discounts, receipt.Discount{Name: "Voucher " + order.VoucherCode.String, Amount: VoucherDiscount})
+	}
+
+	payments, err := q.GetOrderPaymentsByOrderID(ctx, OrderID)
+	if err != nil {
+		return receipt.Receipt{}, err
+	}
+	for _, p := range payments {
+		Amount, _ := strconv.ParseFloat(p.Amount, 64)
+		Tendered, _ := strconv.ParseFloat(p.TenderedAmount, 64)
+		Change, _ := strconv.ParseFloat(p.ChangeAmount, 64)
+		Receipt.Payments = append(Receipt.Payments, receipt.Payment{
+			Method:    p.PaymentMethod,
+			Amount:    Amount,
+			Tendered:  Tendered,
+			Change:    Change,
+			Reference: common.ConvertNullString(p.Reference),
+		})
+	}
+
+	Receipt.Header, Receipt.Footer, err = template.Render(receipt.TemplateData{
+		TrxNumber: Receipt.TrxNumber,
+		Cashier:   Receipt.Cashier,
+		Customer:  Receipt.Customer,
+		Date:      Receipt.Date,
+	})
+	if err != nil {
+		return receipt.Receipt{}, err
+	}
+	return Receipt, nil
+}
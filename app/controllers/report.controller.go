@@ -10,6 +10,7 @@ import (
 	"pos-api/app/schemas"
 	db "pos-api/db/sqlc"
 	"pos-api/util/common"
+	"pos-api/util/profit"
 
 	"github.com/gin-gonic/gin"
 )
@@ -95,12 +96,46 @@ func (c *ReportController) GetDetailOrderByID(ctx *gin.Context) {
 			return
 		}
 
+		DiscountAmount, _ := strconv.ParseFloat(item.DiscountAmount, 64)
+		TaxRate, _ := strconv.ParseFloat(item.TaxRate, 64)
+		TaxAmount, _ := strconv.ParseFloat(item.TaxAmount, 64)
+		UnitCost, _ := strconv.ParseFloat(item.UnitCost, 64)
+		Cogs, _ := strconv.ParseFloat(item.Cogs, 64)
+
 		orderItems[i] = schemas.OrderItemDetail{
-			ID:         item.ID,
-			ProductID:  common.ConvertNullInt64(item.ProductID),
-			OldProduct: common.ConvertNullString(item.OldProduct),
-			Quantity:   item.Quantity,
-			UnitPrice:  UnitPrice,
+			ID:             item.ID,
+			ProductID:      common.ConvertNullInt64(item.ProductID),
+			OldProduct:     common.ConvertNullString(item.OldProduct),
+			Quantity:       item.Quantity,
+			UnitPrice:      UnitPrice,
+			DiscountAmount: DiscountAmount,
+			TaxName:        common.ConvertNullString(item.TaxName),
+			TaxRate:        TaxRate,
+			TaxInclusive:   item.TaxInclusive,
+			TaxAmount:      TaxAmount,
+			UnitCost:       UnitCost,
+			Cogs:           Cogs,
+		}
+	}
+
+	// Get applied promotions
+	promotions, err := c.db.GetOrderPromotionsByOrderID(ctx, sql.NullInt64{Int64: id, Valid: true})
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	appliedPromotions := make([]schemas.AppliedPromotionData, len(promotions))
+	for i, promo := range promotions {
+		DiscountAmount, _ := strconv.ParseFloat(promo.DiscountAmount, 64)
+		appliedPromotions[i] = schemas.AppliedPromotionData{
+			PromotionID:    common.ConvertNullInt64(promo.PromotionID),
+			Name:           promo.PromotionName,
+			Type:           promo.PromotionType,
+			DiscountAmount: DiscountAmount,
 		}
 	}
 
@@ -114,19 +149,49 @@ func (c *ReportController) GetDetailOrderByID(ctx *gin.Context) {
 		return
 	}
 
+	// Get order payments
+	orderPayments, err := c.db.GetOrderPaymentsByOrderID(ctx, sql.NullInt64{Int64: id, Valid: true})
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	payments := make([]schemas.OrderPaymentData, len(orderPayments))
+	for i, p := range orderPayments {
+		payments[i] = orderPaymentData(p)
+	}
+
+	Subtotal, _ := strconv.ParseFloat(order.Subtotal, 64)
+	DiscountAmount, _ := strconv.ParseFloat(order.DiscountAmount, 64)
+	VoucherDiscount, _ := strconv.ParseFloat(order.VoucherDiscount, 64)
+	TaxAmount, _ := strconv.ParseFloat(order.TaxAmount, 64)
+	ChangeAmount, _ := strconv.ParseFloat(order.ChangeAmount, 64)
+
 	response := schemas.OrderDetailResponse{
-		ID:            order.ID,
-		TrxNumber:     order.TrxNumber,
-		CashierID:     common.ConvertNullInt64(order.CashierID),
-		CustomerID:    order.CustomerID,
-		TotalAmount:   TotalAmount,
-		PaymentMethod: order.PaymentMethod,
-		Status:        order.Status,
-		OrderDate:     common.ConvertNullTime(order.OrderDate),
-		UpdatedBy:     order.UpdatedBy,
-		UpdatedAt:     order.UpdatedAt,
-		Customer:      customer,
-		OrderItems:    orderItems,
+		ID:                order.ID,
+		TrxNumber:         order.TrxNumber,
+		CashierID:         common.ConvertNullInt64(order.CashierID),
+		ShiftID:           common.ConvertNullInt64(order.ShiftID),
+		CustomerID:        order.CustomerID,
+		Subtotal:          Subtotal,
+		DiscountAmount:    DiscountAmount,
+		VoucherCode:       common.ConvertNullString(order.VoucherCode),
+		VoucherDiscount:   VoucherDiscount,
+		TaxAmount:         TaxAmount,
+		TotalAmount:       TotalAmount,
+		ChangeAmount:      ChangeAmount,
+		PaymentMethod:     order.PaymentMethod,
+		Status:            order.Status,
+		OrderDate:         common.ConvertNullTime(order.OrderDate),
+		UpdatedBy:         order.UpdatedBy,
+		UpdatedAt:         order.UpdatedAt,
+		Customer:          customer,
+		OrderItems:        orderItems,
+		AppliedPromotions: appliedPromotions,
+		Payments:          payments,
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{
@@ -395,3 +460,232 @@ func (c *ReportController) TopCustomer(ctx *gin.Context) {
 		"data":    customers,
 	})
 }
+
+// TaxSummary godoc
+// @Security BearerAuth
+// @Summary Get tax summary
+// @Description Get sales, taxable amount (DPP) and tax collected grouped by tax rate for specific month and year
+// @Tags reports
+// @Produce json
+// @Param month query int true "Month (1-12)"
+// @Param year query int true "Year"
+// @Success 200 {object} schemas.Response
+// @Failure 400,502 {object} schemas.Response
+// @Router /api/v1/reports/tax-summary [get]
+func (c *ReportController) TaxSummary(ctx *gin.Context) {
+	month, err := strconv.Atoi(ctx.Query("month"))
+	if err != nil || month < 1 || month > 12 {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid month",
+		})
+		return
+	}
+
+	year, err := strconv.Atoi(ctx.Query("year"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid year",
+		})
+		return
+	}
+
+	StartDate := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	EndDate := time.Date(year, time.Month(month+1), 1, 0, 0, 0, 0, time.UTC)
+
+	args := &db.GetTaxSummaryParams{
+		OrderDate:   sql.NullTime{Time: StartDate, Valid: true},
+		OrderDate_2: sql.NullTime{Time: EndDate, Valid: true},
+	}
+
+	taxes, err := c.db.GetTaxSummary(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    taxes,
+	})
+}
+
+// GrossProfit godoc
+// @Security BearerAuth
+// @Summary Get gross profit and margin
+// @Description Get revenue, discounts, COGS, gross profit and margin percent for specific month and year grouped by product, category, cashier, customer or day. Refunds made in the period are netted out.
+// @Tags reports
+// @Produce json
+// @Param month query int true "Month (1-12)"
+// @Param year query int true "Year"
+// @Param group_by query string false "Group by: product, category, cashier, customer or day" default(product)
+// @Success 200 {object} schemas.Response
+// @Failure 400,502 {object} schemas.Response
+// @Router /api/v1/reports/profit [get]
+func (c *ReportController) GrossProfit(ctx *gin.Context) {
+	month, err := strconv.Atoi(ctx.Query("month"))
+	if err != nil || month < 1 || month > 12 {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid month",
+		})
+		return
+	}
+
+	year, err := strconv.Atoi(ctx.Query("year"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid year",
+		})
+		return
+	}
+
+	GroupBy := ctx.DefaultQuery("group_by", profit.GroupProduct)
+	if err := profit.ValidateGroup(GroupBy); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	StartDate := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	EndDate := time.Date(year, time.Month(month+1), 1, 0, 0, 0, 0, time.UTC)
+
+	rows, err := c.profitRows(ctx, GroupBy, StartDate, EndDate)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	report := schemas.ProfitReportData{
+		GroupBy:   GroupBy,
+		StartDate: StartDate,
+		EndDate:   EndDate,
+		Rows:      make([]schemas.ProfitData, len(rows)),
+	}
+	Total := profit.Figures{}
+	for i, row := range rows {
+		report.Rows[i] = profitData(row.id, row.name, row.date, row.figures)
+		Total = Total.Add(row.figures)
+	}
+	report.Total = profitData(0, "", "", Total)
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    report,
+	})
+}
+
+// profitRow adalah satu baris laporan laba kotor dari pengelompokan mana pun
+type profitRow struct {
+	id      int64
+	name    string
+	date    string
+	figures profit.Figures
+}
+
+func (c *ReportController) profitRows(ctx context.Context, group string, start, end time.Time) ([]profitRow, error) {
+	var rows []profitRow
+
+	switch group {
+	case profit.GroupProduct:
+		products, err := c.db.GetProfitByProduct(ctx, db.GetProfitByProductParams{StartDate: start, EndDate: end})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range products {
+			rows = append(rows, profitRow{
+				id:      common.ConvertNullInt64(p.ProductID),
+				name:    p.Name,
+				figures: profitFigures(p.Quantity, p.TotalOrders, p.Revenue, p.Discount, p.Tax, p.Refund, p.Cogs),
+			})
+		}
+	case profit.GroupCategory:
+		categories, err := c.db.GetProfitByCategory(ctx, db.GetProfitByCategoryParams{StartDate: start, EndDate: end})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range categories {
+			rows = append(rows, profitRow{
+				id:      common.ConvertNullInt64(p.CategoryID),
+				name:    p.Name,
+				figures: profitFigures(p.Quantity, p.TotalOrders, p.Revenue, p.Discount, p.Tax, p.Refund, p.Cogs),
+			})
+		}
+	case profit.GroupCashier:
+		cashiers, err := c.db.GetProfitByCashier(ctx, db.GetProfitByCashierParams{StartDate: start, EndDate: end})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range cashiers {
+			rows = append(rows, profitRow{
+				id:      common.ConvertNullInt64(p.CashierID),
+				name:    p.Name,
+				figures: profitFigures(p.Quantity, p.TotalOrders, p.Revenue, p.Discount, p.Tax, p.Refund, p.Cogs),
+			})
+		}
+	case profit.GroupCustomer:
+		customers, err := c.db.GetProfitByCustomer(ctx, db.GetProfitByCustomerParams{StartDate: start, EndDate: end})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range customers {
+			rows = append(rows, profitRow{
+				id:      common.ConvertNullInt64(p.CustomerID),
+				name:    p.Name,
+				figures: profitFigures(p.Quantity, p.TotalOrders, p.Revenue, p.Discount, p.Tax, p.Refund, p.Cogs),
+			})
+		}
+	case profit.GroupDay:
+		days, err := c.db.GetProfitByDay(ctx, db.GetProfitByDayParams{StartDate: start, EndDate: end})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range days {
+			rows = append(rows, profitRow{
+				date:    p.Date.Format("2006-01-02"),
+				figures: profitFigures(p.Quantity, p.TotalOrders, p.Revenue, p.Discount, p.Tax, p.Refund, p.Cogs),
+			})
+		}
+	}
+	return rows, nil
+}
+
+func profitFigures(quantity, orders int64, revenue, discount, tax, refund, cogs string) profit.Figures {
+	f := profit.Figures{Quantity: quantity, TotalOrders: orders}
+	f.Revenue, _ = strconv.ParseFloat(revenue, 64)
+	f.Discount, _ = strconv.ParseFloat(discount, 64)
+	f.Tax, _ = strconv.ParseFloat(tax, 64)
+	f.Refund, _ = strconv.ParseFloat(refund, 64)
+	f.Cogs, _ = strconv.ParseFloat(cogs, 64)
+	return f
+}
+
+func profitData(id int64, name string, date string, f profit.Figures) schemas.ProfitData {
+	return schemas.ProfitData{
+		ID:          id,
+		Name:        name,
+		Date:        date,
+		Quantity:    f.Quantity,
+		TotalOrders: f.TotalOrders,
+		Revenue:     profit.Round(f.Revenue),
+		Discount:    profit.Round(f.Discount),
+		Tax:         profit.Round(f.Tax),
+		NetSales:    f.NetSales(),
+		Refund:      profit.Round(f.Refund),
+		Cogs:        profit.Round(f.Cogs),
+		GrossProfit: f.GrossProfit(),
+		Margin:      f.Margin(),
+	}
+}
@@ -0,0 +1,725 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/jwt"
+	"pos-api/util/shift"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ShiftController struct {
+	db    *db.Queries
+	sqlDB *sql.DB
+	ctx   context.Context
+}
+
+func NewShiftController(db *db.Queries, sqlDB *sql.DB, ctx context.Context) *ShiftController {
+	return &ShiftController{db, sqlDB, ctx}
+}
+
+// OpenShift godoc
+// @Security BearerAuth
+// @Summary Open a cashier shift
+// @Description Open a new shift for the logged in cashier with an opening float in the cash drawer
+// @Tags shifts
+// @Accept json
+// @Produce json
+// @Param payload body schemas.OpenShift true "Shift Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/shifts/open [post]
+func (c *ShiftController) OpenShift(ctx *gin.Context) {
+	var payload schemas.OpenShift
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if payload.OpeningFloat < 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": shift.ErrInvalidFloat.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	if _, err := c.db.GetOpenShiftByCashierID(ctx, sql.NullInt64{Int64: UserID, Valid: true}); err != sql.ErrNoRows {
+		if err == nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": shift.ErrShiftAlreadyOpen.Error(),
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	args := &db.CreateShiftParams{
+		ShiftNumber:  fmt.Sprintf("SHIFT-%d-%s", UserID, time.Now().Format("20060102150405")),
+		CashierID:    sql.NullInt64{Int64: UserID, Valid: true},
+		OpeningFloat: strconv.FormatFloat(payload.OpeningFloat, 'f', 2, 64),
+		Note:         sql.NullString{String: payload.Note, Valid: payload.Note != ""},
+	}
+
+	Shift, err := c.db.CreateShift(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "shift opened successfully",
+		"data":    shiftData(Shift, ""),
+	})
+}
+
+// GetCurrentShift godoc
+// @Security BearerAuth
+// @Summary Get current shift
+// @Description Retrieve the open shift of the logged in cashier
+// @Tags shifts
+// @Produce json
+// @Success 200 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/shifts/current [get]
+func (c *ShiftController) GetCurrentShift(ctx *gin.Context) {
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	Shift, err := c.db.GetOpenShiftByCashierID(ctx, sql.NullInt64{Int64: userInfo.UserID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": shift.ErrNoOpenShift.Error(),
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "shift retrieved successfully",
+		"data":    shiftData(Shift, ""),
+	})
+}
+
+// GetShiftById godoc
+// @Security BearerAuth
+// @Summary Get a shift by ID
+// @Description Retrieve a shift and its cash movements by ID
+// @Tags shifts
+// @Produce json
+// @Param id path int true "Shift ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/shifts/{id} [get]
+func (c *ShiftController) GetShiftById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid shift id",
+		})
+		return
+	}
+
+	Shift, err := c.db.GetShiftByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve shift with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	movements, err := c.db.GetShiftCashMovements(ctx, sql.NullInt64{Int64: Shift.ID, Valid: true})
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	CashMovements := make([]schemas.ShiftCashMovementData, len(movements))
+	for i, movement := range movements {
+		CashMovements[i] = shiftCashMovementData(movement)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "shift retrieved successfully",
+		"data": gin.H{
+			"shift":          shiftData(Shift, ""),
+			"cash_movements": CashMovements,
+		},
+	})
+}
+
+// GetAllShifts godoc
+// @Security BearerAuth
+// @Summary Get all shifts
+// @Description Retrieve all shifts with pagination, optionally filtered by cashier
+// @Tags shifts
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Limit per page" default(10)
+// @Param cashier_id query int false "Cashier ID"
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/shifts [get]
+func (c *ShiftController) GetAllShifts(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	cashierID, _ := strconv.ParseInt(ctx.DefaultQuery("cashier_id", "0"), 10, 64)
+	offset := (page - 1) * limit
+
+	args := &db.GetAllShiftsParams{
+		CashierID: cashierID,
+		Limit:     int32(limit),
+		Offset:    int32(offset),
+	}
+
+	shifts, err := c.db.GetAllShifts(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.ShiftData, len(shifts))
+	for i, s := range shifts {
+		data[i] = shiftData(db.Shift{
+			ID:           s.ID,
+			ShiftNumber:  s.ShiftNumber,
+			CashierID:    s.CashierID,
+			OpeningFloat: s.OpeningFloat,
+			ExpectedCash: s.ExpectedCash,
+			CountedCash:  s.CountedCash,
+			Variance:     s.Variance,
+			Status:       s.Status,
+			Note:         s.Note,
+			OpenedAt:     s.OpenedAt,
+			ClosedAt:     s.ClosedAt,
+			ClosedBy:     s.ClosedBy,
+		}, common.ConvertNullString(s.CashierName))
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// CreateCashMovement godoc
+// @Security BearerAuth
+// @Summary Record cash in or cash out
+// @Description Record petty cash, safe drop or additional float on an open shift
+// @Tags shifts
+// @Accept json
+// @Produce json
+// @Param id path int true "Shift ID"
+// @Param payload body schemas.CreateShiftCashMovement true "Cash Movement Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/shifts/{id}/cash-movements [post]
+func (c *ShiftController) CreateCashMovement(ctx *gin.Context) {
+	var payload schemas.CreateShiftCashMovement
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid shift id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if err := shift.ValidateMovement(shift.Movement{Type: payload.Type, Amount: payload.Amount}); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	Shift, err := c.db.GetShiftByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve shift with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if Shift.Status != shift.StatusOpen {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": shift.ErrShiftClosed.Error(),
+		})
+		return
+	}
+
+	args := &db.CreateShiftCashMovementParams{
+		ShiftID:   sql.NullInt64{Int64: Shift.ID, Valid: true},
+		Type:      payload.Type,
+		Amount:    strconv.FormatFloat(payload.Amount, 'f', 2, 64),
+		Reason:    payload.Reason,
+		CreatedBy: sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	movement, err := c.db.CreateShiftCashMovement(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "created successfully",
+		"data":    shiftCashMovementData(movement),
+	})
+}
+
+// CloseShift godoc
+// @Security BearerAuth
+// @Summary Close a cashier shift
+// @Description Close an open shift with the counted cash, the expected cash and variance are recorded
+// @Tags shifts
+// @Accept json
+// @Produce json
+// @Param id path int true "Shift ID"
+// @Param payload body schemas.CloseShift true "Close Shift Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/shifts/{id}/close [post]
+func (c *ShiftController) CloseShift(ctx *gin.Context) {
+	var payload schemas.CloseShift
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid shift id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if *payload.CountedCash < 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "counted cash can not be negative",
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	Shift, err := qtx.GetShiftByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve shift with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if Shift.Status != shift.StatusOpen {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": shift.ErrShiftClosed.Error(),
+		})
+		return
+	}
+
+	report, drawer, err := buildShiftReport(ctx, qtx, Shift, shift.ReportZ)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	Note := Shift.Note
+	if payload.Note != "" {
+		Note = sql.NullString{String: payload.Note, Valid: true}
+	}
+
+	args := &db.CloseShiftParams{
+		ID:           Shift.ID,
+		ExpectedCash: sql.NullString{String: strconv.FormatFloat(drawer.Expected(), 'f', 2, 64), Valid: true},
+		CountedCash:  sql.NullString{String: strconv.FormatFloat(*payload.CountedCash, 'f', 2, 64), Valid: true},
+		Variance:     sql.NullString{String: strconv.FormatFloat(drawer.Variance(*payload.CountedCash), 'f', 2, 64), Valid: true},
+		Note:         Note,
+		ClosedBy:     sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	Shift, err = qtx.CloseShift(ctx, *args)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": shift.ErrShiftClosed.Error(),
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	tx.Commit()
+
+	report.Shift = shiftData(Shift, "")
+	report.Drawer.CountedCash = payload.CountedCash
+	Variance := drawer.Variance(*payload.CountedCash)
+	report.Drawer.Variance = &Variance
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "shift closed successfully",
+		"data":    report,
+	})
+}
+
+// GetShiftReport godoc
+// @Security BearerAuth
+// @Summary Get shift X/Z report
+// @Description X report is a running report of an open shift, Z report is the closing report of a closed shift
+// @Tags shifts
+// @Produce json
+// @Param id path int true "Shift ID"
+// @Param type query string false "Report type (x or z)" default(x)
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/shifts/{id}/report [get]
+func (c *ShiftController) GetShiftReport(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid shift id",
+		})
+		return
+	}
+
+	ReportType := ctx.DefaultQuery("type", shift.ReportX)
+	if ReportType != shift.ReportX && ReportType != shift.ReportZ {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "report type must be x or z",
+		})
+		return
+	}
+
+	Shift, err := c.db.GetShiftByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve shift with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if ReportType == shift.ReportZ && Shift.Status != shift.StatusClosed {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": shift.ErrShiftOpen.Error(),
+		})
+		return
+	}
+
+	report, _, err := buildShiftReport(ctx, c.db, Shift, ReportType)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "report retrieved successfully",
+		"data":    report,
+	})
+}
+
+// openShiftID mengambil shift yang sedang terbuka milik kasir
+func openShiftID(ctx context.Context, q *db.Queries, cashierID int64) (int64, error) {
+	Shift, err := q.GetOpenShiftByCashierID(ctx, sql.NullInt64{Int64: cashierID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, shift.ErrNoOpenShift
+		}
+		return 0, err
+	}
+	return Shift.ID, nil
+}
+
+// buildShiftReport merekap penjualan, pembayaran, refund dan pergerakan kas dalam satu shift
+func buildShiftReport(ctx context.Context, q *db.Queries, s db.Shift, reportType string) (schemas.ShiftReport, shift.Drawer, error) {
+	ShiftID := sql.NullInt64{Int64: s.ID, Valid: true}
+
+	sales, err := q.GetShiftSalesSummary(ctx, ShiftID)
+	if err != nil {
+		return schemas.ShiftReport{}, shift.Drawer{}, err
+	}
+
+	payments, err := q.GetShiftPaymentSummary(ctx, ShiftID)
+	if err != nil {
+		return schemas.ShiftReport{}, shift.Drawer{}, err
+	}
+
+	refunds, err := q.GetShiftRefundSummary(ctx, ShiftID)
+	if err != nil {
+		return schemas.ShiftReport{}, shift.Drawer{}, err
+	}
+
+	movements, err := q.GetShiftCashMovements(ctx, ShiftID)
+	if err != nil {
+		return schemas.ShiftReport{}, shift.Drawer{}, err
+	}
+
+	CashSales := 0.0
+	Payments := make([]schemas.ShiftPaymentSummary, len(payments))
+	for i, p := range payments {
+		Amount, _ := strconv.ParseFloat(p.Amount, 64)
+		if p.PaymentType == "cash" {
+			CashSales += Amount
+		}
+		Payments[i] = schemas.ShiftPaymentSummary{
+			PaymentMethod: p.PaymentMethod,
+			PaymentType:   p.PaymentType,
+			TotalPayments: p.TotalPayments,
+			Amount:        Amount,
+		}
+	}
+
+	Movements := make([]shift.Movement, len(movements))
+	CashMovements := make([]schemas.ShiftCashMovementData, len(movements))
+	for i, movement := range movements {
+		CashMovements[i] = shiftCashMovementData(movement)
+		Movements[i] = shift.Movement{Type: movement.Type, Amount: CashMovements[i].Amount}
+	}
+
+	OpeningFloat, _ := strconv.ParseFloat(s.OpeningFloat, 64)
+	CashRefunds, _ := strconv.ParseFloat(refunds.CashRefundAmount, 64)
+	drawer := shift.NewDrawer(OpeningFloat, CashSales, CashRefunds, Movements)
+
+	Subtotal, _ := strconv.ParseFloat(sales.Subtotal, 64)
+	DiscountAmount, _ := strconv.ParseFloat(sales.DiscountAmount, 64)
+	TaxAmount, _ := strconv.ParseFloat(sales.TaxAmount, 64)
+	TotalSales, _ := strconv.ParseFloat(sales.TotalAmount, 64)
+	RefundAmount, _ := strconv.ParseFloat(refunds.RefundAmount, 64)
+
+	ShiftData := shiftData(s, "")
+	report := schemas.ShiftReport{
+		Type:           reportType,
+		Shift:          ShiftData,
+		TotalOrders:    sales.TotalOrders,
+		Subtotal:       Subtotal,
+		DiscountAmount: DiscountAmount,
+		TaxAmount:      TaxAmount,
+		TotalSales:     TotalSales,
+		TotalRefunds:   refunds.TotalRefunds,
+		RefundAmount:   RefundAmount,
+		Payments:       Payments,
+		CashMovements:  CashMovements,
+		Drawer: schemas.ShiftDrawerSummary{
+			OpeningFloat: drawer.OpeningFloat,
+			CashSales:    drawer.CashSales,
+			CashRefunds:  drawer.CashRefunds,
+			CashIn:       drawer.CashIn,
+			CashOut:      drawer.CashOut,
+			ExpectedCash: drawer.Expected(),
+			CountedCash:  ShiftData.CountedCash,
+			Variance:     ShiftData.Variance,
+		},
+		GeneratedAt: time.Now(),
+	}
+
+	// shift yang sudah ditutup memakai angka yang tersimpan saat penutupan
+	if ShiftData.ExpectedCash != nil {
+		report.Drawer.ExpectedCash = *ShiftData.ExpectedCash
+	}
+	return report, drawer, nil
+}
+
+func nullDecimal(ns sql.NullString) *float64 {
+	if !ns.Valid {
+		return nil
+	}
+	value, _ := strconv.ParseFloat(ns.String, 64)
+	return &value
+}
+
+func shiftData(s db.Shift, cashierName string) schemas.ShiftData {
+	OpeningFloat, _ := strconv.ParseFloat(s.OpeningFloat, 64)
+	return schemas.ShiftData{
+		ID:           s.ID,
+		ShiftNumber:  s.ShiftNumber,
+		CashierID:    common.ConvertNullInt64(s.CashierID),
+		CashierName:  cashierName,
+		OpeningFloat: OpeningFloat,
+		ExpectedCash: nullDecimal(s.ExpectedCash),
+		CountedCash:  nullDecimal(s.CountedCash),
+		Variance:     nullDecimal(s.Variance),
+		Status:       s.Status,
+		Note:         common.ConvertNullString(s.Note),
+		OpenedAt:     common.ConvertNullTime(s.OpenedAt),
+		ClosedAt:     common.ConvertNullTime(s.ClosedAt),
+		ClosedBy:     common.ConvertNullInt64(s.ClosedBy),
+	}
+}
+
+func shiftCashMovementData(m db.ShiftCashMovement) schemas.ShiftCashMovementData {
+	Amount, _ := strconv.ParseFloat(m.Amount, 64)
+	return schemas.ShiftCashMovementData{
+		ID:        m.ID,
+		ShiftID:   common.ConvertNullInt64(m.ShiftID),
+		Type:      m.Type,
+		Amount:    Amount,
+		Reason:    m.Reason,
+		CreatedBy: common.ConvertNullInt64(m.CreatedBy),
+		CreatedAt: common.ConvertNullTime(m.CreatedAt),
+	}
+}
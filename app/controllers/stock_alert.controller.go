@@ -0,0 +1,165 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/notifier"
+	"pos-api/util/reorder"
+
+	"github.com/gin-gonic/gin"
+)
+
+// stockAlertBatch adalah jumlah alert yang dimasukkan ke outbox setiap kali job berjalan
+const stockAlertBatch = 50
+
+type StockAlertController struct {
+	db         *db.Queries
+	sqlDB      *sql.DB
+	notifiers  notifier.Registry
+	recipients map[string]string // penerima alert per channel notifikasi
+	ctx        context.Context
+}
+
+func NewStockAlertController(db *db.Queries, sqlDB *sql.DB, notifiers notifier.Registry, recipients map[string]string, ctx context.Context) *StockAlertController {
+	return &StockAlertController{db, sqlDB, notifiers, recipients, ctx}
+}
+
+// GetAllStockAlerts godoc
+// @Security BearerAuth
+// @Summary Get all stock alerts
+// @Description Retrieve low stock alerts with pagination, newest first, optionally filtered by status
+// @Tags stock-alerts
+// @Produce json
+// @Param status query string false "Status (open, resolved)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Limit per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/stock-alerts [get]
+func (c *StockAlertController) GetAllStockAlerts(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	offset := (page - 1) * limit
+
+	Status := ctx.Query("status")
+	if Status != "" {
+		if err := reorder.ValidateStatus(Status); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+	}
+
+	args := db.GetAllStockAlertsParams{
+		Status: Status,
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	}
+
+	alerts, err := c.db.GetAllStockAlerts(ctx, args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.StockAlertData, len(alerts))
+	for i, alert := range alerts {
+		data[i] = schemas.StockAlertData{
+			ID:          alert.ID,
+			ProductID:   alert.ProductID,
+			ProductName: alert.ProductName,
+			TrxRef:      alert.TrxRef,
+			Stock:       alert.Stock,
+			MinStock:    alert.MinStock,
+			Status:      alert.Status,
+			NotifiedAt:  common.ConvertNullTime(alert.NotifiedAt),
+			ResolvedAt:  common.ConvertNullTime(alert.ResolvedAt),
+			CreatedBy:   common.ConvertNullInt64(alert.CreatedBy),
+			CreatedAt:   common.ConvertNullTime(alert.CreatedAt),
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// QueueStockAlerts memasukkan alert baru ke outbox untuk setiap channel yang punya
+// penerima, pengiriman dilakukan oleh dispatcher notifikasi. Alert tetap ditandai
+// sudah diproses walaupun belum ada penerima yang dikonfigurasi.
+func (c *StockAlertController) QueueStockAlerts(ctx context.Context) error {
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	alerts, err := qtx.GetUnnotifiedStockAlerts(ctx, stockAlertBatch)
+	if err != nil {
+		return err
+	}
+
+	for _, alert := range alerts {
+		for _, channel := range []string{notifier.ChannelEmail, notifier.ChannelWhatsApp} {
+			to, ok := c.recipients[channel]
+			if !ok || !c.notifiers.Enabled(channel) {
+				continue
+			}
+
+			args := &db.CreateNotificationParams{
+				StockAlertID: sql.NullInt64{Int64: alert.ID, Valid: true},
+				Kind:         reorder.KindLowStock,
+				Channel:      channel,
+				Recipient:    to,
+				MaxAttempts:  notifier.MaxAttempts,
+			}
+			if _, err := qtx.CreateNotification(ctx, *args); err != nil {
+				return err
+			}
+		}
+
+		if err := qtx.MarkStockAlertNotified(ctx, alert.ID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// trackStockLevel membuat alert saat perubahan stok membuat produk turun ke batas
+// minimum dan menutup alert saat stok kembali di atas batas. Dipanggil di dalam
+// transaksi yang mengubah stok; before adalah stok sebelum perubahan.
+func trackStockLevel(ctx context.Context, q *db.Queries, before int32, product db.Product, trxRef string, userID int64) error {
+	if reorder.Crossed(before, product.Stock, product.MinStock) {
+		args := db.CreateStockAlertParams{
+			ProductID: product.ID,
+			TrxRef:    trxRef,
+			Stock:     product.Stock,
+			MinStock:  product.MinStock,
+			CreatedBy: sql.NullInt64{Int64: userID, Valid: userID != 0},
+		}
+		_, err := q.CreateStockAlert(ctx, args)
+		return err
+	}
+
+	if reorder.Recovered(before, product.Stock, product.MinStock) {
+		_, err := q.ResolveStockAlerts(ctx, product.ID)
+		return err
+	}
+	return nil
+}
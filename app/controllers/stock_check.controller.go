@@ -0,0 +1,191 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/jwt"
+	"pos-api/util/movement"
+	"pos-api/util/stockcheck"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StockCheckController struct {
+	db    *db.Queries
+	sqlDB *sql.DB
+	ctx   context.Context
+}
+
+func NewStockCheckController(db *db.Queries, sqlDB *sql.DB, ctx context.Context) *StockCheckController {
+	return &StockCheckController{db, sqlDB, ctx}
+}
+
+// GetStockCheck godoc
+// @Security BearerAuth
+// @Summary Check stock consistency
+// @Description Recompute the expected stock of every product from the sum of its product history and list the products whose stock does not match
+// @Tags inventory
+// @Produce json
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/inventory/stock-check [get]
+func (c *StockCheckController) GetStockCheck(ctx *gin.Context) {
+	data, err := c.CheckStock(ctx, false, 0)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": "failed to check stock",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// RepairStock godoc
+// @Security BearerAuth
+// @Summary Repair stock consistency
+// @Description Write an adjustment entry with reason correction for every product whose stock does not match its product history, so the history adds up to the current stock. Product stock is not changed; all entries are written in a single transaction
+// @Tags inventory
+// @Produce json
+// @Success 200 {object} schemas.Response
+// @Failure 401,502 {object} schemas.Response
+// @Router /api/v1/inventory/stock-check/repair [post]
+func (c *StockCheckController) RepairStock(ctx *gin.Context) {
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data, err := c.CheckStock(ctx, true, userInfo.UserID)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": "failed to repair stock",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "stock repaired successfully",
+		"data":    data,
+	})
+}
+
+// CheckStock membandingkan stok setiap produk dengan jumlah product history-nya.
+// Jika repair bernilai true, selisihnya dicatat sebagai product history bertipe
+// adjustment dalam satu transaksi. Dipakai oleh endpoint dan perintah stock-check.
+func (c *StockCheckController) CheckStock(ctx context.Context, repair bool, userID int64) (schemas.StockCheckData, error) {
+	data := schemas.StockCheckData{CheckedAt: time.Now()}
+
+	if !repair {
+		rows, err := c.db.GetProductStockBalances(ctx)
+		if err != nil {
+			return data, err
+		}
+		mismatches := stockcheck.Mismatches(stockBalances(rows))
+		data.Checked = len(rows)
+		data.Mismatches = stockMismatchData(mismatches)
+		return data, nil
+	}
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		return data, err
+	}
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	rows, err := qtx.GetProductStockBalances(ctx)
+	if err != nil {
+		return data, err
+	}
+	data.Checked = len(rows)
+
+	TrxRef := stockcheck.GenerateNumber(data.CheckedAt)
+	repaired := make([]stockcheck.Balance, 0)
+	for _, balance := range stockcheck.Mismatches(stockBalances(rows)) {
+		// hitung ulang setelah produk dikunci karena stok bisa berubah sejak pemeriksaan
+		Product, err := qtx.GetProductByIDForUpdate(ctx, balance.ProductID)
+		if err != nil {
+			return data, err
+		}
+		Expected, err := qtx.GetProductLedgerTotal(ctx, sql.NullInt64{Int64: Product.ID, Valid: true})
+		if err != nil {
+			return data, err
+		}
+		balance.Stock = Product.Stock
+		balance.Expected = Expected
+		if balance.Difference() == 0 {
+			continue
+		}
+
+		historyArgs := db.CreateProductHistoryParams{
+			TrxRef:         TrxRef,
+			ProductID:      sql.NullInt64{Int64: Product.ID, Valid: true},
+			QuantityChange: int32(balance.Difference()),
+			Type:           movement.TypeAdjustment,
+			Reason:         sql.NullString{String: stockcheck.Reason, Valid: true},
+			ReasonCode:     sql.NullString{String: movement.ReasonCorrection, Valid: true},
+			CreatedBy:      sql.NullInt64{Int64: userID, Valid: userID != 0},
+		}
+		if _, err := qtx.CreateProductHistory(ctx, historyArgs); err != nil {
+			return data, err
+		}
+		repaired = append(repaired, balance)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return data, err
+	}
+
+	data.Mismatches = stockMismatchData(repaired)
+	data.Repaired = true
+	if len(repaired) > 0 {
+		data.TrxRef = TrxRef
+	}
+	return data, nil
+}
+
+func stockBalances(rows []db.GetProductStockBalancesRow) []stockcheck.Balance {
+	balances := make([]stockcheck.Balance, len(rows))
+	for i, row := range rows {
+		balances[i] = stockcheck.Balance{
+			ProductID: row.ProductID,
+			Name:      row.Name,
+			Stock:     row.Stock,
+			Expected:  row.ExpectedStock,
+		}
+	}
+	return balances
+}
+
+func stockMismatchData(balances []stockcheck.Balance) []schemas.StockMismatchData {
+	data := make([]schemas.StockMismatchData, len(balances))
+	for i, balance := range balances {
+		data[i] = schemas.StockMismatchData{
+			ProductID:     balance.ProductID,
+			Name:          balance.Name,
+			Stock:         balance.Stock,
+			ExpectedStock: balance.Expected,
+			Difference:    balance.Difference(),
+		}
+	}
+	return data
+}
@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"pos-api/app/schemas"
+)
+
+// mismatch mencari produk pada hasil pemeriksaan stok
+func mismatch(data schemas.StockCheckData, productID int64) (schemas.StockMismatchData, bool) {
+	for _, m := range data.Mismatches {
+		if m.ProductID == productID {
+			return m, true
+		}
+	}
+	return schemas.StockMismatchData{}, false
+}
+
+func TestCheckStockRepair(t *testing.T) {
+	q, sqlDB := testDB(t)
+	ctx := context.Background()
+	c := NewStockCheckController(q, sqlDB, ctx)
+
+	// produk dibuat dengan stok awal tanpa product history sehingga selisih 10
+	user := createTestUser(t, q)
+	product := createTestProduct(t, q, 10)
+
+	data, err := c.CheckStock(ctx, false, 0)
+	if err != nil {
+		t.Fatalf("CheckStock() error = %v", err)
+	}
+	m, ok := mismatch(data, product.ID)
+	if !ok || m.Stock != 10 || m.ExpectedStock != 0 || m.Difference != 10 {
+		t.Fatalf("mismatch = %+v, %v, want stock 10, expected 0", m, ok)
+	}
+	if data.Repaired || data.TrxRef != "" {
+		t.Errorf("check without repair = %+v, want nothing repaired", data)
+	}
+
+	data, err = c.CheckStock(ctx, true, user.ID)
+	if err != nil {
+		t.Fatalf("CheckStock() repair error = %v", err)
+	}
+	if _, ok := mismatch(data, product.ID); !ok || !data.Repaired || data.TrxRef == "" {
+		t.Errorf("repair = %+v, want product %d repaired", data, product.ID)
+	}
+
+	// stok produk tidak berubah, product history yang disesuaikan
+	got, err := q.GetProductByID(ctx, product.ID)
+	if err != nil || got.Stock != 10 {
+		t.Errorf("stock = %d, %v, want 10", got.Stock, err)
+	}
+
+	data, err = c.CheckStock(ctx, false, 0)
+	if err != nil {
+		t.Fatalf("CheckStock() error = %v", err)
+	}
+	if m, ok := mismatch(data, product.ID); ok {
+		t.Errorf("mismatch after repair = %+v", m)
+	}
+}
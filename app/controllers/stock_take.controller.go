@@ -0,0 +1,895 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/costing"
+	"pos-api/util/jwt"
+	"pos-api/util/movement"
+	"pos-api/util/stocktake"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StockTakeController struct {
+	db        *db.Queries
+	sqlDB     *sql.DB
+	valuation string // metode penilaian persediaan untuk selisih yang keluar
+	ctx       context.Context
+}
+
+func NewStockTakeController(db *db.Queries, sqlDB *sql.DB, valuation string, ctx context.Context) *StockTakeController {
+	return &StockTakeController{db, sqlDB, valuation, ctx}
+}
+
+// CreateStockTake godoc
+// @Security BearerAuth
+// @Summary Start a stock take session
+// @Description Start a physical inventory counting session for all products or a single category. The stock of every product is frozen as the expected quantity when the session starts.
+// @Tags stock-takes
+// @Accept json
+// @Produce json
+// @Param payload body schemas.CreateStockTake true "Stock Take Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/stock-takes [post]
+func (c *StockTakeController) CreateStockTake(ctx *gin.Context) {
+	var payload schemas.CreateStockTake
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	CategoryID := int64(0)
+	CategoryName := ""
+	if payload.Scope == stocktake.ScopeCategory {
+		category, err := c.db.GetCategoryByID(ctx, payload.CategoryID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				ctx.JSON(http.StatusNotFound, gin.H{
+					"status":  "failed",
+					"message": "category id not found",
+				})
+				return
+			}
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		CategoryID = category.ID
+		CategoryName = category.Name
+	}
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	args := &db.CreateStockTakeParams{
+		SessionNumber: stocktake.GenerateNumber(UserID, time.Now()),
+		Scope:         payload.Scope,
+		CategoryID:    sql.NullInt64{Int64: CategoryID, Valid: CategoryID != 0},
+		Notes:         sql.NullString{String: payload.Notes, Valid: payload.Notes != ""},
+		StartedBy:     sql.NullInt64{Int64: UserID, Valid: true},
+	}
+	StockTake, err := qtx.CreateStockTake(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	// snapshot stok dibekukan saat sesi dimulai
+	itemArgs := db.CreateStockTakeItemsParams{
+		StockTakeID: StockTake.ID,
+		CategoryID:  CategoryID,
+	}
+	if _, err := qtx.CreateStockTakeItems(ctx, itemArgs); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": "failed to freeze stock snapshot",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	items, err := qtx.GetStockTakeItems(ctx, StockTake.ID)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "stock take started successfully",
+		"data":    stockTakeData(StockTake, CategoryName, items, false, true),
+	})
+}
+
+// GetAllStockTakes godoc
+// @Security BearerAuth
+// @Summary Get all stock take sessions
+// @Description Retrieve stock take sessions with pagination, optionally filtered by status
+// @Tags stock-takes
+// @Produce json
+// @Param status query string false "Filter by status (counting, posted, cancelled)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Limit per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/stock-takes [get]
+func (c *StockTakeController) GetAllStockTakes(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	offset := (page - 1) * limit
+
+	args := &db.GetAllStockTakesParams{
+		Status: ctx.Query("status"),
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	}
+
+	sessions, err := c.db.GetAllStockTakes(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.StockTakeData, len(sessions))
+	for i, session := range sessions {
+		StockTake := db.StockTake{
+			ID:            session.ID,
+			SessionNumber: session.SessionNumber,
+			Scope:         session.Scope,
+			CategoryID:    session.CategoryID,
+			Status:        session.Status,
+			Notes:         session.Notes,
+			StartedBy:     session.StartedBy,
+			StartedAt:     session.StartedAt,
+			PostedBy:      session.PostedBy,
+			PostedAt:      session.PostedAt,
+			CancelledBy:   session.CancelledBy,
+			CancelledAt:   session.CancelledAt,
+		}
+		data[i] = stockTakeData(StockTake, common.ConvertNullString(session.CategoryName), nil, false, false)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// GetStockTakeById godoc
+// @Security BearerAuth
+// @Summary Get a stock take session by ID
+// @Description Retrieve a stock take session with the frozen and counted quantity of every product
+// @Tags stock-takes
+// @Produce json
+// @Param id path int true "Stock Take ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/stock-takes/{id} [get]
+func (c *StockTakeController) GetStockTakeById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid stock take id",
+		})
+		return
+	}
+
+	data, err := loadStockTake(ctx, c.db, id, false, true)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve stock take with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "stock take retrieved successfully",
+		"data":    data,
+	})
+}
+
+// CountStockTake godoc
+// @Security BearerAuth
+// @Summary Enter a stock count
+// @Description Enter the counted quantity of a product found by barcode or product ID. Counts sent from several devices are added up unless replace is set.
+// @Tags stock-takes
+// @Accept json
+// @Produce json
+// @Param id path int true "Stock Take ID"
+// @Param payload body schemas.StockTakeCount true "Count Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/stock-takes/{id}/counts [post]
+func (c *StockTakeController) CountStockTake(ctx *gin.Context) {
+	var payload schemas.StockTakeCount
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid stock take id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	var Product db.Product
+	if payload.Barcode != "" {
+		Product, err = c.db.GetProductByBarcode(ctx, sql.NullString{String: payload.Barcode, Valid: true})
+	} else {
+		Product, err = c.db.GetProductByID(ctx, payload.ProductID)
+	}
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "product not found",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	// hitungan dari beberapa perangkat boleh berjalan bersamaan, posting menunggu sampai selesai
+	StockTake, err := qtx.GetStockTakeByIDForShare(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve stock take with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if StockTake.Status != stocktake.StatusCounting {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": stocktake.ErrNotCounting.Error(),
+		})
+		return
+	}
+
+	countArgs := &db.AddStockTakeCountParams{
+		Replace:     payload.Replace,
+		Quantity:    payload.Quantity,
+		StockTakeID: StockTake.ID,
+		ProductID:   Product.ID,
+	}
+	Item, err := qtx.AddStockTakeCount(ctx, *countArgs)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": stocktake.ErrNotInSession.Error(),
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	logArgs := &db.CreateStockTakeCountParams{
+		StockTakeID: StockTake.ID,
+		ProductID:   Product.ID,
+		Barcode:     sql.NullString{String: payload.Barcode, Valid: payload.Barcode != ""},
+		Quantity:    payload.Quantity,
+		Replace:     payload.Replace,
+		DeviceID:    sql.NullString{String: payload.DeviceID, Valid: payload.DeviceID != ""},
+		CountedBy:   sql.NullInt64{Int64: UserID, Valid: true},
+	}
+	if _, err := qtx.CreateStockTakeCount(ctx, *logArgs); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := stockTakeItemData(db.GetStockTakeItemsRow{
+		ID:               Item.ID,
+		StockTakeID:      Item.StockTakeID,
+		ProductID:        Item.ProductID,
+		ExpectedQuantity: Item.ExpectedQuantity,
+		CountedQuantity:  Item.CountedQuantity,
+		UnitCost:         Item.UnitCost,
+		ReasonCode:       Item.ReasonCode,
+		CountedAt:        Item.CountedAt,
+		ProductName:      Product.Name,
+		Barcode:          Product.Barcode,
+	}, false)
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "count recorded successfully",
+		"data":    data,
+	})
+}
+
+// GetStockTakeVariances godoc
+// @Security BearerAuth
+// @Summary Review stock take variances
+// @Description Retrieve only the products whose counted quantity differs from the frozen stock, with the variance value at the product cost
+// @Tags stock-takes
+// @Produce json
+// @Param id path int true "Stock Take ID"
+// @Param zero_uncounted query bool false "Treat products that were not counted as zero"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/stock-takes/{id}/variances [get]
+func (c *StockTakeController) GetStockTakeVariances(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid stock take id",
+		})
+		return
+	}
+	ZeroUncounted := ctx.Query("zero_uncounted") == "true"
+
+	data, err := loadStockTake(ctx, c.db, id, ZeroUncounted, true)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve stock take with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	Variances := make([]schemas.StockTakeItemData, 0)
+	for _, item := range data.Items {
+		if item.Variance != 0 {
+			Variances = append(Variances, item)
+		}
+	}
+	data.Items = Variances
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// PostStockTake godoc
+// @Security BearerAuth
+// @Summary Post a stock take
+// @Description Post the variances of a stock take to product stock. Every variance is applied on top of the current stock and written as a product history entry with type "adjustment" and a reason code (shrinkage, damaged, expired, theft, count_error or found).
+// @Tags stock-takes
+// @Accept json
+// @Produce json
+// @Param id path int true "Stock Take ID"
+// @Param payload body schemas.PostStockTake true "Posting Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/stock-takes/{id}/post [post]
+func (c *StockTakeController) PostStockTake(ctx *gin.Context) {
+	var payload schemas.PostStockTake
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid stock take id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	Reasons := make(map[int64]string, len(payload.Reasons))
+	for _, reason := range payload.Reasons {
+		if err := stocktake.ValidateReason(reason.ReasonCode); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		Reasons[reason.ProductID] = reason.ReasonCode
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	StockTake, err := qtx.GetStockTakeByIDForUpdate(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve stock take with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if StockTake.Status != stocktake.StatusCounting {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": stocktake.ErrNotCounting.Error(),
+		})
+		return
+	}
+
+	items, err := qtx.GetStockTakeItems(ctx, StockTake.ID)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	for _, item := range items {
+		Line := stockTakeLine(item)
+		Variance := Line.Variance(payload.ZeroUncounted)
+		if Variance == 0 {
+			continue
+		}
+
+		ReasonCode, ok := Reasons[item.ProductID]
+		if !ok {
+			ReasonCode = stocktake.DefaultReason(Variance)
+		}
+
+		resultArgs := db.UpdateStockTakeItemResultParams{
+			ID:              item.ID,
+			CountedQuantity: sql.NullInt32{Int32: Line.Expected + Variance, Valid: true},
+			ReasonCode:      sql.NullString{String: ReasonCode, Valid: true},
+		}
+		if err := qtx.UpdateStockTakeItemResult(ctx, resultArgs); err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		// selisih diterapkan di atas stok saat ini agar penjualan selama penghitungan tetap terhitung
+		stockArgs := &db.IncrementProductStockParams{
+			Quantity:  Variance,
+			UpdatedBy: sql.NullInt64{Int64: UserID, Valid: true},
+			ID:        item.ProductID,
+		}
+		Product, err := qtx.IncrementProductStock(ctx, *stockArgs)
+		if err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": "failed to update product stock with id " + strconv.FormatInt(item.ProductID, 10),
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		if err := trackStockLevel(ctx, qtx, Product.Stock-Variance, Product, StockTake.SessionNumber, UserID); err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": "failed to record stock alert",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		Product.Stock -= Variance
+		if Variance > 0 {
+			err = receiveStockCost(ctx, qtx, Product, Variance, productCost(Product), costing.SourceAdjustment, StockTake.SessionNumber)
+		} else {
+			_, err = issueStockCost(ctx, qtx, c.valuation, Product, -Variance, StockTake.SessionNumber)
+		}
+		if err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": "failed to update inventory cost",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		historyArgs := &db.CreateProductHistoryParams{
+			TrxRef:         StockTake.SessionNumber,
+			ProductID:      sql.NullInt64{Int64: item.ProductID, Valid: true},
+			QuantityChange: Variance,
+			Type:           movement.TypeAdjustment,
+			Reason:         sql.NullString{String: "Stock take " + StockTake.SessionNumber, Valid: true},
+			ReasonCode:     sql.NullString{String: ReasonCode, Valid: true},
+			CreatedBy:      sql.NullInt64{Int64: UserID, Valid: true},
+		}
+		if _, err := qtx.CreateProductHistory(ctx, *historyArgs); err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+	}
+
+	postArgs := &db.PostStockTakeParams{
+		ID:       StockTake.ID,
+		PostedBy: sql.NullInt64{Int64: UserID, Valid: true},
+	}
+	if _, err := qtx.PostStockTake(ctx, *postArgs); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data, err := loadStockTake(ctx, qtx, StockTake.ID, payload.ZeroUncounted, true)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "stock take posted successfully",
+		"data":    data,
+	})
+}
+
+// CancelStockTake godoc
+// @Security BearerAuth
+// @Summary Cancel a stock take
+// @Description Cancel a stock take that is still counting, product stock is not changed
+// @Tags stock-takes
+// @Produce json
+// @Param id path int true "Stock Take ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/stock-takes/{id}/cancel [post]
+func (c *StockTakeController) CancelStockTake(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid stock take id",
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	tx, err := c.sqlDB.Begin()
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	defer tx.Rollback()
+	qtx := c.db.WithTx(tx)
+
+	StockTake, err := qtx.GetStockTakeByIDForUpdate(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve stock take with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if StockTake.Status != stocktake.StatusCounting {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": stocktake.ErrNotCounting.Error(),
+		})
+		return
+	}
+
+	args := &db.CancelStockTakeParams{
+		ID:          StockTake.ID,
+		CancelledBy: sql.NullInt64{Int64: UserID, Valid: true},
+	}
+	if _, err := qtx.CancelStockTake(ctx, *args); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data, err := loadStockTake(ctx, qtx, StockTake.ID, false, false)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "stock take cancelled successfully",
+		"data":    data,
+	})
+}
+
+// loadStockTake mengambil sesi stock take beserta kategori dan seluruh produknya
+func loadStockTake(ctx context.Context, q *db.Queries, id int64, zeroUncounted bool, withItems bool) (schemas.StockTakeData, error) {
+	StockTake, err := q.GetStockTakeByID(ctx, id)
+	if err != nil {
+		return schemas.StockTakeData{}, err
+	}
+
+	CategoryName := ""
+	if StockTake.CategoryID.Valid {
+		category, err := q.GetCategoryByID(ctx, StockTake.CategoryID.Int64)
+		if err != nil && err != sql.ErrNoRows {
+			return schemas.StockTakeData{}, err
+		}
+		CategoryName = category.Name
+	}
+
+	items, err := q.GetStockTakeItems(ctx, StockTake.ID)
+	if err != nil {
+		return schemas.StockTakeData{}, err
+	}
+
+	return stockTakeData(StockTake, CategoryName, items, zeroUncounted, withItems), nil
+}
+
+func stockTakeLine(item db.GetStockTakeItemsRow) stocktake.Line {
+	Line := stocktake.Line{Expected: item.ExpectedQuantity}
+	if item.CountedQuantity.Valid {
+		Counted := item.CountedQuantity.Int32
+		Line.Counted = &Counted
+	}
+	return Line
+}
+
+func stockTakeItemData(item db.GetStockTakeItemsRow, zeroUncounted bool) schemas.StockTakeItemData {
+	Line := stockTakeLine(item)
+	Variance := Line.Variance(zeroUncounted)
+	UnitCost, _ := strconv.ParseFloat(item.UnitCost, 64)
+
+	return schemas.StockTakeItemData{
+		ProductID:   item.ProductID,
+		ProductName: item.ProductName,
+		Barcode:     common.ConvertNullString(item.Barcode),
+		Expected:    Line.Expected,
+		Counted:     Line.Counted,
+		Variance:    Variance,
+		UnitCost:    UnitCost,
+		Value:       costing.Round(float64(Variance) * UnitCost),
+		ReasonCode:  common.ConvertNullString(item.ReasonCode),
+		CountedAt:   common.ConvertNullTime(item.CountedAt),
+	}
+}
+
+func stockTakeData(take db.StockTake, categoryName string, items []db.GetStockTakeItemsRow, zeroUncounted bool, withItems bool) schemas.StockTakeData {
+	data := schemas.StockTakeData{
+		ID:            take.ID,
+		SessionNumber: take.SessionNumber,
+		Scope:         take.Scope,
+		CategoryID:    common.ConvertNullInt64(take.CategoryID),
+		CategoryName:  categoryName,
+		Status:        take.Status,
+		Notes:         common.ConvertNullString(take.Notes),
+		StartedBy:     common.ConvertNullInt64(take.StartedBy),
+		StartedAt:     common.ConvertNullTime(take.StartedAt),
+		PostedBy:      common.ConvertNullInt64(take.PostedBy),
+		PostedAt:      common.ConvertNullTime(take.PostedAt),
+		CancelledBy:   common.ConvertNullInt64(take.CancelledBy),
+		CancelledAt:   common.ConvertNullTime(take.CancelledAt),
+		TotalItems:    len(items),
+	}
+
+	for _, item := range items {
+		Item := stockTakeItemData(item, zeroUncounted)
+		if Item.Counted != nil {
+			data.CountedItems++
+		}
+		if Item.Variance != 0 {
+			data.VarianceItems++
+			data.VarianceValue += Item.Value
+		}
+		if withItems {
+			data.Items = append(data.Items, Item)
+		}
+	}
+	data.VarianceValue = costing.Round(data.VarianceValue)
+	return data
+}
@@ -0,0 +1,327 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/jwt"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SupplierController struct {
+	db  *db.Queries
+	ctx context.Context
+}
+
+func NewSupplierController(db *db.Queries, ctx context.Context) *SupplierController {
+	return &SupplierController{db, ctx}
+}
+
+// CreateSupplier godoc
+// @Security BearerAuth
+// @Summary Create a new supplier
+// @Description Create a new supplier that purchase orders can be sent to
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param payload body schemas.CreateSupplier true "Supplier Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/suppliers [post]
+func (c *SupplierController) CreateSupplier(ctx *gin.Context) {
+	var payload schemas.CreateSupplier
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	args := &db.CreateSupplierParams{
+		Name:        payload.Name,
+		ContactName: sql.NullString{String: payload.ContactName, Valid: payload.ContactName != ""},
+		Phone:       sql.NullString{String: payload.Phone, Valid: payload.Phone != ""},
+		Email:       sql.NullString{String: payload.Email, Valid: payload.Email != ""},
+		Address:     sql.NullString{String: payload.Address, Valid: payload.Address != ""},
+		Notes:       sql.NullString{String: payload.Notes, Valid: payload.Notes != ""},
+		CreatedBy:   sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	supplier, err := c.db.CreateSupplier(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "created successfully",
+		"data":    supplierData(supplier),
+	})
+}
+
+// UpdateSupplier godoc
+// @Security BearerAuth
+// @Summary Update an existing supplier
+// @Description Update a supplier with the given ID and payload
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param id path int true "Supplier ID"
+// @Param payload body schemas.UpdateSupplier true "Supplier Update Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/suppliers/{id} [put]
+func (c *SupplierController) UpdateSupplier(ctx *gin.Context) {
+	var payload schemas.UpdateSupplier
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid supplier id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	args := &db.UpdateSupplierParams{
+		ID:          id,
+		Name:        payload.Name,
+		ContactName: sql.NullString{String: payload.ContactName, Valid: payload.ContactName != ""},
+		Phone:       sql.NullString{String: payload.Phone, Valid: payload.Phone != ""},
+		Email:       sql.NullString{String: payload.Email, Valid: payload.Email != ""},
+		Address:     sql.NullString{String: payload.Address, Valid: payload.Address != ""},
+		Notes:       sql.NullString{String: payload.Notes, Valid: payload.Notes != ""},
+		UpdatedBy:   sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	supplier, err := c.db.UpdateSupplier(ctx, *args)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve supplier with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "updated successfully",
+		"data":    supplierData(supplier),
+	})
+}
+
+// GetSupplierById godoc
+// @Security BearerAuth
+// @Summary Get a supplier by ID
+// @Description Retrieve a supplier by its ID
+// @Tags suppliers
+// @Produce json
+// @Param id path int true "Supplier ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/suppliers/{id} [get]
+func (c *SupplierController) GetSupplierById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid supplier id",
+		})
+		return
+	}
+
+	supplier, err := c.db.GetSupplierByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve supplier with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "supplier retrieved successfully",
+		"data":    supplierData(supplier),
+	})
+}
+
+// GetAllSuppliers godoc
+// @Security BearerAuth
+// @Summary Get all suppliers
+// @Description Retrieve all suppliers with pagination, optionally filtered by name or contact name
+// @Tags suppliers
+// @Produce json
+// @Param q query string false "Search by name or contact name"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Limit per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/suppliers [get]
+func (c *SupplierController) GetAllSuppliers(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	offset := (page - 1) * limit
+
+	args := &db.GetAllSuppliersParams{
+		Query:  ctx.Query("q"),
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	}
+
+	suppliers, err := c.db.GetAllSuppliers(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.SupplierData, len(suppliers))
+	for i, supplier := range suppliers {
+		data[i] = supplierData(supplier)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// SoftDeleteSupplierById godoc
+// @Security BearerAuth
+// @Summary Soft delete a supplier by ID
+// @Description Soft delete a supplier with the given ID, existing purchase orders keep referring to it
+// @Tags suppliers
+// @Produce json
+// @Param id path int true "Supplier ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 401 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/suppliers/{id}/soft [delete]
+func (c *SupplierController) SoftDeleteSupplierById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid supplier id",
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	args := &db.SoftDeleteSupplierByIDParams{
+		ID:        id,
+		DeletedBy: sql.NullInt64{Int64: UserID, Valid: true},
+	}
+	if _, err = c.db.SoftDeleteSupplierByID(ctx, *args); err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve supplier with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "soft deleted successfully",
+	})
+}
+
+func supplierData(supplier db.Supplier) schemas.SupplierData {
+	return schemas.SupplierData{
+		ID:          supplier.ID,
+		Name:        supplier.Name,
+		ContactName: common.ConvertNullString(supplier.ContactName),
+		Phone:       common.ConvertNullString(supplier.Phone),
+		Email:       common.ConvertNullString(supplier.Email),
+		Address:     common.ConvertNullString(supplier.Address),
+		Notes:       common.ConvertNullString(supplier.Notes),
+		CreatedBy:   common.ConvertNullInt64(supplier.CreatedBy),
+		CreatedAt:   common.ConvertNullTime(supplier.CreatedAt),
+		UpdatedBy:   common.ConvertNullInt64(supplier.UpdatedBy),
+		UpdatedAt:   common.ConvertNullTime(supplier.UpdatedAt),
+	}
+}
@@ -0,0 +1,353 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/jwt"
+	"pos-api/util/tax"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TaxController struct {
+	db  *db.Queries
+	ctx context.Context
+}
+
+func NewTaxController(db *db.Queries, ctx context.Context) *TaxController {
+	return &TaxController{db, ctx}
+}
+
+// CreateTaxRate godoc
+// @Security BearerAuth
+// @Summary Create a new tax rate
+// @Description Create a new tax rate (e.g. PPN) that can be assigned to products or categories
+// @Tags taxes
+// @Accept json
+// @Produce json
+// @Param payload body schemas.CreateTaxRate true "Tax Rate Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/taxes [post]
+func (c *TaxController) CreateTaxRate(ctx *gin.Context) {
+	var payload schemas.CreateTaxRate
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if err := tax.Validate(tax.Rate{Name: payload.Name, Rate: payload.Rate}); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	args := &db.CreateTaxRateParams{
+		Name:        payload.Name,
+		Rate:        strconv.FormatFloat(payload.Rate, 'f', 2, 64),
+		IsInclusive: payload.IsInclusive,
+		CreatedBy:   sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	rate, err := c.db.CreateTaxRate(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "created successfully",
+		"data":    taxRateData(rate),
+	})
+}
+
+// UpdateTaxRate godoc
+// @Security BearerAuth
+// @Summary Update an existing tax rate
+// @Description Update a tax rate with the given ID and payload
+// @Tags taxes
+// @Accept json
+// @Produce json
+// @Param id path int true "Tax Rate ID"
+// @Param payload body schemas.UpdateTaxRate true "Tax Rate Update Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/taxes/{id} [put]
+func (c *TaxController) UpdateTaxRate(ctx *gin.Context) {
+	var payload schemas.UpdateTaxRate
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid tax rate id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if err := tax.Validate(tax.Rate{Name: payload.Name, Rate: payload.Rate}); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	args := &db.UpdateTaxRateParams{
+		ID:          id,
+		Name:        payload.Name,
+		Rate:        strconv.FormatFloat(payload.Rate, 'f', 2, 64),
+		IsInclusive: payload.IsInclusive,
+		IsActive:    payload.IsActive,
+		UpdatedBy:   sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	rate, err := c.db.UpdateTaxRate(ctx, *args)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve tax rate with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "updated successfully",
+		"data":    taxRateData(rate),
+	})
+}
+
+// GetTaxRateById godoc
+// @Security BearerAuth
+// @Summary Get a tax rate by ID
+// @Description Retrieve a tax rate by ID
+// @Tags taxes
+// @Produce json
+// @Param id path int true "Tax Rate ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/taxes/{id} [get]
+func (c *TaxController) GetTaxRateById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid tax rate id",
+		})
+		return
+	}
+
+	rate, err := c.db.GetTaxRateByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve tax rate with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "tax rate retrieved successfully",
+		"data":    taxRateData(rate),
+	})
+}
+
+// GetAllTaxRates godoc
+// @Security BearerAuth
+// @Summary Get all tax rates
+// @Description Retrieve all tax rates with pagination
+// @Tags taxes
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Limit per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/taxes [get]
+func (c *TaxController) GetAllTaxRates(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	offset := (page - 1) * limit
+
+	args := &db.GetAllTaxRatesParams{
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	}
+
+	rates, err := c.db.GetAllTaxRates(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.TaxRateData, len(rates))
+	for i, rate := range rates {
+		data[i] = taxRateData(rate)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// SoftDeleteTaxRateById godoc
+// @Security BearerAuth
+// @Summary Soft delete a tax rate by ID
+// @Description Soft delete a tax rate with the given ID
+// @Tags taxes
+// @Produce json
+// @Param id path int true "Tax Rate ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/taxes/{id}/soft [delete]
+func (c *TaxController) SoftDeleteTaxRateById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid tax rate id",
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	args := &db.SoftDeleteTaxRateByIDParams{
+		ID:        id,
+		DeletedBy: sql.NullInt64{Int64: UserID, Valid: true},
+	}
+	if _, err = c.db.SoftDeleteTaxRateByID(ctx, *args); err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve tax rate with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "soft deleted successfully",
+	})
+}
+
+// loadProductTaxRate mengambil tarif pajak produk, jika produk tidak punya tarif
+// sendiri maka memakai tarif kategori. Produk tanpa tarif dianggap tidak kena pajak.
+func loadProductTaxRate(ctx context.Context, q *db.Queries, productID int64) (tax.Rate, error) {
+	rate, err := q.GetTaxRateByProductID(ctx, productID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return tax.Rate{}, nil
+		}
+		return tax.Rate{}, err
+	}
+
+	Rate, _ := strconv.ParseFloat(rate.Rate, 64)
+	return tax.Rate{
+		ID:        rate.ID,
+		Name:      rate.Name,
+		Rate:      Rate,
+		Inclusive: rate.IsInclusive,
+	}, nil
+}
+
+func taxRateData(rate db.TaxRate) schemas.TaxRateData {
+	Rate, _ := strconv.ParseFloat(rate.Rate, 64)
+	return schemas.TaxRateData{
+		ID:          rate.ID,
+		Name:        rate.Name,
+		Rate:        Rate,
+		IsInclusive: rate.IsInclusive,
+		IsActive:    rate.IsActive,
+		CreatedBy:   common.ConvertNullInt64(rate.CreatedBy),
+		CreatedAt:   common.ConvertNullTime(rate.CreatedAt),
+		UpdatedBy:   common.ConvertNullInt64(rate.UpdatedBy),
+		UpdatedAt:   common.ConvertNullTime(rate.UpdatedAt),
+	}
+}
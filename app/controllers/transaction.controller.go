@@ -3,6 +3,7 @@ package controllers
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -11,25 +12,41 @@ import (
 	"pos-api/app/schemas"
 	db "pos-api/db/sqlc"
 	"pos-api/util/common"
+	"pos-api/util/costing"
+	"pos-api/util/giftcard"
 	"pos-api/util/jwt"
+	"pos-api/util/loyalty"
+	"pos-api/util/movement"
+	"pos-api/util/notifier"
+	"pos-api/util/payment"
+	"pos-api/util/promotion"
+	"pos-api/util/shift"
+	"pos-api/util/tax"
+	"pos-api/util/tier"
+	"pos-api/util/voucher"
 
 	"github.com/gin-gonic/gin"
 )
 
 type TransactionController struct {
-	db    *db.Queries
-	sqlDB *sql.DB
-	ctx   context.Context
+	db        *db.Queries
+	sqlDB     *sql.DB
+	gateway   payment.Provider
+	notifiers notifier.Registry
+	points    loyalty.Rules
+	giftCards int    // masa berlaku gift card yang dijual (hari)
+	valuation string // metode penilaian persediaan untuk harga pokok penjualan
+	ctx       context.Context
 }
 
-func NewTransactionController(db *db.Queries, sqlDB *sql.DB, ctx context.Context) *TransactionController {
-	return &TransactionController{db, sqlDB, ctx}
+func NewTransactionController(db *db.Queries, sqlDB *sql.DB, gateway payment.Provider, notifiers notifier.Registry, points loyalty.Rules, giftCards int, valuation string, ctx context.Context) *TransactionController {
+	return &TransactionController{db, sqlDB, gateway, notifiers, points, giftCards, valuation, ctx}
 }
 
 // CreateOrder godoc
 // @Security BearerAuth
 // @Summary Create product stock history
-// @Description Create a new order
+// @Description Create a new order. The order is linked to the open shift of the cashier; without an open shift it is created with an empty shift_id and left out of shift reports
 // @Tags transaction
 // @Accept json
 // @Produce json
@@ -50,6 +67,95 @@ func (p *TransactionController) CreateOrder(ctx *gin.Context) {
 		return
 	}
 
+	p.placeOrder(ctx, payload, 0)
+}
+
+// ConvertParkedOrder godoc
+// @Security BearerAuth
+// @Summary Convert a parked order into an order
+// @Description Create an order from a held or resumed parked order, the stock reservation is released and the order is created with the same rules as create order, including linking it to the open shift of the cashier when there is one
+// @Tags parked-orders
+// @Accept json
+// @Produce json
+// @Param id path int true "Parked Order ID"
+// @Param payload body schemas.ConvertParkedOrder true "Payment Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/parked-orders/{id}/convert [post]
+func (p *TransactionController) ConvertParkedOrder(ctx *gin.Context) {
+	var payload schemas.ConvertParkedOrder
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid parked order id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	parked, err := p.db.GetParkedOrderByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve parked order with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	items, err := p.db.GetParkedOrderItems(ctx, sql.NullInt64{Int64: parked.ID, Valid: true})
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	order := schemas.CreateOrder{
+		Type:       parked.CustomerType,
+		CustomerID: common.ConvertNullInt64(parked.CustomerID),
+		Customer: schemas.Customer{
+			Name:  common.ConvertNullString(parked.CustomerName),
+			Phone: common.ConvertNullString(parked.CustomerPhone),
+			Email: common.ConvertNullString(parked.CustomerEmail),
+		},
+		PaymentMethod: payload.PaymentMethod,
+		Payments:      payload.Payments,
+		VoucherCode:   common.ConvertNullString(parked.VoucherCode),
+		Items:         make([]schemas.CreateOrderItem, len(items)),
+	}
+	for i, item := range items {
+		order.Items[i] = schemas.CreateOrderItem{
+			ProductID: item.ProductID.Int64,
+			Quantity:  item.Quantity,
+		}
+	}
+
+	p.placeOrder(ctx, order, parked.ID)
+}
+
+// placeOrder membuat order dari payload, dipakai oleh CreateOrder dan konversi order
+// yang di-park. Jika ParkedOrderID diisi, reservasi stok order tersebut dilepas lalu
+// statusnya diubah menjadi converted dalam transaksi yang sama.
+func (p *TransactionController) placeOrder(ctx *gin.Context, payload schemas.CreateOrder, ParkedOrderID int64) {
 	userInfo, err := jwt.GetUserInfo(ctx)
 	if err != nil {
 		ctx.JSON(http.StatusUnauthorized, gin.H{
@@ -72,12 +178,74 @@ func (p *TransactionController) CreateOrder(ctx *gin.Context) {
 	defer tx.Rollback()
 	qtx := p.db.WithTx(tx)
 
+	//Shift, order tanpa shift terbuka tetap dibuat dengan shift_id kosong
+	ShiftID, err := openShiftID(ctx, qtx, UserID)
+	if err != nil && !errors.Is(err, shift.ErrNoOpenShift) {
+		tx.Rollback()
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	//Parked order
+	if ParkedOrderID != 0 {
+		if err := releaseParkedOrder(ctx, qtx, ParkedOrderID, UserID); err != nil {
+			tx.Rollback()
+			if err == sql.ErrNoRows {
+				ctx.JSON(http.StatusNotFound, gin.H{
+					"status":  "failed",
+					"message": "failed to retrieve parked order with this id",
+				})
+				return
+			}
+			if errors.Is(err, errParkedOrderClosed) {
+				ctx.JSON(http.StatusBadRequest, gin.H{
+					"status":  "failed",
+					"message": err.Error(),
+				})
+				return
+			}
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+	}
+
 	//Customer
 	var CustomerID int64
 	if payload.Type == "guest" {
 
 	} else if payload.Type == "member" {
 		CustomerID = payload.CustomerID
+		if CustomerID == 0 {
+			CustomerID, err = findMember(ctx, qtx, payload.Member)
+			if err != nil {
+				tx.Rollback()
+				if err == sql.ErrNoRows {
+					ctx.JSON(http.StatusNotFound, gin.H{
+						"status":  "failed",
+						"message": "failed to retrieve member with this member code or phone",
+					})
+					return
+				}
+				if errors.Is(err, errAmbiguousMember) {
+					ctx.JSON(http.StatusBadRequest, gin.H{
+						"status":  "failed",
+						"message": err.Error(),
+					})
+					return
+				}
+				ctx.JSON(http.StatusBadGateway, gin.H{
+					"status":  "failed",
+					"message": err.Error(),
+				})
+				return
+			}
+		}
 	} else if payload.Type == "new" {
 		MemberCode, err := qtx.GenerateMemberCode(ctx)
 		if err != nil {
@@ -118,12 +286,16 @@ func (p *TransactionController) CreateOrder(ctx *gin.Context) {
 
 	//Order
 	TrxNumber := fmt.Sprintf("TRX-%d-%s-%d", CustomerID, time.Now().Format("20060102150405"), UserID)
-	TotalAmount := 0.0
+	Subtotal := 0.0
 
 	Items := make([]db.CreateOrderItemParams, 0)
+	Lines := make([]promotion.Line, 0)
+	TaxRates := make([]tax.Rate, 0)
+	GiftCardLines := make([]bool, 0)
 
 	for _, item := range payload.Items {
-		Product, err := qtx.GetProductByID(ctx, item.ProductID)
+		// produk dikunci sampai transaksi selesai supaya order lain tidak menjual stok yang sama
+		Product, err := qtx.GetProductByIDForUpdate(ctx, item.ProductID)
 		if err != nil {
 			tx.Rollback()
 			if err == sql.ErrNoRows {
@@ -140,7 +312,8 @@ func (p *TransactionController) CreateOrder(ctx *gin.Context) {
 			return
 		}
 
-		if Product.Stock < item.Quantity {
+		// stok yang direservasi order lain yang di-park tidak bisa dijual
+		if Product.Stock-Product.ReservedStock < item.Quantity {
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"status":  "failed",
 				"message": "stock not enough product with id " + strconv.FormatInt(item.ProductID, 10),
@@ -164,16 +337,217 @@ func (p *TransactionController) CreateOrder(ctx *gin.Context) {
 			})
 			return
 		}
-		TotalAmount += Price * float64(item.Quantity)
+		Subtotal += Price * float64(item.Quantity)
+		Lines = append(Lines, promotion.Line{
+			ProductID:  Product.ID,
+			CategoryID: common.ConvertNullInt64(Product.CategoryID),
+			Quantity:   item.Quantity,
+			UnitPrice:  Price,
+		})
+		GiftCardLines = append(GiftCardLines, Product.IsGiftCard)
+
+		TaxRate, err := loadProductTaxRate(ctx, qtx, Product.ID)
+		if err != nil {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		TaxRates = append(TaxRates, TaxRate)
+	}
+
+	//Promotion
+	Rules, err := loadPromotionRules(ctx, qtx, time.Now())
+	if err != nil {
+		tx.Rollback()
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	Promo := promotion.Apply(Lines, Rules, time.Now())
+	LineDiscounts := Promo.LineDiscounts
+
+	//Member tier price
+	if payload.Type == "member" && CustomerID != 0 {
+		MemberTier, found, err := loadCustomerTier(ctx, qtx, CustomerID)
+		if err != nil {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		if found {
+			MemberDiscounts, MemberDiscount := MemberTier.Discounts(Lines, LineDiscounts)
+			if MemberDiscount > 0 {
+				for i, d := range MemberDiscounts {
+					LineDiscounts[i] += d
+				}
+				Promo.TotalDiscount += MemberDiscount
+				Promo.Applied = append(Promo.Applied, promotion.Applied{
+					Name:     MemberTier.Name + " member price",
+					Type:     tier.TypeMemberPrice,
+					Discount: MemberDiscount,
+				})
+			}
+		}
+	}
+
+	//Voucher
+	var Voucher db.Voucher
+	VoucherDiscount := 0.0
+	if payload.VoucherCode != "" {
+		Voucher, VoucherDiscount, err = checkVoucher(ctx, qtx, payload.VoucherCode, CustomerID, Subtotal-Promo.TotalDiscount, true)
+		if err != nil {
+			tx.Rollback()
+			ctx.JSON(voucherErrorStatus(err), gin.H{
+				"status":  "failed",
+				"message": voucherErrorMessage(err),
+			})
+			return
+		}
+
+		// usage limit dicek ulang di query untuk menghindari pemakaian bersamaan
+		if _, err := qtx.IncrementVoucherUsage(ctx, Voucher.ID); err != nil {
+			tx.Rollback()
+			if err == sql.ErrNoRows {
+				ctx.JSON(http.StatusBadRequest, gin.H{
+					"status":  "failed",
+					"message": voucher.ErrUsageLimit.Error(),
+				})
+				return
+			}
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		for i, d := range promotion.Allocate(Lines, LineDiscounts, VoucherDiscount) {
+			LineDiscounts[i] += d
+		}
+	}
+
+	//Tax
+	TaxLines := make([]tax.Line, len(Items))
+	for i := range Items {
+		Items[i].DiscountAmount = strconv.FormatFloat(LineDiscounts[i], 'f', 2, 64)
+
+		TaxLines[i] = tax.Compute(Lines[i].UnitPrice*float64(Lines[i].Quantity)-LineDiscounts[i], TaxRates[i])
+		Items[i].TaxName = sql.NullString{String: TaxRates[i].Name, Valid: TaxRates[i].ID != 0}
+		Items[i].TaxRate = strconv.FormatFloat(TaxRates[i].Rate, 'f', 2, 64)
+		Items[i].TaxInclusive = TaxRates[i].Inclusive
+		Items[i].TaxAmount = strconv.FormatFloat(TaxLines[i].TaxAmount, 'f', 2, 64)
+	}
+	TaxAmount, ExclusiveTax := tax.Totals(TaxLines)
+	DiscountAmount := Promo.TotalDiscount + VoucherDiscount
+	TotalAmount := Subtotal - DiscountAmount + ExclusiveTax
+
+	//Payment
+	Tenders, err := loadTenders(ctx, qtx, payload, TotalAmount)
+	if err != nil {
+		tx.Rollback()
+		if errors.Is(err, payment.ErrNoTender) || errors.Is(err, payment.ErrUnknownMethod) {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	Settlement, err := payment.Settle(TotalAmount, Tenders)
+	if err != nil {
+		tx.Rollback()
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	// pembayaran dengan poin hanya untuk pelanggan member
+	PointsPaid := 0.0
+	for _, pay := range Settlement.Payments {
+		if pay.Type == payment.TypePoints {
+			PointsPaid += pay.Applied
+		}
+	}
+
+	var PointsRedeemed int32
+	if PointsPaid > 0 {
+		if CustomerID == 0 {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": loyalty.ErrMemberRequired.Error(),
+			})
+			return
+		}
+
+		PointsRedeemed, err = p.points.PointsFor(PointsPaid)
+		if err != nil {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+	}
+
+	// store credit hanya untuk pelanggan member
+	for _, pay := range Settlement.Payments {
+		if pay.Type == payment.TypeStoreCredit && CustomerID == 0 {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": giftcard.ErrMemberRequired.Error(),
+			})
+			return
+		}
+	}
+
+	PaymentMethod := Tenders[0].Method
+	if len(Tenders) > 1 {
+		PaymentMethod = "split"
+	}
+
+	// order yang dibayar lewat payment gateway menunggu konfirmasi webhook,
+	// stok tetap dipotong sebagai reservasi sampai pembayaran kedaluwarsa
+	Status := "order"
+	GatewayPayment, UseGateway := Settlement.GatewayPayment()
+	if UseGateway {
+		Status = "pending_payment"
 	}
 
 	args := &db.CreateOrderParams{
-		TrxNumber:     TrxNumber,
-		CashierID:     sql.NullInt64{Int64: UserID, Valid: true},
-		CustomerID:    sql.NullInt64{Int64: int64(CustomerID), Valid: CustomerID != 0},
-		TotalAmount:   strconv.FormatFloat(TotalAmount, 'f', 2, 64),
-		PaymentMethod: payload.PaymentMethod,
-		Status:        "order",
+		TrxNumber:       TrxNumber,
+		CashierID:       sql.NullInt64{Int64: UserID, Valid: true},
+		CustomerID:      sql.NullInt64{Int64: int64(CustomerID), Valid: CustomerID != 0},
+		Subtotal:        strconv.FormatFloat(Subtotal, 'f', 2, 64),
+		DiscountAmount:  strconv.FormatFloat(DiscountAmount, 'f', 2, 64),
+		VoucherCode:     sql.NullString{String: Voucher.Code, Valid: Voucher.Code != ""},
+		VoucherDiscount: strconv.FormatFloat(VoucherDiscount, 'f', 2, 64),
+		TaxAmount:       strconv.FormatFloat(TaxAmount, 'f', 2, 64),
+		TotalAmount:     strconv.FormatFloat(TotalAmount, 'f', 2, 64),
+		ChangeAmount:    strconv.FormatFloat(Settlement.Change, 'f', 2, 64),
+		PaymentMethod:   PaymentMethod,
+		Status:          Status,
+		ShiftID:         sql.NullInt64{Int64: ShiftID, Valid: ShiftID != 0},
 	}
 
 	Order, err := qtx.CreateOrder(ctx, *args)
@@ -205,14 +579,33 @@ func (p *TransactionController) CreateOrder(ctx *gin.Context) {
 			return
 		}
 
+		// harga pokok penjualan dihitung saat stok keluar
+		Issue, err := issueStockCost(ctx, qtx, p.valuation, Product, item.Quantity, Order.TrxNumber)
+		if err != nil {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": "failed to calculate cost of goods sold",
+				"error":   err.Error(),
+			})
+			return
+		}
+
 		//order item
 		args := &db.CreateOrderItemParams{
-			OrderID:    sql.NullInt64{Int64: Order.ID, Valid: true},
-			ProductID:  item.ProductID,
-			OldProduct: sql.NullString{String: Product.Name, Valid: true},
-			Quantity:   item.Quantity,
-			UnitPrice:  item.UnitPrice,
-			CreatedBy:  sql.NullInt64{Int64: 1, Valid: true},
+			OrderID:        sql.NullInt64{Int64: Order.ID, Valid: true},
+			ProductID:      item.ProductID,
+			OldProduct:     sql.NullString{String: Product.Name, Valid: true},
+			Quantity:       item.Quantity,
+			UnitPrice:      item.UnitPrice,
+			DiscountAmount: item.DiscountAmount,
+			TaxName:        item.TaxName,
+			TaxRate:        item.TaxRate,
+			TaxInclusive:   item.TaxInclusive,
+			TaxAmount:      item.TaxAmount,
+			UnitCost:       strconv.FormatFloat(Issue.UnitCost, 'f', 4, 64),
+			Cogs:           strconv.FormatFloat(Issue.Total, 'f', 2, 64),
+			CreatedBy:      sql.NullInt64{Int64: 1, Valid: true},
 		}
 
 		if _, err := qtx.CreateOrderItem(ctx, *args); err != nil {
@@ -224,21 +617,23 @@ func (p *TransactionController) CreateOrder(ctx *gin.Context) {
 			return
 		}
 
-		// Update product stock
-		newStock := Product.Stock
-		if payload.Type == "in" {
-			newStock += item.Quantity
-		} else {
-			newStock -= item.Quantity
-		}
-
-		updateArgs := db.UpdateProductStockParams{
-			ID:        Product.ID,
-			Stock:     newStock,
+		// stok dipotong di dalam transaksi yang sama dengan product history
+		stockArgs := db.DecrementProductStockParams{
+			Quantity:  item.Quantity,
 			UpdatedBy: sql.NullInt64{Int64: UserID, Valid: true},
+			ID:        Product.ID,
 		}
 
-		if _, err = p.db.UpdateProductStock(ctx, updateArgs); err != nil {
+		Updated, err := qtx.DecrementProductStock(ctx, stockArgs)
+		if err != nil {
+			tx.Rollback()
+			if err == sql.ErrNoRows {
+				ctx.JSON(http.StatusBadRequest, gin.H{
+					"status":  "failed",
+					"message": "stock not enough product with id " + strconv.FormatInt(item.ProductID.Int64, 10),
+				})
+				return
+			}
 			ctx.JSON(http.StatusBadGateway, gin.H{
 				"status":  "failed",
 				"message": "failed to update product stock with id " + strconv.FormatInt(item.ProductID.Int64, 10),
@@ -247,13 +642,24 @@ func (p *TransactionController) CreateOrder(ctx *gin.Context) {
 			return
 		}
 
+		if err := trackStockLevel(ctx, qtx, Updated.Stock+item.Quantity, Updated, Order.TrxNumber, UserID); err != nil {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": "failed to record stock alert",
+				"error":   err.Error(),
+			})
+			return
+		}
+
 		//product history
 		historyArgs := &db.CreateProductHistoryParams{
 			TrxRef:         Order.TrxNumber,
 			ProductID:      sql.NullInt64{Int64: item.ProductID.Int64, Valid: true},
-			QuantityChange: item.Quantity,
-			Type:           "out",
+			QuantityChange: -item.Quantity,
+			Type:           movement.TypeSale,
 			Reason:         sql.NullString{String: "Order", Valid: true},
+			ReasonCode:     sql.NullString{String: movement.ReasonOrder, Valid: true},
 			CreatedBy:      sql.NullInt64{Int64: UserID, Valid: true},
 		}
 
@@ -268,17 +674,281 @@ func (p *TransactionController) CreateOrder(ctx *gin.Context) {
 		}
 	}
 
-	tx.Commit()
+	//applied promotion
+	AppliedPromotions := make([]schemas.AppliedPromotionData, 0)
+	for _, applied := range Promo.Applied {
+		promotionArgs := &db.CreateOrderPromotionParams{
+			OrderID:        sql.NullInt64{Int64: Order.ID, Valid: true},
+			PromotionID:    sql.NullInt64{Int64: applied.PromotionID, Valid: applied.PromotionID != 0},
+			PromotionName:  applied.Name,
+			PromotionType:  applied.Type,
+			DiscountAmount: strconv.FormatFloat(applied.Discount, 'f', 2, 64),
+		}
+
+		if _, err := qtx.CreateOrderPromotion(ctx, *promotionArgs); err != nil {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		AppliedPromotions = append(AppliedPromotions, schemas.AppliedPromotionData{
+			PromotionID:    applied.PromotionID,
+			Name:           applied.Name,
+			Type:           applied.Type,
+			DiscountAmount: applied.Discount,
+		})
+	}
+
+	//order payment
+	Payments := make([]schemas.OrderPaymentData, 0)
+	for _, tender := range Settlement.Payments {
+		paymentArgs := &db.CreateOrderPaymentParams{
+			OrderID:         sql.NullInt64{Int64: Order.ID, Valid: true},
+			PaymentMethodID: sql.NullInt64{Int64: tender.MethodID, Valid: true},
+			PaymentMethod:   tender.Method,
+			Amount:          strconv.FormatFloat(tender.Applied, 'f', 2, 64),
+			TenderedAmount:  strconv.FormatFloat(tender.Tendered, 'f', 2, 64),
+			ChangeAmount:    strconv.FormatFloat(tender.Change, 'f', 2, 64),
+			Reference:       sql.NullString{String: tender.Reference, Valid: tender.Reference != ""},
+			CreatedBy:       sql.NullInt64{Int64: UserID, Valid: true},
+		}
+
+		OrderPayment, err := qtx.CreateOrderPayment(ctx, *paymentArgs)
+		if err != nil {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		Payments = append(Payments, orderPaymentData(OrderPayment))
+
+		if err := redeemStoredValue(ctx, qtx, tender, CustomerID, Order.ID, UserID); err != nil {
+			tx.Rollback()
+			// PIN salah dicatat setelah rollback supaya gift card tetap terkunci setelah terlalu banyak percobaan
+			if errors.Is(err, giftcard.ErrInvalidCard) && tender.Type == payment.TypeGiftCard {
+				if err := recordGiftCardPINFailure(ctx, p.db, tender.Reference); err != nil {
+					ctx.JSON(http.StatusBadGateway, gin.H{
+						"status":  "failed",
+						"message": err.Error(),
+					})
+					return
+				}
+			}
+			if errors.Is(err, giftcard.ErrInvalidCard) || errors.Is(err, giftcard.ErrCardLocked) || errors.Is(err, giftcard.ErrInactive) || errors.Is(err, giftcard.ErrExpired) ||
+				errors.Is(err, giftcard.ErrInsufficientBalance) || errors.Is(err, giftcard.ErrMemberRequired) || errors.Is(err, giftcard.ErrNoStoreCredit) {
+				ctx.JSON(http.StatusBadRequest, gin.H{
+					"status":  "failed",
+					"message": err.Error(),
+				})
+				return
+			}
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+	}
+
+	//gift card, diaktifkan setelah order lunas
+	GiftCards := make([]schemas.IssuedGiftCardData, 0)
+	GiftCardStatus := giftcard.StatusActive
+	if Status == "pending_payment" {
+		GiftCardStatus = giftcard.StatusPending
+	}
+	for i, line := range Lines {
+		if !GiftCardLines[i] {
+			continue
+		}
+
+		// satu kartu per unit, nilai kartu adalah harga setelah diskon
+		Amount := (line.UnitPrice*float64(line.Quantity) - LineDiscounts[i]) / float64(line.Quantity)
+		for n := int32(0); n < line.Quantity; n++ {
+			card, Pin, err := issueGiftCard(ctx, qtx, Amount, CustomerID, Order.ID, GiftCardStatus, p.giftCards, UserID)
+			if err != nil {
+				tx.Rollback()
+				ctx.JSON(http.StatusBadGateway, gin.H{
+					"status":  "failed",
+					"message": err.Error(),
+				})
+				return
+			}
+			GiftCards = append(GiftCards, issuedGiftCardData(card, Pin))
+		}
+	}
+
+	//loyalty points
+	var PointsEarned int32
+	if CustomerID != 0 {
+		if PointsRedeemed > 0 {
+			if err := redeemPoints(ctx, qtx, CustomerID, Order.ID, PointsRedeemed, UserID); err != nil {
+				tx.Rollback()
+				if errors.Is(err, loyalty.ErrInsufficientPoints) {
+					ctx.JSON(http.StatusBadRequest, gin.H{
+						"status":  "failed",
+						"message": err.Error(),
+					})
+					return
+				}
+				ctx.JSON(http.StatusBadGateway, gin.H{
+					"status":  "failed",
+					"message": err.Error(),
+				})
+				return
+			}
+		}
+
+		Amounts := make([]float64, len(Lines))
+		CategoryIDs := make([]int64, len(Lines))
+		for i, line := range Lines {
+			Amounts[i] = line.UnitPrice*float64(line.Quantity) - LineDiscounts[i]
+			CategoryIDs[i] = line.CategoryID
+		}
+
+		PointLines, err := loadPointLines(ctx, qtx, Amounts, CategoryIDs)
+		if err != nil {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		PointsEarned = p.points.Earn(PointLines, TotalAmount, PointsPaid)
+		if err := awardPoints(ctx, qtx, p.points, CustomerID, Order.ID, PointsEarned, UserID); err != nil {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+	}
+
+	//voucher redemption
+	if Voucher.ID != 0 {
+		redemptionArgs := &db.CreateVoucherRedemptionParams{
+			VoucherID:      sql.NullInt64{Int64: Voucher.ID, Valid: true},
+			OrderID:        sql.NullInt64{Int64: Order.ID, Valid: true},
+			CustomerID:     sql.NullInt64{Int64: CustomerID, Valid: CustomerID != 0},
+			DiscountAmount: strconv.FormatFloat(VoucherDiscount, 'f', 2, 64),
+		}
+
+		if _, err := qtx.CreateVoucherRedemption(ctx, *redemptionArgs); err != nil {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+	}
+
+	//parked order
+	if ParkedOrderID != 0 {
+		parkedArgs := &db.UpdateParkedOrderStatusParams{
+			ID:        ParkedOrderID,
+			Status:    "converted",
+			OrderID:   sql.NullInt64{Int64: Order.ID, Valid: true},
+			UpdatedBy: sql.NullInt64{Int64: UserID, Valid: true},
+		}
+
+		if _, err := qtx.UpdateParkedOrderStatus(ctx, *parkedArgs); err != nil {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+	}
+
+	//digital receipt, dikirim dispatcher di background supaya tidak memperlambat order
+	if CustomerID != 0 {
+		Customer, err := qtx.GetCustomerByID(ctx, CustomerID)
+		if err != nil && err != sql.ErrNoRows {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		if _, err := queueReceipt(ctx, qtx, Order.ID, customerContacts(Customer, p.notifiers), UserID); err != nil {
+			tx.Rollback()
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": "failed to create order",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	//payment gateway charge, dibuat setelah order tersimpan supaya charge tidak
+	//pernah aktif untuk order yang gagal di-commit
+	var PaymentCharge *schemas.PaymentChargeData
+	if UseGateway {
+		chargeReq := payment.ChargeRequest{
+			Reference: Order.TrxNumber,
+			Method:    GatewayPayment.Method,
+			Amount:    GatewayPayment.Applied,
+		}
+
+		PaymentChargeRow, err := p.createPaymentCharge(ctx, Order, GatewayPayment.Method, chargeReq)
+		if err != nil {
+			// order dibatalkan supaya stok yang direservasi kembali
+			if expireErr := expireUnchargedOrder(ctx, p.db, p.sqlDB, Order.ID); expireErr != nil {
+				err = fmt.Errorf("%w; failed to expire order: %v", err, expireErr)
+			}
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": "failed to create payment charge",
+				"error":   err.Error(),
+			})
+			return
+		}
+		chargeData := paymentChargeData(PaymentChargeRow)
+		PaymentCharge = &chargeData
+	}
 
 	data := schemas.OrderData{
-		ID:            Order.ID,
-		TrxNumber:     Order.TrxNumber,
-		CashierID:     common.ConvertNullInt64(Order.CashierID),
-		CustomerID:    common.ConvertNullInt64(Order.CustomerID),
-		TotalAmount:   Order.TotalAmount,
-		PaymentMethod: Order.PaymentMethod,
-		Status:        Order.Status,
-		OrderDate:     common.ConvertNullTime(Order.OrderDate),
+		ID:                Order.ID,
+		TrxNumber:         Order.TrxNumber,
+		CashierID:         common.ConvertNullInt64(Order.CashierID),
+		ShiftID:           common.ConvertNullInt64(Order.ShiftID),
+		CustomerID:        common.ConvertNullInt64(Order.CustomerID),
+		Subtotal:          Order.Subtotal,
+		DiscountAmount:    Order.DiscountAmount,
+		VoucherCode:       common.ConvertNullString(Order.VoucherCode),
+		VoucherDiscount:   Order.VoucherDiscount,
+		TaxAmount:         Order.TaxAmount,
+		TotalAmount:       Order.TotalAmount,
+		ChangeAmount:      Order.ChangeAmount,
+		PaymentMethod:     Order.PaymentMethod,
+		Status:            Order.Status,
+		OrderDate:         common.ConvertNullTime(Order.OrderDate),
+		AppliedPromotions: AppliedPromotions,
+		Payments:          Payments,
+		PaymentCharge:     PaymentCharge,
+		PointsEarned:      PointsEarned,
+		PointsRedeemed:    PointsRedeemed,
+		GiftCards:         GiftCards,
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{
@@ -288,6 +958,28 @@ func (p *TransactionController) CreateOrder(ctx *gin.Context) {
 	})
 }
 
+// createPaymentCharge membuat charge di payment gateway untuk order yang sudah
+// tersimpan lalu mencatatnya di payment_charges
+func (p *TransactionController) createPaymentCharge(ctx context.Context, order db.Order, method string, req payment.ChargeRequest) (db.PaymentCharge, error) {
+	Charge, err := p.gateway.CreateCharge(ctx, req)
+	if err != nil {
+		return db.PaymentCharge{}, err
+	}
+
+	chargeArgs := &db.CreatePaymentChargeParams{
+		OrderID:       sql.NullInt64{Int64: order.ID, Valid: true},
+		Provider:      p.gateway.Name(),
+		ChargeID:      Charge.ID,
+		PaymentMethod: method,
+		Amount:        strconv.FormatFloat(Charge.Amount, 'f', 2, 64),
+		Status:        Charge.Status,
+		PaymentUrl:    sql.NullString{String: Charge.PaymentURL, Valid: Charge.PaymentURL != ""},
+		QrString:      sql.NullString{String: Charge.QRString, Valid: Charge.QRString != ""},
+		ExpiresAt:     sql.NullTime{Time: Charge.ExpiresAt, Valid: !Charge.ExpiresAt.IsZero()},
+	}
+	return p.db.CreatePaymentCharge(ctx, *chargeArgs)
+}
+
 // CreateRefund godoc
 // @Security BearerAuth
 // @Summary Create product stock history
@@ -334,8 +1026,8 @@ func (p *TransactionController) CreateRefund(ctx *gin.Context) {
 	defer tx.Rollback()
 	qtx := p.db.WithTx(tx)
 
-	// Get Order by TrxNumber
-	order, err := qtx.GetOrderByTrxNumber(ctx, payload.TrxNumber)
+	// Get Order by TrxNumber, locked so a concurrent refund of the same order waits and sees it refunded
+	order, err := qtx.GetOrderByTrxNumberForUpdate(ctx, payload.TrxNumber)
 	Order := order
 
 	if err != nil {
@@ -362,6 +1054,41 @@ func (p *TransactionController) CreateRefund(ctx *gin.Context) {
 		return
 	}
 
+	// Order that has not been paid can not be refunded
+	if order.Status == "pending_payment" || order.Status == "expired" {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "order has not been paid",
+		})
+		return
+	}
+
+	// Store credit can only be issued to a member customer
+	if payload.RefundTo == giftcard.TypeStoreCredit && !order.CustomerID.Valid {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": giftcard.ErrMemberRequired.Error(),
+		})
+		return
+	}
+
+	// Void gift cards sold by the order, used gift cards can not be refunded
+	if err := voidOrderGiftCards(ctx, qtx, order.ID, UserID); err != nil {
+		if errors.Is(err, giftcard.ErrCardUsed) {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": "failed to void gift cards",
+			"error":   err.Error(),
+		})
+		return
+	}
+
 	// Get Order Items
 	orderItems, err := qtx.GetOrderItemsByOrderID(ctx, sql.NullInt64{Int64: order.ID, Valid: true})
 	if err != nil {
@@ -374,8 +1101,8 @@ func (p *TransactionController) CreateRefund(ctx *gin.Context) {
 
 	// Process each item for refund
 	for _, item := range orderItems {
-		// Get current product
-		product, err := qtx.GetProductByID(ctx, item.ProductID.Int64)
+		// Get current product, locked because its cost is averaged with the returned stock
+		product, err := qtx.GetProductByIDForUpdate(ctx, item.ProductID.Int64)
 		if err != nil {
 			if err == sql.ErrNoRows {
 				ctx.JSON(http.StatusNotFound, gin.H{
@@ -392,14 +1119,14 @@ func (p *TransactionController) CreateRefund(ctx *gin.Context) {
 		}
 
 		// Update product stock (add back the quantity)
-		newStock := product.Stock + item.Quantity
-		updateArgs := db.UpdateProductStockParams{
-			ID:        product.ID,
-			Stock:     newStock,
+		stockArgs := db.IncrementProductStockParams{
+			Quantity:  item.Quantity,
 			UpdatedBy: sql.NullInt64{Int64: UserID, Valid: true},
+			ID:        product.ID,
 		}
 
-		if _, err = qtx.UpdateProductStock(ctx, updateArgs); err != nil {
+		Updated, err := qtx.IncrementProductStock(ctx, stockArgs)
+		if err != nil {
 			ctx.JSON(http.StatusBadGateway, gin.H{
 				"status":  "failed",
 				"message": "failed to update product stock",
@@ -408,13 +1135,34 @@ func (p *TransactionController) CreateRefund(ctx *gin.Context) {
 			return
 		}
 
+		if err := trackStockLevel(ctx, qtx, product.Stock, Updated, order.TrxNumber+"-REFUND", UserID); err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": "failed to record stock alert",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		// Barang retur kembali ke persediaan dengan harga pokok saat dijual
+		UnitCost, _ := strconv.ParseFloat(item.UnitCost, 64)
+		if err := receiveStockCost(ctx, qtx, product, item.Quantity, UnitCost, costing.SourceReturn, order.TrxNumber+"-REFUND"); err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": "failed to update inventory cost",
+				"error":   err.Error(),
+			})
+			return
+		}
+
 		// Create product history for refund
 		historyArgs := &db.CreateProductHistoryParams{
 			TrxRef:         order.TrxNumber + "-REFUND",
 			ProductID:      sql.NullInt64{Int64: item.ProductID.Int64, Valid: true},
 			QuantityChange: item.Quantity,
-			Type:           "in",
+			Type:           movement.TypeRefund,
 			Reason:         sql.NullString{String: "Refund", Valid: true},
+			ReasonCode:     sql.NullString{String: movement.ReasonCustomerReturn, Valid: true},
 			CreatedBy:      sql.NullInt64{Int64: UserID, Valid: true},
 		}
 
@@ -428,11 +1176,114 @@ func (p *TransactionController) CreateRefund(ctx *gin.Context) {
 		}
 	}
 
+	// Reverse voucher usage
+	if err := reverseOrderVoucher(ctx, qtx, order.ID); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": "failed to reverse voucher usage",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Reverse loyalty points
+	if err := reverseOrderPoints(ctx, qtx, order.ID, UserID); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": "failed to reverse loyalty points",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Return gift card and store credit payments
+	if err := reverseOrderStoredValue(ctx, qtx, order.ID, UserID); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": "failed to reverse gift card payments",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Refund payment gateway charge. The refund is recorded here and sent to the
+	// gateway after commit so a failed commit never leaves money refunded
+	charge, err := qtx.GetPaymentChargeByOrderID(ctx, sql.NullInt64{Int64: order.ID, Valid: true})
+	if err != nil && err != sql.ErrNoRows {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	var PaymentRefund *db.PaymentRefund
+	if err == nil && charge.Status == payment.StatusPaid {
+		paymentRefundArgs := &db.CreatePaymentRefundParams{
+			PaymentChargeID: charge.ID,
+			OrderID:         sql.NullInt64{Int64: order.ID, Valid: true},
+			Amount:          charge.Amount,
+			CreatedBy:       sql.NullInt64{Int64: UserID, Valid: true},
+		}
+		// tidak ada baris berarti charge ini sudah pernah direfund, gateway tidak dipanggil lagi
+		Refund, err := qtx.CreatePaymentRefund(ctx, *paymentRefundArgs)
+		if err != nil && err != sql.ErrNoRows {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": "failed to record payment refund",
+				"error":   err.Error(),
+			})
+			return
+		}
+		if err == nil {
+			PaymentRefund = &Refund
+		}
+	}
+
+	// Cash and other non gateway payments are returned as store credit instead of cash
+	RefundMethod := "original"
+	var StoreCredit float64
+	if payload.RefundTo == giftcard.TypeStoreCredit {
+		Amount, err := qtx.GetOrderStoreCreditRefundAmount(ctx, sql.NullInt64{Int64: order.ID, Valid: true})
+		if err != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{
+				"status":  "failed",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		StoreCredit, _ = strconv.ParseFloat(Amount, 64)
+		if StoreCredit > 0 {
+			if _, err := issueStoreCredit(ctx, qtx, order.CustomerID.Int64, order.ID, StoreCredit, giftcard.TxIssue, "Refund "+order.TrxNumber, UserID); err != nil {
+				ctx.JSON(http.StatusBadGateway, gin.H{
+					"status":  "failed",
+					"message": "failed to issue store credit",
+					"error":   err.Error(),
+				})
+				return
+			}
+		}
+		RefundMethod = giftcard.TypeStoreCredit
+	}
+
+	// Refund is recorded on the open shift of the user so the cash drawer can be reconciled
+	ShiftID, err := openShiftID(ctx, qtx, UserID)
+	if err != nil && !errors.Is(err, shift.ErrNoOpenShift) {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
 	//create refund
 	refundArgs := &db.CreateRefundParams{
-		OrderID:   sql.NullInt64{Int64: order.ID, Valid: true},
-		Reason:    payload.Reason,
-		CreatedBy: sql.NullInt64{Int64: UserID, Valid: true},
+		OrderID:      sql.NullInt64{Int64: order.ID, Valid: true},
+		Reason:       payload.Reason,
+		CreatedBy:    sql.NullInt64{Int64: UserID, Valid: true},
+		ShiftID:      sql.NullInt64{Int64: ShiftID, Valid: ShiftID != 0},
+		RefundMethod: RefundMethod,
 	}
 
 	_, err = qtx.CreateRefund(ctx, *refundArgs)
@@ -462,22 +1313,46 @@ func (p *TransactionController) CreateRefund(ctx *gin.Context) {
 		return
 	}
 
-	tx.Commit()
+	if err := tx.Commit(); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": "failed to refund order",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// A rejected gateway refund is kept pending and retried in the background
+	Message := "order refunded successfully"
+	if PaymentRefund != nil {
+		sent, err := sendPaymentRefund(ctx, p.db, p.sqlDB, p.gateway, *PaymentRefund)
+		if err != nil || !sent {
+			Message = "order refunded, payment gateway refund is pending and will be retried"
+		}
+	}
 
 	data := schemas.OrderData{
-		ID:            Order.ID,
-		TrxNumber:     Order.TrxNumber,
-		CashierID:     common.ConvertNullInt64(Order.CashierID),
-		CustomerID:    common.ConvertNullInt64(Order.CustomerID),
-		TotalAmount:   Order.TotalAmount,
-		PaymentMethod: Order.PaymentMethod,
-		Status:        Order.Status,
-		OrderDate:     common.ConvertNullTime(Order.OrderDate),
+		ID:              Order.ID,
+		TrxNumber:       Order.TrxNumber,
+		CashierID:       common.ConvertNullInt64(Order.CashierID),
+		ShiftID:         common.ConvertNullInt64(Order.ShiftID),
+		CustomerID:      common.ConvertNullInt64(Order.CustomerID),
+		Subtotal:        Order.Subtotal,
+		DiscountAmount:  Order.DiscountAmount,
+		VoucherCode:     common.ConvertNullString(Order.VoucherCode),
+		VoucherDiscount: Order.VoucherDiscount,
+		TaxAmount:       Order.TaxAmount,
+		TotalAmount:     Order.TotalAmount,
+		ChangeAmount:    Order.ChangeAmount,
+		PaymentMethod:   Order.PaymentMethod,
+		Status:          Order.Status,
+		OrderDate:       common.ConvertNullTime(Order.OrderDate),
+		StoreCredit:     StoreCredit,
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{
 		"status":  "success",
-		"message": "order refunded successfully",
+		"message": Message,
 		"data":    data,
 	})
 }
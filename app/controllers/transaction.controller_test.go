@@ -0,0 +1,124 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	db "pos-api/db/sqlc"
+	"pos-api/util/costing"
+	"pos-api/util/loyalty"
+	"pos-api/util/notifier"
+	"pos-api/util/payment"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCreateRefundConcurrent(t *testing.T) {
+	q, sqlDB := testDB(t)
+	ctx := context.Background()
+	c := NewTransactionController(q, sqlDB, payment.NewFakeProvider("secret", time.Minute), notifier.NewRegistry(), loyalty.Rules{}, 0, costing.MethodAverage, ctx)
+
+	router := gin.New()
+	router.POST("/transaction/refund", c.CreateRefund)
+
+	user := createTestUser(t, q)
+	product := createTestProduct(t, q, 10)
+	order := createTestOrder(t, q, product, 4, "order")
+	body, _ := json.Marshal(map[string]string{"trx_number": order.TrxNumber, "reason": "double click"})
+
+	// dua refund bersamaan untuk order yang sama, hanya satu yang boleh berhasil
+	codes := make([]int, 2)
+	var wg sync.WaitGroup
+	for i := range codes {
+		req := httptest.NewRequest(http.MethodPost, "/transaction/refund", bytes.NewReader(body))
+		authorize(t, req, user)
+
+		wg.Add(1)
+		go func(i int, req *http.Request) {
+			defer wg.Done()
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			codes[i] = rec.Code
+		}(i, req)
+	}
+	wg.Wait()
+
+	ok := 0
+	for _, code := range codes {
+		if code == http.StatusOK {
+			ok++
+		} else if code != http.StatusBadRequest {
+			t.Errorf("refund status = %d, want 200 or 400", code)
+		}
+	}
+	if ok != 1 {
+		t.Errorf("successful refunds = %d, want 1", ok)
+	}
+
+	product, err := q.GetProductByID(ctx, product.ID)
+	if err != nil || product.Stock != 10 {
+		t.Errorf("stock = %d, %v, want 10", product.Stock, err)
+	}
+}
+
+func TestCreateOrderShift(t *testing.T) {
+	q, sqlDB := testDB(t)
+	ctx := context.Background()
+	c := NewTransactionController(q, sqlDB, payment.NewFakeProvider("secret", time.Minute), notifier.NewRegistry(), loyalty.Rules{}, 0, costing.MethodAverage, ctx)
+
+	router := gin.New()
+	router.POST("/transaction/order", c.CreateOrder)
+
+	user := createTestUser(t, q)
+	product := createTestProduct(t, q, 10)
+	body, _ := json.Marshal(map[string]interface{}{
+		"type":           "guest",
+		"payment_method": "cash",
+		"items":          []map[string]interface{}{{"product_id": product.ID, "quantity": 1}},
+	})
+
+	placeOrder := func() int64 {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/transaction/order", bytes.NewReader(body))
+		authorize(t, req, user)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("create order status = %d, body %s", rec.Code, rec.Body.String())
+		}
+
+		var response struct {
+			Data struct {
+				ShiftID int64 `json:"shift_id"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		return response.Data.ShiftID
+	}
+
+	// tanpa shift terbuka order tetap dibuat tanpa shift
+	if shiftID := placeOrder(); shiftID != 0 {
+		t.Errorf("shift_id = %d, want none without an open shift", shiftID)
+	}
+
+	opened, err := q.CreateShift(ctx, db.CreateShiftParams{
+		ShiftNumber:  uniqueRef("SHIFT"),
+		CashierID:    sql.NullInt64{Int64: user.ID, Valid: true},
+		OpeningFloat: "100000",
+	})
+	if err != nil {
+		t.Fatalf("CreateShift() error = %v", err)
+	}
+	if shiftID := placeOrder(); shiftID != opened.ID {
+		t.Errorf("shift_id = %d, want open shift %d", shiftID, opened.ID)
+	}
+}
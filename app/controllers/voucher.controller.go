@@ -0,0 +1,527 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pos-api/app/schemas"
+	db "pos-api/db/sqlc"
+	"pos-api/util/common"
+	"pos-api/util/jwt"
+	"pos-api/util/voucher"
+
+	"github.com/gin-gonic/gin"
+)
+
+type VoucherController struct {
+	db  *db.Queries
+	ctx context.Context
+}
+
+func NewVoucherController(db *db.Queries, ctx context.Context) *VoucherController {
+	return &VoucherController{db, ctx}
+}
+
+// CreateVoucher godoc
+// @Security BearerAuth
+// @Summary Create a new voucher
+// @Description Create a new voucher code with value, usage limits, validity window and minimum spend
+// @Tags vouchers
+// @Accept json
+// @Produce json
+// @Param payload body schemas.CreateVoucher true "Voucher Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/vouchers [post]
+func (c *VoucherController) CreateVoucher(ctx *gin.Context) {
+	var payload schemas.CreateVoucher
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if err := voucher.Validate(voucherFromPayload(payload)); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	args := &db.CreateVoucherParams{
+		Code:             voucher.NormalizeCode(payload.Code),
+		Description:      sql.NullString{String: payload.Description, Valid: payload.Description != ""},
+		ValueType:        payload.ValueType,
+		Value:            strconv.FormatFloat(payload.Value, 'f', 2, 64),
+		MaxDiscount:      strconv.FormatFloat(payload.MaxDiscount, 'f', 2, 64),
+		MinSpend:         strconv.FormatFloat(payload.MinSpend, 'f', 2, 64),
+		UsageLimit:       payload.UsageLimit,
+		UsagePerCustomer: payload.UsagePerCustomer,
+		StartAt:          sql.NullTime{Time: payload.StartAt, Valid: !payload.StartAt.IsZero()},
+		EndAt:            sql.NullTime{Time: payload.EndAt, Valid: !payload.EndAt.IsZero()},
+		CreatedBy:        sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	Voucher, err := c.db.CreateVoucher(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "created successfully",
+		"data":    voucherData(Voucher),
+	})
+}
+
+// UpdateVoucher godoc
+// @Security BearerAuth
+// @Summary Update an existing voucher
+// @Description Update a voucher with the given ID and payload
+// @Tags vouchers
+// @Accept json
+// @Produce json
+// @Param id path int true "Voucher ID"
+// @Param payload body schemas.UpdateVoucher true "Voucher Update Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/vouchers/{id} [put]
+func (c *VoucherController) UpdateVoucher(ctx *gin.Context) {
+	var payload schemas.UpdateVoucher
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid voucher id",
+		})
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if err := voucher.Validate(voucherFromPayload(payload.CreateVoucher)); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	if _, err := c.db.GetVoucherByID(ctx, id); err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve voucher with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	args := &db.UpdateVoucherParams{
+		ID:               id,
+		Code:             voucher.NormalizeCode(payload.Code),
+		Description:      sql.NullString{String: payload.Description, Valid: payload.Description != ""},
+		ValueType:        payload.ValueType,
+		Value:            strconv.FormatFloat(payload.Value, 'f', 2, 64),
+		MaxDiscount:      strconv.FormatFloat(payload.MaxDiscount, 'f', 2, 64),
+		MinSpend:         strconv.FormatFloat(payload.MinSpend, 'f', 2, 64),
+		UsageLimit:       payload.UsageLimit,
+		UsagePerCustomer: payload.UsagePerCustomer,
+		IsActive:         payload.IsActive,
+		StartAt:          sql.NullTime{Time: payload.StartAt, Valid: !payload.StartAt.IsZero()},
+		EndAt:            sql.NullTime{Time: payload.EndAt, Valid: !payload.EndAt.IsZero()},
+		UpdatedBy:        sql.NullInt64{Int64: UserID, Valid: true},
+	}
+
+	Voucher, err := c.db.UpdateVoucher(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "updated successfully",
+		"data":    voucherData(Voucher),
+	})
+}
+
+// GetVoucherById godoc
+// @Security BearerAuth
+// @Summary Get a voucher by ID
+// @Description Retrieve a voucher by ID
+// @Tags vouchers
+// @Produce json
+// @Param id path int true "Voucher ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/vouchers/{id} [get]
+func (c *VoucherController) GetVoucherById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid voucher id",
+		})
+		return
+	}
+
+	Voucher, err := c.db.GetVoucherByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve voucher with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "voucher retrieved successfully",
+		"data":    voucherData(Voucher),
+	})
+}
+
+// GetAllVouchers godoc
+// @Security BearerAuth
+// @Summary Get all vouchers
+// @Description Retrieve all vouchers with pagination
+// @Tags vouchers
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Limit per page" default(10)
+// @Success 200 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/vouchers [get]
+func (c *VoucherController) GetAllVouchers(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	offset := (page - 1) * limit
+
+	args := &db.GetAllVouchersParams{
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	}
+
+	vouchers, err := c.db.GetAllVouchers(ctx, *args)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	data := make([]schemas.VoucherData, len(vouchers))
+	for i, v := range vouchers {
+		data[i] = voucherData(v)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "retrieved successfully",
+		"data":    data,
+	})
+}
+
+// SoftDeleteVoucherById godoc
+// @Security BearerAuth
+// @Summary Soft delete a voucher by ID
+// @Description Soft delete a voucher with the given ID
+// @Tags vouchers
+// @Produce json
+// @Param id path int true "Voucher ID"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/vouchers/{id}/soft [delete]
+func (c *VoucherController) SoftDeleteVoucherById(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid voucher id",
+		})
+		return
+	}
+
+	if _, err := c.db.GetVoucherByID(ctx, id); err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"status":  "failed",
+				"message": "failed to retrieve voucher with this id",
+			})
+			return
+		}
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	userInfo, err := jwt.GetUserInfo(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	UserID := userInfo.UserID
+
+	args := &db.SoftDeleteVoucherByIDParams{
+		ID:        id,
+		DeletedBy: sql.NullInt64{Int64: UserID, Valid: true},
+	}
+	if _, err = c.db.SoftDeleteVoucherByID(ctx, *args); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{
+			"status":  "failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "soft deleted successfully",
+	})
+}
+
+// ValidateVoucher godoc
+// @Security BearerAuth
+// @Summary Validate a voucher code
+// @Description Preview the discount of a voucher code for the given amount and customer without redeeming it
+// @Tags vouchers
+// @Accept json
+// @Produce json
+// @Param payload body schemas.ValidateVoucher true "Voucher Validation Data"
+// @Success 200 {object} schemas.Response
+// @Failure 400 {object} schemas.Response
+// @Failure 404 {object} schemas.Response
+// @Failure 502 {object} schemas.Response
+// @Router /api/v1/vouchers/validate [post]
+func (c *VoucherController) ValidateVoucher(ctx *gin.Context) {
+	var payload schemas.ValidateVoucher
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"status":  "failed",
+			"message": "invalid request data",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	Voucher, Discount, err := checkVoucher(ctx, c.db, payload.Code, payload.CustomerID, payload.Amount, false)
+	if err != nil {
+		ctx.JSON(voucherErrorStatus(err), gin.H{
+			"status":  "failed",
+			"message": voucherErrorMessage(err),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "voucher is valid",
+		"data": schemas.VoucherPreview{
+			Code:                Voucher.Code,
+			Amount:              payload.Amount,
+			DiscountAmount:      Discount,
+			AmountAfterDiscount: payload.Amount - Discount,
+		},
+	})
+}
+
+// reverseOrderVoucher membatalkan redemption voucher milik order dan mengembalikan kuota pemakaiannya
+func reverseOrderVoucher(ctx context.Context, q *db.Queries, orderID int64) error {
+	redemption, err := q.GetVoucherRedemptionByOrderID(ctx, sql.NullInt64{Int64: orderID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+
+	if _, err := q.ReverseVoucherRedemption(ctx, redemption.ID); err != nil {
+		return err
+	}
+	return q.DecrementVoucherUsage(ctx, redemption.VoucherID.Int64)
+}
+
+// checkVoucher mencari voucher berdasarkan kode lalu menghitung potongannya untuk customer dan nominal belanja.
+// Jika lock, baris voucher dikunci dulu agar pemakaian per customer dihitung tanpa balapan dengan order lain.
+func checkVoucher(ctx context.Context, q *db.Queries, code string, customerID int64, amount float64, lock bool) (db.Voucher, float64, error) {
+	var Voucher db.Voucher
+	var err error
+	if lock {
+		Voucher, err = q.GetVoucherByCodeForUpdate(ctx, voucher.NormalizeCode(code))
+	} else {
+		Voucher, err = q.GetVoucherByCode(ctx, voucher.NormalizeCode(code))
+	}
+	if err != nil {
+		return Voucher, 0, err
+	}
+
+	var CustomerUsage int64
+	if customerID != 0 && Voucher.UsagePerCustomer > 0 {
+		args := &db.CountCustomerVoucherRedemptionsParams{
+			VoucherID:  sql.NullInt64{Int64: Voucher.ID, Valid: true},
+			CustomerID: sql.NullInt64{Int64: customerID, Valid: true},
+		}
+		CustomerUsage, err = q.CountCustomerVoucherRedemptions(ctx, *args)
+		if err != nil {
+			return Voucher, 0, err
+		}
+	}
+
+	usage := voucher.Usage{
+		CustomerID:    customerID,
+		CustomerUsage: CustomerUsage,
+		Amount:        amount,
+	}
+	Discount, err := voucher.Check(voucherFromRow(Voucher), usage, time.Now())
+	return Voucher, Discount, err
+}
+
+func voucherErrorStatus(err error) int {
+	switch {
+	case err == sql.ErrNoRows:
+		return http.StatusNotFound
+	case errors.Is(err, voucher.ErrInactive),
+		errors.Is(err, voucher.ErrNotStarted),
+		errors.Is(err, voucher.ErrExpired),
+		errors.Is(err, voucher.ErrUsageLimit),
+		errors.Is(err, voucher.ErrCustomerLimit),
+		errors.Is(err, voucher.ErrCustomerRequired),
+		errors.Is(err, voucher.ErrMinSpend):
+		return http.StatusBadRequest
+	}
+	return http.StatusBadGateway
+}
+
+func voucherErrorMessage(err error) string {
+	if err == sql.ErrNoRows {
+		return "voucher not found"
+	}
+	return err.Error()
+}
+
+func voucherFromPayload(payload schemas.CreateVoucher) voucher.Voucher {
+	return voucher.Voucher{
+		Code:             payload.Code,
+		ValueType:        payload.ValueType,
+		Value:            payload.Value,
+		MaxDiscount:      payload.MaxDiscount,
+		MinSpend:         payload.MinSpend,
+		UsageLimit:       payload.UsageLimit,
+		UsagePerCustomer: payload.UsagePerCustomer,
+		StartAt:          payload.StartAt,
+		EndAt:            payload.EndAt,
+	}
+}
+
+func voucherFromRow(v db.Voucher) voucher.Voucher {
+	Value, _ := strconv.ParseFloat(v.Value, 64)
+	MaxDiscount, _ := strconv.ParseFloat(v.MaxDiscount, 64)
+	MinSpend, _ := strconv.ParseFloat(v.MinSpend, 64)
+
+	return voucher.Voucher{
+		Code:             v.Code,
+		ValueType:        v.ValueType,
+		Value:            Value,
+		MaxDiscount:      MaxDiscount,
+		MinSpend:         MinSpend,
+		UsageLimit:       v.UsageLimit,
+		UsagePerCustomer: v.UsagePerCustomer,
+		UsedCount:        v.UsedCount,
+		IsActive:         v.IsActive,
+		StartAt:          common.ConvertNullTime(v.StartAt),
+		EndAt:            common.ConvertNullTime(v.EndAt),
+	}
+}
+
+func voucherData(v db.Voucher) schemas.VoucherData {
+	row := voucherFromRow(v)
+	return schemas.VoucherData{
+		ID:               v.ID,
+		Code:             v.Code,
+		Description:      common.ConvertNullString(v.Description),
+		ValueType:        v.ValueType,
+		Value:            row.Value,
+		MaxDiscount:      row.MaxDiscount,
+		MinSpend:         row.MinSpend,
+		UsageLimit:       v.UsageLimit,
+		UsagePerCustomer: v.UsagePerCustomer,
+		UsedCount:        v.UsedCount,
+		IsActive:         v.IsActive,
+		StartAt:          row.StartAt,
+		EndAt:            row.EndAt,
+		CreatedBy:        common.ConvertNullInt64(v.CreatedBy),
+		CreatedAt:        common.ConvertNullTime(v.CreatedAt),
+		UpdatedBy:        common.ConvertNullInt64(v.UpdatedBy),
+		UpdatedAt:        common.ConvertNullTime(v.UpdatedAt),
+	}
+}
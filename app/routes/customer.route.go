@@ -14,8 +14,11 @@ func SetupCustomerRoutes(db *db.Queries, ctx context.Context, rg *gin.RouterGrou
 	router.POST("/", customerController.CreateCustomer)
 	router.GET("/", customerController.GetAllCustomers)
 	router.GET("/deleted", customerController.GetAllDeletedCustomers)
+	router.GET("/search", customerController.SearchCustomers)
 	router.PUT("/:id", customerController.UpdateCustomer)
 	router.GET("/:id", customerController.GetCustomerById)
+	router.GET("/:id/summary", customerController.GetCustomerSummary)
+	router.GET("/:id/orders", customerController.GetCustomerOrders)
 	router.DELETE("/:id", customerController.DeleteCustomerById)
 	router.DELETE("/:id/soft", customerController.SoftDeleteCustomerById)
 }
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupCustomerMergeRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, rg *gin.RouterGroup) {
+	customerMergeController := *controllers.NewCustomerMergeController(db, sqlDB, ctx)
+	router := rg.Group("customers")
+	router.GET("/duplicates", customerMergeController.GetDuplicateCustomers)
+	router.POST("/:id/merge", customerMergeController.MergeCustomer)
+	router.GET("/:id/merges", customerMergeController.GetCustomerMerges)
+}
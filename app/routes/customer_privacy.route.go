@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupCustomerPrivacyRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, rg *gin.RouterGroup) {
+	customerPrivacyController := *controllers.NewCustomerPrivacyController(db, sqlDB, ctx)
+	router := rg.Group("customers")
+	router.GET("/:id/consent", customerPrivacyController.GetCustomerConsent)
+	router.PUT("/:id/consent", customerPrivacyController.UpdateCustomerConsent)
+	router.GET("/:id/export", customerPrivacyController.ExportCustomerData)
+	router.POST("/:id/anonymize", customerPrivacyController.AnonymizeCustomer)
+}
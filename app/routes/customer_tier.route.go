@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupCustomerTierRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, windowDays int, rg *gin.RouterGroup) {
+	customerTierController := *controllers.NewCustomerTierController(db, sqlDB, windowDays, ctx)
+
+	router := rg.Group("customer-tiers")
+	router.GET("/", customerTierController.GetAllCustomerTiers)
+	router.POST("/recalculate", customerTierController.RecalculateTiers)
+	router.PUT("/:id", customerTierController.UpdateCustomerTier)
+	router.GET("/:id/prices", customerTierController.GetCustomerTierPrices)
+	router.PUT("/:id/prices", customerTierController.SetCustomerTierPrice)
+	router.DELETE("/:id/prices/:product_id", customerTierController.DeleteCustomerTierPrice)
+
+	customerRouter := rg.Group("customers")
+	customerRouter.GET("/:id/tier", customerTierController.GetCustomerTier)
+}
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupGiftCardRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, rg *gin.RouterGroup) {
+	giftCardController := *controllers.NewGiftCardController(db, sqlDB, ctx)
+
+	router := rg.Group("gift-cards")
+	router.GET("/", giftCardController.GetAllGiftCards)
+	router.POST("/balance", giftCardController.CheckGiftCardBalance)
+	router.GET("/:id", giftCardController.GetGiftCardById)
+
+	customerRouter := rg.Group("customers")
+	customerRouter.GET("/:id/store-credit", giftCardController.GetCustomerStoreCredit)
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"context"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupInventoryRoutes(db *db.Queries, ctx context.Context, method string, rg *gin.RouterGroup) {
+	inventoryController := *controllers.NewInventoryController(db, method, ctx)
+	router := rg.Group("inventory")
+	router.GET("/valuation", inventoryController.GetInventoryValuation)
+	router.GET("/products/:id/cost-layers", inventoryController.GetProductCostLayers)
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+	"pos-api/util/loyalty"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupLoyaltyRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, rules loyalty.Rules, rg *gin.RouterGroup) {
+	loyaltyController := *controllers.NewLoyaltyController(db, sqlDB, rules, ctx)
+	router := rg.Group("customers")
+	router.GET("/:id/points", loyaltyController.GetCustomerPoints)
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+	"pos-api/util/notifier"
+	"pos-api/util/receipt"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupNotificationRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, notifiers notifier.Registry, template receipt.Template, rg *gin.RouterGroup) {
+	notificationController := *controllers.NewNotificationController(db, sqlDB, notifiers, template, ctx)
+	router := rg.Group("orders")
+	router.POST("/:id/send-receipt", notificationController.SendReceipt)
+	router.GET("/:id/notifications", notificationController.GetOrderNotifications)
+}
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+	"pos-api/util/loyalty"
+	"pos-api/util/notifier"
+	"pos-api/util/payment"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupParkedOrderRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, gateway payment.Provider, notifiers notifier.Registry, points loyalty.Rules, giftCardExpiryDays int, valuation string, expiry time.Duration, rg *gin.RouterGroup) {
+	parkedOrderController := *controllers.NewParkedOrderController(db, sqlDB, expiry, ctx)
+	transactionController := *controllers.NewTransactionController(db, sqlDB, gateway, notifiers, points, giftCardExpiryDays, valuation, ctx)
+
+	router := rg.Group("parked-orders")
+	router.POST("/", parkedOrderController.CreateParkedOrder)
+	router.GET("/", parkedOrderController.GetAllParkedOrders)
+	router.GET("/:id", parkedOrderController.GetParkedOrderById)
+	router.POST("/:id/resume", parkedOrderController.ResumeParkedOrder)
+	router.POST("/:id/convert", transactionController.ConvertParkedOrder)
+	router.DELETE("/:id", parkedOrderController.CancelParkedOrder)
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+	"pos-api/util/payment"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupPaymentWebhookRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, gateway payment.Provider, rg *gin.RouterGroup) {
+	paymentController := *controllers.NewPaymentController(db, sqlDB, gateway, ctx)
+
+	router := rg.Group("payments")
+	router.POST("/webhook", paymentController.Webhook)
+}
+
+func SetupPaymentRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, gateway payment.Provider, rg *gin.RouterGroup) {
+	paymentController := *controllers.NewPaymentController(db, sqlDB, gateway, ctx)
+
+	router := rg.Group("payments")
+	router.GET("/orders/:id", paymentController.GetOrderPaymentStatus)
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"context"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupPaymentMethodRoutes(db *db.Queries, ctx context.Context, rg *gin.RouterGroup) {
+	paymentMethodController := *controllers.NewPaymentMethodController(db, ctx)
+	router := rg.Group("payment-methods")
+	router.POST("/", paymentMethodController.CreatePaymentMethod)
+	router.GET("/", paymentMethodController.GetAllPaymentMethods)
+	router.PUT("/:id", paymentMethodController.UpdatePaymentMethod)
+	router.GET("/:id", paymentMethodController.GetPaymentMethodById)
+	router.DELETE("/:id/soft", paymentMethodController.SoftDeletePaymentMethodById)
+}
@@ -14,8 +14,10 @@ func SetupProductRoutes(db *db.Queries, ctx context.Context, rg *gin.RouterGroup
 	router.POST("/", productController.CreateProduct)
 	router.GET("/", productController.GetAllProducts)
 	router.GET("/deleted", productController.GetAllDeletedProducts)
+	router.GET("/low-stock", productController.GetLowStockProducts)
 	router.PUT("/:id", productController.UpdateProduct)
 	router.GET("/:id", productController.GetProductById)
+	router.GET("/:id/ledger", productController.GetProductLedger)
 	router.DELETE("/:id", productController.DeleteProductById)
 	router.DELETE("/:id/soft", productController.SoftDeleteProductById)
 }
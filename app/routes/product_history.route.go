@@ -2,15 +2,17 @@ package routes
 
 import (
 	"context"
+	"database/sql"
 	"pos-api/app/controllers"
 	db "pos-api/db/sqlc"
 
 	"github.com/gin-gonic/gin"
 )
 
-func SetupProductHistoryRoutes(db *db.Queries, ctx context.Context, rg *gin.RouterGroup) {
-	productHistoryController := *controllers.NewProductHistoryController(db, ctx)
+func SetupProductHistoryRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, valuation string, rg *gin.RouterGroup) {
+	productHistoryController := *controllers.NewProductHistoryController(db, sqlDB, valuation, ctx)
 	router := rg.Group("product-history")
 	router.GET("/", productHistoryController.GetAllProductHistory)
 	router.POST("/", productHistoryController.CreateProductHistory)
+	router.GET("/summary", productHistoryController.GetProductHistorySummary)
 }
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupPromotionRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, rg *gin.RouterGroup) {
+	promotionController := *controllers.NewPromotionController(db, sqlDB, ctx)
+	router := rg.Group("promotions")
+	router.POST("/", promotionController.CreatePromotion)
+	router.GET("/", promotionController.GetAllPromotions)
+	router.PUT("/:id", promotionController.UpdatePromotion)
+	router.GET("/:id", promotionController.GetPromotionById)
+	router.DELETE("/:id/soft", promotionController.SoftDeletePromotionById)
+}
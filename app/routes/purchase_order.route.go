@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+	"pos-api/util/receipt"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupPurchaseOrderRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, template receipt.Template, rg *gin.RouterGroup) {
+	purchaseOrderController := *controllers.NewPurchaseOrderController(db, sqlDB, template, ctx)
+	router := rg.Group("purchase-orders")
+	router.POST("/", purchaseOrderController.CreatePurchaseOrder)
+	router.GET("/", purchaseOrderController.GetAllPurchaseOrders)
+	router.GET("/suggestions", purchaseOrderController.GetReorderSuggestions)
+	router.POST("/suggestions", purchaseOrderController.CreateReorderPurchaseOrders)
+	router.PUT("/:id", purchaseOrderController.UpdatePurchaseOrder)
+	router.GET("/:id", purchaseOrderController.GetPurchaseOrderById)
+	router.POST("/:id/send", purchaseOrderController.SendPurchaseOrder)
+	router.POST("/:id/cancel", purchaseOrderController.CancelPurchaseOrder)
+	router.GET("/:id/document", purchaseOrderController.GetPurchaseOrderDocument)
+}
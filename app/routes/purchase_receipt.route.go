@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupPurchaseReceiptRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, rg *gin.RouterGroup) {
+	purchaseReceiptController := *controllers.NewPurchaseReceiptController(db, sqlDB, ctx)
+	router := rg.Group("purchase-orders")
+	router.POST("/:id/receive", purchaseReceiptController.ReceivePurchaseOrder)
+	router.GET("/:id/receipts", purchaseReceiptController.GetPurchaseReceipts)
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"context"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+	"pos-api/util/receipt"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupReceiptRoutes(db *db.Queries, ctx context.Context, template receipt.Template, rg *gin.RouterGroup) {
+	receiptController := *controllers.NewReceiptController(db, template, ctx)
+	router := rg.Group("orders")
+	router.GET("/:id/receipt", receiptController.GetReceipt)
+}
@@ -18,4 +18,6 @@ func SetupReportRoutes(db *db.Queries, ctx context.Context, rg *gin.RouterGroup)
 	router.GET("/slow-moving", reportController.SlowMoving)
 	router.GET("/top-cashiers", reportController.TopCashier)
 	router.GET("/top-customers", reportController.TopCustomer)
+	router.GET("/tax-summary", reportController.TaxSummary)
+	router.GET("/profit", reportController.GrossProfit)
 }
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupShiftRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, rg *gin.RouterGroup) {
+	shiftController := *controllers.NewShiftController(db, sqlDB, ctx)
+	router := rg.Group("shifts")
+	router.POST("/open", shiftController.OpenShift)
+	router.GET("/current", shiftController.GetCurrentShift)
+	router.GET("/", shiftController.GetAllShifts)
+	router.GET("/:id", shiftController.GetShiftById)
+	router.POST("/:id/cash-movements", shiftController.CreateCashMovement)
+	router.POST("/:id/close", shiftController.CloseShift)
+	router.GET("/:id/report", shiftController.GetShiftReport)
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+	"pos-api/util/notifier"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupStockAlertRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, notifiers notifier.Registry, recipients map[string]string, rg *gin.RouterGroup) {
+	stockAlertController := *controllers.NewStockAlertController(db, sqlDB, notifiers, recipients, ctx)
+	router := rg.Group("stock-alerts")
+	router.GET("/", stockAlertController.GetAllStockAlerts)
+}
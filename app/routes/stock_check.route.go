@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupStockCheckRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, rg *gin.RouterGroup) {
+	stockCheckController := *controllers.NewStockCheckController(db, sqlDB, ctx)
+	router := rg.Group("inventory/stock-check")
+	router.GET("/", stockCheckController.GetStockCheck)
+	router.POST("/repair", stockCheckController.RepairStock)
+}
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"context"
+	"database/sql"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupStockTakeRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, valuation string, rg *gin.RouterGroup) {
+	stockTakeController := *controllers.NewStockTakeController(db, sqlDB, valuation, ctx)
+	router := rg.Group("stock-takes")
+	router.POST("/", stockTakeController.CreateStockTake)
+	router.GET("/", stockTakeController.GetAllStockTakes)
+	router.GET("/:id", stockTakeController.GetStockTakeById)
+	router.POST("/:id/counts", stockTakeController.CountStockTake)
+	router.GET("/:id/variances", stockTakeController.GetStockTakeVariances)
+	router.POST("/:id/post", stockTakeController.PostStockTake)
+	router.POST("/:id/cancel", stockTakeController.CancelStockTake)
+}
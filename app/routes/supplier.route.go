@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"context"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupSupplierRoutes(db *db.Queries, ctx context.Context, rg *gin.RouterGroup) {
+	supplierController := *controllers.NewSupplierController(db, ctx)
+	router := rg.Group("suppliers")
+	router.POST("/", supplierController.CreateSupplier)
+	router.GET("/", supplierController.GetAllSuppliers)
+	router.PUT("/:id", supplierController.UpdateSupplier)
+	router.GET("/:id", supplierController.GetSupplierById)
+	router.DELETE("/:id/soft", supplierController.SoftDeleteSupplierById)
+}
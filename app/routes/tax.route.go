@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"context"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupTaxRoutes(db *db.Queries, ctx context.Context, rg *gin.RouterGroup) {
+	taxController := *controllers.NewTaxController(db, ctx)
+	router := rg.Group("taxes")
+	router.POST("/", taxController.CreateTaxRate)
+	router.GET("/", taxController.GetAllTaxRates)
+	router.PUT("/:id", taxController.UpdateTaxRate)
+	router.GET("/:id", taxController.GetTaxRateById)
+	router.DELETE("/:id/soft", taxController.SoftDeleteTaxRateById)
+}
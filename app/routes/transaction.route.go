@@ -5,12 +5,15 @@ import (
 	"database/sql"
 	"pos-api/app/controllers"
 	db "pos-api/db/sqlc"
+	"pos-api/util/loyalty"
+	"pos-api/util/notifier"
+	"pos-api/util/payment"
 
 	"github.com/gin-gonic/gin"
 )
 
-func SetupTransactionRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, rg *gin.RouterGroup) {
-	transactionHistoryController := *controllers.NewTransactionController(db, sqlDB, ctx)
+func SetupTransactionRoutes(db *db.Queries, ctx context.Context, sqlDB *sql.DB, gateway payment.Provider, notifiers notifier.Registry, points loyalty.Rules, giftCardExpiryDays int, valuation string, rg *gin.RouterGroup) {
+	transactionHistoryController := *controllers.NewTransactionController(db, sqlDB, gateway, notifiers, points, giftCardExpiryDays, valuation, ctx)
 	router := rg.Group("transaction")
 	router.POST("/order", transactionHistoryController.CreateOrder)
 	router.POST("/refund", transactionHistoryController.CreateRefund)
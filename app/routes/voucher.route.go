@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"context"
+	"pos-api/app/controllers"
+	db "pos-api/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupVoucherRoutes(db *db.Queries, ctx context.Context, rg *gin.RouterGroup) {
+	voucherController := *controllers.NewVoucherController(db, ctx)
+	router := rg.Group("vouchers")
+	router.POST("/", voucherController.CreateVoucher)
+	router.GET("/", voucherController.GetAllVouchers)
+	router.POST("/validate", voucherController.ValidateVoucher)
+	router.PUT("/:id", voucherController.UpdateVoucher)
+	router.GET("/:id", voucherController.GetVoucherById)
+	router.DELETE("/:id/soft", voucherController.SoftDeleteVoucherById)
+}
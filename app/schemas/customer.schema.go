@@ -23,6 +23,7 @@ type CustomerData struct {
 	Name       string    `json:"name"`
 	Phone      string    `json:"phone,omitempty"`
 	Email      string    `json:"email,omitempty"`
+	TierID     int64     `json:"tier_id,omitempty"`
 	CreatedBy  int64     `json:"created_by,omitempty"`
 	CreatedAt  time.Time `json:"created_at,omitempty"`
 	UpdatedBy  int64     `json:"modified_by,omitempty"`
@@ -30,3 +31,107 @@ type CustomerData struct {
 	DeletedBy  int64     `json:"deleted_by,omitempty"`
 	DeletedAt  time.Time `json:"deleted_at,omitempty"`
 }
+
+// CustomerFavoriteProduct digunakan untuk menampilkan produk yang paling sering dibeli pelanggan
+type CustomerFavoriteProduct struct {
+	ProductID     int64   `json:"product_id,omitempty"`
+	ProductName   string  `json:"product_name"`
+	TotalQuantity int64   `json:"total_quantity"`
+	TotalOrders   int64   `json:"total_orders"`
+	TotalSpent    float64 `json:"total_spent"`
+}
+
+// CustomerSummaryData digunakan untuk menampilkan ringkasan riwayat belanja pelanggan di kasir
+type CustomerSummaryData struct {
+	CustomerID       int64                     `json:"customer_id"`
+	MemberCode       string                    `json:"member_code"`
+	Name             string                    `json:"name"`
+	Tier             string                    `json:"tier,omitempty"`
+	PointsBalance    int64                     `json:"points_balance"`
+	LifetimeSpend    float64                   `json:"lifetime_spend"`
+	TotalOrders      int64                     `json:"total_orders"`
+	AverageBasket    float64                   `json:"average_basket"`
+	TotalRefunds     int64                     `json:"total_refunds"`
+	LastVisit        time.Time                 `json:"last_visit,omitempty"`
+	LastTrxNumber    string                    `json:"last_trx_number,omitempty"`
+	FavoriteProducts []CustomerFavoriteProduct `json:"favorite_products"`
+}
+
+// CustomerContactData digunakan untuk menampilkan data kontak pelanggan pada kandidat duplikat
+type CustomerContactData struct {
+	ID         int64  `json:"id"`
+	MemberCode string `json:"member_code"`
+	Name       string `json:"name"`
+	Phone      string `json:"phone,omitempty"`
+	Email      string `json:"email,omitempty"`
+}
+
+// CustomerDuplicateData digunakan untuk menampilkan pasangan pelanggan yang kemungkinan duplikat
+type CustomerDuplicateData struct {
+	Customer  CustomerContactData `json:"customer"`
+	Duplicate CustomerContactData `json:"duplicate"`
+	Score     int                 `json:"score"`
+	Reasons   []string            `json:"reasons"`
+}
+
+// MergeCustomer digunakan untuk payload penggabungan pelanggan duplikat ke pelanggan yang dipertahankan
+type MergeCustomer struct {
+	DuplicateID int64 `json:"duplicate_id" binding:"required"`
+}
+
+// CustomerMergeData digunakan untuk menampilkan riwayat penggabungan pelanggan
+type CustomerMergeData struct {
+	ID               int64     `json:"id"`
+	CustomerID       int64     `json:"customer_id"`
+	MergedCustomerID int64     `json:"merged_customer_id,omitempty"`
+	MergedMemberCode string    `json:"merged_member_code"`
+	MergedName       string    `json:"merged_name"`
+	MergedPhone      string    `json:"merged_phone,omitempty"`
+	MergedEmail      string    `json:"merged_email,omitempty"`
+	OrdersMoved      int32     `json:"orders_moved"`
+	PointsMoved      int32     `json:"points_moved"`
+	MergedBy         int64     `json:"merged_by,omitempty"`
+	MergedAt         time.Time `json:"merged_at"`
+}
+
+// UpdateCustomerConsent digunakan untuk payload perubahan persetujuan pemasaran pelanggan.
+// Kanal yang tidak diisi tidak diubah.
+type UpdateCustomerConsent struct {
+	EmailMarketing    *bool  `json:"email_marketing"`
+	WhatsappMarketing *bool  `json:"whatsapp_marketing"`
+	Source            string `json:"source"` // asal persetujuan, misalnya kasir, formulir atau aplikasi
+}
+
+// CustomerConsentLogData digunakan untuk menampilkan riwayat persetujuan pemasaran pelanggan
+type CustomerConsentLogData struct {
+	ID        int64     `json:"id"`
+	Channel   string    `json:"channel"`
+	Granted   bool      `json:"granted"`
+	Source    string    `json:"source,omitempty"`
+	CreatedBy int64     `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CustomerConsentData digunakan untuk menampilkan status persetujuan pemasaran pelanggan
+type CustomerConsentData struct {
+	CustomerID        int64                    `json:"customer_id"`
+	EmailMarketing    bool                     `json:"email_marketing"`
+	WhatsappMarketing bool                     `json:"whatsapp_marketing"`
+	UpdatedAt         time.Time                `json:"updated_at,omitempty"`
+	History           []CustomerConsentLogData `json:"history"`
+}
+
+// CustomerExportData digunakan untuk ekspor seluruh data pribadi pelanggan (hak akses subjek data UU PDP)
+type CustomerExportData struct {
+	ExportedAt      time.Time                `json:"exported_at"`
+	Customer        CustomerData             `json:"customer"`
+	Consent         CustomerConsentData      `json:"consent"`
+	Orders          []OrderData              `json:"orders"`
+	OrderItems      []OrderItemData          `json:"order_items"`
+	LoyaltyPoints   []LoyaltyPointData       `json:"loyalty_points"`
+	TierChanges     []CustomerTierChangeData `json:"tier_changes"`
+	GiftCards       []GiftCardData           `json:"gift_cards"`
+	ParkedOrders    []ParkedOrderData        `json:"parked_orders"`
+	Notifications   []NotificationData       `json:"notifications"`
+	MergedCustomers []CustomerMergeData      `json:"merged_customers"`
+}
@@ -0,0 +1,63 @@
+package schemas
+
+import "time"
+
+// UpdateCustomerTier digunakan untuk payload pembaruan tier pelanggan
+type UpdateCustomerTier struct {
+	Name            string   `json:"name" binding:"required"`
+	MinSpend        *float64 `json:"min_spend" binding:"required,gte=0"`
+	DiscountPercent float64  `json:"discount_percent" binding:"gte=0,lte=100"`
+}
+
+// SetCustomerTierPrice digunakan untuk payload harga khusus produk per tier
+type SetCustomerTierPrice struct {
+	ProductID int64   `json:"product_id" binding:"required"`
+	Price     float64 `json:"price" binding:"required,gt=0"`
+}
+
+// CustomerTierData digunakan untuk menampilkan data tier di response
+type CustomerTierData struct {
+	ID              int64     `json:"id"`
+	Code            string    `json:"code"`
+	Name            string    `json:"name"`
+	MinSpend        float64   `json:"min_spend"`
+	DiscountPercent float64   `json:"discount_percent"`
+	UpdatedBy       int64     `json:"modified_by,omitempty"`
+	UpdatedAt       time.Time `json:"modified_at,omitempty"`
+}
+
+// CustomerTierPriceData digunakan untuk menampilkan harga khusus produk per tier
+type CustomerTierPriceData struct {
+	ProductID    int64   `json:"product_id"`
+	ProductName  string  `json:"product_name,omitempty"`
+	ProductPrice float64 `json:"product_price,omitempty"`
+	Price        float64 `json:"price"`
+}
+
+// CustomerTierChangeData digunakan untuk menampilkan riwayat perubahan tier pelanggan
+type CustomerTierChangeData struct {
+	OldTierID    int64     `json:"old_tier_id,omitempty"`
+	OldTierName  string    `json:"old_tier_name,omitempty"`
+	NewTierID    int64     `json:"new_tier_id,omitempty"`
+	NewTierName  string    `json:"new_tier_name,omitempty"`
+	RollingSpend float64   `json:"rolling_spend"`
+	ChangedAt    time.Time `json:"changed_at"`
+}
+
+// CustomerTierStatusData digunakan untuk menampilkan tier pelanggan saat ini dan sisa belanja ke tier berikutnya
+type CustomerTierStatusData struct {
+	CustomerID    int64                    `json:"customer_id"`
+	RollingSpend  float64                  `json:"rolling_spend"`
+	WindowDays    int                      `json:"window_days"`
+	Tier          *CustomerTierData        `json:"tier,omitempty"`
+	NextTier      *CustomerTierData        `json:"next_tier,omitempty"`
+	SpendToNext   float64                  `json:"spend_to_next,omitempty"`
+	TierUpdatedAt time.Time                `json:"tier_updated_at,omitempty"`
+	History       []CustomerTierChangeData `json:"history"`
+}
+
+// RecalculateTiersData digunakan untuk menampilkan hasil hitung ulang tier
+type RecalculateTiersData struct {
+	Customers int `json:"customers"`
+	Changed   int `json:"changed"`
+}
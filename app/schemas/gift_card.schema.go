@@ -0,0 +1,60 @@
+package schemas
+
+import "time"
+
+// CheckGiftCardBalance digunakan untuk payload cek saldo gift card
+type CheckGiftCardBalance struct {
+	Code string `json:"code" binding:"required"`
+	Pin  string `json:"pin" binding:"required"`
+}
+
+// GiftCardData digunakan untuk menampilkan data gift card/store credit di response
+type GiftCardData struct {
+	ID            int64     `json:"id"`
+	Code          string    `json:"code"`
+	Type          string    `json:"type"`
+	CustomerID    int64     `json:"customer_id,omitempty"`
+	OrderID       int64     `json:"order_id,omitempty"`
+	InitialAmount float64   `json:"initial_amount"`
+	Balance       float64   `json:"balance"`
+	Status        string    `json:"status"`
+	ExpiresAt     time.Time `json:"expires_at,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// IssuedGiftCardData digunakan untuk menampilkan gift card yang baru diterbitkan beserta PIN-nya.
+// PIN hanya ditampilkan sekali saat penerbitan.
+type IssuedGiftCardData struct {
+	ID        int64     `json:"id"`
+	Code      string    `json:"code"`
+	Pin       string    `json:"pin"`
+	Amount    float64   `json:"amount"`
+	Status    string    `json:"status"`
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+}
+
+// GiftCardTransactionData digunakan untuk menampilkan mutasi saldo gift card/store credit
+type GiftCardTransactionData struct {
+	ID          int64     `json:"id"`
+	OrderID     int64     `json:"order_id,omitempty"`
+	Type        string    `json:"type"`
+	Amount      float64   `json:"amount"`
+	Balance     float64   `json:"balance"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// GiftCardDetailData digunakan untuk menampilkan gift card beserta riwayat mutasinya
+type GiftCardDetailData struct {
+	GiftCardData
+	Transactions []GiftCardTransactionData `json:"transactions"`
+}
+
+// CustomerStoreCreditData digunakan untuk menampilkan saldo dan riwayat store credit pelanggan
+type CustomerStoreCreditData struct {
+	CustomerID int64                     `json:"customer_id"`
+	MemberCode string                    `json:"member_code"`
+	Code       string                    `json:"code,omitempty"`
+	Balance    float64                   `json:"balance"`
+	History    []GiftCardTransactionData `json:"history"`
+}
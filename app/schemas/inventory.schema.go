@@ -0,0 +1,61 @@
+package schemas
+
+import "time"
+
+// InventoryValuationItem adalah jumlah dan nilai stok satu produk pada tanggal penilaian
+type InventoryValuationItem struct {
+	ProductID    int64   `json:"product_id"`
+	ProductName  string  `json:"product_name"`
+	CategoryName string  `json:"category_name,omitempty"`
+	Quantity     int64   `json:"quantity"`
+	UnitCost     float64 `json:"unit_cost"`
+	Value        float64 `json:"value"`
+}
+
+// InventoryValuationData adalah laporan nilai persediaan pada suatu tanggal
+type InventoryValuationData struct {
+	AsOf          time.Time                `json:"as_of"`
+	Method        string                   `json:"method"`
+	TotalQuantity int64                    `json:"total_quantity"`
+	TotalValue    float64                  `json:"total_value"`
+	Products      []InventoryValuationItem `json:"products"`
+}
+
+// CostLayerData adalah sisa stok dari satu penerimaan beserta harga belinya
+type CostLayerData struct {
+	ID        int64     `json:"id"`
+	Source    string    `json:"source"`
+	TrxRef    string    `json:"trx_ref"`
+	Quantity  int32     `json:"quantity"`
+	Remaining int32     `json:"remaining"`
+	UnitCost  float64   `json:"unit_cost"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ProductCostData adalah harga pokok rata-rata dan cost layer yang masih terbuka
+type ProductCostData struct {
+	ProductID int64           `json:"product_id"`
+	Name      string          `json:"name"`
+	Stock     int32           `json:"stock"`
+	Cost      float64         `json:"cost"`
+	Method    string          `json:"method"`
+	Layers    []CostLayerData `json:"layers"`
+}
+
+// StockMismatchData adalah produk yang stoknya berbeda dengan jumlah product history
+type StockMismatchData struct {
+	ProductID     int64  `json:"product_id"`
+	Name          string `json:"name"`
+	Stock         int32  `json:"stock"`
+	ExpectedStock int64  `json:"expected_stock"` // jumlah quantity_change pada product history
+	Difference    int64  `json:"difference"`     // stock dikurangi expected_stock
+}
+
+// StockCheckData adalah hasil pemeriksaan konsistensi stok
+type StockCheckData struct {
+	CheckedAt  time.Time           `json:"checked_at"`
+	Checked    int                 `json:"checked"`
+	Mismatches []StockMismatchData `json:"mismatches"`
+	Repaired   bool                `json:"repaired"`
+	TrxRef     string              `json:"trx_ref,omitempty"` // referensi product history koreksi
+}
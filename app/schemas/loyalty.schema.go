@@ -0,0 +1,25 @@
+package schemas
+
+import "time"
+
+// LoyaltyPointData digunakan untuk menampilkan mutasi poin loyalti di response
+type LoyaltyPointData struct {
+	ID          int64     `json:"id"`
+	OrderID     int64     `json:"order_id,omitempty"`
+	Type        string    `json:"type"`
+	Points      int32     `json:"points"`
+	Remaining   int32     `json:"remaining,omitempty"`
+	ExpiresAt   time.Time `json:"expires_at,omitempty"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// CustomerPointsData digunakan untuk menampilkan saldo dan riwayat poin pelanggan
+type CustomerPointsData struct {
+	CustomerID     int64              `json:"customer_id"`
+	MemberCode     string             `json:"member_code"`
+	Balance        int64              `json:"balance"`
+	BalanceValue   float64            `json:"balance_value"`   // nilai saldo poin dalam rupiah
+	ExpiringPoints int64              `json:"expiring_points"` // poin yang hangus dalam 30 hari
+	History        []LoyaltyPointData `json:"history"`
+}
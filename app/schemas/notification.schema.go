@@ -0,0 +1,28 @@
+package schemas
+
+import "time"
+
+// SendReceipt digunakan untuk payload kirim ulang struk digital. Tanpa channel, struk dikirim
+// ke semua kontak pelanggan (email/whatsapp), to mengganti tujuan bawaan dari data pelanggan.
+type SendReceipt struct {
+	Channel string `json:"channel" binding:"omitempty,oneof=email whatsapp"`
+	To      string `json:"to"`
+}
+
+// NotificationData digunakan untuk menampilkan status pesan di outbox
+type NotificationData struct {
+	ID            int64     `json:"id"`
+	OrderID       int64     `json:"order_id,omitempty"`
+	StockAlertID  int64     `json:"stock_alert_id,omitempty"`
+	Kind          string    `json:"kind"`
+	Channel       string    `json:"channel"`
+	Recipient     string    `json:"recipient"`
+	Subject       string    `json:"subject,omitempty"`
+	Status        string    `json:"status"`
+	Attempts      int32     `json:"attempts"`
+	MaxAttempts   int32     `json:"max_attempts"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	LastError     string    `json:"last_error,omitempty"`
+	SentAt        time.Time `json:"sent_at,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
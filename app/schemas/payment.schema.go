@@ -0,0 +1,60 @@
+package schemas
+
+import "time"
+
+// CreatePaymentMethod digunakan untuk payload pembuatan metode pembayaran baru
+type CreatePaymentMethod struct {
+	Code              string `json:"code" binding:"required"`
+	Name              string `json:"name" binding:"required"`
+	Type              string `json:"type" binding:"required,oneof=cash card ewallet transfer points gift_card store_credit other"`
+	RequiresReference bool   `json:"requires_reference"`
+	Gateway           bool   `json:"gateway"`
+}
+
+// UpdatePaymentMethod digunakan untuk payload pembaruan metode pembayaran
+type UpdatePaymentMethod struct {
+	Code              string `json:"code" binding:"required"`
+	Name              string `json:"name" binding:"required"`
+	Type              string `json:"type" binding:"required,oneof=cash card ewallet transfer points gift_card store_credit other"`
+	RequiresReference bool   `json:"requires_reference"`
+	Gateway           bool   `json:"gateway"`
+	IsActive          bool   `json:"is_active"`
+}
+
+// PaymentMethodData digunakan untuk menampilkan data metode pembayaran di response
+type PaymentMethodData struct {
+	ID                int64     `json:"id"`
+	Code              string    `json:"code"`
+	Name              string    `json:"name"`
+	Type              string    `json:"type"`
+	RequiresReference bool      `json:"requires_reference"`
+	Gateway           bool      `json:"gateway"`
+	IsActive          bool      `json:"is_active"`
+	CreatedBy         int64     `json:"created_by,omitempty"`
+	CreatedAt         time.Time `json:"created_at,omitempty"`
+	UpdatedBy         int64     `json:"updated_by,omitempty"`
+	UpdatedAt         time.Time `json:"updated_at,omitempty"`
+}
+
+// OrderPaymentData digunakan untuk menampilkan pembayaran order di response
+type OrderPaymentData struct {
+	PaymentMethod  string  `json:"payment_method"`
+	Amount         float64 `json:"amount"`
+	TenderedAmount float64 `json:"tendered_amount"`
+	ChangeAmount   float64 `json:"change_amount"`
+	Reference      string  `json:"reference,omitempty"`
+}
+
+// PaymentChargeData digunakan untuk menampilkan tagihan payment gateway di response
+type PaymentChargeData struct {
+	OrderID       int64     `json:"order_id"`
+	Provider      string    `json:"provider"`
+	ChargeID      string    `json:"charge_id"`
+	PaymentMethod string    `json:"payment_method"`
+	Amount        float64   `json:"amount"`
+	Status        string    `json:"status"`
+	PaymentURL    string    `json:"payment_url,omitempty"`
+	QRString      string    `json:"qr_string,omitempty"`
+	ExpiresAt     time.Time `json:"expires_at,omitempty"`
+	PaidAt        time.Time `json:"paid_at,omitempty"`
+}
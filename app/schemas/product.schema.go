@@ -3,27 +3,60 @@ package schemas
 import "time"
 
 type ProductData struct {
-	ID         int64     `json:"id"`
-	Name       string    `json:"name"`
-	Price      float64   `json:"price"`
-	Stock      int32     `json:"stock"`
-	CategoryID int64     `json:"category_id"`
-	CreatedBy  int64     `json:"created_by,omitempty"`
-	CreatedAt  time.Time `json:"created_at,omitempty"`
-	UpdatedBy  int64     `json:"updated_by,omitempty"`
-	UpdatedAt  time.Time `json:"updated_at,omitempty"`
-	DeletedBy  int64     `json:"deleted_by,omitempty"`
-	DeletedAt  time.Time `json:"deleted_at,omitempty"`
+	ID            int64     `json:"id"`
+	Name          string    `json:"name"`
+	Barcode       string    `json:"barcode,omitempty"`
+	Price         float64   `json:"price"`
+	Cost          float64   `json:"cost"`
+	Stock         int32     `json:"stock"`
+	ReservedStock int32     `json:"reserved_stock"`
+	MinStock      int32     `json:"min_stock"`
+	ReorderQty    int32     `json:"reorder_quantity"`
+	SupplierID    int64     `json:"supplier_id,omitempty"`
+	CategoryID    int64     `json:"category_id"`
+	TaxRateID     int64     `json:"tax_rate_id,omitempty"`
+	IsGiftCard    bool      `json:"is_gift_card"`
+	CreatedBy     int64     `json:"created_by,omitempty"`
+	CreatedAt     time.Time `json:"created_at,omitempty"`
+	UpdatedBy     int64     `json:"updated_by,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at,omitempty"`
+	DeletedBy     int64     `json:"deleted_by,omitempty"`
+	DeletedAt     time.Time `json:"deleted_at,omitempty"`
 }
 
 type CreateProduct struct {
 	Name       string  `json:"name" binding:"required"`
 	Price      float64 `json:"price" binding:"required"`
 	CategoryID int64   `json:"category_id" binding:"required"`
+	TaxRateID  int64   `json:"tax_rate_id"`
+	IsGiftCard bool    `json:"is_gift_card"` // menjual gift card senilai harga produk
+	Barcode    string  `json:"barcode"`
+	MinStock   int32   `json:"min_stock" binding:"min=0"`        // alert dikirim saat stok turun ke batas ini, 0 berarti tidak dipantau
+	ReorderQty int32   `json:"reorder_quantity" binding:"min=0"` // jumlah pesanan minimal saat pemesanan ulang
+	SupplierID int64   `json:"supplier_id"`                      // supplier utama untuk pemesanan ulang
 }
 
 type UpdateProduct struct {
 	Name       string  `json:"name,omitempty"`
 	Price      float64 `json:"price,omitempty"`
 	CategoryID int64   `json:"category_id,omitempty"`
+	TaxRateID  int64   `json:"tax_rate_id,omitempty"`
+	IsGiftCard *bool   `json:"is_gift_card,omitempty"`
+	Barcode    string  `json:"barcode,omitempty"`
+	MinStock   *int32  `json:"min_stock,omitempty" binding:"omitempty,min=0"`
+	ReorderQty *int32  `json:"reorder_quantity,omitempty" binding:"omitempty,min=0"`
+	SupplierID int64   `json:"supplier_id,omitempty"`
+}
+
+// LowStockProductData adalah produk yang stoknya sudah mencapai batas minimum
+type LowStockProductData struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Barcode      string `json:"barcode,omitempty"`
+	Stock        int32  `json:"stock"`
+	MinStock     int32  `json:"min_stock"`
+	ReorderQty   int32  `json:"reorder_quantity"`
+	Shortage     int32  `json:"shortage"` // min_stock dikurangi stock
+	SupplierID   int64  `json:"supplier_id,omitempty"`
+	SupplierName string `json:"supplier_name,omitempty"`
 }
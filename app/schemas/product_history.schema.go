@@ -6,8 +6,9 @@ type ProductHistoryData struct {
 	ID             int64     `json:"id"`
 	TrxRef         string    `json:"trx_ref"`
 	ProductID      int64     `json:"product_id"`
-	QuantityChange int32     `json:"quantity_change"`
-	Type           string    `json:"type"` // "in" or "out"
+	QuantityChange int32     `json:"quantity_change"` // positif untuk stok masuk, negatif untuk stok keluar
+	Type           string    `json:"type"`            // sale, refund, purchase, adjustment, transfer, damage, expiry atau supplier_return
+	ReasonCode     string    `json:"reason_code,omitempty"`
 	Reason         string    `json:"reason"`
 	CreatedBy      int64     `json:"created_by"`
 	CreatedAt      time.Time `json:"created_at"`
@@ -15,7 +16,44 @@ type ProductHistoryData struct {
 
 type CreateProductHistory struct {
 	ProductID      int64  `json:"product_id" binding:"required"`
-	QuantityChange int32  `json:"quantity_change" binding:"required"`
-	Type           string `json:"type" binding:"required,oneof=in out"`
-	Reason         string `json:"reason" binding:"required"`
+	QuantityChange int32  `json:"quantity_change" binding:"required"` // tanda menentukan arah untuk adjustment dan transfer
+	Type           string `json:"type" binding:"required"`
+	ReasonCode     string `json:"reason_code"`
+	Reason         string `json:"reason"`
+	// harga beli per unit untuk stok masuk, default harga rata-rata produk
+	UnitCost *float64 `json:"unit_cost" binding:"omitempty,gte=0"`
+}
+
+// ProductHistorySummaryData adalah jumlah pergerakan stok per tipe dan kode alasan
+type ProductHistorySummaryData struct {
+	Type        string `json:"type"`
+	ReasonCode  string `json:"reason_code,omitempty"`
+	Movements   int64  `json:"movements"`
+	QuantityIn  int64  `json:"quantity_in"`
+	QuantityOut int64  `json:"quantity_out"`
+	NetQuantity int64  `json:"net_quantity"`
+}
+
+// ProductLedgerEntry adalah satu pergerakan stok beserta saldo sebelum dan sesudahnya
+type ProductLedgerEntry struct {
+	ID             int64     `json:"id"`
+	TrxRef         string    `json:"trx_ref"`
+	QuantityChange int32     `json:"quantity_change"`
+	Type           string    `json:"type"`
+	ReasonCode     string    `json:"reason_code,omitempty"`
+	Reason         string    `json:"reason,omitempty"`
+	BalanceBefore  int64     `json:"balance_before"`
+	BalanceAfter   int64     `json:"balance_after"`
+	CreatedBy      int64     `json:"created_by"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ProductLedgerData adalah kartu stok satu produk, diurutkan dari pergerakan paling lama
+type ProductLedgerData struct {
+	ProductID   int64                `json:"product_id"`
+	Name        string               `json:"name"`
+	Stock       int32                `json:"stock"`
+	LedgerStock int64                `json:"ledger_stock"` // jumlah seluruh product history
+	Drift       int64                `json:"drift"`        // stok produk dikurangi ledger_stock
+	Entries     []ProductLedgerEntry `json:"entries"`
 }
@@ -0,0 +1,76 @@
+package schemas
+
+import "time"
+
+// PromotionProduct digunakan untuk daftar produk syarat promosi
+type PromotionProduct struct {
+	ProductID int64 `json:"product_id" binding:"required"`
+	Quantity  int32 `json:"quantity"`
+}
+
+// CreatePromotion digunakan untuk payload pembuatan promosi baru
+type CreatePromotion struct {
+	Name string `json:"name" binding:"required"`
+	Type string `json:"type" binding:"required,oneof=buy_x_get_y bundle category_percentage time_window min_spend"`
+	// - buy_x_get_y: required: buy_quantity, get_quantity, products/category_id
+	// - bundle: required: products (with quantity), bundle_price
+	// - category_percentage: required: category_id, discount_percent
+	// - time_window: required: daily_start, daily_end, products/category_id, discount_percent/discount_amount
+	// - min_spend: required: min_spend, discount_percent/discount_amount
+	Priority        int32              `json:"priority"`
+	Stackable       bool               `json:"stackable"`
+	CategoryID      int64              `json:"category_id"`
+	BuyQuantity     int32              `json:"buy_quantity"`
+	GetQuantity     int32              `json:"get_quantity"`
+	BundlePrice     float64            `json:"bundle_price"`
+	DiscountPercent float64            `json:"discount_percent"`
+	DiscountAmount  float64            `json:"discount_amount"`
+	MinSpend        float64            `json:"min_spend"`
+	DailyStart      string             `json:"daily_start"`
+	DailyEnd        string             `json:"daily_end"`
+	DaysOfWeek      string             `json:"days_of_week"`
+	StartAt         time.Time          `json:"start_at"`
+	EndAt           time.Time          `json:"end_at"`
+	Products        []PromotionProduct `json:"products" binding:"dive"`
+}
+
+// UpdatePromotion digunakan untuk payload pembaruan promosi
+type UpdatePromotion struct {
+	CreatePromotion
+	IsActive bool `json:"is_active"`
+}
+
+// PromotionData digunakan untuk menampilkan data promosi di response
+type PromotionData struct {
+	ID              int64              `json:"id"`
+	Name            string             `json:"name"`
+	Type            string             `json:"type"`
+	Priority        int32              `json:"priority"`
+	Stackable       bool               `json:"stackable"`
+	IsActive        bool               `json:"is_active"`
+	CategoryID      int64              `json:"category_id,omitempty"`
+	BuyQuantity     int32              `json:"buy_quantity,omitempty"`
+	GetQuantity     int32              `json:"get_quantity,omitempty"`
+	BundlePrice     float64            `json:"bundle_price,omitempty"`
+	DiscountPercent float64            `json:"discount_percent,omitempty"`
+	DiscountAmount  float64            `json:"discount_amount,omitempty"`
+	MinSpend        float64            `json:"min_spend,omitempty"`
+	DailyStart      string             `json:"daily_start,omitempty"`
+	DailyEnd        string             `json:"daily_end,omitempty"`
+	DaysOfWeek      string             `json:"days_of_week,omitempty"`
+	StartAt         time.Time          `json:"start_at,omitempty"`
+	EndAt           time.Time          `json:"end_at,omitempty"`
+	Products        []PromotionProduct `json:"products"`
+	CreatedBy       int64              `json:"created_by,omitempty"`
+	CreatedAt       time.Time          `json:"created_at,omitempty"`
+	UpdatedBy       int64              `json:"updated_by,omitempty"`
+	UpdatedAt       time.Time          `json:"updated_at,omitempty"`
+}
+
+// AppliedPromotionData digunakan untuk menampilkan promosi yang diterapkan pada order
+type AppliedPromotionData struct {
+	PromotionID    int64   `json:"promotion_id"`
+	Name           string  `json:"name"`
+	Type           string  `json:"type"`
+	DiscountAmount float64 `json:"discount_amount"`
+}
@@ -0,0 +1,139 @@
+package schemas
+
+import "time"
+
+// PurchaseOrderItem digunakan untuk payload baris produk purchase order
+type PurchaseOrderItem struct {
+	ProductID int64   `json:"product_id" binding:"required"`
+	Quantity  int32   `json:"quantity" binding:"required,min=1"`
+	UnitCost  float64 `json:"unit_cost" binding:"min=0"` // harga beli yang disepakati dengan supplier
+}
+
+// CreatePurchaseOrder digunakan untuk payload pembuatan purchase order (draft)
+type CreatePurchaseOrder struct {
+	SupplierID int64               `json:"supplier_id" binding:"required"`
+	ExpectedAt time.Time           `json:"expected_at"` // perkiraan tanggal barang datang
+	Notes      string              `json:"notes"`
+	Items      []PurchaseOrderItem `json:"items" binding:"required,min=1,dive"`
+}
+
+// UpdatePurchaseOrder digunakan untuk payload pembaruan purchase order yang masih draft.
+// Seluruh baris diganti dengan baris pada payload.
+type UpdatePurchaseOrder struct {
+	SupplierID int64               `json:"supplier_id" binding:"required"`
+	ExpectedAt time.Time           `json:"expected_at"`
+	Notes      string              `json:"notes"`
+	Items      []PurchaseOrderItem `json:"items" binding:"required,min=1,dive"`
+}
+
+// CancelPurchaseOrder digunakan untuk payload pembatalan purchase order
+type CancelPurchaseOrder struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// PurchaseOrderItemData digunakan untuk menampilkan baris purchase order di response
+type PurchaseOrderItemData struct {
+	ID          int64   `json:"id"`
+	ProductID   int64   `json:"product_id"`
+	ProductName string  `json:"product_name"`
+	Quantity    int32   `json:"quantity"`
+	Received    int32   `json:"quantity_received"`
+	UnitCost    float64 `json:"unit_cost"`
+	Total       float64 `json:"total"`
+}
+
+// PurchaseOrderData digunakan untuk menampilkan purchase order di response
+type PurchaseOrderData struct {
+	ID           int64                   `json:"id"`
+	PONumber     string                  `json:"po_number"`
+	SupplierID   int64                   `json:"supplier_id"`
+	SupplierName string                  `json:"supplier_name,omitempty"`
+	Status       string                  `json:"status"`
+	ExpectedAt   time.Time               `json:"expected_at,omitempty"`
+	Notes        string                  `json:"notes,omitempty"`
+	TotalAmount  float64                 `json:"total_amount"`
+	SentBy       int64                   `json:"sent_by,omitempty"`
+	SentAt       time.Time               `json:"sent_at,omitempty"`
+	CancelledBy  int64                   `json:"cancelled_by,omitempty"`
+	CancelledAt  time.Time               `json:"cancelled_at,omitempty"`
+	CancelReason string                  `json:"cancel_reason,omitempty"`
+	ReceivedAt   time.Time               `json:"received_at,omitempty"`
+	CreatedBy    int64                   `json:"created_by,omitempty"`
+	CreatedAt    time.Time               `json:"created_at,omitempty"`
+	UpdatedBy    int64                   `json:"updated_by,omitempty"`
+	UpdatedAt    time.Time               `json:"updated_at,omitempty"`
+	Items        []PurchaseOrderItemData `json:"items,omitempty"`
+}
+
+// ReceivePurchaseOrderItem digunakan untuk payload jumlah, harga dan batch produk yang diterima
+type ReceivePurchaseOrderItem struct {
+	ProductID   int64     `json:"product_id" binding:"required"`
+	Quantity    int32     `json:"quantity" binding:"required,min=1"`
+	UnitCost    *float64  `json:"unit_cost" binding:"omitempty,min=0"` // harga pada faktur, kosong berarti sesuai purchase order
+	BatchNumber string    `json:"batch_number"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// ReceivePurchaseOrder digunakan untuk payload penerimaan barang dari supplier.
+// Penerimaan boleh sebagian atau melebihi pesanan, selisihnya ditandai.
+type ReceivePurchaseOrder struct {
+	Notes string                     `json:"notes"`
+	Items []ReceivePurchaseOrderItem `json:"items" binding:"required,min=1,dive"`
+}
+
+// PurchaseReceiptItemData digunakan untuk menampilkan produk yang diterima beserta selisihnya
+type PurchaseReceiptItemData struct {
+	ID               int64     `json:"id"`
+	ProductID        int64     `json:"product_id"`
+	ProductName      string    `json:"product_name"`
+	QuantityExpected int32     `json:"quantity_expected"` // sisa pesanan saat barang diterima
+	QuantityReceived int32     `json:"quantity_received"`
+	ExpectedUnitCost float64   `json:"expected_unit_cost"`
+	UnitCost         float64   `json:"unit_cost"`
+	BatchNumber      string    `json:"batch_number,omitempty"`
+	ExpiresAt        time.Time `json:"expires_at,omitempty"`
+	Discrepancies    []string  `json:"discrepancies"`
+}
+
+// PurchaseReceiptData digunakan untuk menampilkan penerimaan barang purchase order
+type PurchaseReceiptData struct {
+	ID              int64                     `json:"id"`
+	ReceiptNumber   string                    `json:"receipt_number"`
+	PurchaseOrderID int64                     `json:"purchase_order_id"`
+	Notes           string                    `json:"notes,omitempty"`
+	HasDiscrepancy  bool                      `json:"has_discrepancy"`
+	ReceivedBy      int64                     `json:"received_by,omitempty"`
+	ReceivedAt      time.Time                 `json:"received_at"`
+	Items           []PurchaseReceiptItemData `json:"items"`
+	PurchaseOrder   *PurchaseOrderData        `json:"purchase_order,omitempty"`
+}
+
+// ReorderSuggestionLine adalah produk yang disarankan untuk dipesan ulang
+type ReorderSuggestionLine struct {
+	ProductID       int64   `json:"product_id"`
+	ProductName     string  `json:"product_name"`
+	Stock           int32   `json:"stock"`
+	OnOrder         int32   `json:"on_order"` // belum diterima dari purchase order yang masih terbuka
+	MinStock        int32   `json:"min_stock"`
+	ReorderQuantity int32   `json:"reorder_quantity"`
+	Quantity        int32   `json:"quantity"`  // jumlah yang disarankan untuk dipesan
+	UnitCost        float64 `json:"unit_cost"` // harga pokok rata-rata produk
+	Total           float64 `json:"total"`
+}
+
+// ReorderSuggestionData adalah saran purchase order untuk satu supplier. Produk
+// tanpa supplier dikumpulkan dengan supplier_id 0.
+type ReorderSuggestionData struct {
+	SupplierID   int64                   `json:"supplier_id"`
+	SupplierName string                  `json:"supplier_name,omitempty"`
+	TotalAmount  float64                 `json:"total_amount"`
+	Items        []ReorderSuggestionLine `json:"items"`
+}
+
+// CreateReorderPurchaseOrders digunakan untuk payload pembuatan draft purchase order
+// dari saran pemesanan ulang. Tanpa supplier_ids, dibuat untuk semua supplier.
+type CreateReorderPurchaseOrders struct {
+	SupplierIDs []int64   `json:"supplier_ids"`
+	ExpectedAt  time.Time `json:"expected_at"`
+	Notes       string    `json:"notes"`
+}
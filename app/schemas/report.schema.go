@@ -6,18 +6,27 @@ import (
 )
 
 type OrderDetailResponse struct {
-	ID            int64             `json:"id"`
-	TrxNumber     string            `json:"trx_number"`
-	CashierID     int64             `json:"cashier_id"`
-	CustomerID    sql.NullInt64     `json:"customer_id"`
-	TotalAmount   float64           `json:"total_amount"`
-	PaymentMethod string            `json:"payment_method"`
-	Status        string            `json:"status"`
-	OrderDate     time.Time         `json:"order_date"`
-	UpdatedBy     sql.NullInt64     `json:"updated_by"`
-	UpdatedAt     sql.NullTime      `json:"updated_at"`
-	Customer      *CustomerResponse `json:"customer,omitempty"`
-	OrderItems    []OrderItemDetail `json:"order_items"`
+	ID                int64                  `json:"id"`
+	TrxNumber         string                 `json:"trx_number"`
+	CashierID         int64                  `json:"cashier_id"`
+	ShiftID           int64                  `json:"shift_id,omitempty"`
+	CustomerID        sql.NullInt64          `json:"customer_id"`
+	Subtotal          float64                `json:"subtotal"`
+	DiscountAmount    float64                `json:"discount_amount"`
+	VoucherCode       string                 `json:"voucher_code,omitempty"`
+	VoucherDiscount   float64                `json:"voucher_discount"`
+	TaxAmount         float64                `json:"tax_amount"`
+	TotalAmount       float64                `json:"total_amount"`
+	ChangeAmount      float64                `json:"change_amount"`
+	PaymentMethod     string                 `json:"payment_method"`
+	Status            string                 `json:"status"`
+	OrderDate         time.Time              `json:"order_date"`
+	UpdatedBy         sql.NullInt64          `json:"updated_by"`
+	UpdatedAt         sql.NullTime           `json:"updated_at"`
+	Customer          *CustomerResponse      `json:"customer,omitempty"`
+	OrderItems        []OrderItemDetail      `json:"order_items"`
+	AppliedPromotions []AppliedPromotionData `json:"applied_promotions"`
+	Payments          []OrderPaymentData     `json:"payments"`
 }
 
 type CustomerResponse struct {
@@ -29,11 +38,18 @@ type CustomerResponse struct {
 }
 
 type OrderItemDetail struct {
-	ID         int64   `json:"id"`
-	ProductID  int64   `json:"product_id"`
-	OldProduct string  `json:"old_product"`
-	Quantity   int32   `json:"quantity"`
-	UnitPrice  float64 `json:"unit_price"`
+	ID             int64   `json:"id"`
+	ProductID      int64   `json:"product_id"`
+	OldProduct     string  `json:"old_product"`
+	Quantity       int32   `json:"quantity"`
+	UnitPrice      float64 `json:"unit_price"`
+	DiscountAmount float64 `json:"discount_amount"`
+	TaxName        string  `json:"tax_name,omitempty"`
+	TaxRate        float64 `json:"tax_rate"`
+	TaxInclusive   bool    `json:"tax_inclusive"`
+	TaxAmount      float64 `json:"tax_amount"`
+	UnitCost       float64 `json:"unit_cost"`
+	Cogs           float64 `json:"cogs"`
 }
 
 type OrderListParams struct {
@@ -43,3 +59,29 @@ type OrderListParams struct {
 	CashierID  sql.NullInt64  `json:"cashier_id"`
 	Status     sql.NullString `json:"status"`
 }
+
+// ProfitData adalah laba kotor satu kelompok (produk, kategori, kasir, pelanggan atau hari)
+type ProfitData struct {
+	ID          int64   `json:"id,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	Date        string  `json:"date,omitempty"`
+	Quantity    int64   `json:"quantity"`
+	TotalOrders int64   `json:"total_orders,omitempty"`
+	Revenue     float64 `json:"revenue"`
+	Discount    float64 `json:"discount"`
+	Tax         float64 `json:"tax"`
+	NetSales    float64 `json:"net_sales"`
+	Refund      float64 `json:"refund"`
+	Cogs        float64 `json:"cogs"`
+	GrossProfit float64 `json:"gross_profit"`
+	Margin      float64 `json:"margin_percent"`
+}
+
+// ProfitReportData adalah laporan laba kotor pada satu periode beserta totalnya
+type ProfitReportData struct {
+	GroupBy   string       `json:"group_by"`
+	StartDate time.Time    `json:"start_date"`
+	EndDate   time.Time    `json:"end_date"`
+	Total     ProfitData   `json:"total"`
+	Rows      []ProfitData `json:"rows"`
+}
@@ -0,0 +1,87 @@
+package schemas
+
+import "time"
+
+// OpenShift digunakan untuk payload membuka shift kasir dengan modal awal di laci kas
+type OpenShift struct {
+	OpeningFloat float64 `json:"opening_float"`
+	Note         string  `json:"note"`
+}
+
+// CreateShiftCashMovement digunakan untuk payload kas masuk/keluar (kas kecil, setoran brankas)
+type CreateShiftCashMovement struct {
+	Type   string  `json:"type" binding:"required,oneof=cash_in cash_out"`
+	Amount float64 `json:"amount" binding:"required"`
+	Reason string  `json:"reason" binding:"required"`
+}
+
+// CloseShift digunakan untuk payload menutup shift dengan uang tunai hasil hitungan kasir
+type CloseShift struct {
+	CountedCash *float64 `json:"counted_cash" binding:"required"`
+	Note        string   `json:"note"`
+}
+
+// ShiftData digunakan untuk menampilkan data shift di response
+type ShiftData struct {
+	ID           int64     `json:"id"`
+	ShiftNumber  string    `json:"shift_number"`
+	CashierID    int64     `json:"cashier_id"`
+	CashierName  string    `json:"cashier_name,omitempty"`
+	OpeningFloat float64   `json:"opening_float"`
+	ExpectedCash *float64  `json:"expected_cash,omitempty"`
+	CountedCash  *float64  `json:"counted_cash,omitempty"`
+	Variance     *float64  `json:"variance,omitempty"`
+	Status       string    `json:"status"`
+	Note         string    `json:"note,omitempty"`
+	OpenedAt     time.Time `json:"opened_at"`
+	ClosedAt     time.Time `json:"closed_at,omitempty"`
+	ClosedBy     int64     `json:"closed_by,omitempty"`
+}
+
+// ShiftCashMovementData digunakan untuk menampilkan kas masuk/keluar di response
+type ShiftCashMovementData struct {
+	ID        int64     `json:"id"`
+	ShiftID   int64     `json:"shift_id"`
+	Type      string    `json:"type"`
+	Amount    float64   `json:"amount"`
+	Reason    string    `json:"reason"`
+	CreatedBy int64     `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// ShiftPaymentSummary digunakan untuk rincian penjualan per metode pembayaran pada laporan shift
+type ShiftPaymentSummary struct {
+	PaymentMethod string  `json:"payment_method"`
+	PaymentType   string  `json:"payment_type"`
+	TotalPayments int64   `json:"total_payments"`
+	Amount        float64 `json:"amount"`
+}
+
+// ShiftDrawerSummary digunakan untuk rekonsiliasi uang tunai di laci kas
+type ShiftDrawerSummary struct {
+	OpeningFloat float64  `json:"opening_float"`
+	CashSales    float64  `json:"cash_sales"`
+	CashRefunds  float64  `json:"cash_refunds"`
+	CashIn       float64  `json:"cash_in"`
+	CashOut      float64  `json:"cash_out"`
+	ExpectedCash float64  `json:"expected_cash"`
+	CountedCash  *float64 `json:"counted_cash,omitempty"`
+	Variance     *float64 `json:"variance,omitempty"`
+}
+
+// ShiftReport digunakan untuk laporan X (shift berjalan) dan Z (penutupan shift)
+type ShiftReport struct {
+	Type           string                  `json:"type"`
+	Shift          ShiftData               `json:"shift"`
+	TotalOrders    int64                   `json:"total_orders"`
+	Subtotal       float64                 `json:"subtotal"`
+	DiscountAmount float64                 `json:"discount_amount"`
+	TaxAmount      float64                 `json:"tax_amount"`
+	TotalSales     float64                 `json:"total_sales"`
+	TotalRefunds   int64                   `json:"total_refunds"`
+	RefundAmount   float64                 `json:"refund_amount"`
+	Payments       []ShiftPaymentSummary   `json:"payments"`
+	CashMovements  []ShiftCashMovementData `json:"cash_movements"`
+	Drawer         ShiftDrawerSummary      `json:"drawer"`
+	GeneratedAt    time.Time               `json:"generated_at"`
+}
@@ -0,0 +1,18 @@
+package schemas
+
+import "time"
+
+// StockAlertData digunakan untuk menampilkan alert stok menipis di response
+type StockAlertData struct {
+	ID          int64     `json:"id"`
+	ProductID   int64     `json:"product_id"`
+	ProductName string    `json:"product_name"`
+	TrxRef      string    `json:"trx_ref"` // transaksi yang membuat stok turun ke batas minimum
+	Stock       int32     `json:"stock"`
+	MinStock    int32     `json:"min_stock"`
+	Status      string    `json:"status"`
+	NotifiedAt  time.Time `json:"notified_at,omitempty"`
+	ResolvedAt  time.Time `json:"resolved_at,omitempty"`
+	CreatedBy   int64     `json:"created_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,66 @@
+package schemas
+
+import "time"
+
+// CreateStockTake digunakan untuk memulai sesi stock take
+type CreateStockTake struct {
+	Scope      string `json:"scope" binding:"required,oneof=full category"`
+	CategoryID int64  `json:"category_id" binding:"required_if=Scope category"`
+	Notes      string `json:"notes"`
+}
+
+// StockTakeCount adalah hitungan satu produk yang dikirim perangkat, produk
+// dicari dari barcode atau product_id
+type StockTakeCount struct {
+	Barcode   string `json:"barcode" binding:"required_without=ProductID"`
+	ProductID int64  `json:"product_id" binding:"required_without=Barcode"`
+	Quantity  int32  `json:"quantity" binding:"gte=0"`
+	Replace   bool   `json:"replace"` // ganti hitungan sebelumnya, bukan menambahkan
+	DeviceID  string `json:"device_id"`
+}
+
+// StockTakeReason adalah kode alasan selisih untuk satu produk
+type StockTakeReason struct {
+	ProductID  int64  `json:"product_id" binding:"required"`
+	ReasonCode string `json:"reason_code" binding:"required"`
+}
+
+// PostStockTake digunakan untuk memposting selisih hasil hitungan ke stok
+type PostStockTake struct {
+	ZeroUncounted bool              `json:"zero_uncounted"` // produk yang tidak dihitung dianggap habis
+	Reasons       []StockTakeReason `json:"reasons" binding:"dive"`
+}
+
+type StockTakeItemData struct {
+	ProductID   int64     `json:"product_id"`
+	ProductName string    `json:"product_name"`
+	Barcode     string    `json:"barcode,omitempty"`
+	Expected    int32     `json:"expected_quantity"`
+	Counted     *int32    `json:"counted_quantity"`
+	Variance    int32     `json:"variance"`
+	UnitCost    float64   `json:"unit_cost"`
+	Value       float64   `json:"variance_value"`
+	ReasonCode  string    `json:"reason_code,omitempty"`
+	CountedAt   time.Time `json:"counted_at,omitempty"`
+}
+
+type StockTakeData struct {
+	ID            int64               `json:"id"`
+	SessionNumber string              `json:"session_number"`
+	Scope         string              `json:"scope"`
+	CategoryID    int64               `json:"category_id,omitempty"`
+	CategoryName  string              `json:"category_name,omitempty"`
+	Status        string              `json:"status"`
+	Notes         string              `json:"notes,omitempty"`
+	StartedBy     int64               `json:"started_by"`
+	StartedAt     time.Time           `json:"started_at"`
+	PostedBy      int64               `json:"posted_by,omitempty"`
+	PostedAt      time.Time           `json:"posted_at,omitempty"`
+	CancelledBy   int64               `json:"cancelled_by,omitempty"`
+	CancelledAt   time.Time           `json:"cancelled_at,omitempty"`
+	TotalItems    int                 `json:"total_items,omitempty"`
+	CountedItems  int                 `json:"counted_items,omitempty"`
+	VarianceItems int                 `json:"variance_items,omitempty"`
+	VarianceValue float64             `json:"variance_value,omitempty"`
+	Items         []StockTakeItemData `json:"items,omitempty"`
+}
@@ -0,0 +1,38 @@
+package schemas
+
+import "time"
+
+// CreateSupplier digunakan untuk payload pembuatan supplier baru
+type CreateSupplier struct {
+	Name        string `json:"name" binding:"required"`
+	ContactName string `json:"contact_name"`
+	Phone       string `json:"phone"`
+	Email       string `json:"email" binding:"omitempty,email"`
+	Address     string `json:"address"`
+	Notes       string `json:"notes"`
+}
+
+// UpdateSupplier digunakan untuk payload pembaruan supplier
+type UpdateSupplier struct {
+	Name        string `json:"name" binding:"required"`
+	ContactName string `json:"contact_name"`
+	Phone       string `json:"phone"`
+	Email       string `json:"email" binding:"omitempty,email"`
+	Address     string `json:"address"`
+	Notes       string `json:"notes"`
+}
+
+// SupplierData digunakan untuk menampilkan data supplier di response
+type SupplierData struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	ContactName string    `json:"contact_name,omitempty"`
+	Phone       string    `json:"phone,omitempty"`
+	Email       string    `json:"email,omitempty"`
+	Address     string    `json:"address,omitempty"`
+	Notes       string    `json:"notes,omitempty"`
+	CreatedBy   int64     `json:"created_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+	UpdatedBy   int64     `json:"updated_by,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+}
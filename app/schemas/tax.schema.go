@@ -0,0 +1,31 @@
+package schemas
+
+import "time"
+
+// CreateTaxRate digunakan untuk payload pembuatan tarif pajak baru
+type CreateTaxRate struct {
+	Name        string  `json:"name" binding:"required"`
+	Rate        float64 `json:"rate" binding:"required"`
+	IsInclusive bool    `json:"is_inclusive"`
+}
+
+// UpdateTaxRate digunakan untuk payload pembaruan tarif pajak
+type UpdateTaxRate struct {
+	Name        string  `json:"name" binding:"required"`
+	Rate        float64 `json:"rate" binding:"required"`
+	IsInclusive bool    `json:"is_inclusive"`
+	IsActive    bool    `json:"is_active"`
+}
+
+// TaxRateData digunakan untuk menampilkan data tarif pajak di response
+type TaxRateData struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Rate        float64   `json:"rate"`
+	IsInclusive bool      `json:"is_inclusive"`
+	IsActive    bool      `json:"is_active"`
+	CreatedBy   int64     `json:"created_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+	UpdatedBy   int64     `json:"updated_by,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+}
@@ -8,6 +8,14 @@ type CreateOrderItem struct {
 	Quantity  int32 `json:"quantity" binding:"required,min=1"`
 }
 
+// CreateOrderPayment digunakan untuk payload pembayaran (tender) dalam order
+type CreateOrderPayment struct {
+	PaymentMethod string  `json:"payment_method" binding:"required"`
+	Amount        float64 `json:"amount" binding:"gte=0"` // 0 hanya untuk order dengan total 0
+	Reference     string  `json:"reference"`              // untuk gift card berisi kode kartu
+	Pin           string  `json:"pin"`                    // PIN gift card
+}
+
 type Customer struct {
 	Name  string `json:"name"`
 	Phone string `json:"phone,omitempty"`
@@ -19,11 +27,14 @@ type CreateOrder struct {
 	Type string `json:"type" binding:"required,oneof=new guest member"`
 	// - new: required: customer.name, customer.email, customer.phone
 	// - guest: required: guest_name
-	// - member: required: customer.id
-	Customer      Customer          `json:"customer"`
-	CustomerID    int64             `json:"customer_id"`
-	PaymentMethod string            `json:"payment_method" binding:"required,oneof=cash transfer"`
-	Items         []CreateOrderItem `json:"items" binding:"required,min=1,dive"`
+	// - member: required: customer_id atau member (kode member / nomor telepon)
+	Customer      Customer             `json:"customer"`
+	CustomerID    int64                `json:"customer_id"`
+	Member        string               `json:"member"`
+	PaymentMethod string               `json:"payment_method"`          // satu metode pembayaran
+	Payments      []CreateOrderPayment `json:"payments" binding:"dive"` // pembayaran terpisah (split payment)
+	VoucherCode   string               `json:"voucher_code"`
+	Items         []CreateOrderItem    `json:"items" binding:"required,min=1,dive"`
 }
 
 // OrderItemData digunakan untuk menampilkan data order item di response
@@ -40,17 +51,89 @@ type OrderItemData struct {
 
 // OrderData digunakan untuk menampilkan data order di response
 type OrderData struct {
-	ID            int64     `json:"id"`
-	TrxNumber     string    `json:"trx_number"`
-	CashierID     int64     `json:"cashier_id"`
-	CustomerID    int64     `json:"customer_id,omitempty"`
-	TotalAmount   string    `json:"total_amount"`
-	PaymentMethod string    `json:"payment_method"`
-	Status        string    `json:"status"`
-	OrderDate     time.Time `json:"order_date"`
+	ID                int64                  `json:"id"`
+	TrxNumber         string                 `json:"trx_number"`
+	CashierID         int64                  `json:"cashier_id"`
+	ShiftID           int64                  `json:"shift_id,omitempty"`
+	CustomerID        int64                  `json:"customer_id,omitempty"`
+	Subtotal          string                 `json:"subtotal"`
+	DiscountAmount    string                 `json:"discount_amount"`
+	VoucherCode       string                 `json:"voucher_code,omitempty"`
+	VoucherDiscount   string                 `json:"voucher_discount"`
+	TaxAmount         string                 `json:"tax_amount"`
+	TotalAmount       string                 `json:"total_amount"`
+	ChangeAmount      string                 `json:"change_amount"`
+	PaymentMethod     string                 `json:"payment_method"`
+	Status            string                 `json:"status"`
+	OrderDate         time.Time              `json:"order_date"`
+	AppliedPromotions []AppliedPromotionData `json:"applied_promotions,omitempty"`
+	Payments          []OrderPaymentData     `json:"payments,omitempty"`
+	PaymentCharge     *PaymentChargeData     `json:"payment_charge,omitempty"`
+	PointsEarned      int32                  `json:"points_earned,omitempty"`
+	PointsRedeemed    int32                  `json:"points_redeemed,omitempty"`
+	GiftCards         []IssuedGiftCardData   `json:"gift_cards,omitempty"`
+	StoreCredit       float64                `json:"store_credit,omitempty"`
 }
 
 type CreateRefund struct {
 	TrxNumber string `json:"trx_number" binding:"required"`
 	Reason    string `json:"reason" binding:"required"`
+	// - original (default): dikembalikan ke metode pembayaran semula
+	// - store_credit: pembayaran tunai/non gateway dikembalikan sebagai store credit pelanggan
+	RefundTo string `json:"refund_to" binding:"omitempty,oneof=original store_credit"`
+}
+
+// CreateParkedOrder digunakan untuk payload menahan (park) keranjang belanja dengan label
+type CreateParkedOrder struct {
+	Label        string            `json:"label" binding:"required"`
+	StoreCode    string            `json:"store_code"`
+	Terminal     string            `json:"terminal"`
+	Type         string            `json:"type" binding:"required,oneof=new guest member"`
+	Customer     Customer          `json:"customer"`
+	CustomerID   int64             `json:"customer_id"`
+	VoucherCode  string            `json:"voucher_code"`
+	ReserveStock bool              `json:"reserve_stock"` // stok ditahan sementara selama order di-park
+	Items        []CreateOrderItem `json:"items" binding:"required,min=1,dive"`
+}
+
+// ResumeParkedOrder digunakan untuk payload melanjutkan order yang di-park dari terminal mana pun
+type ResumeParkedOrder struct {
+	Terminal string `json:"terminal"`
+}
+
+// ConvertParkedOrder digunakan untuk payload pembayaran saat order yang di-park dijadikan order
+type ConvertParkedOrder struct {
+	PaymentMethod string               `json:"payment_method"`
+	Payments      []CreateOrderPayment `json:"payments" binding:"dive"`
+}
+
+// ParkedOrderItemData digunakan untuk menampilkan item order yang di-park di response
+type ParkedOrderItemData struct {
+	ProductID   int64   `json:"product_id"`
+	ProductName string  `json:"product_name"`
+	Quantity    int32   `json:"quantity"`
+	UnitPrice   float64 `json:"unit_price"`
+}
+
+// ParkedOrderData digunakan untuk menampilkan order yang di-park di response
+type ParkedOrderData struct {
+	ID              int64                 `json:"id"`
+	ParkNumber      string                `json:"park_number"`
+	Label           string                `json:"label"`
+	StoreCode       string                `json:"store_code"`
+	Terminal        string                `json:"terminal,omitempty"`
+	Type            string                `json:"type"`
+	CustomerID      int64                 `json:"customer_id,omitempty"`
+	Customer        *Customer             `json:"customer,omitempty"`
+	VoucherCode     string                `json:"voucher_code,omitempty"`
+	ReserveStock    bool                  `json:"reserve_stock"`
+	Status          string                `json:"status"`
+	OrderID         int64                 `json:"order_id,omitempty"`
+	ExpiresAt       time.Time             `json:"expires_at,omitempty"`
+	ResumedBy       int64                 `json:"resumed_by,omitempty"`
+	ResumedTerminal string                `json:"resumed_terminal,omitempty"`
+	ResumedAt       time.Time             `json:"resumed_at,omitempty"`
+	Items           []ParkedOrderItemData `json:"items,omitempty"`
+	CreatedBy       int64                 `json:"created_by,omitempty"`
+	CreatedAt       time.Time             `json:"created_at,omitempty"`
 }
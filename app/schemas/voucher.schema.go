@@ -0,0 +1,59 @@
+package schemas
+
+import "time"
+
+// CreateVoucher digunakan untuk payload pembuatan voucher baru
+type CreateVoucher struct {
+	Code             string    `json:"code" binding:"required"`
+	Description      string    `json:"description"`
+	ValueType        string    `json:"value_type" binding:"required,oneof=percentage fixed"`
+	Value            float64   `json:"value" binding:"required,gt=0"`
+	MaxDiscount      float64   `json:"max_discount"`
+	MinSpend         float64   `json:"min_spend"`
+	UsageLimit       int32     `json:"usage_limit"`
+	UsagePerCustomer int32     `json:"usage_per_customer"`
+	StartAt          time.Time `json:"start_at"`
+	EndAt            time.Time `json:"end_at"`
+}
+
+// UpdateVoucher digunakan untuk payload pembaruan voucher
+type UpdateVoucher struct {
+	CreateVoucher
+	IsActive bool `json:"is_active"`
+}
+
+// ValidateVoucher digunakan untuk payload pengecekan voucher sebelum dipakai
+type ValidateVoucher struct {
+	Code       string  `json:"code" binding:"required"`
+	CustomerID int64   `json:"customer_id"`
+	Amount     float64 `json:"amount" binding:"required,gt=0"`
+}
+
+// VoucherPreview digunakan untuk menampilkan hasil pengecekan voucher
+type VoucherPreview struct {
+	Code                string  `json:"code"`
+	Amount              float64 `json:"amount"`
+	DiscountAmount      float64 `json:"discount_amount"`
+	AmountAfterDiscount float64 `json:"amount_after_discount"`
+}
+
+// VoucherData digunakan untuk menampilkan data voucher di response
+type VoucherData struct {
+	ID               int64     `json:"id"`
+	Code             string    `json:"code"`
+	Description      string    `json:"description,omitempty"`
+	ValueType        string    `json:"value_type"`
+	Value            float64   `json:"value"`
+	MaxDiscount      float64   `json:"max_discount"`
+	MinSpend         float64   `json:"min_spend"`
+	UsageLimit       int32     `json:"usage_limit"`
+	UsagePerCustomer int32     `json:"usage_per_customer"`
+	UsedCount        int32     `json:"used_count"`
+	IsActive         bool      `json:"is_active"`
+	StartAt          time.Time `json:"start_at,omitempty"`
+	EndAt            time.Time `json:"end_at,omitempty"`
+	CreatedBy        int64     `json:"created_by,omitempty"`
+	CreatedAt        time.Time `json:"created_at,omitempty"`
+	UpdatedBy        int64     `json:"updated_by,omitempty"`
+	UpdatedAt        time.Time `json:"updated_at,omitempty"`
+}
@@ -6,11 +6,19 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
+	"pos-api/app/controllers"
 	"pos-api/app/middlewares"
 	"pos-api/app/routes"
 	dbCon "pos-api/db/sqlc"
 	"pos-api/util/config"
+	"pos-api/util/costing"
+	"pos-api/util/loyalty"
+	"pos-api/util/notifier"
+	"pos-api/util/payment"
+	"pos-api/util/receipt"
+	"pos-api/util/scheduler"
 	"pos-api/util/swagger"
 
 	"github.com/gin-gonic/gin"
@@ -18,10 +26,13 @@ import (
 )
 
 type Server struct {
-	engine *gin.Engine
-	db     *dbCon.Queries
-	ctx    context.Context
-	sqlDB  *sql.DB
+	engine    *gin.Engine
+	db        *dbCon.Queries
+	ctx       context.Context
+	sqlDB     *sql.DB
+	gateway   payment.Provider
+	notifiers notifier.Registry
+	config    config.Config
 }
 
 func NewServer(config config.Config) *Server {
@@ -36,10 +47,13 @@ func NewServer(config config.Config) *Server {
 	fmt.Println("PostgreSql connected successfully...")
 
 	server := &Server{
-		engine: gin.Default(),
-		db:     db,
-		ctx:    ctx,
-		sqlDB:  conn,
+		engine:    gin.Default(),
+		db:        db,
+		ctx:       ctx,
+		sqlDB:     conn,
+		gateway:   newPaymentProvider(config),
+		notifiers: newNotifiers(config),
+		config:    config,
 	}
 
 	// Initialize Swagger
@@ -47,9 +61,113 @@ func NewServer(config config.Config) *Server {
 
 	// Set up routes
 	server.setupRoutes()
+
+	// Set up background jobs
+	server.setupJobs()
 	return server
 }
 
+func newPaymentProvider(config config.Config) payment.Provider {
+	// webhook bersifat publik, tanpa secret siapa pun bisa memalsukan pembayaran
+	if config.PaymentWebhookSecret == "" {
+		log.Fatalf("PAYMENT_WEBHOOK_SECRET is required")
+	}
+
+	expiry := time.Duration(config.PaymentExpiryMinutes) * time.Minute
+	switch config.PaymentProvider {
+	case "fake":
+		return payment.NewFakeProvider(config.PaymentWebhookSecret, expiry)
+	case "":
+		log.Fatalf("PAYMENT_PROVIDER is required")
+	default:
+		log.Fatalf("Unknown payment provider: %s", config.PaymentProvider)
+	}
+	return nil
+}
+
+// newNotifiers mengaktifkan channel struk digital yang dikonfigurasi
+func newNotifiers(config config.Config) notifier.Registry {
+	notifiers := notifier.NewRegistry()
+	if config.SmtpHost != "" {
+		notifiers[notifier.ChannelEmail] = notifier.NewSMTPNotifier(config.SmtpHost, config.SmtpPort, config.SmtpUsername, config.SmtpPassword, config.SmtpFrom)
+	}
+	if config.WhatsappUrl != "" {
+		notifiers[notifier.ChannelWhatsApp] = notifier.NewHTTPNotifier(notifier.ChannelWhatsApp, config.WhatsappUrl, config.WhatsappToken)
+	}
+	return notifiers
+}
+
+func (s *Server) setupJobs() {
+	paymentController := controllers.NewPaymentController(s.db, s.sqlDB, s.gateway, s.ctx)
+	scheduler.Every(s.ctx, time.Minute, "expire pending payments", paymentController.ExpirePendingPayments)
+	scheduler.Every(s.ctx, time.Minute, "retry payment refunds", paymentController.RetryPaymentRefunds)
+
+	parkedOrderController := controllers.NewParkedOrderController(s.db, s.sqlDB, s.parkedOrderExpiry(), s.ctx)
+	scheduler.Every(s.ctx, time.Minute, "expire parked orders", parkedOrderController.ExpireParkedOrders)
+
+	notificationController := controllers.NewNotificationController(s.db, s.sqlDB, s.notifiers, s.receiptTemplate(), s.ctx)
+	scheduler.Every(s.ctx, 30*time.Second, "dispatch notifications", notificationController.DispatchNotifications)
+
+	stockAlertController := controllers.NewStockAlertController(s.db, s.sqlDB, s.notifiers, s.lowStockRecipients(), s.ctx)
+	scheduler.Every(s.ctx, time.Minute, "queue stock alerts", stockAlertController.QueueStockAlerts)
+
+	loyaltyController := controllers.NewLoyaltyController(s.db, s.sqlDB, s.loyaltyRules(), s.ctx)
+	scheduler.Every(s.ctx, time.Hour, "expire loyalty points", loyaltyController.ExpirePoints)
+
+	giftCardController := controllers.NewGiftCardController(s.db, s.sqlDB, s.ctx)
+	scheduler.Every(s.ctx, time.Hour, "expire gift cards", giftCardController.ExpireGiftCards)
+
+	customerTierController := controllers.NewCustomerTierController(s.db, s.sqlDB, s.config.CustomerTierWindowDays, s.ctx)
+	scheduler.Every(s.ctx, 24*time.Hour, "recalculate customer tiers", customerTierController.RecalculateCustomerTiers)
+}
+
+func (s *Server) receiptTemplate() receipt.Template {
+	return receipt.Template{
+		StoreName:    s.config.StoreName,
+		StoreAddress: s.config.StoreAddress,
+		StorePhone:   s.config.StorePhone,
+		Header:       s.config.ReceiptHeader,
+		Footer:       s.config.ReceiptFooter,
+		Width:        s.config.ReceiptWidth,
+	}
+}
+
+func (s *Server) loyaltyRules() loyalty.Rules {
+	return loyalty.Rules{
+		EarnAmount: s.config.LoyaltyEarnAmount,
+		PointValue: s.config.LoyaltyPointValue,
+		ExpiryDays: s.config.LoyaltyPointExpiryDays,
+	}
+}
+
+func (s *Server) parkedOrderExpiry() time.Duration {
+	return time.Duration(s.config.ParkedOrderExpiryMinutes) * time.Minute
+}
+
+// valuationMethod adalah metode penilaian persediaan, default FIFO
+func (s *Server) valuationMethod() string {
+	method := s.config.InventoryValuationMethod
+	if method == "" {
+		method = costing.MethodFIFO
+	}
+	if err := costing.Validate(method); err != nil {
+		log.Fatalf("Unknown inventory valuation method: %s", method)
+	}
+	return method
+}
+
+// lowStockRecipients mengembalikan penerima alert stok menipis per channel notifikasi
+func (s *Server) lowStockRecipients() map[string]string {
+	recipients := map[string]string{}
+	if s.config.LowStockAlertEmail != "" {
+		recipients[notifier.ChannelEmail] = s.config.LowStockAlertEmail
+	}
+	if s.config.LowStockAlertWhatsapp != "" {
+		recipients[notifier.ChannelWhatsApp] = s.config.LowStockAlertWhatsapp
+	}
+	return recipients
+}
+
 func (s *Server) setupRoutes() {
 	prefix := "/api/v1/"
 
@@ -87,6 +205,7 @@ func (s *Server) setupRoutes() {
 	// Public routes
 	group := s.engine.Group(prefix)
 	routes.SetupAuthRoutes(s.db, s.ctx, group)
+	routes.SetupPaymentWebhookRoutes(s.db, s.ctx, s.sqlDB, s.gateway, group)
 
 	// Protected routes with AuthMiddleware
 	protected := s.engine.Group(prefix)
@@ -94,9 +213,30 @@ func (s *Server) setupRoutes() {
 	routes.SetupUserRoutes(s.db, s.ctx, protected)
 	routes.SetupCategoryRoutes(s.db, s.ctx, protected)
 	routes.SetupCustomerRoutes(s.db, s.ctx, protected)
+	routes.SetupCustomerMergeRoutes(s.db, s.ctx, s.sqlDB, protected)
+	routes.SetupCustomerPrivacyRoutes(s.db, s.ctx, s.sqlDB, protected)
+	routes.SetupLoyaltyRoutes(s.db, s.ctx, s.sqlDB, s.loyaltyRules(), protected)
+	routes.SetupGiftCardRoutes(s.db, s.ctx, s.sqlDB, protected)
+	routes.SetupCustomerTierRoutes(s.db, s.ctx, s.sqlDB, s.config.CustomerTierWindowDays, protected)
 	routes.SetupProductRoutes(s.db, s.ctx, protected)
-	routes.SetupProductHistoryRoutes(s.db, s.ctx, protected)
-	routes.SetupTransactionRoutes(s.db, s.ctx, s.sqlDB, protected)
+	routes.SetupProductHistoryRoutes(s.db, s.ctx, s.sqlDB, s.valuationMethod(), protected)
+	routes.SetupInventoryRoutes(s.db, s.ctx, s.valuationMethod(), protected)
+	routes.SetupStockTakeRoutes(s.db, s.ctx, s.sqlDB, s.valuationMethod(), protected)
+	routes.SetupStockCheckRoutes(s.db, s.ctx, s.sqlDB, protected)
+	routes.SetupStockAlertRoutes(s.db, s.ctx, s.sqlDB, s.notifiers, s.lowStockRecipients(), protected)
+	routes.SetupSupplierRoutes(s.db, s.ctx, protected)
+	routes.SetupPurchaseOrderRoutes(s.db, s.ctx, s.sqlDB, s.receiptTemplate(), protected)
+	routes.SetupPurchaseReceiptRoutes(s.db, s.ctx, s.sqlDB, protected)
+	routes.SetupTaxRoutes(s.db, s.ctx, protected)
+	routes.SetupPromotionRoutes(s.db, s.ctx, s.sqlDB, protected)
+	routes.SetupVoucherRoutes(s.db, s.ctx, protected)
+	routes.SetupPaymentMethodRoutes(s.db, s.ctx, protected)
+	routes.SetupShiftRoutes(s.db, s.ctx, s.sqlDB, protected)
+	routes.SetupPaymentRoutes(s.db, s.ctx, s.sqlDB, s.gateway, protected)
+	routes.SetupTransactionRoutes(s.db, s.ctx, s.sqlDB, s.gateway, s.notifiers, s.loyaltyRules(), s.config.GiftCardExpiryDays, s.valuationMethod(), protected)
+	routes.SetupParkedOrderRoutes(s.db, s.ctx, s.sqlDB, s.gateway, s.notifiers, s.loyaltyRules(), s.config.GiftCardExpiryDays, s.valuationMethod(), s.parkedOrderExpiry(), protected)
+	routes.SetupReceiptRoutes(s.db, s.ctx, s.receiptTemplate(), protected)
+	routes.SetupNotificationRoutes(s.db, s.ctx, s.sqlDB, s.notifiers, s.receiptTemplate(), protected)
 	routes.SetupReportRoutes(s.db, s.ctx, protected)
 
 	// Handle 404
@@ -0,0 +1,6 @@
+ALTER TABLE order_items DROP COLUMN IF EXISTS discount_amount;
+ALTER TABLE orders DROP COLUMN IF EXISTS discount_amount;
+ALTER TABLE orders DROP COLUMN IF EXISTS subtotal;
+DROP TABLE IF EXISTS order_promotions;
+DROP TABLE IF EXISTS promotion_products;
+DROP TABLE IF EXISTS promotions;
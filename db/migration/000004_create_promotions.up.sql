@@ -0,0 +1,53 @@
+CREATE TABLE promotions (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    type VARCHAR NOT NULL,
+    priority INT NOT NULL DEFAULT 0,
+    stackable BOOLEAN NOT NULL DEFAULT FALSE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    category_id BIGINT REFERENCES categories(id) ON DELETE SET NULL,
+    buy_quantity INT NOT NULL DEFAULT 0,
+    get_quantity INT NOT NULL DEFAULT 0,
+    bundle_price DECIMAL NOT NULL DEFAULT 0,
+    discount_percent DECIMAL NOT NULL DEFAULT 0,
+    discount_amount DECIMAL NOT NULL DEFAULT 0,
+    min_spend DECIMAL NOT NULL DEFAULT 0,
+    daily_start VARCHAR,
+    daily_end VARCHAR,
+    days_of_week VARCHAR,
+    start_at TIMESTAMP,
+    end_at TIMESTAMP,
+    created_by BIGINT,
+    updated_by BIGINT,
+    deleted_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE promotion_products (
+    id BIGSERIAL PRIMARY KEY,
+    promotion_id BIGINT REFERENCES promotions(id) ON DELETE CASCADE,
+    product_id BIGINT REFERENCES products(id) ON DELETE CASCADE,
+    quantity INT NOT NULL DEFAULT 1
+);
+
+CREATE TABLE order_promotions (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT REFERENCES orders(id) ON DELETE CASCADE,
+    promotion_id BIGINT REFERENCES promotions(id) ON DELETE SET NULL,
+    promotion_name VARCHAR NOT NULL,
+    promotion_type VARCHAR NOT NULL,
+    discount_amount DECIMAL NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE orders
+    ADD COLUMN subtotal DECIMAL NOT NULL DEFAULT 0,
+    ADD COLUMN discount_amount DECIMAL NOT NULL DEFAULT 0;
+
+-- Existing orders had no discounts, so their subtotal is the total amount
+UPDATE orders SET subtotal = total_amount;
+
+ALTER TABLE order_items
+    ADD COLUMN discount_amount DECIMAL NOT NULL DEFAULT 0;
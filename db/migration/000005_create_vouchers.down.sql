@@ -0,0 +1,4 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS voucher_discount;
+ALTER TABLE orders DROP COLUMN IF EXISTS voucher_code;
+DROP TABLE IF EXISTS voucher_redemptions;
+DROP TABLE IF EXISTS vouchers;
@@ -0,0 +1,35 @@
+CREATE TABLE vouchers (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR UNIQUE NOT NULL,
+    description TEXT,
+    value_type VARCHAR NOT NULL,
+    value DECIMAL NOT NULL,
+    max_discount DECIMAL NOT NULL DEFAULT 0,
+    min_spend DECIMAL NOT NULL DEFAULT 0,
+    usage_limit INT NOT NULL DEFAULT 0,
+    usage_per_customer INT NOT NULL DEFAULT 0,
+    used_count INT NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    start_at TIMESTAMP,
+    end_at TIMESTAMP,
+    created_by BIGINT,
+    updated_by BIGINT,
+    deleted_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE voucher_redemptions (
+    id BIGSERIAL PRIMARY KEY,
+    voucher_id BIGINT REFERENCES vouchers(id) ON DELETE CASCADE,
+    order_id BIGINT REFERENCES orders(id) ON DELETE CASCADE,
+    customer_id BIGINT REFERENCES customers(id) ON DELETE SET NULL,
+    discount_amount DECIMAL NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'redeemed',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    reversed_at TIMESTAMP
+);
+
+ALTER TABLE orders ADD COLUMN voucher_code VARCHAR;
+ALTER TABLE orders ADD COLUMN voucher_discount DECIMAL NOT NULL DEFAULT 0;
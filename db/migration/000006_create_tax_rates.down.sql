@@ -0,0 +1,8 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS tax_amount;
+ALTER TABLE order_items DROP COLUMN IF EXISTS tax_amount;
+ALTER TABLE order_items DROP COLUMN IF EXISTS tax_inclusive;
+ALTER TABLE order_items DROP COLUMN IF EXISTS tax_rate;
+ALTER TABLE order_items DROP COLUMN IF EXISTS tax_name;
+ALTER TABLE products DROP COLUMN IF EXISTS tax_rate_id;
+ALTER TABLE categories DROP COLUMN IF EXISTS tax_rate_id;
+DROP TABLE IF EXISTS tax_rates;
@@ -0,0 +1,22 @@
+CREATE TABLE tax_rates (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    rate DECIMAL NOT NULL,
+    is_inclusive BOOLEAN NOT NULL DEFAULT FALSE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by BIGINT,
+    updated_by BIGINT,
+    deleted_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+ALTER TABLE categories ADD COLUMN tax_rate_id BIGINT REFERENCES tax_rates(id) ON DELETE SET NULL;
+ALTER TABLE products ADD COLUMN tax_rate_id BIGINT REFERENCES tax_rates(id) ON DELETE SET NULL;
+
+ALTER TABLE order_items ADD COLUMN tax_name VARCHAR;
+ALTER TABLE order_items ADD COLUMN tax_rate DECIMAL NOT NULL DEFAULT 0;
+ALTER TABLE order_items ADD COLUMN tax_inclusive BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE order_items ADD COLUMN tax_amount DECIMAL NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN tax_amount DECIMAL NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS change_amount;
+DROP TABLE IF EXISTS order_payments;
+DROP TABLE IF EXISTS payment_methods;
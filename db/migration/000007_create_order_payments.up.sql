@@ -0,0 +1,44 @@
+CREATE TABLE payment_methods (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR UNIQUE NOT NULL,
+    name VARCHAR NOT NULL,
+    type VARCHAR NOT NULL,
+    requires_reference BOOLEAN NOT NULL DEFAULT FALSE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by BIGINT,
+    updated_by BIGINT,
+    deleted_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE order_payments (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT REFERENCES orders(id) ON DELETE CASCADE,
+    payment_method_id BIGINT REFERENCES payment_methods(id) ON DELETE SET NULL,
+    payment_method VARCHAR NOT NULL,
+    amount DECIMAL NOT NULL,
+    tendered_amount DECIMAL NOT NULL DEFAULT 0,
+    change_amount DECIMAL NOT NULL DEFAULT 0,
+    reference VARCHAR,
+    created_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE orders ADD COLUMN change_amount DECIMAL NOT NULL DEFAULT 0;
+
+-- Seeder for payment methods used before payment methods were configurable
+INSERT INTO payment_methods (code, name, type, created_by, created_at)
+SELECT 'cash', 'Cash', 'cash', 1, CURRENT_TIMESTAMP
+WHERE NOT EXISTS (SELECT 1 FROM payment_methods WHERE code = 'cash');
+
+INSERT INTO payment_methods (code, name, type, requires_reference, created_by, created_at)
+SELECT 'transfer', 'Bank Transfer', 'transfer', TRUE, 1, CURRENT_TIMESTAMP
+WHERE NOT EXISTS (SELECT 1 FROM payment_methods WHERE code = 'transfer');
+
+-- Existing orders were paid with a single tender
+INSERT INTO order_payments (order_id, payment_method_id, payment_method, amount, tendered_amount, created_by, created_at)
+SELECT o.id, pm.id, o.payment_method, o.total_amount, o.total_amount, o.cashier_id, o.order_date
+FROM orders o
+LEFT JOIN payment_methods pm ON pm.code = o.payment_method;
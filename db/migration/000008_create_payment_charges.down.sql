@@ -0,0 +1,4 @@
+DELETE FROM payment_methods WHERE code = 'qris';
+ALTER TABLE payment_methods DROP COLUMN IF EXISTS gateway;
+DROP TABLE IF EXISTS payment_refunds;
+DROP TABLE IF EXISTS payment_charges;
@@ -0,0 +1,41 @@
+CREATE TABLE payment_charges (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT REFERENCES orders(id) ON DELETE CASCADE,
+    provider VARCHAR NOT NULL,
+    charge_id VARCHAR UNIQUE NOT NULL,
+    payment_method VARCHAR NOT NULL,
+    amount DECIMAL NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'pending',
+    payment_url TEXT,
+    qr_string TEXT,
+    expires_at TIMESTAMP,
+    paid_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+-- Refund of a gateway charge, recorded before the gateway is called so a failed
+-- call can be retried with the same idempotency key instead of refunding twice
+CREATE TABLE payment_refunds (
+    id BIGSERIAL PRIMARY KEY,
+    payment_charge_id BIGINT NOT NULL UNIQUE REFERENCES payment_charges(id) ON DELETE CASCADE,
+    order_id BIGINT REFERENCES orders(id) ON DELETE CASCADE,
+    amount DECIMAL NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT,
+    refunded_at TIMESTAMP,
+    created_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX payment_refunds_due_idx ON payment_refunds (next_attempt_at) WHERE status = 'pending';
+
+ALTER TABLE payment_methods ADD COLUMN gateway BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Seeder for QRIS paid through the payment gateway
+INSERT INTO payment_methods (code, name, type, gateway, created_by, created_at)
+SELECT 'qris', 'QRIS', 'ewallet', TRUE, 1, CURRENT_TIMESTAMP
+WHERE NOT EXISTS (SELECT 1 FROM payment_methods WHERE code = 'qris');
@@ -0,0 +1,30 @@
+CREATE TABLE shifts (
+    id BIGSERIAL PRIMARY KEY,
+    shift_number VARCHAR UNIQUE NOT NULL,
+    cashier_id BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    opening_float DECIMAL NOT NULL DEFAULT 0,
+    expected_cash DECIMAL,
+    counted_cash DECIMAL,
+    variance DECIMAL,
+    status VARCHAR NOT NULL DEFAULT 'open',
+    note VARCHAR,
+    opened_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    closed_at TIMESTAMP,
+    closed_by BIGINT
+);
+
+-- A cashier can only have one open shift at a time
+CREATE UNIQUE INDEX shifts_open_cashier_idx ON shifts (cashier_id) WHERE status = 'open';
+
+CREATE TABLE shift_cash_movements (
+    id BIGSERIAL PRIMARY KEY,
+    shift_id BIGINT REFERENCES shifts(id) ON DELETE CASCADE,
+    type VARCHAR NOT NULL,
+    amount DECIMAL NOT NULL,
+    reason VARCHAR NOT NULL,
+    created_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE orders ADD COLUMN shift_id BIGINT REFERENCES shifts(id) ON DELETE SET NULL;
+ALTER TABLE refunds ADD COLUMN shift_id BIGINT REFERENCES shifts(id) ON DELETE SET NULL;
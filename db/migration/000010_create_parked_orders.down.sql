@@ -0,0 +1,3 @@
+ALTER TABLE products DROP COLUMN IF EXISTS reserved_stock;
+DROP TABLE IF EXISTS parked_order_items;
+DROP TABLE IF EXISTS parked_orders;
@@ -0,0 +1,34 @@
+CREATE TABLE parked_orders (
+    id BIGSERIAL PRIMARY KEY,
+    park_number VARCHAR UNIQUE NOT NULL,
+    label VARCHAR NOT NULL,
+    store_code VARCHAR NOT NULL DEFAULT 'default',
+    terminal VARCHAR,
+    customer_type VARCHAR NOT NULL,
+    customer_id BIGINT REFERENCES customers(id) ON DELETE SET NULL,
+    customer_name VARCHAR,
+    customer_phone VARCHAR,
+    customer_email VARCHAR,
+    voucher_code VARCHAR,
+    reserve_stock BOOLEAN NOT NULL DEFAULT FALSE,
+    status VARCHAR NOT NULL DEFAULT 'held',
+    order_id BIGINT REFERENCES orders(id) ON DELETE SET NULL,
+    expires_at TIMESTAMP,
+    resumed_by BIGINT,
+    resumed_terminal VARCHAR,
+    resumed_at TIMESTAMP,
+    created_by BIGINT,
+    updated_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE parked_order_items (
+    id BIGSERIAL PRIMARY KEY,
+    parked_order_id BIGINT REFERENCES parked_orders(id) ON DELETE CASCADE,
+    product_id BIGINT REFERENCES products(id) ON DELETE CASCADE,
+    quantity INT NOT NULL
+);
+
+-- Stock softly reserved by held orders, available stock is stock - reserved_stock
+ALTER TABLE products ADD COLUMN reserved_stock INT NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS last_printed_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS receipt_print_count;
@@ -0,0 +1,2 @@
+ALTER TABLE orders ADD COLUMN receipt_print_count INT NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN last_printed_at TIMESTAMP;
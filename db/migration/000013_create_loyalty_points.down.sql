@@ -0,0 +1,3 @@
+DELETE FROM payment_methods WHERE code = 'points';
+ALTER TABLE categories DROP COLUMN IF EXISTS points_multiplier;
+DROP TABLE IF EXISTS loyalty_points;
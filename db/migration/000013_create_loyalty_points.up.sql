@@ -0,0 +1,22 @@
+CREATE TABLE loyalty_points (
+    id BIGSERIAL PRIMARY KEY,
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    order_id BIGINT REFERENCES orders(id) ON DELETE SET NULL,
+    type VARCHAR NOT NULL,
+    points INT NOT NULL,
+    remaining INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP,
+    description VARCHAR,
+    created_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Lots (earn/return) that still hold points, used for FIFO redemption and expiry
+CREATE INDEX loyalty_points_lots_idx ON loyalty_points (customer_id, expires_at) WHERE remaining > 0;
+
+ALTER TABLE categories ADD COLUMN points_multiplier DECIMAL NOT NULL DEFAULT 1;
+
+-- Seeder for paying with loyalty points
+INSERT INTO payment_methods (code, name, type, created_by, created_at)
+SELECT 'points', 'Loyalty Points', 'points', 1, CURRENT_TIMESTAMP
+WHERE NOT EXISTS (SELECT 1 FROM payment_methods WHERE code = 'points');
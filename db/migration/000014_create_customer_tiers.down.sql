@@ -0,0 +1,5 @@
+ALTER TABLE customers DROP COLUMN IF EXISTS tier_updated_at;
+ALTER TABLE customers DROP COLUMN IF EXISTS tier_id;
+DROP TABLE IF EXISTS customer_tier_changes;
+DROP TABLE IF EXISTS customer_tier_prices;
+DROP TABLE IF EXISTS customer_tiers;
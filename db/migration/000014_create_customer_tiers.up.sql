@@ -0,0 +1,39 @@
+CREATE TABLE customer_tiers (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR UNIQUE NOT NULL,
+    name VARCHAR NOT NULL,
+    min_spend DECIMAL NOT NULL DEFAULT 0,
+    discount_percent DECIMAL NOT NULL DEFAULT 0,
+    updated_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+-- Tier specific price list, overrides the tier discount percent for a product
+CREATE TABLE customer_tier_prices (
+    id BIGSERIAL PRIMARY KEY,
+    tier_id BIGINT NOT NULL REFERENCES customer_tiers(id) ON DELETE CASCADE,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    price DECIMAL NOT NULL,
+    created_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (tier_id, product_id)
+);
+
+CREATE TABLE customer_tier_changes (
+    id BIGSERIAL PRIMARY KEY,
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    old_tier_id BIGINT REFERENCES customer_tiers(id) ON DELETE SET NULL,
+    new_tier_id BIGINT REFERENCES customer_tiers(id) ON DELETE SET NULL,
+    rolling_spend DECIMAL NOT NULL,
+    changed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE customers ADD COLUMN tier_id BIGINT REFERENCES customer_tiers(id) ON DELETE SET NULL;
+ALTER TABLE customers ADD COLUMN tier_updated_at TIMESTAMP;
+
+-- Seeder for default tiers
+INSERT INTO customer_tiers (code, name, min_spend, discount_percent) VALUES
+    ('silver', 'Silver', 1000000, 2),
+    ('gold', 'Gold', 5000000, 5),
+    ('platinum', 'Platinum', 15000000, 10);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_customers_member_code_lower;
+DROP INDEX IF EXISTS idx_customers_phone_normalized;
+DROP INDEX IF EXISTS idx_customers_email_trgm;
+DROP INDEX IF EXISTS idx_customers_name_trgm;
+DROP FUNCTION IF EXISTS normalize_phone(VARCHAR);
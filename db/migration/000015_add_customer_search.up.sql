@@ -0,0 +1,11 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Normalizes Indonesian phone numbers so +62 812..., 62812... and 0812... are equal
+CREATE OR REPLACE FUNCTION normalize_phone(phone VARCHAR) RETURNS VARCHAR AS $$
+    SELECT regexp_replace(regexp_replace(regexp_replace(COALESCE(phone, ''), '[^0-9]', '', 'g'), '^62', '0'), '^8', '08');
+$$ LANGUAGE SQL IMMUTABLE;
+
+CREATE INDEX idx_customers_name_trgm ON customers USING GIN (lower(name) gin_trgm_ops);
+CREATE INDEX idx_customers_email_trgm ON customers USING GIN (lower(email) gin_trgm_ops);
+CREATE INDEX idx_customers_phone_normalized ON customers (normalize_phone(phone));
+CREATE INDEX idx_customers_member_code_lower ON customers (lower(member_code));
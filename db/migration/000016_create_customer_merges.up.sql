@@ -0,0 +1,16 @@
+-- Audit trail of merged duplicate customers, keeps a snapshot of the removed customer
+CREATE TABLE customer_merges (
+    id BIGSERIAL PRIMARY KEY,
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    merged_customer_id BIGINT REFERENCES customers(id) ON DELETE SET NULL,
+    merged_member_code VARCHAR NOT NULL,
+    merged_name VARCHAR NOT NULL,
+    merged_phone VARCHAR,
+    merged_email VARCHAR,
+    orders_moved INT NOT NULL DEFAULT 0,
+    points_moved INT NOT NULL DEFAULT 0,
+    merged_by BIGINT,
+    merged_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX customer_merges_customer_idx ON customer_merges (customer_id);
@@ -0,0 +1,5 @@
+DELETE FROM payment_methods WHERE code IN ('gift_card', 'store_credit');
+ALTER TABLE refunds DROP COLUMN IF EXISTS refund_method;
+ALTER TABLE products DROP COLUMN IF EXISTS is_gift_card;
+DROP TABLE IF EXISTS gift_card_transactions;
+DROP TABLE IF EXISTS gift_cards;
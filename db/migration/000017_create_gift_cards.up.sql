@@ -0,0 +1,52 @@
+-- Prepaid balances: gift cards (code + PIN) and customer store credit
+CREATE TABLE gift_cards (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR UNIQUE NOT NULL,
+    pin_hash VARCHAR,
+    -- wrong PIN attempts, the card is locked for a while after too many
+    failed_pin_attempts INT NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP,
+    type VARCHAR NOT NULL,
+    customer_id BIGINT REFERENCES customers(id) ON DELETE SET NULL,
+    order_id BIGINT REFERENCES orders(id) ON DELETE SET NULL,
+    initial_amount DECIMAL NOT NULL,
+    balance DECIMAL NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'active',
+    expires_at TIMESTAMP,
+    created_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+-- A customer has a single store credit account
+CREATE UNIQUE INDEX gift_cards_store_credit_idx ON gift_cards (customer_id) WHERE type = 'store_credit';
+CREATE INDEX gift_cards_order_idx ON gift_cards (order_id);
+CREATE INDEX gift_cards_expiry_idx ON gift_cards (expires_at) WHERE status = 'active';
+
+-- Ledger of issues, redemptions, reversals and expiries
+CREATE TABLE gift_card_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    gift_card_id BIGINT NOT NULL REFERENCES gift_cards(id) ON DELETE CASCADE,
+    order_id BIGINT REFERENCES orders(id) ON DELETE SET NULL,
+    type VARCHAR NOT NULL,
+    amount DECIMAL NOT NULL,
+    balance DECIMAL NOT NULL,
+    description VARCHAR,
+    created_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX gift_card_transactions_card_idx ON gift_card_transactions (gift_card_id);
+CREATE INDEX gift_card_transactions_order_idx ON gift_card_transactions (order_id);
+
+ALTER TABLE products ADD COLUMN is_gift_card BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE refunds ADD COLUMN refund_method VARCHAR NOT NULL DEFAULT 'original';
+
+-- Seeder for paying with gift cards and store credit
+INSERT INTO payment_methods (code, name, type, requires_reference, created_by, created_at)
+SELECT 'gift_card', 'Gift Card', 'gift_card', TRUE, 1, CURRENT_TIMESTAMP
+WHERE NOT EXISTS (SELECT 1 FROM payment_methods WHERE code = 'gift_card');
+
+INSERT INTO payment_methods (code, name, type, created_by, created_at)
+SELECT 'store_credit', 'Store Credit', 'store_credit', 1, CURRENT_TIMESTAMP
+WHERE NOT EXISTS (SELECT 1 FROM payment_methods WHERE code = 'store_credit');
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS customer_consents;
+ALTER TABLE customers DROP COLUMN IF EXISTS anonymized_at;
+ALTER TABLE customers DROP COLUMN IF EXISTS consent_updated_at;
+ALTER TABLE customers DROP COLUMN IF EXISTS whatsapp_marketing_consent;
+ALTER TABLE customers DROP COLUMN IF EXISTS email_marketing_consent;
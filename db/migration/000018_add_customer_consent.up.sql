@@ -0,0 +1,17 @@
+ALTER TABLE customers ADD COLUMN email_marketing_consent BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE customers ADD COLUMN whatsapp_marketing_consent BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE customers ADD COLUMN consent_updated_at TIMESTAMP;
+ALTER TABLE customers ADD COLUMN anonymized_at TIMESTAMP;
+
+-- History of marketing consent given or withdrawn, kept as evidence for the PDP law
+CREATE TABLE customer_consents (
+    id BIGSERIAL PRIMARY KEY,
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    channel VARCHAR NOT NULL,
+    granted BOOLEAN NOT NULL,
+    source VARCHAR,
+    created_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX customer_consents_customer_idx ON customer_consents (customer_id);
@@ -0,0 +1,49 @@
+CREATE TABLE suppliers (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    contact_name VARCHAR,
+    phone VARCHAR,
+    email VARCHAR,
+    address VARCHAR,
+    notes VARCHAR,
+    created_by BIGINT,
+    updated_by BIGINT,
+    deleted_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE purchase_orders (
+    id BIGSERIAL PRIMARY KEY,
+    po_number VARCHAR NOT NULL UNIQUE,
+    supplier_id BIGINT NOT NULL REFERENCES suppliers(id),
+    status VARCHAR NOT NULL DEFAULT 'draft',
+    expected_at TIMESTAMP,
+    notes VARCHAR,
+    total_amount DECIMAL NOT NULL DEFAULT 0,
+    sent_by BIGINT,
+    sent_at TIMESTAMP,
+    cancelled_by BIGINT,
+    cancelled_at TIMESTAMP,
+    cancel_reason VARCHAR,
+    created_by BIGINT,
+    updated_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX purchase_orders_supplier_idx ON purchase_orders (supplier_id);
+CREATE INDEX purchase_orders_status_idx ON purchase_orders (status);
+
+-- Ordered lines with the expected cost agreed with the supplier
+CREATE TABLE purchase_order_items (
+    id BIGSERIAL PRIMARY KEY,
+    purchase_order_id BIGINT NOT NULL REFERENCES purchase_orders(id) ON DELETE CASCADE,
+    product_id BIGINT NOT NULL REFERENCES products(id),
+    quantity INT NOT NULL,
+    unit_cost DECIMAL NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX purchase_order_items_order_idx ON purchase_order_items (purchase_order_id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS purchase_receipt_items;
+DROP TABLE IF EXISTS purchase_receipts;
+ALTER TABLE purchase_orders DROP COLUMN IF EXISTS received_at;
+ALTER TABLE purchase_order_items DROP COLUMN IF EXISTS quantity_received;
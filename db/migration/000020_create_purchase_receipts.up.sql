@@ -0,0 +1,32 @@
+ALTER TABLE purchase_order_items ADD COLUMN quantity_received INT NOT NULL DEFAULT 0;
+ALTER TABLE purchase_orders ADD COLUMN received_at TIMESTAMP;
+
+-- A delivery received against a purchase order, a purchase order can be received in several deliveries
+CREATE TABLE purchase_receipts (
+    id BIGSERIAL PRIMARY KEY,
+    receipt_number VARCHAR NOT NULL UNIQUE,
+    purchase_order_id BIGINT NOT NULL REFERENCES purchase_orders(id) ON DELETE CASCADE,
+    notes VARCHAR,
+    has_discrepancy BOOLEAN NOT NULL DEFAULT FALSE,
+    received_by BIGINT,
+    received_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX purchase_receipts_order_idx ON purchase_receipts (purchase_order_id);
+
+CREATE TABLE purchase_receipt_items (
+    id BIGSERIAL PRIMARY KEY,
+    purchase_receipt_id BIGINT NOT NULL REFERENCES purchase_receipts(id) ON DELETE CASCADE,
+    purchase_order_item_id BIGINT NOT NULL REFERENCES purchase_order_items(id) ON DELETE CASCADE,
+    product_id BIGINT NOT NULL REFERENCES products(id),
+    quantity_expected INT NOT NULL,
+    quantity_received INT NOT NULL,
+    expected_unit_cost DECIMAL NOT NULL,
+    unit_cost DECIMAL NOT NULL,
+    batch_number VARCHAR,
+    expires_at TIMESTAMP,
+    discrepancy VARCHAR,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX purchase_receipt_items_receipt_idx ON purchase_receipt_items (purchase_receipt_id);
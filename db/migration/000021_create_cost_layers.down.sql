@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS stock_cost_movements;
+DROP TABLE IF EXISTS cost_layers;
+ALTER TABLE order_items DROP COLUMN IF EXISTS cogs;
+ALTER TABLE order_items DROP COLUMN IF EXISTS unit_cost;
+ALTER TABLE products DROP COLUMN IF EXISTS cost;
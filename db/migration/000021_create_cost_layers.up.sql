@@ -0,0 +1,45 @@
+-- Moving weighted average purchase cost of the product
+ALTER TABLE products ADD COLUMN cost DECIMAL NOT NULL DEFAULT 0;
+
+-- Cost of goods sold, captured per line at sale time
+ALTER TABLE order_items ADD COLUMN unit_cost DECIMAL NOT NULL DEFAULT 0;
+ALTER TABLE order_items ADD COLUMN cogs DECIMAL NOT NULL DEFAULT 0;
+
+-- Stock received at a cost (purchases, returns, adjustments), consumed oldest first
+CREATE TABLE cost_layers (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    source VARCHAR NOT NULL,
+    trx_ref VARCHAR NOT NULL,
+    quantity INT NOT NULL,
+    remaining INT NOT NULL,
+    unit_cost DECIMAL NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX cost_layers_open_idx ON cost_layers (product_id, created_at) WHERE remaining > 0;
+
+-- Quantity and value of every stock movement, summed up to a date for the inventory valuation
+CREATE TABLE stock_cost_movements (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    trx_ref VARCHAR NOT NULL,
+    quantity INT NOT NULL,
+    unit_cost DECIMAL NOT NULL,
+    amount DECIMAL NOT NULL,
+    method VARCHAR NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX stock_cost_movements_product_idx ON stock_cost_movements (product_id, created_at);
+
+-- Stock on hand before cost tracking has no known cost
+INSERT INTO cost_layers (product_id, source, trx_ref, quantity, remaining, unit_cost)
+SELECT id, 'opening', 'OPENING', stock, stock, 0
+FROM products
+WHERE stock > 0;
+
+INSERT INTO stock_cost_movements (product_id, trx_ref, quantity, unit_cost, amount, method)
+SELECT id, 'OPENING', stock, 0, 0, 'opening'
+FROM products
+WHERE stock <> 0;
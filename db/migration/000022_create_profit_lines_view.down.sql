@@ -0,0 +1 @@
+DROP VIEW IF EXISTS profit_lines;
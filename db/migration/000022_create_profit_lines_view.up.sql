@@ -0,0 +1,26 @@
+-- Order item lines for the gross profit report: sales are dated on the order date,
+-- refunds are dated on the refund date and reverse the sale
+CREATE VIEW profit_lines AS
+SELECT oi.product_id, oi.old_product, o.id AS order_id, o.cashier_id, o.customer_id,
+    o.order_date AS trx_date,
+    oi.quantity::BIGINT AS quantity,
+    (oi.unit_price * oi.quantity)::DECIMAL AS revenue,
+    oi.discount_amount::DECIMAL AS discount,
+    (CASE WHEN oi.tax_inclusive THEN oi.tax_amount ELSE 0 END)::DECIMAL AS tax,
+    0::DECIMAL AS refund,
+    oi.cogs::DECIMAL AS cogs
+FROM order_items oi
+JOIN orders o ON oi.order_id = o.id
+WHERE o.status IN ('order', 'paid', 'refunded')
+UNION ALL
+SELECT oi.product_id, oi.old_product, o.id AS order_id, o.cashier_id, o.customer_id,
+    r.refund_at AS trx_date,
+    (-oi.quantity)::BIGINT AS quantity,
+    (-oi.unit_price * oi.quantity)::DECIMAL AS revenue,
+    (-oi.discount_amount)::DECIMAL AS discount,
+    (CASE WHEN oi.tax_inclusive THEN -oi.tax_amount ELSE 0 END)::DECIMAL AS tax,
+    (oi.unit_price * oi.quantity - oi.discount_amount - CASE WHEN oi.tax_inclusive THEN oi.tax_amount ELSE 0 END)::DECIMAL AS refund,
+    (-oi.cogs)::DECIMAL AS cogs
+FROM order_items oi
+JOIN orders o ON oi.order_id = o.id
+JOIN refunds r ON r.order_id = o.id;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS stock_take_counts;
+DROP TABLE IF EXISTS stock_take_items;
+DROP TABLE IF EXISTS stock_takes;
+DROP INDEX IF EXISTS products_barcode_idx;
+ALTER TABLE products DROP COLUMN IF EXISTS barcode;
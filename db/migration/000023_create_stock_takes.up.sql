@@ -0,0 +1,47 @@
+-- Barcode printed on the product, used to enter stock counts by scanning
+ALTER TABLE products ADD COLUMN barcode VARCHAR;
+CREATE UNIQUE INDEX products_barcode_idx ON products (barcode) WHERE barcode IS NOT NULL;
+
+-- Physical inventory counting session, for all products or a single category
+CREATE TABLE stock_takes (
+    id BIGSERIAL PRIMARY KEY,
+    session_number VARCHAR NOT NULL UNIQUE,
+    scope VARCHAR NOT NULL DEFAULT 'full',
+    category_id BIGINT REFERENCES categories(id) ON DELETE SET NULL,
+    status VARCHAR NOT NULL DEFAULT 'counting',
+    notes VARCHAR,
+    started_by BIGINT,
+    started_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    posted_by BIGINT,
+    posted_at TIMESTAMP,
+    cancelled_by BIGINT,
+    cancelled_at TIMESTAMP
+);
+
+-- Stock of every product frozen when the session starts, with the quantity counted so far
+CREATE TABLE stock_take_items (
+    id BIGSERIAL PRIMARY KEY,
+    stock_take_id BIGINT NOT NULL REFERENCES stock_takes(id) ON DELETE CASCADE,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    expected_quantity INT NOT NULL,
+    counted_quantity INT,
+    unit_cost DECIMAL NOT NULL DEFAULT 0,
+    reason_code VARCHAR,
+    counted_at TIMESTAMP,
+    UNIQUE (stock_take_id, product_id)
+);
+
+-- Every count entry sent by a device, counts of the same product from several devices are added up
+CREATE TABLE stock_take_counts (
+    id BIGSERIAL PRIMARY KEY,
+    stock_take_id BIGINT NOT NULL REFERENCES stock_takes(id) ON DELETE CASCADE,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    barcode VARCHAR,
+    quantity INT NOT NULL,
+    replace BOOLEAN NOT NULL DEFAULT FALSE,
+    device_id VARCHAR,
+    counted_by BIGINT,
+    counted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX stock_take_counts_session_idx ON stock_take_counts (stock_take_id);
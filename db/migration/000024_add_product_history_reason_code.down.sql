@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS product_history_type_idx;
+
+UPDATE product_history
+SET type = 'out', quantity_change = -quantity_change
+WHERE quantity_change < 0;
+
+UPDATE product_history
+SET type = 'in'
+WHERE type <> 'out';
+
+ALTER TABLE product_history DROP COLUMN IF EXISTS reason_code;
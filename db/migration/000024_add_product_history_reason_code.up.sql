@@ -0,0 +1,33 @@
+-- Controlled reason code next to the free text reason
+ALTER TABLE product_history ADD COLUMN reason_code VARCHAR;
+
+-- Typed movements: quantity_change is signed, negative when stock goes out
+UPDATE product_history
+SET type = 'sale', reason_code = 'order', quantity_change = -quantity_change
+WHERE type = 'out' AND reason = 'Order';
+
+UPDATE product_history
+SET type = 'refund', reason_code = 'customer_return'
+WHERE type = 'in' AND reason = 'Refund';
+
+UPDATE product_history
+SET type = 'refund', reason_code = 'payment_expired'
+WHERE type = 'in' AND reason = 'Payment Expired';
+
+UPDATE product_history
+SET type = 'purchase', reason_code = 'purchase_order'
+WHERE type = 'in' AND reason LIKE 'Purchase order receipt%';
+
+UPDATE product_history
+SET reason_code = reason
+WHERE type = 'adjustment';
+
+UPDATE product_history
+SET type = 'adjustment', reason_code = 'correction'
+WHERE type = 'in';
+
+UPDATE product_history
+SET type = 'adjustment', reason_code = 'correction', quantity_change = -quantity_change
+WHERE type = 'out';
+
+CREATE INDEX product_history_type_idx ON product_history (type, created_at);
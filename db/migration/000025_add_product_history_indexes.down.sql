@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS product_history_created_by_idx;
+DROP INDEX IF EXISTS product_history_trx_ref_idx;
+DROP INDEX IF EXISTS product_history_product_idx;
@@ -0,0 +1,4 @@
+-- Filtering product history and the per product ledger by product, transaction and user
+CREATE INDEX product_history_product_idx ON product_history (product_id, created_at);
+CREATE INDEX product_history_trx_ref_idx ON product_history (trx_ref);
+CREATE INDEX product_history_created_by_idx ON product_history (created_by, created_at);
@@ -0,0 +1,8 @@
+ALTER TABLE notification_outbox DROP COLUMN IF EXISTS stock_alert_id;
+
+DROP TABLE IF EXISTS stock_alerts;
+
+DROP INDEX IF EXISTS products_supplier_idx;
+ALTER TABLE products DROP COLUMN IF EXISTS supplier_id;
+ALTER TABLE products DROP COLUMN IF EXISTS reorder_quantity;
+ALTER TABLE products DROP COLUMN IF EXISTS min_stock;
@@ -0,0 +1,27 @@
+-- Reorder settings per product, alerts are raised when stock drops to min_stock
+ALTER TABLE products ADD COLUMN min_stock INT NOT NULL DEFAULT 0;
+ALTER TABLE products ADD COLUMN reorder_quantity INT NOT NULL DEFAULT 0;
+ALTER TABLE products ADD COLUMN supplier_id BIGINT REFERENCES suppliers(id) ON DELETE SET NULL;
+
+CREATE INDEX products_supplier_idx ON products (supplier_id);
+
+-- A product crossing its low stock threshold, resolved once stock is above it again
+CREATE TABLE stock_alerts (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    trx_ref VARCHAR NOT NULL,
+    stock INT NOT NULL,
+    min_stock INT NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'open',
+    notified_at TIMESTAMP,
+    resolved_at TIMESTAMP,
+    created_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- At most one open alert per product
+CREATE UNIQUE INDEX stock_alerts_open_idx ON stock_alerts (product_id) WHERE status = 'open';
+CREATE INDEX stock_alerts_unnotified_idx ON stock_alerts (created_at) WHERE notified_at IS NULL;
+
+-- Alerts are delivered through the notification outbox like receipts
+ALTER TABLE notification_outbox ADD COLUMN stock_alert_id BIGINT REFERENCES stock_alerts(id) ON DELETE CASCADE;
@@ -16,13 +16,13 @@ ORDER BY created_at DESC
 LIMIT $1 OFFSET $2;
 
 -- name: CreateCategory :one
-INSERT INTO categories (name, created_by, created_at)
-VALUES ($1, $2, CURRENT_TIMESTAMP)
+INSERT INTO categories (name, tax_rate_id, points_multiplier, created_by, created_at)
+VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)
 RETURNING *;
 
 -- name: UpdateCategory :one
 UPDATE categories
-SET name = $2, updated_by = $3, updated_at = CURRENT_TIMESTAMP
+SET name = $2, tax_rate_id = $3, points_multiplier = $4, updated_by = $5, updated_at = CURRENT_TIMESTAMP
 WHERE id = $1
 RETURNING *;
 
@@ -64,3 +64,69 @@ RETURNING *;
 DELETE FROM customers
 WHERE id = $1
 RETURNING id;
+
+-- name: GetCustomerSummary :one
+SELECT
+    COUNT(DISTINCT CASE WHEN o.status IN ('order', 'paid') THEN o.id END) as total_orders,
+    COALESCE(SUM(CASE WHEN o.status IN ('order', 'paid') THEN o.total_amount END), 0)::DECIMAL as lifetime_spend,
+    COALESCE(AVG(CASE WHEN o.status IN ('order', 'paid') THEN o.total_amount END), 0)::DECIMAL as average_basket,
+    COUNT(DISTINCT CASE WHEN o.status = 'refunded' THEN o.id END) as total_refunds
+FROM orders o
+WHERE o.customer_id = $1;
+
+-- name: GetCustomerLastOrder :one
+SELECT *
+FROM orders
+WHERE customer_id = $1 AND status IN ('order', 'paid', 'refunded')
+ORDER BY order_date DESC
+LIMIT 1;
+
+-- name: GetCustomerFavoriteProducts :many
+SELECT
+    oi.product_id,
+    COALESCE(p.name, MAX(oi.old_product))::VARCHAR as product_name,
+    SUM(oi.quantity) as total_quantity,
+    COUNT(DISTINCT o.id) as total_orders,
+    SUM(oi.unit_price * oi.quantity - oi.discount_amount)::DECIMAL as total_spent
+FROM order_items oi
+JOIN orders o ON oi.order_id = o.id
+LEFT JOIN products p ON oi.product_id = p.id
+WHERE o.customer_id = sqlc.arg(customer_id) AND o.status IN ('order', 'paid')
+GROUP BY oi.product_id, p.name
+ORDER BY total_quantity DESC
+LIMIT sqlc.arg(limit);
+
+-- name: GetCustomerOrders :many
+SELECT *
+FROM orders
+WHERE customer_id = sqlc.arg(customer_id)
+    AND (sqlc.arg(status)::VARCHAR = '' OR status = sqlc.arg(status)::VARCHAR)
+ORDER BY order_date DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: SearchCustomers :many
+SELECT *
+FROM customers
+WHERE deleted_at IS NULL
+    AND (
+        lower(member_code) = lower(sqlc.arg(query)::VARCHAR)
+        OR (sqlc.arg(phone)::VARCHAR != '' AND normalize_phone(phone) LIKE '%' || sqlc.arg(phone)::VARCHAR || '%')
+        OR lower(email) LIKE '%' || lower(sqlc.arg(query)::VARCHAR) || '%'
+        OR lower(name) LIKE '%' || lower(sqlc.arg(query)::VARCHAR) || '%'
+        OR similarity(lower(name), lower(sqlc.arg(query)::VARCHAR)) >= 0.3
+    )
+ORDER BY
+    (lower(member_code) = lower(sqlc.arg(query)::VARCHAR)) DESC,
+    (sqlc.arg(phone)::VARCHAR != '' AND normalize_phone(phone) = sqlc.arg(phone)::VARCHAR) DESC,
+    similarity(lower(name), lower(sqlc.arg(query)::VARCHAR)) DESC,
+    created_at DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: GetCustomersByMemberCodeOrPhone :many
+SELECT *
+FROM customers
+WHERE deleted_at IS NULL
+    AND (lower(member_code) = lower(sqlc.arg(member_code)::VARCHAR)
+        OR (sqlc.arg(phone)::VARCHAR != '' AND normalize_phone(phone) = sqlc.arg(phone)::VARCHAR))
+ORDER BY (lower(member_code) = lower(sqlc.arg(member_code)::VARCHAR)) DESC, created_at DESC
+LIMIT 2;
@@ -0,0 +1,62 @@
+-- #CUSTOMER MERGE
+
+-- name: GetDuplicateCustomerCandidates :many
+SELECT
+    a.id as customer_id,
+    a.member_code as customer_member_code,
+    a.name as customer_name,
+    a.phone as customer_phone,
+    a.email as customer_email,
+    b.id as duplicate_id,
+    b.member_code as duplicate_member_code,
+    b.name as duplicate_name,
+    b.phone as duplicate_phone,
+    b.email as duplicate_email,
+    similarity(lower(a.name), lower(b.name))::DECIMAL as name_similarity
+FROM customers a
+JOIN customers b ON a.id < b.id AND b.deleted_at IS NULL
+WHERE a.deleted_at IS NULL
+    AND (sqlc.arg(customer_id)::BIGINT = 0 OR a.id = sqlc.arg(customer_id)::BIGINT OR b.id = sqlc.arg(customer_id)::BIGINT)
+    AND (
+        (normalize_phone(a.phone) != '' AND normalize_phone(a.phone) = normalize_phone(b.phone))
+        OR (COALESCE(a.email, '') != '' AND lower(a.email) = lower(b.email))
+        OR lower(a.name) % lower(b.name)
+    )
+ORDER BY a.id, b.id
+LIMIT sqlc.arg(limit);
+
+-- name: ReassignCustomerOrders :execrows
+UPDATE orders
+SET customer_id = sqlc.arg(customer_id)::BIGINT
+WHERE customer_id = sqlc.arg(merged_customer_id)::BIGINT;
+
+-- name: ReassignCustomerParkedOrders :exec
+UPDATE parked_orders
+SET customer_id = sqlc.arg(customer_id)::BIGINT
+WHERE customer_id = sqlc.arg(merged_customer_id)::BIGINT;
+
+-- name: ReassignCustomerVoucherRedemptions :exec
+UPDATE voucher_redemptions
+SET customer_id = sqlc.arg(customer_id)::BIGINT
+WHERE customer_id = sqlc.arg(merged_customer_id)::BIGINT;
+
+-- name: ReassignCustomerLoyaltyPoints :exec
+UPDATE loyalty_points
+SET customer_id = sqlc.arg(customer_id)::BIGINT
+WHERE customer_id = sqlc.arg(merged_customer_id)::BIGINT;
+
+-- name: ReassignCustomerTierChanges :exec
+UPDATE customer_tier_changes
+SET customer_id = sqlc.arg(customer_id)::BIGINT
+WHERE customer_id = sqlc.arg(merged_customer_id)::BIGINT;
+
+-- name: CreateCustomerMerge :one
+INSERT INTO customer_merges (customer_id, merged_customer_id, merged_member_code, merged_name, merged_phone, merged_email, orders_moved, points_moved, merged_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetCustomerMerges :many
+SELECT *
+FROM customer_merges
+WHERE customer_id = $1
+ORDER BY merged_at DESC;
@@ -0,0 +1,95 @@
+-- #CUSTOMER PRIVACY
+
+-- name: UpdateCustomerConsent :one
+UPDATE customers
+SET email_marketing_consent = $2, whatsapp_marketing_consent = $3, consent_updated_at = CURRENT_TIMESTAMP, updated_by = $4, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateCustomerConsent :one
+INSERT INTO customer_consents (customer_id, channel, granted, source, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: GetCustomerConsents :many
+SELECT *
+FROM customer_consents
+WHERE customer_id = $1
+ORDER BY created_at DESC, id DESC;
+
+-- name: CountCustomerOrders :one
+SELECT COUNT(*)
+FROM orders
+WHERE customer_id = $1;
+
+-- name: GetCustomerExportOrders :many
+SELECT *
+FROM orders
+WHERE customer_id = $1
+ORDER BY order_date ASC, id ASC;
+
+-- name: GetCustomerExportOrderItems :many
+SELECT oi.*
+FROM order_items oi
+JOIN orders o ON oi.order_id = o.id
+WHERE o.customer_id = $1
+ORDER BY oi.order_id, oi.id;
+
+-- name: GetCustomerExportLoyaltyPoints :many
+SELECT *
+FROM loyalty_points
+WHERE customer_id = $1
+ORDER BY created_at ASC, id ASC;
+
+-- name: GetCustomerExportGiftCards :many
+SELECT *
+FROM gift_cards
+WHERE customer_id = $1
+ORDER BY created_at ASC, id ASC;
+
+-- name: GetCustomerExportNotifications :many
+SELECT n.*
+FROM notification_outbox n
+JOIN orders o ON n.order_id = o.id
+WHERE o.customer_id = $1
+ORDER BY n.created_at ASC, n.id ASC;
+
+-- name: GetCustomerExportParkedOrders :many
+SELECT *
+FROM parked_orders
+WHERE customer_id = $1
+ORDER BY created_at ASC, id ASC;
+
+-- name: AnonymizeCustomer :one
+UPDATE customers
+SET member_code = sqlc.arg(member_code)::VARCHAR,
+    name = sqlc.arg(name)::VARCHAR,
+    phone = NULL,
+    email = NULL,
+    email_marketing_consent = FALSE,
+    whatsapp_marketing_consent = FALSE,
+    consent_updated_at = CURRENT_TIMESTAMP,
+    anonymized_at = CURRENT_TIMESTAMP,
+    updated_by = sqlc.arg(user_id)::BIGINT,
+    updated_at = CURRENT_TIMESTAMP,
+    deleted_by = COALESCE(deleted_by, sqlc.arg(user_id)::BIGINT),
+    deleted_at = COALESCE(deleted_at, CURRENT_TIMESTAMP)
+WHERE id = sqlc.arg(id)::BIGINT
+RETURNING *;
+
+-- name: AnonymizeCustomerParkedOrders :exec
+UPDATE parked_orders
+SET customer_name = NULL, customer_phone = NULL, customer_email = NULL
+WHERE customer_id = $1;
+
+-- name: AnonymizeCustomerNotifications :exec
+UPDATE notification_outbox
+SET recipient = sqlc.arg(recipient)::VARCHAR,
+    status = CASE WHEN status = 'pending' THEN 'failed' ELSE status END,
+    last_error = CASE WHEN status = 'pending' THEN 'customer anonymized' ELSE last_error END
+WHERE order_id IN (SELECT id FROM orders WHERE customer_id = sqlc.arg(customer_id)::BIGINT);
+
+-- name: AnonymizeCustomerMerges :exec
+UPDATE customer_merges
+SET merged_member_code = sqlc.arg(member_code)::VARCHAR, merged_name = sqlc.arg(name)::VARCHAR, merged_phone = NULL, merged_email = NULL
+WHERE customer_id = sqlc.arg(customer_id)::BIGINT OR merged_customer_id = sqlc.arg(customer_id)::BIGINT;
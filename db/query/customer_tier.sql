@@ -0,0 +1,71 @@
+-- name: GetAllCustomerTiers :many
+SELECT *
+FROM customer_tiers
+ORDER BY min_spend ASC;
+
+-- name: GetCustomerTierByID :one
+SELECT *
+FROM customer_tiers
+WHERE id = $1;
+
+-- name: UpdateCustomerTier :one
+UPDATE customer_tiers
+SET name = $2, min_spend = $3, discount_percent = $4, updated_by = $5, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: GetCustomerTierPrices :many
+SELECT
+    ctp.*,
+    p.name as product_name,
+    p.price as product_price
+FROM customer_tier_prices ctp
+JOIN products p ON ctp.product_id = p.id
+WHERE ctp.tier_id = $1
+ORDER BY p.name;
+
+-- name: UpsertCustomerTierPrice :one
+INSERT INTO customer_tier_prices (tier_id, product_id, price, created_by, created_at)
+VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)
+ON CONFLICT (tier_id, product_id) DO UPDATE SET price = EXCLUDED.price
+RETURNING *;
+
+-- name: DeleteCustomerTierPrice :one
+DELETE FROM customer_tier_prices
+WHERE tier_id = $1 AND product_id = $2
+RETURNING id;
+
+-- name: GetCustomerRollingSpend :many
+SELECT
+    c.id as customer_id,
+    c.tier_id,
+    COALESCE(SUM(o.total_amount), 0)::DECIMAL as total_spent
+FROM customers c
+LEFT JOIN orders o ON c.id = o.customer_id
+    AND o.status IN ('order', 'paid')
+    AND o.order_date >= sqlc.arg(since)::TIMESTAMP
+WHERE c.deleted_at IS NULL
+    AND (sqlc.arg(customer_id)::BIGINT = 0 OR c.id = sqlc.arg(customer_id)::BIGINT)
+GROUP BY c.id, c.tier_id;
+
+-- name: SetCustomerTier :exec
+UPDATE customers
+SET tier_id = $2, tier_updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: CreateCustomerTierChange :one
+INSERT INTO customer_tier_changes (customer_id, old_tier_id, new_tier_id, rolling_spend, changed_at)
+VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: GetCustomerTierChanges :many
+SELECT
+    ctc.*,
+    ot.name as old_tier_name,
+    nt.name as new_tier_name
+FROM customer_tier_changes ctc
+LEFT JOIN customer_tiers ot ON ctc.old_tier_id = ot.id
+LEFT JOIN customer_tiers nt ON ctc.new_tier_id = nt.id
+WHERE ctc.customer_id = sqlc.arg(customer_id)
+ORDER BY ctc.changed_at DESC, ctc.id DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
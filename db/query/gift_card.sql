@@ -0,0 +1,125 @@
+-- #GIFT CARD
+
+-- name: CreateGiftCard :one
+INSERT INTO gift_cards (code, pin_hash, type, customer_id, order_id, initial_amount, balance, status, expires_at, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: GetGiftCardByID :one
+SELECT *
+FROM gift_cards
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetGiftCardByCode :one
+SELECT *
+FROM gift_cards
+WHERE code = $1
+LIMIT 1;
+
+-- name: GetGiftCardByCodeForUpdate :one
+SELECT *
+FROM gift_cards
+WHERE code = $1
+LIMIT 1
+FOR UPDATE;
+
+-- name: GetGiftCardByIDForUpdate :one
+SELECT *
+FROM gift_cards
+WHERE id = $1
+LIMIT 1
+FOR UPDATE;
+
+-- name: GetStoreCreditByCustomerID :one
+SELECT *
+FROM gift_cards
+WHERE customer_id = $1 AND type = 'store_credit'
+LIMIT 1;
+
+-- name: GetStoreCreditByCustomerIDForUpdate :one
+SELECT *
+FROM gift_cards
+WHERE customer_id = $1 AND type = 'store_credit'
+LIMIT 1
+FOR UPDATE;
+
+-- name: GetAllGiftCards :many
+SELECT *
+FROM gift_cards
+WHERE (sqlc.arg(type)::VARCHAR = '' OR type = sqlc.arg(type)::VARCHAR)
+    AND (sqlc.arg(status)::VARCHAR = '' OR status = sqlc.arg(status)::VARCHAR)
+ORDER BY created_at DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: GetGiftCardsByOrderID :many
+SELECT *
+FROM gift_cards
+WHERE order_id = $1 AND type = 'gift_card'
+ORDER BY id
+FOR UPDATE;
+
+-- name: UpdateGiftCardBalance :one
+UPDATE gift_cards
+SET balance = $2, status = $3, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: GetExpiredGiftCards :many
+SELECT *
+FROM gift_cards
+WHERE status = 'active' AND expires_at <= $1
+ORDER BY expires_at ASC
+FOR UPDATE SKIP LOCKED;
+
+-- name: CreateGiftCardTransaction :one
+INSERT INTO gift_card_transactions (gift_card_id, order_id, type, amount, balance, description, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: GetGiftCardTransactions :many
+SELECT *
+FROM gift_card_transactions
+WHERE gift_card_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: GetGiftCardRedemptionsByOrderID :many
+SELECT *
+FROM gift_card_transactions
+WHERE order_id = $1 AND type = 'redeem'
+ORDER BY id;
+
+-- name: GetOrderStoreCreditRefundAmount :one
+SELECT COALESCE(SUM(op.amount), 0)::DECIMAL as amount
+FROM order_payments op
+LEFT JOIN payment_methods pm ON op.payment_method_id = pm.id
+WHERE op.order_id = $1
+    AND COALESCE(pm.type, 'cash') NOT IN ('points', 'gift_card', 'store_credit')
+    AND NOT COALESCE(pm.gateway, FALSE);
+
+-- name: ReassignCustomerGiftCards :exec
+UPDATE gift_cards
+SET customer_id = sqlc.arg(customer_id)::BIGINT, updated_at = CURRENT_TIMESTAMP
+WHERE customer_id = sqlc.arg(merged_customer_id)::BIGINT AND type = 'gift_card';
+
+-- name: IncrementGiftCardPinAttempts :one
+UPDATE gift_cards
+SET failed_pin_attempts = failed_pin_attempts + 1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE code = $1 AND type = 'gift_card'
+RETURNING failed_pin_attempts;
+
+-- name: LockGiftCardPin :exec
+UPDATE gift_cards
+SET failed_pin_attempts = 0,
+    locked_until = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE code = $1;
+
+-- name: ResetGiftCardPinAttempts :exec
+UPDATE gift_cards
+SET failed_pin_attempts = 0,
+    locked_until = NULL,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND (failed_pin_attempts > 0 OR locked_until IS NOT NULL);
@@ -0,0 +1,62 @@
+-- #INVENTORY
+
+-- name: CreateCostLayer :one
+INSERT INTO cost_layers (product_id, source, trx_ref, quantity, remaining, unit_cost, created_at)
+VALUES ($1, $2, $3, $4, $4, $5, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: GetOpenCostLayersForUpdate :many
+SELECT *
+FROM cost_layers
+WHERE product_id = $1 AND remaining > 0
+ORDER BY created_at ASC, id ASC
+FOR UPDATE;
+
+-- name: UpdateCostLayerRemaining :exec
+UPDATE cost_layers
+SET remaining = $2
+WHERE id = $1;
+
+-- name: GetProductCostLayers :many
+SELECT *
+FROM cost_layers
+WHERE product_id = $1 AND remaining > 0
+ORDER BY created_at ASC, id ASC;
+
+-- name: UpdateProductCost :exec
+UPDATE products
+SET cost = $2
+WHERE id = $1;
+
+-- name: CreateStockCostMovement :one
+INSERT INTO stock_cost_movements (product_id, trx_ref, quantity, unit_cost, amount, method, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: GetInventoryValuation :many
+SELECT p.id AS product_id,
+    p.name AS product_name,
+    c.name AS category_name,
+    COALESCE(SUM(m.quantity), 0)::BIGINT AS quantity,
+    COALESCE(SUM(m.amount), 0)::DECIMAL AS value
+FROM products p
+LEFT JOIN categories c ON p.category_id = c.id
+JOIN stock_cost_movements m ON m.product_id = p.id AND m.created_at <= sqlc.arg(as_of)::TIMESTAMP
+WHERE (sqlc.arg(category_id)::BIGINT = 0 OR p.category_id = sqlc.arg(category_id)::BIGINT)
+GROUP BY p.id, p.name, c.name
+HAVING COALESCE(SUM(m.quantity), 0) <> 0 OR COALESCE(SUM(m.amount), 0) <> 0
+ORDER BY p.name ASC;
+
+-- name: GetProductStockBalances :many
+-- Stok seharusnya adalah jumlah seluruh quantity_change pada product history
+SELECT p.id AS product_id, p.name, p.stock,
+    COALESCE(SUM(ph.quantity_change), 0)::BIGINT AS expected_stock
+FROM products p
+LEFT JOIN product_history ph ON ph.product_id = p.id
+GROUP BY p.id, p.name, p.stock
+ORDER BY p.id;
+
+-- name: GetProductLedgerTotal :one
+SELECT COALESCE(SUM(quantity_change), 0)::BIGINT AS expected_stock
+FROM product_history
+WHERE product_id = $1;
@@ -0,0 +1,61 @@
+-- name: CreateLoyaltyPoint :one
+INSERT INTO loyalty_points (
+    customer_id,
+    order_id,
+    type,
+    points,
+    remaining,
+    expires_at,
+    description,
+    created_by,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: GetLoyaltyLotsForUpdate :many
+SELECT *
+FROM loyalty_points
+WHERE customer_id = sqlc.arg(customer_id)
+    AND remaining > 0
+    AND (expires_at IS NULL OR expires_at > sqlc.arg(now)::TIMESTAMP)
+ORDER BY (order_id IS NOT NULL AND order_id = sqlc.arg(order_id)::BIGINT) DESC, expires_at ASC NULLS LAST, id ASC
+FOR UPDATE;
+
+-- name: UpdateLoyaltyPointRemaining :exec
+UPDATE loyalty_points
+SET remaining = $2
+WHERE id = $1;
+
+-- name: GetLoyaltyPointsByOrderID :many
+SELECT *
+FROM loyalty_points
+WHERE order_id = $1
+ORDER BY id ASC;
+
+-- name: GetCustomerPointBalance :one
+SELECT COALESCE(SUM(points), 0)::BIGINT AS balance
+FROM loyalty_points
+WHERE customer_id = $1;
+
+-- name: GetCustomerExpiringPoints :one
+SELECT COALESCE(SUM(remaining), 0)::BIGINT AS points
+FROM loyalty_points
+WHERE customer_id = sqlc.arg(customer_id)
+    AND remaining > 0
+    AND expires_at > sqlc.arg(now)::TIMESTAMP
+    AND expires_at <= sqlc.arg(until)::TIMESTAMP;
+
+-- name: GetCustomerPointHistory :many
+SELECT *
+FROM loyalty_points
+WHERE customer_id = sqlc.arg(customer_id)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: GetExpiredLoyaltyLots :many
+SELECT *
+FROM loyalty_points
+WHERE remaining > 0 AND expires_at <= $1
+ORDER BY expires_at ASC
+FOR UPDATE SKIP LOCKED;
@@ -0,0 +1,62 @@
+-- name: CreateNotification :one
+INSERT INTO notification_outbox (
+    order_id,
+    stock_alert_id,
+    kind,
+    channel,
+    recipient,
+    max_attempts,
+    created_by,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: GetNotificationsByOrderID :many
+SELECT *
+FROM notification_outbox
+WHERE order_id = $1
+ORDER BY id;
+
+-- name: ClaimDueNotifications :many
+-- Pesan yang diklaim dijadwalkan ulang ke lease_until supaya dispatcher lain tidak
+-- mengambilnya selama dikirim; pesan yang tidak sempat ditandai dicoba lagi setelahnya
+UPDATE notification_outbox
+SET next_attempt_at = sqlc.arg(lease_until)::TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id IN (
+    SELECT id
+    FROM notification_outbox
+    WHERE status = 'pending' AND next_attempt_at <= sqlc.arg(now)::TIMESTAMP
+    ORDER BY next_attempt_at
+    LIMIT sqlc.arg(limit)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkNotificationSent :one
+UPDATE notification_outbox
+SET status = 'sent',
+    subject = $2,
+    attempts = attempts + 1,
+    last_error = NULL,
+    sent_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: MarkNotificationAttemptFailed :one
+UPDATE notification_outbox
+SET status = $2,
+    attempts = attempts + 1,
+    next_attempt_at = $3,
+    last_error = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: PostponeNotification :exec
+UPDATE notification_outbox
+SET next_attempt_at = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
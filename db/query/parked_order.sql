@@ -0,0 +1,93 @@
+-- #PARKED ORDER
+
+-- name: CreateParkedOrder :one
+INSERT INTO parked_orders (
+    park_number,
+    label,
+    store_code,
+    terminal,
+    customer_type,
+    customer_id,
+    customer_name,
+    customer_phone,
+    customer_email,
+    voucher_code,
+    reserve_stock,
+    expires_at,
+    created_by,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: CreateParkedOrderItem :one
+INSERT INTO parked_order_items (parked_order_id, product_id, quantity)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetParkedOrderByID :one
+SELECT *
+FROM parked_orders
+WHERE id = $1;
+
+-- name: GetParkedOrderByIDForUpdate :one
+SELECT *
+FROM parked_orders
+WHERE id = $1
+FOR UPDATE;
+
+-- name: GetParkedOrderItems :many
+SELECT
+    poi.*,
+    p.name as product_name,
+    p.price as product_price
+FROM parked_order_items poi
+LEFT JOIN products p ON poi.product_id = p.id
+WHERE poi.parked_order_id = $1
+ORDER BY poi.id;
+
+-- name: GetAllParkedOrders :many
+SELECT *
+FROM parked_orders
+WHERE store_code = sqlc.arg(store_code)
+    AND (sqlc.arg(status)::VARCHAR = '' OR status = sqlc.arg(status)::VARCHAR)
+ORDER BY created_at DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: ResumeParkedOrder :one
+UPDATE parked_orders
+SET status = 'resumed',
+    resumed_by = $2,
+    resumed_terminal = $3,
+    resumed_at = CURRENT_TIMESTAMP,
+    updated_by = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status IN ('held', 'resumed')
+RETURNING *;
+
+-- name: UpdateParkedOrderStatus :one
+UPDATE parked_orders
+SET status = $2,
+    order_id = $3,
+    updated_by = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: GetExpiredParkedOrders :many
+SELECT *
+FROM parked_orders
+WHERE status IN ('held', 'resumed')
+    AND expires_at < sqlc.arg(now)::TIMESTAMP
+ORDER BY expires_at ASC;
+
+-- name: ReserveProductStock :one
+UPDATE products
+SET reserved_stock = reserved_stock + sqlc.arg(quantity)::INT
+WHERE id = sqlc.arg(id) AND stock - reserved_stock >= sqlc.arg(quantity)::INT
+RETURNING *;
+
+-- name: ReleaseProductStock :exec
+UPDATE products
+SET reserved_stock = GREATEST(reserved_stock - sqlc.arg(quantity)::INT, 0)
+WHERE id = sqlc.arg(id);
@@ -0,0 +1,147 @@
+-- #PAYMENT
+
+-- name: GetAllPaymentMethods :many
+SELECT *
+FROM payment_methods
+WHERE deleted_at IS NULL
+ORDER BY id ASC
+LIMIT $1 OFFSET $2;
+
+-- name: GetPaymentMethodByID :one
+SELECT *
+FROM payment_methods
+WHERE id = $1;
+
+-- name: GetPaymentMethodByCode :one
+SELECT *
+FROM payment_methods
+WHERE code = $1 AND deleted_at IS NULL;
+
+-- name: CreatePaymentMethod :one
+INSERT INTO payment_methods (code, name, type, requires_reference, gateway, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: UpdatePaymentMethod :one
+UPDATE payment_methods
+SET code = $2, name = $3, type = $4, requires_reference = $5, gateway = $6, is_active = $7, updated_by = $8, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: SoftDeletePaymentMethodByID :one
+UPDATE payment_methods
+SET deleted_by = $2, deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateOrderPayment :one
+INSERT INTO order_payments (
+    order_id,
+    payment_method_id,
+    payment_method,
+    amount,
+    tendered_amount,
+    change_amount,
+    reference,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetOrderPaymentsByOrderID :many
+SELECT *
+FROM order_payments
+WHERE order_id = $1
+ORDER BY id ASC;
+
+-- name: CreatePaymentCharge :one
+INSERT INTO payment_charges (
+    order_id,
+    provider,
+    charge_id,
+    payment_method,
+    amount,
+    status,
+    payment_url,
+    qr_string,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: GetPaymentChargeByChargeID :one
+SELECT *
+FROM payment_charges
+WHERE charge_id = $1;
+
+-- name: GetPaymentChargeByID :one
+SELECT *
+FROM payment_charges
+WHERE id = $1;
+
+-- name: GetPaymentChargeByOrderID :one
+SELECT *
+FROM payment_charges
+WHERE order_id = $1
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: UpdatePaymentChargeStatus :one
+-- Hanya charge yang masih pending yang bisa berubah status, tidak ada baris
+-- berarti charge sudah diproses oleh webhook atau job lain
+UPDATE payment_charges
+SET status = $2, paid_at = $3, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: MarkPaymentChargeLatePaid :one
+-- Pembayaran yang masuk setelah charge ditutup tetap dicatat supaya bisa direfund
+UPDATE payment_charges
+SET status = 'paid', paid_at = $2, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status IN ('expired', 'failed')
+RETURNING *;
+
+-- name: GetExpiredPendingCharges :many
+SELECT *
+FROM payment_charges
+WHERE status = 'pending'
+    AND expires_at < sqlc.arg(now)::TIMESTAMP
+ORDER BY id ASC;
+
+-- name: MarkPaymentChargeRefunded :exec
+UPDATE payment_charges
+SET status = 'refunded', updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'paid';
+
+-- name: CreatePaymentRefund :one
+-- Satu charge hanya punya satu refund, tidak ada baris berarti refund charge
+-- sudah dicatat sebelumnya
+INSERT INTO payment_refunds (payment_charge_id, order_id, amount, created_by, created_at)
+VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)
+ON CONFLICT (payment_charge_id) DO NOTHING
+RETURNING *;
+
+-- name: GetDuePaymentRefunds :many
+SELECT *
+FROM payment_refunds
+WHERE status = 'pending' AND next_attempt_at <= sqlc.arg(now)::TIMESTAMP
+ORDER BY next_attempt_at
+LIMIT sqlc.arg(limit);
+
+-- name: MarkPaymentRefundSucceeded :exec
+UPDATE payment_refunds
+SET status = 'succeeded',
+    attempts = attempts + 1,
+    last_error = NULL,
+    refunded_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'pending';
+
+-- name: MarkPaymentRefundAttemptFailed :exec
+UPDATE payment_refunds
+SET status = $2,
+    attempts = attempts + 1,
+    next_attempt_at = $3,
+    last_error = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'pending';
@@ -15,13 +15,13 @@ ORDER BY created_at DESC
 LIMIT $1 OFFSET $2;
 
 -- name: CreateProduct :one
-INSERT INTO products (name, price, stock, category_id, created_by, created_at)
-VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP)
+INSERT INTO products (name, price, stock, category_id, tax_rate_id, is_gift_card, barcode, min_stock, reorder_quantity, supplier_id, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, CURRENT_TIMESTAMP)
 RETURNING *;
 
 -- name: UpdateProduct :one
 UPDATE products
-SET name = $2, price = $3, category_id = $4, updated_by = $5, updated_at = CURRENT_TIMESTAMP
+SET name = $2, price = $3, category_id = $4, tax_rate_id = $5, is_gift_card = $6, barcode = $7, min_stock = $8, reorder_quantity = $9, supplier_id = $10, updated_by = $11, updated_at = CURRENT_TIMESTAMP
 WHERE id = $1
 RETURNING *;
 
@@ -30,6 +30,19 @@ SELECT *
 FROM products
 WHERE id = $1;
 
+-- name: GetLowStockProducts :many
+SELECT p.*, s.name AS supplier_name
+FROM products p
+LEFT JOIN suppliers s ON p.supplier_id = s.id
+WHERE p.deleted_at IS NULL AND p.min_stock > 0 AND p.stock <= p.min_stock
+    AND (sqlc.arg(supplier_id)::BIGINT = 0 OR p.supplier_id = sqlc.arg(supplier_id)::BIGINT)
+ORDER BY p.stock - p.min_stock, p.id;
+
+-- name: GetProductByBarcode :one
+SELECT *
+FROM products
+WHERE barcode = $1 AND deleted_at IS NULL;
+
 -- name: SoftDeleteProductByID :one
 UPDATE products
 SET deleted_by = $2, deleted_at = CURRENT_TIMESTAMP
@@ -41,6 +54,12 @@ DELETE FROM products
 WHERE id = $1
 RETURNING id;
 
+-- name: GetProductByIDForUpdate :one
+SELECT *
+FROM products
+WHERE id = $1
+FOR UPDATE;
+
 -- name: UpdateProductStock :one
 UPDATE products 
 SET stock = $2, 
@@ -48,3 +67,20 @@ SET stock = $2,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 
 RETURNING *;
+
+-- name: IncrementProductStock :one
+UPDATE products
+SET stock = stock + sqlc.arg(quantity)::INT,
+    updated_by = sqlc.arg(updated_by),
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DecrementProductStock :one
+-- Tidak ada baris berarti stok yang belum direservasi tidak cukup
+UPDATE products
+SET stock = stock - sqlc.arg(quantity)::INT,
+    updated_by = sqlc.arg(updated_by),
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id) AND stock - reserved_stock >= sqlc.arg(quantity)::INT
+RETURNING *;
@@ -3,10 +3,45 @@
 -- name: GetAllProductHistory :many
 SELECT *
 FROM product_history
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
+WHERE (sqlc.arg(product_id)::BIGINT = 0 OR product_id = sqlc.arg(product_id)::BIGINT)
+    AND (sqlc.arg(movement_type)::VARCHAR = '' OR type = sqlc.arg(movement_type)::VARCHAR)
+    AND (sqlc.arg(reason_code)::VARCHAR = '' OR reason_code = sqlc.arg(reason_code)::VARCHAR)
+    AND (sqlc.arg(trx_ref)::VARCHAR = '' OR trx_ref = sqlc.arg(trx_ref)::VARCHAR)
+    AND (sqlc.arg(created_by)::BIGINT = 0 OR created_by = sqlc.arg(created_by)::BIGINT)
+    AND created_at >= sqlc.arg(start_date)::TIMESTAMP AND created_at < sqlc.arg(end_date)::TIMESTAMP
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: GetProductHistorySummary :many
+SELECT type,
+    COALESCE(reason_code, '')::VARCHAR AS reason_code,
+    COUNT(*)::BIGINT AS movements,
+    COALESCE(SUM(CASE WHEN quantity_change > 0 THEN quantity_change ELSE 0 END), 0)::BIGINT AS quantity_in,
+    COALESCE(SUM(CASE WHEN quantity_change < 0 THEN -quantity_change ELSE 0 END), 0)::BIGINT AS quantity_out,
+    COALESCE(SUM(quantity_change), 0)::BIGINT AS net_quantity
+FROM product_history
+WHERE (sqlc.arg(movement_type)::VARCHAR = '' OR type = sqlc.arg(movement_type)::VARCHAR)
+GROUP BY type, reason_code
+ORDER BY type, reason_code;
+
+-- name: GetProductLedger :many
+-- Saldo dihitung maju dari 0 dengan menjumlahkan seluruh product history, selisih
+-- dengan stok produk dilaporkan terpisah sebagai drift
+WITH ledger AS (
+    SELECT ph.id, ph.trx_ref, ph.quantity_change, ph.type, ph.reason_code, ph.reason, ph.created_by, ph.created_at,
+        SUM(ph.quantity_change) OVER (ORDER BY ph.created_at, ph.id)::BIGINT AS balance_after
+    FROM product_history ph
+    WHERE ph.product_id = sqlc.arg(product_id)::BIGINT
+)
+SELECT id, trx_ref, quantity_change, type, reason_code, reason, created_by, created_at,
+    (balance_after - quantity_change)::BIGINT AS balance_before,
+    balance_after
+FROM ledger
+WHERE created_at >= sqlc.arg(start_date)::TIMESTAMP AND created_at < sqlc.arg(end_date)::TIMESTAMP
+ORDER BY created_at, id
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
 
 -- name: CreateProductHistory :one
-INSERT INTO product_history (trx_ref, product_id, quantity_change, type, reason, created_by, created_at)
-VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP)
+INSERT INTO product_history (trx_ref, product_id, quantity_change, type, reason, reason_code, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP)
 RETURNING *;
@@ -0,0 +1,102 @@
+-- #PROMOTION
+
+-- name: GetAllPromotions :many
+SELECT *
+FROM promotions
+WHERE deleted_at IS NULL
+ORDER BY priority DESC, created_at DESC
+LIMIT $1 OFFSET $2;
+
+-- name: GetActivePromotions :many
+SELECT *
+FROM promotions
+WHERE deleted_at IS NULL
+    AND is_active = TRUE
+    AND (start_at IS NULL OR start_at <= sqlc.arg(now)::TIMESTAMP)
+    AND (end_at IS NULL OR end_at >= sqlc.arg(now)::TIMESTAMP)
+ORDER BY priority DESC, id ASC;
+
+-- name: GetPromotionByID :one
+SELECT *
+FROM promotions
+WHERE id = $1;
+
+-- name: CreatePromotion :one
+INSERT INTO promotions (
+    name,
+    type,
+    priority,
+    stackable,
+    category_id,
+    buy_quantity,
+    get_quantity,
+    bundle_price,
+    discount_percent,
+    discount_amount,
+    min_spend,
+    daily_start,
+    daily_end,
+    days_of_week,
+    start_at,
+    end_at,
+    created_by,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: UpdatePromotion :one
+UPDATE promotions
+SET name = $2,
+    type = $3,
+    priority = $4,
+    stackable = $5,
+    is_active = $6,
+    category_id = $7,
+    buy_quantity = $8,
+    get_quantity = $9,
+    bundle_price = $10,
+    discount_percent = $11,
+    discount_amount = $12,
+    min_spend = $13,
+    daily_start = $14,
+    daily_end = $15,
+    days_of_week = $16,
+    start_at = $17,
+    end_at = $18,
+    updated_by = $19,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: SoftDeletePromotionByID :one
+UPDATE promotions
+SET deleted_by = $2, deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: CreatePromotionProduct :one
+INSERT INTO promotion_products (promotion_id, product_id, quantity)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetPromotionProductsByPromotionID :many
+SELECT *
+FROM promotion_products
+WHERE promotion_id = $1
+ORDER BY id ASC;
+
+-- name: DeletePromotionProductsByPromotionID :exec
+DELETE FROM promotion_products
+WHERE promotion_id = $1;
+
+-- name: CreateOrderPromotion :one
+INSERT INTO order_promotions (order_id, promotion_id, promotion_name, promotion_type, discount_amount)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetOrderPromotionsByOrderID :many
+SELECT *
+FROM order_promotions
+WHERE order_id = $1
+ORDER BY id ASC;
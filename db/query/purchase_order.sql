@@ -0,0 +1,113 @@
+-- #PURCHASE ORDER
+
+-- name: CreatePurchaseOrder :one
+INSERT INTO purchase_orders (po_number, supplier_id, status, expected_at, notes, total_amount, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: GetPurchaseOrderByID :one
+SELECT *
+FROM purchase_orders
+WHERE id = $1;
+
+-- name: GetPurchaseOrderByIDForUpdate :one
+SELECT *
+FROM purchase_orders
+WHERE id = $1
+FOR UPDATE;
+
+-- name: GetAllPurchaseOrders :many
+SELECT po.*, s.name AS supplier_name
+FROM purchase_orders po
+JOIN suppliers s ON po.supplier_id = s.id
+WHERE (sqlc.arg(status)::VARCHAR = '' OR po.status = sqlc.arg(status)::VARCHAR)
+    AND (sqlc.arg(supplier_id)::BIGINT = 0 OR po.supplier_id = sqlc.arg(supplier_id)::BIGINT)
+ORDER BY po.created_at DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: UpdatePurchaseOrder :one
+UPDATE purchase_orders
+SET supplier_id = $2, expected_at = $3, notes = $4, total_amount = $5, updated_by = $6, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: SendPurchaseOrder :one
+UPDATE purchase_orders
+SET status = 'sent', sent_by = $2, sent_at = CURRENT_TIMESTAMP, updated_by = $2, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: CancelPurchaseOrder :one
+UPDATE purchase_orders
+SET status = 'cancelled', cancel_reason = $2, cancelled_by = $3, cancelled_at = CURRENT_TIMESTAMP, updated_by = $3, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: CreatePurchaseOrderItem :one
+INSERT INTO purchase_order_items (purchase_order_id, product_id, quantity, unit_cost, created_at)
+VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: GetPurchaseOrderItems :many
+SELECT poi.*, p.name AS product_name
+FROM purchase_order_items poi
+JOIN products p ON poi.product_id = p.id
+WHERE poi.purchase_order_id = $1
+ORDER BY poi.id;
+
+-- name: DeletePurchaseOrderItems :exec
+DELETE FROM purchase_order_items
+WHERE purchase_order_id = $1;
+
+-- name: ReceivePurchaseOrderItem :one
+UPDATE purchase_order_items
+SET quantity_received = quantity_received + sqlc.arg(quantity)::INT
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: UpdatePurchaseOrderReceiveStatus :one
+UPDATE purchase_orders
+SET status = sqlc.arg(status)::VARCHAR,
+    received_at = CASE WHEN sqlc.arg(status)::VARCHAR = 'received' THEN CURRENT_TIMESTAMP ELSE received_at END,
+    updated_by = sqlc.arg(updated_by),
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CreatePurchaseReceipt :one
+INSERT INTO purchase_receipts (receipt_number, purchase_order_id, notes, has_discrepancy, received_by, received_at)
+VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: CreatePurchaseReceiptItem :one
+INSERT INTO purchase_receipt_items (purchase_receipt_id, purchase_order_item_id, product_id, quantity_expected, quantity_received, expected_unit_cost, unit_cost, batch_number, expires_at, discrepancy, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: GetPurchaseReceiptsByOrderID :many
+SELECT *
+FROM purchase_receipts
+WHERE purchase_order_id = $1
+ORDER BY received_at ASC, id ASC;
+
+-- name: GetPurchaseReceiptItems :many
+SELECT pri.*, p.name AS product_name
+FROM purchase_receipt_items pri
+JOIN products p ON pri.product_id = p.id
+WHERE pri.purchase_receipt_id = $1
+ORDER BY pri.id;
+
+-- name: GetReorderCandidates :many
+-- Produk di bawah batas stok beserta jumlah yang masih dipesan pada purchase order yang belum selesai
+SELECT p.id AS product_id, p.name AS product_name, p.stock, p.min_stock, p.reorder_quantity, p.cost,
+    p.supplier_id, s.name AS supplier_name,
+    COALESCE((
+        SELECT SUM(poi.quantity - poi.quantity_received)
+        FROM purchase_order_items poi
+        JOIN purchase_orders po ON poi.purchase_order_id = po.id
+        WHERE poi.product_id = p.id AND po.status IN ('draft', 'sent', 'partially_received')
+    ), 0)::BIGINT AS on_order
+FROM products p
+LEFT JOIN suppliers s ON p.supplier_id = s.id AND s.deleted_at IS NULL
+WHERE p.deleted_at IS NULL AND p.min_stock > 0 AND p.stock <= p.min_stock
+ORDER BY s.name, p.name;
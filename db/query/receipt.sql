@@ -0,0 +1,6 @@
+-- name: IncrementReceiptPrintCount :one
+UPDATE orders
+SET receipt_print_count = receipt_print_count + 1,
+    last_printed_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING receipt_print_count;
@@ -2,6 +2,12 @@
 SELECT * FROM orders
 WHERE id = $1 LIMIT 1;
 
+-- name: GetOrderByIDForUpdate :one
+SELECT *
+FROM orders
+WHERE id = $1
+FOR UPDATE;
+
 -- name: GetAllOrders :many
 SELECT 
     o.*,
@@ -26,7 +32,7 @@ FROM products p
 LEFT JOIN order_items oi ON p.id = oi.product_id
 LEFT JOIN orders o ON oi.order_id = o.id AND
     (o.order_date >= $1 AND  o.order_date < $2) AND
-    o.status = 'order'
+    o.status IN ('order', 'paid')
 LEFT JOIN categories c ON p.category_id = c.id
 GROUP BY p.id, p.name, p.category_id, c.name
 ORDER BY total_quantity DESC
@@ -44,7 +50,7 @@ FROM products p
 LEFT JOIN order_items oi ON p.id = oi.product_id
 LEFT JOIN orders o ON oi.order_id = o.id AND
     (o.order_date >= $1 AND  o.order_date < $2) AND
-    o.status = 'order'
+    o.status IN ('order', 'paid')
 LEFT JOIN categories c ON p.category_id = c.id
 GROUP BY p.id, p.name, p.category_id, c.name
 ORDER BY total_quantity ASC
@@ -62,7 +68,7 @@ FROM users u
 JOIN orders o ON u.id = o.cashier_id
 WHERE 
     (o.order_date >= $1 AND  o.order_date < $2) AND
-    o.status = 'order'
+    o.status IN ('order', 'paid')
 GROUP BY u.id, u.username, u.full_name
 ORDER BY total_amount DESC
 LIMIT 10;
@@ -81,7 +87,106 @@ FROM customers c
 JOIN orders o ON c.id = o.customer_id
 WHERE 
     (o.order_date >= $1 AND  o.order_date < $2) AND
-    o.status = 'order'
+    o.status IN ('order', 'paid')
 GROUP BY c.id, c.member_code, c.name, c.phone, c.email
 ORDER BY total_spent DESC
-LIMIT 10;
\ No newline at end of file
+LIMIT 10;
+
+-- name: GetTaxSummary :many
+SELECT
+    COALESCE(oi.tax_name, 'Non Taxable')::VARCHAR as tax_name,
+    oi.tax_rate,
+    oi.tax_inclusive,
+    COUNT(DISTINCT o.id) as total_orders,
+    SUM(oi.unit_price * oi.quantity - oi.discount_amount)::DECIMAL as sales_amount,
+    SUM(CASE WHEN oi.tax_inclusive THEN oi.unit_price * oi.quantity - oi.discount_amount - oi.tax_amount ELSE oi.unit_price * oi.quantity - oi.discount_amount END)::DECIMAL as taxable_amount,
+    SUM(oi.tax_amount)::DECIMAL as tax_amount
+FROM order_items oi
+JOIN orders o ON oi.order_id = o.id
+WHERE 
+    (o.order_date >= $1 AND  o.order_date < $2) AND
+    o.status IN ('order', 'paid')
+GROUP BY oi.tax_name, oi.tax_rate, oi.tax_inclusive
+ORDER BY oi.tax_rate DESC;
+
+-- Laporan laba kotor dari view profit_lines: penjualan pada periode dikurangi refund
+-- yang terjadi pada periode yang sama
+
+-- name: GetProfitByProduct :many
+SELECT l.product_id,
+    COALESCE(p.name, MAX(l.old_product), '')::VARCHAR AS name,
+    COALESCE(SUM(l.quantity), 0)::BIGINT AS quantity,
+    COUNT(DISTINCT l.order_id) FILTER (WHERE l.quantity > 0)::BIGINT AS total_orders,
+    COALESCE(SUM(l.revenue), 0)::DECIMAL AS revenue,
+    COALESCE(SUM(l.discount), 0)::DECIMAL AS discount,
+    COALESCE(SUM(l.tax), 0)::DECIMAL AS tax,
+    COALESCE(SUM(l.refund), 0)::DECIMAL AS refund,
+    COALESCE(SUM(l.cogs), 0)::DECIMAL AS cogs
+FROM profit_lines l
+LEFT JOIN products p ON l.product_id = p.id
+WHERE l.trx_date >= sqlc.arg(start_date)::TIMESTAMP AND l.trx_date < sqlc.arg(end_date)::TIMESTAMP
+GROUP BY l.product_id, p.name
+ORDER BY SUM(l.revenue - l.discount - l.tax - l.cogs) DESC;
+
+-- name: GetProfitByCategory :many
+SELECT c.id AS category_id,
+    COALESCE(c.name, 'Uncategorized')::VARCHAR AS name,
+    COALESCE(SUM(l.quantity), 0)::BIGINT AS quantity,
+    COUNT(DISTINCT l.order_id) FILTER (WHERE l.quantity > 0)::BIGINT AS total_orders,
+    COALESCE(SUM(l.revenue), 0)::DECIMAL AS revenue,
+    COALESCE(SUM(l.discount), 0)::DECIMAL AS discount,
+    COALESCE(SUM(l.tax), 0)::DECIMAL AS tax,
+    COALESCE(SUM(l.refund), 0)::DECIMAL AS refund,
+    COALESCE(SUM(l.cogs), 0)::DECIMAL AS cogs
+FROM profit_lines l
+LEFT JOIN products p ON l.product_id = p.id
+LEFT JOIN categories c ON p.category_id = c.id
+WHERE l.trx_date >= sqlc.arg(start_date)::TIMESTAMP AND l.trx_date < sqlc.arg(end_date)::TIMESTAMP
+GROUP BY c.id, c.name
+ORDER BY SUM(l.revenue - l.discount - l.tax - l.cogs) DESC;
+
+-- name: GetProfitByCashier :many
+SELECT l.cashier_id,
+    COALESCE(u.username, '')::VARCHAR AS name,
+    COALESCE(SUM(l.quantity), 0)::BIGINT AS quantity,
+    COUNT(DISTINCT l.order_id) FILTER (WHERE l.quantity > 0)::BIGINT AS total_orders,
+    COALESCE(SUM(l.revenue), 0)::DECIMAL AS revenue,
+    COALESCE(SUM(l.discount), 0)::DECIMAL AS discount,
+    COALESCE(SUM(l.tax), 0)::DECIMAL AS tax,
+    COALESCE(SUM(l.refund), 0)::DECIMAL AS refund,
+    COALESCE(SUM(l.cogs), 0)::DECIMAL AS cogs
+FROM profit_lines l
+LEFT JOIN users u ON l.cashier_id = u.id
+WHERE l.trx_date >= sqlc.arg(start_date)::TIMESTAMP AND l.trx_date < sqlc.arg(end_date)::TIMESTAMP
+GROUP BY l.cashier_id, u.username
+ORDER BY SUM(l.revenue - l.discount - l.tax - l.cogs) DESC;
+
+-- name: GetProfitByCustomer :many
+SELECT l.customer_id,
+    COALESCE(c.name, 'Guest')::VARCHAR AS name,
+    COALESCE(SUM(l.quantity), 0)::BIGINT AS quantity,
+    COUNT(DISTINCT l.order_id) FILTER (WHERE l.quantity > 0)::BIGINT AS total_orders,
+    COALESCE(SUM(l.revenue), 0)::DECIMAL AS revenue,
+    COALESCE(SUM(l.discount), 0)::DECIMAL AS discount,
+    COALESCE(SUM(l.tax), 0)::DECIMAL AS tax,
+    COALESCE(SUM(l.refund), 0)::DECIMAL AS refund,
+    COALESCE(SUM(l.cogs), 0)::DECIMAL AS cogs
+FROM profit_lines l
+LEFT JOIN customers c ON l.customer_id = c.id
+WHERE l.trx_date >= sqlc.arg(start_date)::TIMESTAMP AND l.trx_date < sqlc.arg(end_date)::TIMESTAMP
+GROUP BY l.customer_id, c.name
+ORDER BY SUM(l.revenue - l.discount - l.tax - l.cogs) DESC;
+
+-- name: GetProfitByDay :many
+SELECT l.trx_date::DATE AS date,
+    COALESCE(SUM(l.quantity), 0)::BIGINT AS quantity,
+    COUNT(DISTINCT l.order_id) FILTER (WHERE l.quantity > 0)::BIGINT AS total_orders,
+    COALESCE(SUM(l.revenue), 0)::DECIMAL AS revenue,
+    COALESCE(SUM(l.discount), 0)::DECIMAL AS discount,
+    COALESCE(SUM(l.tax), 0)::DECIMAL AS tax,
+    COALESCE(SUM(l.refund), 0)::DECIMAL AS refund,
+    COALESCE(SUM(l.cogs), 0)::DECIMAL AS cogs
+FROM profit_lines l
+WHERE l.trx_date >= sqlc.arg(start_date)::TIMESTAMP AND l.trx_date < sqlc.arg(end_date)::TIMESTAMP
+GROUP BY l.trx_date::DATE
+ORDER BY l.trx_date::DATE ASC;
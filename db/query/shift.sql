@@ -0,0 +1,96 @@
+-- #SHIFT
+
+-- name: CreateShift :one
+INSERT INTO shifts (
+    shift_number,
+    cashier_id,
+    opening_float,
+    note,
+    opened_at
+) VALUES (
+    $1, $2, $3, $4, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: GetShiftByID :one
+SELECT *
+FROM shifts
+WHERE id = $1;
+
+-- name: GetOpenShiftByCashierID :one
+SELECT *
+FROM shifts
+WHERE cashier_id = $1 AND status = 'open'
+LIMIT 1;
+
+-- name: GetAllShifts :many
+SELECT
+    s.*,
+    u.username as cashier_name
+FROM shifts s
+LEFT JOIN users u ON s.cashier_id = u.id
+WHERE (sqlc.arg(cashier_id)::BIGINT = 0 OR s.cashier_id = sqlc.arg(cashier_id)::BIGINT)
+ORDER BY s.opened_at DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: CloseShift :one
+UPDATE shifts
+SET expected_cash = $2,
+    counted_cash = $3,
+    variance = $4,
+    note = $5,
+    status = 'closed',
+    closed_by = $6,
+    closed_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'open'
+RETURNING *;
+
+-- name: CreateShiftCashMovement :one
+INSERT INTO shift_cash_movements (
+    shift_id,
+    type,
+    amount,
+    reason,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetShiftCashMovements :many
+SELECT *
+FROM shift_cash_movements
+WHERE shift_id = $1
+ORDER BY created_at ASC;
+
+-- name: GetShiftSalesSummary :one
+SELECT
+    COUNT(o.id) as total_orders,
+    COALESCE(SUM(o.subtotal), 0)::DECIMAL as subtotal,
+    COALESCE(SUM(o.discount_amount + o.voucher_discount), 0)::DECIMAL as discount_amount,
+    COALESCE(SUM(o.tax_amount), 0)::DECIMAL as tax_amount,
+    COALESCE(SUM(o.total_amount), 0)::DECIMAL as total_amount
+FROM orders o
+WHERE o.shift_id = $1 AND o.status IN ('order', 'paid', 'refunded');
+
+-- name: GetShiftPaymentSummary :many
+SELECT
+    op.payment_method,
+    COALESCE(pm.type, 'other')::VARCHAR as payment_type,
+    COUNT(op.id) as total_payments,
+    COALESCE(SUM(op.amount), 0)::DECIMAL as amount
+FROM order_payments op
+JOIN orders o ON op.order_id = o.id
+LEFT JOIN payment_methods pm ON op.payment_method_id = pm.id
+WHERE o.shift_id = $1 AND o.status IN ('order', 'paid', 'refunded')
+GROUP BY op.payment_method, pm.type
+ORDER BY op.payment_method;
+
+-- name: GetShiftRefundSummary :one
+SELECT
+    COUNT(DISTINCT r.id) as total_refunds,
+    COALESCE(SUM(op.amount), 0)::DECIMAL as refund_amount,
+    COALESCE(SUM(CASE WHEN pm.type = 'cash' AND r.refund_method != 'store_credit' THEN op.amount ELSE 0 END), 0)::DECIMAL as cash_refund_amount
+FROM refunds r
+JOIN orders o ON r.order_id = o.id
+JOIN order_payments op ON op.order_id = o.id
+LEFT JOIN payment_methods pm ON op.payment_method_id = pm.id
+WHERE r.shift_id = $1;
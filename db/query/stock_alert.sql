@@ -0,0 +1,39 @@
+-- #STOCK_ALERT
+
+-- name: CreateStockAlert :execrows
+-- Tidak membuat alert baru jika produk masih punya alert yang terbuka
+INSERT INTO stock_alerts (product_id, trx_ref, stock, min_stock, status, created_by, created_at)
+VALUES ($1, $2, $3, $4, 'open', $5, CURRENT_TIMESTAMP)
+ON CONFLICT (product_id) WHERE status = 'open' DO NOTHING;
+
+-- name: ResolveStockAlerts :execrows
+UPDATE stock_alerts
+SET status = 'resolved', resolved_at = CURRENT_TIMESTAMP
+WHERE product_id = $1 AND status = 'open';
+
+-- name: GetStockAlertByID :one
+SELECT sa.*, p.name AS product_name
+FROM stock_alerts sa
+JOIN products p ON sa.product_id = p.id
+WHERE sa.id = $1;
+
+-- name: GetAllStockAlerts :many
+SELECT sa.*, p.name AS product_name
+FROM stock_alerts sa
+JOIN products p ON sa.product_id = p.id
+WHERE (sqlc.arg(status)::VARCHAR = '' OR sa.status = sqlc.arg(status)::VARCHAR)
+ORDER BY sa.created_at DESC, sa.id DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: GetUnnotifiedStockAlerts :many
+SELECT *
+FROM stock_alerts
+WHERE notified_at IS NULL
+ORDER BY created_at
+LIMIT $1
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkStockAlertNotified :exec
+UPDATE stock_alerts
+SET notified_at = CURRENT_TIMESTAMP
+WHERE id = $1;
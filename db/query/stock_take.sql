@@ -0,0 +1,75 @@
+-- #STOCK TAKE
+
+-- name: CreateStockTake :one
+INSERT INTO stock_takes (session_number, scope, category_id, status, notes, started_by, started_at)
+VALUES ($1, $2, $3, 'counting', $4, $5, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: CreateStockTakeItems :execrows
+INSERT INTO stock_take_items (stock_take_id, product_id, expected_quantity, unit_cost)
+SELECT sqlc.arg(stock_take_id)::BIGINT, p.id, p.stock, p.cost
+FROM products p
+WHERE p.deleted_at IS NULL
+    AND NOT p.is_gift_card
+    AND (sqlc.arg(category_id)::BIGINT = 0 OR p.category_id = sqlc.arg(category_id)::BIGINT);
+
+-- name: GetStockTakeByID :one
+SELECT *
+FROM stock_takes
+WHERE id = $1;
+
+-- name: GetStockTakeByIDForShare :one
+SELECT *
+FROM stock_takes
+WHERE id = $1
+FOR SHARE;
+
+-- name: GetStockTakeByIDForUpdate :one
+SELECT *
+FROM stock_takes
+WHERE id = $1
+FOR UPDATE;
+
+-- name: GetAllStockTakes :many
+SELECT st.*, c.name AS category_name
+FROM stock_takes st
+LEFT JOIN categories c ON st.category_id = c.id
+WHERE (sqlc.arg(status)::VARCHAR = '' OR st.status = sqlc.arg(status)::VARCHAR)
+ORDER BY st.started_at DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: AddStockTakeCount :one
+UPDATE stock_take_items
+SET counted_quantity = CASE WHEN sqlc.arg(replace)::BOOLEAN THEN sqlc.arg(quantity)::INT ELSE COALESCE(counted_quantity, 0) + sqlc.arg(quantity)::INT END,
+    counted_at = CURRENT_TIMESTAMP
+WHERE stock_take_id = sqlc.arg(stock_take_id) AND product_id = sqlc.arg(product_id)
+RETURNING *;
+
+-- name: CreateStockTakeCount :one
+INSERT INTO stock_take_counts (stock_take_id, product_id, barcode, quantity, replace, device_id, counted_by, counted_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: GetStockTakeItems :many
+SELECT sti.*, p.name AS product_name, p.barcode
+FROM stock_take_items sti
+JOIN products p ON sti.product_id = p.id
+WHERE sti.stock_take_id = $1
+ORDER BY p.name ASC;
+
+-- name: UpdateStockTakeItemResult :exec
+UPDATE stock_take_items
+SET counted_quantity = $2, reason_code = $3
+WHERE id = $1;
+
+-- name: PostStockTake :one
+UPDATE stock_takes
+SET status = 'posted', posted_by = $2, posted_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: CancelStockTake :one
+UPDATE stock_takes
+SET status = 'cancelled', cancelled_by = $2, cancelled_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
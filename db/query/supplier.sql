@@ -0,0 +1,31 @@
+-- #SUPPLIER
+
+-- name: GetAllSuppliers :many
+SELECT *
+FROM suppliers
+WHERE deleted_at IS NULL
+    AND (sqlc.arg(query)::VARCHAR = '' OR name ILIKE '%' || sqlc.arg(query)::VARCHAR || '%' OR contact_name ILIKE '%' || sqlc.arg(query)::VARCHAR || '%')
+ORDER BY name ASC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: GetSupplierByID :one
+SELECT *
+FROM suppliers
+WHERE id = $1;
+
+-- name: CreateSupplier :one
+INSERT INTO suppliers (name, contact_name, phone, email, address, notes, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: UpdateSupplier :one
+UPDATE suppliers
+SET name = $2, contact_name = $3, phone = $4, email = $5, address = $6, notes = $7, updated_by = $8, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: SoftDeleteSupplierByID :one
+UPDATE suppliers
+SET deleted_by = $2, deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,40 @@
+-- #TAX
+
+-- name: GetAllTaxRates :many
+SELECT *
+FROM tax_rates
+WHERE deleted_at IS NULL
+ORDER BY created_at DESC
+LIMIT $1 OFFSET $2;
+
+-- name: GetTaxRateByID :one
+SELECT *
+FROM tax_rates
+WHERE id = $1;
+
+-- name: CreateTaxRate :one
+INSERT INTO tax_rates (name, rate, is_inclusive, created_by, created_at)
+VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)
+RETURNING *;
+
+-- name: UpdateTaxRate :one
+UPDATE tax_rates
+SET name = $2, rate = $3, is_inclusive = $4, is_active = $5, updated_by = $6, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: SoftDeleteTaxRateByID :one
+UPDATE tax_rates
+SET deleted_by = $2, deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: GetTaxRateByProductID :one
+-- Tarif produk yang nonaktif atau dihapus jatuh ke tarif kategori
+SELECT t.*
+FROM products p
+LEFT JOIN categories c ON p.category_id = c.id
+LEFT JOIN tax_rates pt ON pt.id = p.tax_rate_id AND pt.deleted_at IS NULL AND pt.is_active = TRUE
+LEFT JOIN tax_rates ct ON ct.id = c.tax_rate_id AND ct.deleted_at IS NULL AND ct.is_active = TRUE
+JOIN tax_rates t ON t.id = COALESCE(pt.id, ct.id)
+WHERE p.id = $1;
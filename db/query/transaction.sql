@@ -3,11 +3,18 @@ INSERT INTO orders (
     trx_number,
     cashier_id,
     customer_id,
+    subtotal,
+    discount_amount,
+    voucher_code,
+    voucher_discount,
+    tax_amount,
     total_amount,
+    change_amount,
     payment_method,
-    status
+    status,
+    shift_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
 ) RETURNING *;
 
 -- name: CreateOrderItem :one
@@ -17,9 +24,16 @@ INSERT INTO order_items (
     old_product,
     quantity,
     unit_price,
+    discount_amount,
+    tax_name,
+    tax_rate,
+    tax_inclusive,
+    tax_amount,
+    unit_cost,
+    cogs,
     created_by
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
 ) RETURNING *;
 
 
@@ -27,9 +41,11 @@ INSERT INTO order_items (
 INSERT INTO refunds (
     order_id,
     reason,
-    created_by
+    created_by,
+    shift_id,
+    refund_method
 ) VALUES (
-    $1, $2, $3
+    $1, $2, $3, $4, $5
 ) RETURNING *;
 
 -- GetOrderByTrxNumber
@@ -38,6 +54,13 @@ SELECT * FROM orders
 WHERE trx_number = $1 
 LIMIT 1;
 
+-- GetOrderByTrxNumberForUpdate
+-- name: GetOrderByTrxNumberForUpdate :one
+SELECT * FROM orders
+WHERE trx_number = $1
+LIMIT 1
+FOR UPDATE;
+
 -- GetOrderItemsByOrderID
 -- name: GetOrderItemsByOrderID :many
 SELECT * FROM order_items 
@@ -0,0 +1,102 @@
+-- #VOUCHER
+
+-- name: GetAllVouchers :many
+SELECT *
+FROM vouchers
+WHERE deleted_at IS NULL
+ORDER BY created_at DESC
+LIMIT $1 OFFSET $2;
+
+-- name: GetVoucherByID :one
+SELECT *
+FROM vouchers
+WHERE id = $1;
+
+-- name: GetVoucherByCode :one
+SELECT *
+FROM vouchers
+WHERE code = $1 AND deleted_at IS NULL;
+
+-- name: GetVoucherByCodeForUpdate :one
+SELECT *
+FROM vouchers
+WHERE code = $1 AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: CreateVoucher :one
+INSERT INTO vouchers (
+    code,
+    description,
+    value_type,
+    value,
+    max_discount,
+    min_spend,
+    usage_limit,
+    usage_per_customer,
+    start_at,
+    end_at,
+    created_by,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: UpdateVoucher :one
+UPDATE vouchers
+SET code = $2,
+    description = $3,
+    value_type = $4,
+    value = $5,
+    max_discount = $6,
+    min_spend = $7,
+    usage_limit = $8,
+    usage_per_customer = $9,
+    is_active = $10,
+    start_at = $11,
+    end_at = $12,
+    updated_by = $13,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: SoftDeleteVoucherByID :one
+UPDATE vouchers
+SET deleted_by = $2, deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: IncrementVoucherUsage :one
+UPDATE vouchers
+SET used_count = used_count + 1
+WHERE id = $1
+    AND (usage_limit = 0 OR used_count < usage_limit)
+RETURNING *;
+
+-- name: DecrementVoucherUsage :exec
+UPDATE vouchers
+SET used_count = GREATEST(used_count - 1, 0)
+WHERE id = $1;
+
+-- name: CountCustomerVoucherRedemptions :one
+SELECT COUNT(*)
+FROM voucher_redemptions
+WHERE voucher_id = $1
+    AND customer_id = $2
+    AND status = 'redeemed';
+
+-- name: CreateVoucherRedemption :one
+INSERT INTO voucher_redemptions (voucher_id, order_id, customer_id, discount_amount)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetVoucherRedemptionByOrderID :one
+SELECT *
+FROM voucher_redemptions
+WHERE order_id = $1 AND status = 'redeemed'
+LIMIT 1;
+
+-- name: ReverseVoucherRedemption :one
+UPDATE voucher_redemptions
+SET status = 'reversed', reversed_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
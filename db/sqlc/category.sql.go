@@ -11,18 +11,25 @@ import (
 )
 
 const createCategory = `-- name: CreateCategory :one
-INSERT INTO categories (name, created_by, created_at)
-VALUES ($1, $2, CURRENT_TIMESTAMP)
-RETURNING id, name, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+INSERT INTO categories (name, tax_rate_id, points_multiplier, created_by, created_at)
+VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)
+RETURNING id, name, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, points_multiplier
 `
 
 type CreateCategoryParams struct {
-	Name      string        `json:"name"`
-	CreatedBy sql.NullInt64 `json:"created_by"`
+	Name             string        `json:"name"`
+	TaxRateID        sql.NullInt64 `json:"tax_rate_id"`
+	PointsMultiplier string        `json:"points_multiplier"`
+	CreatedBy        sql.NullInt64 `json:"created_by"`
 }
 
 func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams) (Category, error) {
-	row := q.queryRow(ctx, q.createCategoryStmt, createCategory, arg.Name, arg.CreatedBy)
+	row := q.queryRow(ctx, q.createCategoryStmt, createCategory,
+		arg.Name,
+		arg.TaxRateID,
+		arg.PointsMultiplier,
+		arg.CreatedBy,
+	)
 	var i Category
 	err := row.Scan(
 		&i.ID,
@@ -33,6 +40,8 @@ func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.PointsMultiplier,
 	)
 	return i, err
 }
@@ -51,7 +60,7 @@ func (q *Queries) DeleteCategoryByID(ctx context.Context, id int64) (int64, erro
 
 const getAllCategories = `-- name: GetAllCategories :many
 
-SELECT id, name, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+SELECT id, name, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, points_multiplier
 FROM categories
 WHERE deleted_at IS NULL
 ORDER BY created_at DESC
@@ -82,6 +91,8 @@ func (q *Queries) GetAllCategories(ctx context.Context, arg GetAllCategoriesPara
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.TaxRateID,
+			&i.PointsMultiplier,
 		); err != nil {
 			return nil, err
 		}
@@ -97,7 +108,7 @@ func (q *Queries) GetAllCategories(ctx context.Context, arg GetAllCategoriesPara
 }
 
 const getAllDeletedCategories = `-- name: GetAllDeletedCategories :many
-SELECT id, name, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+SELECT id, name, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, points_multiplier
 FROM categories
 WHERE deleted_at IS NOT NULL
 ORDER BY created_at DESC
@@ -127,6 +138,8 @@ func (q *Queries) GetAllDeletedCategories(ctx context.Context, arg GetAllDeleted
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.TaxRateID,
+			&i.PointsMultiplier,
 		); err != nil {
 			return nil, err
 		}
@@ -142,7 +155,7 @@ func (q *Queries) GetAllDeletedCategories(ctx context.Context, arg GetAllDeleted
 }
 
 const getCategoryByID = `-- name: GetCategoryByID :one
-SELECT id, name, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+SELECT id, name, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, points_multiplier
 FROM categories
 WHERE id = $1
 `
@@ -159,6 +172,8 @@ func (q *Queries) GetCategoryByID(ctx context.Context, id int64) (Category, erro
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.PointsMultiplier,
 	)
 	return i, err
 }
@@ -167,7 +182,7 @@ const softDeleteCategoryByID = `-- name: SoftDeleteCategoryByID :one
 UPDATE categories
 SET deleted_by = $2, deleted_at = CURRENT_TIMESTAMP
 WHERE id = $1
-RETURNING id, name, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+RETURNING id, name, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, points_multiplier
 `
 
 type SoftDeleteCategoryByIDParams struct {
@@ -187,25 +202,35 @@ func (q *Queries) SoftDeleteCategoryByID(ctx context.Context, arg SoftDeleteCate
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.PointsMultiplier,
 	)
 	return i, err
 }
 
 const updateCategory = `-- name: UpdateCategory :one
 UPDATE categories
-SET name = $2, updated_by = $3, updated_at = CURRENT_TIMESTAMP
+SET name = $2, tax_rate_id = $3, points_multiplier = $4, updated_by = $5, updated_at = CURRENT_TIMESTAMP
 WHERE id = $1
-RETURNING id, name, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+RETURNING id, name, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, points_multiplier
 `
 
 type UpdateCategoryParams struct {
-	ID        int64         `json:"id"`
-	Name      string        `json:"name"`
-	UpdatedBy sql.NullInt64 `json:"updated_by"`
+	ID               int64         `json:"id"`
+	Name             string        `json:"name"`
+	TaxRateID        sql.NullInt64 `json:"tax_rate_id"`
+	PointsMultiplier string        `json:"points_multiplier"`
+	UpdatedBy        sql.NullInt64 `json:"updated_by"`
 }
 
 func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (Category, error) {
-	row := q.queryRow(ctx, q.updateCategoryStmt, updateCategory, arg.ID, arg.Name, arg.UpdatedBy)
+	row := q.queryRow(ctx, q.updateCategoryStmt, updateCategory,
+		arg.ID,
+		arg.Name,
+		arg.TaxRateID,
+		arg.PointsMultiplier,
+		arg.UpdatedBy,
+	)
 	var i Category
 	err := row.Scan(
 		&i.ID,
@@ -216,6 +241,8 @@ func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.PointsMultiplier,
 	)
 	return i, err
 }
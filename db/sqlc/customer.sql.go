@@ -13,7 +13,7 @@ import (
 const createCustomer = `-- name: CreateCustomer :one
 INSERT INTO customers (member_code, name, phone, email, created_by, created_at)
 VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP)
-RETURNING id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+RETURNING id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tier_id, tier_updated_at, email_marketing_consent, whatsapp_marketing_consent, consent_updated_at, anonymized_at
 `
 
 type CreateCustomerParams struct {
@@ -45,6 +45,12 @@ func (q *Queries) CreateCustomer(ctx context.Context, arg CreateCustomerParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TierID,
+		&i.TierUpdatedAt,
+		&i.EmailMarketingConsent,
+		&i.WhatsappMarketingConsent,
+		&i.ConsentUpdatedAt,
+		&i.AnonymizedAt,
 	)
 	return i, err
 }
@@ -63,7 +69,7 @@ func (q *Queries) DeleteCustomerByID(ctx context.Context, id int64) (int64, erro
 
 const getAllCustomers = `-- name: GetAllCustomers :many
 
-SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tier_id, tier_updated_at, email_marketing_consent, whatsapp_marketing_consent, consent_updated_at, anonymized_at
 FROM customers
 WHERE deleted_at IS NULL
 ORDER BY created_at DESC
@@ -97,6 +103,12 @@ func (q *Queries) GetAllCustomers(ctx context.Context, arg GetAllCustomersParams
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.TierID,
+			&i.TierUpdatedAt,
+			&i.EmailMarketingConsent,
+			&i.WhatsappMarketingConsent,
+			&i.ConsentUpdatedAt,
+			&i.AnonymizedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -112,7 +124,7 @@ func (q *Queries) GetAllCustomers(ctx context.Context, arg GetAllCustomersParams
 }
 
 const getAllDeletedCustomers = `-- name: GetAllDeletedCustomers :many
-SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tier_id, tier_updated_at, email_marketing_consent, whatsapp_marketing_consent, consent_updated_at, anonymized_at
 FROM customers
 WHERE deleted_at IS NOT NULL
 ORDER BY created_at DESC
@@ -145,6 +157,12 @@ func (q *Queries) GetAllDeletedCustomers(ctx context.Context, arg GetAllDeletedC
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.TierID,
+			&i.TierUpdatedAt,
+			&i.EmailMarketingConsent,
+			&i.WhatsappMarketingConsent,
+			&i.ConsentUpdatedAt,
+			&i.AnonymizedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -160,7 +178,7 @@ func (q *Queries) GetAllDeletedCustomers(ctx context.Context, arg GetAllDeletedC
 }
 
 const getCustomerByEmail = `-- name: GetCustomerByEmail :one
-SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tier_id, tier_updated_at, email_marketing_consent, whatsapp_marketing_consent, consent_updated_at, anonymized_at
 FROM customers
 WHERE email = $1
 `
@@ -180,12 +198,18 @@ func (q *Queries) GetCustomerByEmail(ctx context.Context, email sql.NullString)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TierID,
+		&i.TierUpdatedAt,
+		&i.EmailMarketingConsent,
+		&i.WhatsappMarketingConsent,
+		&i.ConsentUpdatedAt,
+		&i.AnonymizedAt,
 	)
 	return i, err
 }
 
 const getCustomerByEmailExceptID = `-- name: GetCustomerByEmailExceptID :one
-SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tier_id, tier_updated_at, email_marketing_consent, whatsapp_marketing_consent, consent_updated_at, anonymized_at
 FROM customers
 WHERE email = $1 AND id != $2
 LIMIT 1
@@ -211,12 +235,18 @@ func (q *Queries) GetCustomerByEmailExceptID(ctx context.Context, arg GetCustome
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TierID,
+		&i.TierUpdatedAt,
+		&i.EmailMarketingConsent,
+		&i.WhatsappMarketingConsent,
+		&i.ConsentUpdatedAt,
+		&i.AnonymizedAt,
 	)
 	return i, err
 }
 
 const getCustomerByID = `-- name: GetCustomerByID :one
-SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tier_id, tier_updated_at, email_marketing_consent, whatsapp_marketing_consent, consent_updated_at, anonymized_at
 FROM customers
 WHERE id = $1
 LIMIT 1
@@ -237,12 +267,18 @@ func (q *Queries) GetCustomerByID(ctx context.Context, id int64) (Customer, erro
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TierID,
+		&i.TierUpdatedAt,
+		&i.EmailMarketingConsent,
+		&i.WhatsappMarketingConsent,
+		&i.ConsentUpdatedAt,
+		&i.AnonymizedAt,
 	)
 	return i, err
 }
 
 const getCustomerByPhone = `-- name: GetCustomerByPhone :one
-SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tier_id, tier_updated_at, email_marketing_consent, whatsapp_marketing_consent, consent_updated_at, anonymized_at
 FROM customers
 WHERE phone = $1
 `
@@ -262,12 +298,18 @@ func (q *Queries) GetCustomerByPhone(ctx context.Context, phone sql.NullString)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TierID,
+		&i.TierUpdatedAt,
+		&i.EmailMarketingConsent,
+		&i.WhatsappMarketingConsent,
+		&i.ConsentUpdatedAt,
+		&i.AnonymizedAt,
 	)
 	return i, err
 }
 
 const getCustomerByPhoneExceptID = `-- name: GetCustomerByPhoneExceptID :one
-SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tier_id, tier_updated_at, email_marketing_consent, whatsapp_marketing_consent, consent_updated_at, anonymized_at
 FROM customers
 WHERE phone = $1 AND id != $2
 LIMIT 1
@@ -293,15 +335,335 @@ func (q *Queries) GetCustomerByPhoneExceptID(ctx context.Context, arg GetCustome
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TierID,
+		&i.TierUpdatedAt,
+		&i.EmailMarketingConsent,
+		&i.WhatsappMarketingConsent,
+		&i.ConsentUpdatedAt,
+		&i.AnonymizedAt,
 	)
 	return i, err
 }
 
+const getCustomerFavoriteProducts = `-- name: GetCustomerFavoriteProducts :many
+SELECT
+    oi.product_id,
+    COALESCE(p.name, MAX(oi.old_product))::VARCHAR as product_name,
+    SUM(oi.quantity) as total_quantity,
+    COUNT(DISTINCT o.id) as total_orders,
+    SUM(oi.unit_price * oi.quantity - oi.discount_amount)::DECIMAL as total_spent
+FROM order_items oi
+JOIN orders o ON oi.order_id = o.id
+LEFT JOIN products p ON oi.product_id = p.id
+WHERE o.customer_id = $1 AND o.status IN ('order', 'paid')
+GROUP BY oi.product_id, p.name
+ORDER BY total_quantity DESC
+LIMIT $2
+`
+
+type GetCustomerFavoriteProductsParams struct {
+	CustomerID sql.NullInt64 `json:"customer_id"`
+	Limit      int32         `json:"limit"`
+}
+
+type GetCustomerFavoriteProductsRow struct {
+	ProductID     sql.NullInt64 `json:"product_id"`
+	ProductName   string        `json:"product_name"`
+	TotalQuantity int64         `json:"total_quantity"`
+	TotalOrders   int64         `json:"total_orders"`
+	TotalSpent    string        `json:"total_spent"`
+}
+
+func (q *Queries) GetCustomerFavoriteProducts(ctx context.Context, arg GetCustomerFavoriteProductsParams) ([]GetCustomerFavoriteProductsRow, error) {
+	rows, err := q.query(ctx, q.getCustomerFavoriteProductsStmt, getCustomerFavoriteProducts, arg.CustomerID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetCustomerFavoriteProductsRow{}
+	for rows.Next() {
+		var i GetCustomerFavoriteProductsRow
+		if err := rows.Scan(
+			&i.ProductID,
+			&i.ProductName,
+			&i.TotalQuantity,
+			&i.TotalOrders,
+			&i.TotalSpent,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCustomerLastOrder = `-- name: GetCustomerLastOrder :one
+SELECT id, trx_number, cashier_id, customer_id, total_amount, payment_method, status, order_date, updated_by, updated_at, subtotal, discount_amount, voucher_code, voucher_discount, tax_amount, change_amount, shift_id, receipt_print_count, last_printed_at
+FROM orders
+WHERE customer_id = $1 AND status IN ('order', 'paid', 'refunded')
+ORDER BY order_date DESC
+LIMIT 1
+`
+
+func (q *Queries) GetCustomerLastOrder(ctx context.Context, customerID sql.NullInt64) (Order, error) {
+	row := q.queryRow(ctx, q.getCustomerLastOrderStmt, getCustomerLastOrder, customerID)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.TrxNumber,
+		&i.CashierID,
+		&i.CustomerID,
+		&i.TotalAmount,
+		&i.PaymentMethod,
+		&i.Status,
+		&i.OrderDate,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+		&i.Subtotal,
+		&i.DiscountAmount,
+		&i.VoucherCode,
+		&i.VoucherDiscount,
+		&i.TaxAmount,
+		&i.ChangeAmount,
+		&i.ShiftID,
+		&i.ReceiptPrintCount,
+		&i.LastPrintedAt,
+	)
+	return i, err
+}
+
+const getCustomerOrders = `-- name: GetCustomerOrders :many
+SELECT id, trx_number, cashier_id, customer_id, total_amount, payment_method, status, order_date, updated_by, updated_at, subtotal, discount_amount, voucher_code, voucher_discount, tax_amount, change_amount, shift_id, receipt_print_count, last_printed_at
+FROM orders
+WHERE customer_id = $1
+    AND ($2::VARCHAR = '' OR status = $2::VARCHAR)
+ORDER BY order_date DESC
+LIMIT $3 OFFSET $4
+`
+
+type GetCustomerOrdersParams struct {
+	CustomerID sql.NullInt64 `json:"customer_id"`
+	Status     string        `json:"status"`
+	Limit      int32         `json:"limit"`
+	Offset     int32         `json:"offset"`
+}
+
+func (q *Queries) GetCustomerOrders(ctx context.Context, arg GetCustomerOrdersParams) ([]Order, error) {
+	rows, err := q.query(ctx, q.getCustomerOrdersStmt, getCustomerOrders,
+		arg.CustomerID,
+		arg.Status,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Order{}
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.TrxNumber,
+			&i.CashierID,
+			&i.CustomerID,
+			&i.TotalAmount,
+			&i.PaymentMethod,
+			&i.Status,
+			&i.OrderDate,
+			&i.UpdatedBy,
+			&i.UpdatedAt,
+			&i.Subtotal,
+			&i.DiscountAmount,
+			&i.VoucherCode,
+			&i.VoucherDiscount,
+			&i.TaxAmount,
+			&i.ChangeAmount,
+			&i.ShiftID,
+			&i.ReceiptPrintCount,
+			&i.LastPrintedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCustomerSummary = `-- name: GetCustomerSummary :one
+SELECT
+    COUNT(DISTINCT CASE WHEN o.status IN ('order', 'paid') THEN o.id END) as total_orders,
+    COALESCE(SUM(CASE WHEN o.status IN ('order', 'paid') THEN o.total_amount END), 0)::DECIMAL as lifetime_spend,
+    COALESCE(AVG(CASE WHEN o.status IN ('order', 'paid') THEN o.total_amount END), 0)::DECIMAL as average_basket,
+    COUNT(DISTINCT CASE WHEN o.status = 'refunded' THEN o.id END) as total_refunds
+FROM orders o
+WHERE o.customer_id = $1
+`
+
+type GetCustomerSummaryRow struct {
+	TotalOrders   int64  `json:"total_orders"`
+	LifetimeSpend string `json:"lifetime_spend"`
+	AverageBasket string `json:"average_basket"`
+	TotalRefunds  int64  `json:"total_refunds"`
+}
+
+func (q *Queries) GetCustomerSummary(ctx context.Context, customerID sql.NullInt64) (GetCustomerSummaryRow, error) {
+	row := q.queryRow(ctx, q.getCustomerSummaryStmt, getCustomerSummary, customerID)
+	var i GetCustomerSummaryRow
+	err := row.Scan(
+		&i.TotalOrders,
+		&i.LifetimeSpend,
+		&i.AverageBasket,
+		&i.TotalRefunds,
+	)
+	return i, err
+}
+
+const getCustomersByMemberCodeOrPhone = `-- name: GetCustomersByMemberCodeOrPhone :many
+SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tier_id, tier_updated_at, email_marketing_consent, whatsapp_marketing_consent, consent_updated_at, anonymized_at
+FROM customers
+WHERE deleted_at IS NULL
+    AND (lower(member_code) = lower($1::VARCHAR)
+        OR ($2::VARCHAR != '' AND normalize_phone(phone) = $2::VARCHAR))
+ORDER BY (lower(member_code) = lower($1::VARCHAR)) DESC, created_at DESC
+LIMIT 2
+`
+
+type GetCustomersByMemberCodeOrPhoneParams struct {
+	MemberCode string `json:"member_code"`
+	Phone      string `json:"phone"`
+}
+
+func (q *Queries) GetCustomersByMemberCodeOrPhone(ctx context.Context, arg GetCustomersByMemberCodeOrPhoneParams) ([]Customer, error) {
+	rows, err := q.query(ctx, q.getCustomersByMemberCodeOrPhoneStmt, getCustomersByMemberCodeOrPhone, arg.MemberCode, arg.Phone)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Customer{}
+	for rows.Next() {
+		var i Customer
+		if err := rows.Scan(
+			&i.ID,
+			&i.MemberCode,
+			&i.Name,
+			&i.Phone,
+			&i.Email,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.DeletedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TierID,
+			&i.TierUpdatedAt,
+			&i.EmailMarketingConsent,
+			&i.WhatsappMarketingConsent,
+			&i.ConsentUpdatedAt,
+			&i.AnonymizedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchCustomers = `-- name: SearchCustomers :many
+SELECT id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tier_id, tier_updated_at, email_marketing_consent, whatsapp_marketing_consent, consent_updated_at, anonymized_at
+FROM customers
+WHERE deleted_at IS NULL
+    AND (
+        lower(member_code) = lower($1::VARCHAR)
+        OR ($2::VARCHAR != '' AND normalize_phone(phone) LIKE '%' || $2::VARCHAR || '%')
+        OR lower(email) LIKE '%' || lower($1::VARCHAR) || '%'
+        OR lower(name) LIKE '%' || lower($1::VARCHAR) || '%'
+        OR similarity(lower(name), lower($1::VARCHAR)) >= 0.3
+    )
+ORDER BY
+    (lower(member_code) = lower($1::VARCHAR)) DESC,
+    ($2::VARCHAR != '' AND normalize_phone(phone) = $2::VARCHAR) DESC,
+    similarity(lower(name), lower($1::VARCHAR)) DESC,
+    created_at DESC
+LIMIT $3 OFFSET $4
+`
+
+type SearchCustomersParams struct {
+	Query  string `json:"query"`
+	Phone  string `json:"phone"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) SearchCustomers(ctx context.Context, arg SearchCustomersParams) ([]Customer, error) {
+	rows, err := q.query(ctx, q.searchCustomersStmt, searchCustomers,
+		arg.Query,
+		arg.Phone,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Customer{}
+	for rows.Next() {
+		var i Customer
+		if err := rows.Scan(
+			&i.ID,
+			&i.MemberCode,
+			&i.Name,
+			&i.Phone,
+			&i.Email,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.DeletedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TierID,
+			&i.TierUpdatedAt,
+			&i.EmailMarketingConsent,
+			&i.WhatsappMarketingConsent,
+			&i.ConsentUpdatedAt,
+			&i.AnonymizedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const softDeleteCustomerByID = `-- name: SoftDeleteCustomerByID :one
 UPDATE customers
 SET deleted_by = $2, deleted_at = CURRENT_TIMESTAMP
 WHERE id = $1
-RETURNING id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+RETURNING id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tier_id, tier_updated_at, email_marketing_consent, whatsapp_marketing_consent, consent_updated_at, anonymized_at
 `
 
 type SoftDeleteCustomerByIDParams struct {
@@ -324,6 +686,12 @@ func (q *Queries) SoftDeleteCustomerByID(ctx context.Context, arg SoftDeleteCust
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TierID,
+		&i.TierUpdatedAt,
+		&i.EmailMarketingConsent,
+		&i.WhatsappMarketingConsent,
+		&i.ConsentUpdatedAt,
+		&i.AnonymizedAt,
 	)
 	return i, err
 }
@@ -332,7 +700,7 @@ const updateCustomer = `-- name: UpdateCustomer :one
 UPDATE customers
 SET member_code = $2, name = $3, phone = $4, email = $5, updated_by = $6, updated_at = CURRENT_TIMESTAMP
 WHERE id = $1
-RETURNING id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+RETURNING id, member_code, name, phone, email, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tier_id, tier_updated_at, email_marketing_consent, whatsapp_marketing_consent, consent_updated_at, anonymized_at
 `
 
 type UpdateCustomerParams struct {
@@ -366,6 +734,12 @@ func (q *Queries) UpdateCustomer(ctx context.Context, arg UpdateCustomerParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TierID,
+		&i.TierUpdatedAt,
+		&i.EmailMarketingConsent,
+		&i.WhatsappMarketingConsent,
+		&i.ConsentUpdatedAt,
+		&i.AnonymizedAt,
 	)
 	return i, err
 }
@@ -24,51 +24,201 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.addStockTakeCountStmt, err = db.PrepareContext(ctx, addStockTakeCount); err != nil {
+		return nil, fmt.Errorf("error preparing query AddStockTakeCount: %w", err)
+	}
+	if q.anonymizeCustomerStmt, err = db.PrepareContext(ctx, anonymizeCustomer); err != nil {
+		return nil, fmt.Errorf("error preparing query AnonymizeCustomer: %w", err)
+	}
+	if q.anonymizeCustomerMergesStmt, err = db.PrepareContext(ctx, anonymizeCustomerMerges); err != nil {
+		return nil, fmt.Errorf("error preparing query AnonymizeCustomerMerges: %w", err)
+	}
+	if q.anonymizeCustomerNotificationsStmt, err = db.PrepareContext(ctx, anonymizeCustomerNotifications); err != nil {
+		return nil, fmt.Errorf("error preparing query AnonymizeCustomerNotifications: %w", err)
+	}
+	if q.anonymizeCustomerParkedOrdersStmt, err = db.PrepareContext(ctx, anonymizeCustomerParkedOrders); err != nil {
+		return nil, fmt.Errorf("error preparing query AnonymizeCustomerParkedOrders: %w", err)
+	}
+	if q.cancelPurchaseOrderStmt, err = db.PrepareContext(ctx, cancelPurchaseOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query CancelPurchaseOrder: %w", err)
+	}
+	if q.cancelStockTakeStmt, err = db.PrepareContext(ctx, cancelStockTake); err != nil {
+		return nil, fmt.Errorf("error preparing query CancelStockTake: %w", err)
+	}
 	if q.checkTokenStmt, err = db.PrepareContext(ctx, checkToken); err != nil {
 		return nil, fmt.Errorf("error preparing query CheckToken: %w", err)
 	}
+	if q.claimDueNotificationsStmt, err = db.PrepareContext(ctx, claimDueNotifications); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimDueNotifications: %w", err)
+	}
+	if q.closeShiftStmt, err = db.PrepareContext(ctx, closeShift); err != nil {
+		return nil, fmt.Errorf("error preparing query CloseShift: %w", err)
+	}
+	if q.countCustomerOrdersStmt, err = db.PrepareContext(ctx, countCustomerOrders); err != nil {
+		return nil, fmt.Errorf("error preparing query CountCustomerOrders: %w", err)
+	}
+	if q.countCustomerVoucherRedemptionsStmt, err = db.PrepareContext(ctx, countCustomerVoucherRedemptions); err != nil {
+		return nil, fmt.Errorf("error preparing query CountCustomerVoucherRedemptions: %w", err)
+	}
 	if q.createCategoryStmt, err = db.PrepareContext(ctx, createCategory); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateCategory: %w", err)
 	}
+	if q.createCostLayerStmt, err = db.PrepareContext(ctx, createCostLayer); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateCostLayer: %w", err)
+	}
 	if q.createCustomerStmt, err = db.PrepareContext(ctx, createCustomer); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateCustomer: %w", err)
 	}
+	if q.createCustomerConsentStmt, err = db.PrepareContext(ctx, createCustomerConsent); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateCustomerConsent: %w", err)
+	}
+	if q.createCustomerMergeStmt, err = db.PrepareContext(ctx, createCustomerMerge); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateCustomerMerge: %w", err)
+	}
+	if q.createCustomerTierChangeStmt, err = db.PrepareContext(ctx, createCustomerTierChange); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateCustomerTierChange: %w", err)
+	}
+	if q.createGiftCardStmt, err = db.PrepareContext(ctx, createGiftCard); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateGiftCard: %w", err)
+	}
+	if q.createGiftCardTransactionStmt, err = db.PrepareContext(ctx, createGiftCardTransaction); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateGiftCardTransaction: %w", err)
+	}
+	if q.createLoyaltyPointStmt, err = db.PrepareContext(ctx, createLoyaltyPoint); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateLoyaltyPoint: %w", err)
+	}
+	if q.createNotificationStmt, err = db.PrepareContext(ctx, createNotification); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateNotification: %w", err)
+	}
 	if q.createOrderStmt, err = db.PrepareContext(ctx, createOrder); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateOrder: %w", err)
 	}
 	if q.createOrderItemStmt, err = db.PrepareContext(ctx, createOrderItem); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateOrderItem: %w", err)
 	}
+	if q.createOrderPaymentStmt, err = db.PrepareContext(ctx, createOrderPayment); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateOrderPayment: %w", err)
+	}
+	if q.createOrderPromotionStmt, err = db.PrepareContext(ctx, createOrderPromotion); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateOrderPromotion: %w", err)
+	}
+	if q.createParkedOrderStmt, err = db.PrepareContext(ctx, createParkedOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateParkedOrder: %w", err)
+	}
+	if q.createParkedOrderItemStmt, err = db.PrepareContext(ctx, createParkedOrderItem); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateParkedOrderItem: %w", err)
+	}
+	if q.createPaymentChargeStmt, err = db.PrepareContext(ctx, createPaymentCharge); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePaymentCharge: %w", err)
+	}
+	if q.createPaymentMethodStmt, err = db.PrepareContext(ctx, createPaymentMethod); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePaymentMethod: %w", err)
+	}
+	if q.createPaymentRefundStmt, err = db.PrepareContext(ctx, createPaymentRefund); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePaymentRefund: %w", err)
+	}
 	if q.createProductStmt, err = db.PrepareContext(ctx, createProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateProduct: %w", err)
 	}
 	if q.createProductHistoryStmt, err = db.PrepareContext(ctx, createProductHistory); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateProductHistory: %w", err)
 	}
+	if q.createPromotionStmt, err = db.PrepareContext(ctx, createPromotion); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePromotion: %w", err)
+	}
+	if q.createPromotionProductStmt, err = db.PrepareContext(ctx, createPromotionProduct); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePromotionProduct: %w", err)
+	}
+	if q.createPurchaseOrderStmt, err = db.PrepareContext(ctx, createPurchaseOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePurchaseOrder: %w", err)
+	}
+	if q.createPurchaseOrderItemStmt, err = db.PrepareContext(ctx, createPurchaseOrderItem); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePurchaseOrderItem: %w", err)
+	}
+	if q.createPurchaseReceiptStmt, err = db.PrepareContext(ctx, createPurchaseReceipt); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePurchaseReceipt: %w", err)
+	}
+	if q.createPurchaseReceiptItemStmt, err = db.PrepareContext(ctx, createPurchaseReceiptItem); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePurchaseReceiptItem: %w", err)
+	}
 	if q.createRefundStmt, err = db.PrepareContext(ctx, createRefund); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateRefund: %w", err)
 	}
+	if q.createShiftStmt, err = db.PrepareContext(ctx, createShift); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateShift: %w", err)
+	}
+	if q.createShiftCashMovementStmt, err = db.PrepareContext(ctx, createShiftCashMovement); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateShiftCashMovement: %w", err)
+	}
+	if q.createStockAlertStmt, err = db.PrepareContext(ctx, createStockAlert); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateStockAlert: %w", err)
+	}
+	if q.createStockCostMovementStmt, err = db.PrepareContext(ctx, createStockCostMovement); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateStockCostMovement: %w", err)
+	}
+	if q.createStockTakeStmt, err = db.PrepareContext(ctx, createStockTake); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateStockTake: %w", err)
+	}
+	if q.createStockTakeCountStmt, err = db.PrepareContext(ctx, createStockTakeCount); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateStockTakeCount: %w", err)
+	}
+	if q.createStockTakeItemsStmt, err = db.PrepareContext(ctx, createStockTakeItems); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateStockTakeItems: %w", err)
+	}
+	if q.createSupplierStmt, err = db.PrepareContext(ctx, createSupplier); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateSupplier: %w", err)
+	}
+	if q.createTaxRateStmt, err = db.PrepareContext(ctx, createTaxRate); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateTaxRate: %w", err)
+	}
 	if q.createUserStmt, err = db.PrepareContext(ctx, createUser); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateUser: %w", err)
 	}
+	if q.createVoucherStmt, err = db.PrepareContext(ctx, createVoucher); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateVoucher: %w", err)
+	}
+	if q.createVoucherRedemptionStmt, err = db.PrepareContext(ctx, createVoucherRedemption); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateVoucherRedemption: %w", err)
+	}
+	if q.decrementProductStockStmt, err = db.PrepareContext(ctx, decrementProductStock); err != nil {
+		return nil, fmt.Errorf("error preparing query DecrementProductStock: %w", err)
+	}
+	if q.decrementVoucherUsageStmt, err = db.PrepareContext(ctx, decrementVoucherUsage); err != nil {
+		return nil, fmt.Errorf("error preparing query DecrementVoucherUsage: %w", err)
+	}
 	if q.deleteCategoryByIDStmt, err = db.PrepareContext(ctx, deleteCategoryByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteCategoryByID: %w", err)
 	}
 	if q.deleteCustomerByIDStmt, err = db.PrepareContext(ctx, deleteCustomerByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteCustomerByID: %w", err)
 	}
+	if q.deleteCustomerTierPriceStmt, err = db.PrepareContext(ctx, deleteCustomerTierPrice); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteCustomerTierPrice: %w", err)
+	}
 	if q.deleteProductByIDStmt, err = db.PrepareContext(ctx, deleteProductByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteProductByID: %w", err)
 	}
+	if q.deletePromotionProductsByPromotionIDStmt, err = db.PrepareContext(ctx, deletePromotionProductsByPromotionID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeletePromotionProductsByPromotionID: %w", err)
+	}
+	if q.deletePurchaseOrderItemsStmt, err = db.PrepareContext(ctx, deletePurchaseOrderItems); err != nil {
+		return nil, fmt.Errorf("error preparing query DeletePurchaseOrderItems: %w", err)
+	}
 	if q.deleteUserByIDStmt, err = db.PrepareContext(ctx, deleteUserByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteUserByID: %w", err)
 	}
 	if q.generateMemberCodeStmt, err = db.PrepareContext(ctx, generateMemberCode); err != nil {
 		return nil, fmt.Errorf("error preparing query GenerateMemberCode: %w", err)
 	}
+	if q.getActivePromotionsStmt, err = db.PrepareContext(ctx, getActivePromotions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetActivePromotions: %w", err)
+	}
 	if q.getAllCategoriesStmt, err = db.PrepareContext(ctx, getAllCategories); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAllCategories: %w", err)
 	}
+	if q.getAllCustomerTiersStmt, err = db.PrepareContext(ctx, getAllCustomerTiers); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAllCustomerTiers: %w", err)
+	}
 	if q.getAllCustomersStmt, err = db.PrepareContext(ctx, getAllCustomers); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAllCustomers: %w", err)
 	}
@@ -84,18 +234,51 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getAllDeletedUsersStmt, err = db.PrepareContext(ctx, getAllDeletedUsers); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAllDeletedUsers: %w", err)
 	}
+	if q.getAllGiftCardsStmt, err = db.PrepareContext(ctx, getAllGiftCards); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAllGiftCards: %w", err)
+	}
 	if q.getAllOrdersStmt, err = db.PrepareContext(ctx, getAllOrders); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAllOrders: %w", err)
 	}
+	if q.getAllParkedOrdersStmt, err = db.PrepareContext(ctx, getAllParkedOrders); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAllParkedOrders: %w", err)
+	}
+	if q.getAllPaymentMethodsStmt, err = db.PrepareContext(ctx, getAllPaymentMethods); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAllPaymentMethods: %w", err)
+	}
 	if q.getAllProductHistoryStmt, err = db.PrepareContext(ctx, getAllProductHistory); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAllProductHistory: %w", err)
 	}
 	if q.getAllProductsStmt, err = db.PrepareContext(ctx, getAllProducts); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAllProducts: %w", err)
 	}
+	if q.getAllPromotionsStmt, err = db.PrepareContext(ctx, getAllPromotions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAllPromotions: %w", err)
+	}
+	if q.getAllPurchaseOrdersStmt, err = db.PrepareContext(ctx, getAllPurchaseOrders); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAllPurchaseOrders: %w", err)
+	}
+	if q.getAllShiftsStmt, err = db.PrepareContext(ctx, getAllShifts); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAllShifts: %w", err)
+	}
+	if q.getAllStockAlertsStmt, err = db.PrepareContext(ctx, getAllStockAlerts); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAllStockAlerts: %w", err)
+	}
+	if q.getAllStockTakesStmt, err = db.PrepareContext(ctx, getAllStockTakes); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAllStockTakes: %w", err)
+	}
+	if q.getAllSuppliersStmt, err = db.PrepareContext(ctx, getAllSuppliers); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAllSuppliers: %w", err)
+	}
+	if q.getAllTaxRatesStmt, err = db.PrepareContext(ctx, getAllTaxRates); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAllTaxRates: %w", err)
+	}
 	if q.getAllUsersStmt, err = db.PrepareContext(ctx, getAllUsers); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAllUsers: %w", err)
 	}
+	if q.getAllVouchersStmt, err = db.PrepareContext(ctx, getAllVouchers); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAllVouchers: %w", err)
+	}
 	if q.getCategoryByIDStmt, err = db.PrepareContext(ctx, getCategoryByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCategoryByID: %w", err)
 	}
@@ -114,30 +297,300 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getCustomerByPhoneExceptIDStmt, err = db.PrepareContext(ctx, getCustomerByPhoneExceptID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCustomerByPhoneExceptID: %w", err)
 	}
+	if q.getCustomerConsentsStmt, err = db.PrepareContext(ctx, getCustomerConsents); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerConsents: %w", err)
+	}
+	if q.getCustomerExpiringPointsStmt, err = db.PrepareContext(ctx, getCustomerExpiringPoints); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerExpiringPoints: %w", err)
+	}
+	if q.getCustomerExportGiftCardsStmt, err = db.PrepareContext(ctx, getCustomerExportGiftCards); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerExportGiftCards: %w", err)
+	}
+	if q.getCustomerExportLoyaltyPointsStmt, err = db.PrepareContext(ctx, getCustomerExportLoyaltyPoints); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerExportLoyaltyPoints: %w", err)
+	}
+	if q.getCustomerExportNotificationsStmt, err = db.PrepareContext(ctx, getCustomerExportNotifications); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerExportNotifications: %w", err)
+	}
+	if q.getCustomerExportOrderItemsStmt, err = db.PrepareContext(ctx, getCustomerExportOrderItems); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerExportOrderItems: %w", err)
+	}
+	if q.getCustomerExportOrdersStmt, err = db.PrepareContext(ctx, getCustomerExportOrders); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerExportOrders: %w", err)
+	}
+	if q.getCustomerExportParkedOrdersStmt, err = db.PrepareContext(ctx, getCustomerExportParkedOrders); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerExportParkedOrders: %w", err)
+	}
+	if q.getCustomerFavoriteProductsStmt, err = db.PrepareContext(ctx, getCustomerFavoriteProducts); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerFavoriteProducts: %w", err)
+	}
+	if q.getCustomerLastOrderStmt, err = db.PrepareContext(ctx, getCustomerLastOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerLastOrder: %w", err)
+	}
+	if q.getCustomerMergesStmt, err = db.PrepareContext(ctx, getCustomerMerges); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerMerges: %w", err)
+	}
+	if q.getCustomerOrdersStmt, err = db.PrepareContext(ctx, getCustomerOrders); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerOrders: %w", err)
+	}
+	if q.getCustomerPointBalanceStmt, err = db.PrepareContext(ctx, getCustomerPointBalance); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerPointBalance: %w", err)
+	}
+	if q.getCustomerPointHistoryStmt, err = db.PrepareContext(ctx, getCustomerPointHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerPointHistory: %w", err)
+	}
+	if q.getCustomerRollingSpendStmt, err = db.PrepareContext(ctx, getCustomerRollingSpend); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerRollingSpend: %w", err)
+	}
+	if q.getCustomerSummaryStmt, err = db.PrepareContext(ctx, getCustomerSummary); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerSummary: %w", err)
+	}
+	if q.getCustomerTierByIDStmt, err = db.PrepareContext(ctx, getCustomerTierByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerTierByID: %w", err)
+	}
+	if q.getCustomerTierChangesStmt, err = db.PrepareContext(ctx, getCustomerTierChanges); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerTierChanges: %w", err)
+	}
+	if q.getCustomerTierPricesStmt, err = db.PrepareContext(ctx, getCustomerTierPrices); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomerTierPrices: %w", err)
+	}
+	if q.getCustomersByMemberCodeOrPhoneStmt, err = db.PrepareContext(ctx, getCustomersByMemberCodeOrPhone); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCustomersByMemberCodeOrPhone: %w", err)
+	}
+	if q.getDuePaymentRefundsStmt, err = db.PrepareContext(ctx, getDuePaymentRefunds); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDuePaymentRefunds: %w", err)
+	}
+	if q.getDuplicateCustomerCandidatesStmt, err = db.PrepareContext(ctx, getDuplicateCustomerCandidates); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDuplicateCustomerCandidates: %w", err)
+	}
+	if q.getExpiredGiftCardsStmt, err = db.PrepareContext(ctx, getExpiredGiftCards); err != nil {
+		return nil, fmt.Errorf("error preparing query GetExpiredGiftCards: %w", err)
+	}
+	if q.getExpiredLoyaltyLotsStmt, err = db.PrepareContext(ctx, getExpiredLoyaltyLots); err != nil {
+		return nil, fmt.Errorf("error preparing query GetExpiredLoyaltyLots: %w", err)
+	}
+	if q.getExpiredParkedOrdersStmt, err = db.PrepareContext(ctx, getExpiredParkedOrders); err != nil {
+		return nil, fmt.Errorf("error preparing query GetExpiredParkedOrders: %w", err)
+	}
+	if q.getExpiredPendingChargesStmt, err = db.PrepareContext(ctx, getExpiredPendingCharges); err != nil {
+		return nil, fmt.Errorf("error preparing query GetExpiredPendingCharges: %w", err)
+	}
 	if q.getFastMovingProductsStmt, err = db.PrepareContext(ctx, getFastMovingProducts); err != nil {
 		return nil, fmt.Errorf("error preparing query GetFastMovingProducts: %w", err)
 	}
+	if q.getGiftCardByCodeStmt, err = db.PrepareContext(ctx, getGiftCardByCode); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGiftCardByCode: %w", err)
+	}
+	if q.getGiftCardByCodeForUpdateStmt, err = db.PrepareContext(ctx, getGiftCardByCodeForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGiftCardByCodeForUpdate: %w", err)
+	}
+	if q.getGiftCardByIDStmt, err = db.PrepareContext(ctx, getGiftCardByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGiftCardByID: %w", err)
+	}
+	if q.getGiftCardByIDForUpdateStmt, err = db.PrepareContext(ctx, getGiftCardByIDForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGiftCardByIDForUpdate: %w", err)
+	}
+	if q.getGiftCardRedemptionsByOrderIDStmt, err = db.PrepareContext(ctx, getGiftCardRedemptionsByOrderID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGiftCardRedemptionsByOrderID: %w", err)
+	}
+	if q.getGiftCardTransactionsStmt, err = db.PrepareContext(ctx, getGiftCardTransactions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGiftCardTransactions: %w", err)
+	}
+	if q.getGiftCardsByOrderIDStmt, err = db.PrepareContext(ctx, getGiftCardsByOrderID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGiftCardsByOrderID: %w", err)
+	}
+	if q.getInventoryValuationStmt, err = db.PrepareContext(ctx, getInventoryValuation); err != nil {
+		return nil, fmt.Errorf("error preparing query GetInventoryValuation: %w", err)
+	}
+	if q.getLowStockProductsStmt, err = db.PrepareContext(ctx, getLowStockProducts); err != nil {
+		return nil, fmt.Errorf("error preparing query GetLowStockProducts: %w", err)
+	}
+	if q.getLoyaltyLotsForUpdateStmt, err = db.PrepareContext(ctx, getLoyaltyLotsForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetLoyaltyLotsForUpdate: %w", err)
+	}
+	if q.getLoyaltyPointsByOrderIDStmt, err = db.PrepareContext(ctx, getLoyaltyPointsByOrderID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetLoyaltyPointsByOrderID: %w", err)
+	}
+	if q.getNotificationsByOrderIDStmt, err = db.PrepareContext(ctx, getNotificationsByOrderID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetNotificationsByOrderID: %w", err)
+	}
+	if q.getOpenCostLayersForUpdateStmt, err = db.PrepareContext(ctx, getOpenCostLayersForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOpenCostLayersForUpdate: %w", err)
+	}
+	if q.getOpenShiftByCashierIDStmt, err = db.PrepareContext(ctx, getOpenShiftByCashierID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOpenShiftByCashierID: %w", err)
+	}
 	if q.getOrderByIDStmt, err = db.PrepareContext(ctx, getOrderByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetOrderByID: %w", err)
 	}
+	if q.getOrderByIDForUpdateStmt, err = db.PrepareContext(ctx, getOrderByIDForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOrderByIDForUpdate: %w", err)
+	}
 	if q.getOrderByTrxNumberStmt, err = db.PrepareContext(ctx, getOrderByTrxNumber); err != nil {
 		return nil, fmt.Errorf("error preparing query GetOrderByTrxNumber: %w", err)
 	}
+	if q.getOrderByTrxNumberForUpdateStmt, err = db.PrepareContext(ctx, getOrderByTrxNumberForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOrderByTrxNumberForUpdate: %w", err)
+	}
 	if q.getOrderItemsByOrderIDStmt, err = db.PrepareContext(ctx, getOrderItemsByOrderID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetOrderItemsByOrderID: %w", err)
 	}
+	if q.getOrderPaymentsByOrderIDStmt, err = db.PrepareContext(ctx, getOrderPaymentsByOrderID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOrderPaymentsByOrderID: %w", err)
+	}
+	if q.getOrderPromotionsByOrderIDStmt, err = db.PrepareContext(ctx, getOrderPromotionsByOrderID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOrderPromotionsByOrderID: %w", err)
+	}
+	if q.getOrderStoreCreditRefundAmountStmt, err = db.PrepareContext(ctx, getOrderStoreCreditRefundAmount); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOrderStoreCreditRefundAmount: %w", err)
+	}
+	if q.getParkedOrderByIDStmt, err = db.PrepareContext(ctx, getParkedOrderByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetParkedOrderByID: %w", err)
+	}
+	if q.getParkedOrderByIDForUpdateStmt, err = db.PrepareContext(ctx, getParkedOrderByIDForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetParkedOrderByIDForUpdate: %w", err)
+	}
+	if q.getParkedOrderItemsStmt, err = db.PrepareContext(ctx, getParkedOrderItems); err != nil {
+		return nil, fmt.Errorf("error preparing query GetParkedOrderItems: %w", err)
+	}
+	if q.getPaymentChargeByChargeIDStmt, err = db.PrepareContext(ctx, getPaymentChargeByChargeID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPaymentChargeByChargeID: %w", err)
+	}
+	if q.getPaymentChargeByIDStmt, err = db.PrepareContext(ctx, getPaymentChargeByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPaymentChargeByID: %w", err)
+	}
+	if q.getPaymentChargeByOrderIDStmt, err = db.PrepareContext(ctx, getPaymentChargeByOrderID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPaymentChargeByOrderID: %w", err)
+	}
+	if q.getPaymentMethodByCodeStmt, err = db.PrepareContext(ctx, getPaymentMethodByCode); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPaymentMethodByCode: %w", err)
+	}
+	if q.getPaymentMethodByIDStmt, err = db.PrepareContext(ctx, getPaymentMethodByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPaymentMethodByID: %w", err)
+	}
+	if q.getProductByBarcodeStmt, err = db.PrepareContext(ctx, getProductByBarcode); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductByBarcode: %w", err)
+	}
 	if q.getProductByIDStmt, err = db.PrepareContext(ctx, getProductByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetProductByID: %w", err)
 	}
+	if q.getProductByIDForUpdateStmt, err = db.PrepareContext(ctx, getProductByIDForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductByIDForUpdate: %w", err)
+	}
+	if q.getProductCostLayersStmt, err = db.PrepareContext(ctx, getProductCostLayers); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductCostLayers: %w", err)
+	}
+	if q.getProductHistorySummaryStmt, err = db.PrepareContext(ctx, getProductHistorySummary); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductHistorySummary: %w", err)
+	}
+	if q.getProductLedgerStmt, err = db.PrepareContext(ctx, getProductLedger); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductLedger: %w", err)
+	}
+	if q.getProductLedgerTotalStmt, err = db.PrepareContext(ctx, getProductLedgerTotal); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductLedgerTotal: %w", err)
+	}
+	if q.getProductStockBalancesStmt, err = db.PrepareContext(ctx, getProductStockBalances); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductStockBalances: %w", err)
+	}
+	if q.getProfitByCashierStmt, err = db.PrepareContext(ctx, getProfitByCashier); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProfitByCashier: %w", err)
+	}
+	if q.getProfitByCategoryStmt, err = db.PrepareContext(ctx, getProfitByCategory); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProfitByCategory: %w", err)
+	}
+	if q.getProfitByCustomerStmt, err = db.PrepareContext(ctx, getProfitByCustomer); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProfitByCustomer: %w", err)
+	}
+	if q.getProfitByDayStmt, err = db.PrepareContext(ctx, getProfitByDay); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProfitByDay: %w", err)
+	}
+	if q.getProfitByProductStmt, err = db.PrepareContext(ctx, getProfitByProduct); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProfitByProduct: %w", err)
+	}
+	if q.getPromotionByIDStmt, err = db.PrepareContext(ctx, getPromotionByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPromotionByID: %w", err)
+	}
+	if q.getPromotionProductsByPromotionIDStmt, err = db.PrepareContext(ctx, getPromotionProductsByPromotionID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPromotionProductsByPromotionID: %w", err)
+	}
+	if q.getPurchaseOrderByIDStmt, err = db.PrepareContext(ctx, getPurchaseOrderByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPurchaseOrderByID: %w", err)
+	}
+	if q.getPurchaseOrderByIDForUpdateStmt, err = db.PrepareContext(ctx, getPurchaseOrderByIDForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPurchaseOrderByIDForUpdate: %w", err)
+	}
+	if q.getPurchaseOrderItemsStmt, err = db.PrepareContext(ctx, getPurchaseOrderItems); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPurchaseOrderItems: %w", err)
+	}
+	if q.getPurchaseReceiptItemsStmt, err = db.PrepareContext(ctx, getPurchaseReceiptItems); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPurchaseReceiptItems: %w", err)
+	}
+	if q.getPurchaseReceiptsByOrderIDStmt, err = db.PrepareContext(ctx, getPurchaseReceiptsByOrderID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPurchaseReceiptsByOrderID: %w", err)
+	}
+	if q.getReorderCandidatesStmt, err = db.PrepareContext(ctx, getReorderCandidates); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReorderCandidates: %w", err)
+	}
+	if q.getShiftByIDStmt, err = db.PrepareContext(ctx, getShiftByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetShiftByID: %w", err)
+	}
+	if q.getShiftCashMovementsStmt, err = db.PrepareContext(ctx, getShiftCashMovements); err != nil {
+		return nil, fmt.Errorf("error preparing query GetShiftCashMovements: %w", err)
+	}
+	if q.getShiftPaymentSummaryStmt, err = db.PrepareContext(ctx, getShiftPaymentSummary); err != nil {
+		return nil, fmt.Errorf("error preparing query GetShiftPaymentSummary: %w", err)
+	}
+	if q.getShiftRefundSummaryStmt, err = db.PrepareContext(ctx, getShiftRefundSummary); err != nil {
+		return nil, fmt.Errorf("error preparing query GetShiftRefundSummary: %w", err)
+	}
+	if q.getShiftSalesSummaryStmt, err = db.PrepareContext(ctx, getShiftSalesSummary); err != nil {
+		return nil, fmt.Errorf("error preparing query GetShiftSalesSummary: %w", err)
+	}
 	if q.getSlowMovingProductsStmt, err = db.PrepareContext(ctx, getSlowMovingProducts); err != nil {
 		return nil, fmt.Errorf("error preparing query GetSlowMovingProducts: %w", err)
 	}
+	if q.getStockAlertByIDStmt, err = db.PrepareContext(ctx, getStockAlertByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetStockAlertByID: %w", err)
+	}
+	if q.getStockTakeByIDStmt, err = db.PrepareContext(ctx, getStockTakeByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetStockTakeByID: %w", err)
+	}
+	if q.getStockTakeByIDForShareStmt, err = db.PrepareContext(ctx, getStockTakeByIDForShare); err != nil {
+		return nil, fmt.Errorf("error preparing query GetStockTakeByIDForShare: %w", err)
+	}
+	if q.getStockTakeByIDForUpdateStmt, err = db.PrepareContext(ctx, getStockTakeByIDForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetStockTakeByIDForUpdate: %w", err)
+	}
+	if q.getStockTakeItemsStmt, err = db.PrepareContext(ctx, getStockTakeItems); err != nil {
+		return nil, fmt.Errorf("error preparing query GetStockTakeItems: %w", err)
+	}
+	if q.getStoreCreditByCustomerIDStmt, err = db.PrepareContext(ctx, getStoreCreditByCustomerID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetStoreCreditByCustomerID: %w", err)
+	}
+	if q.getStoreCreditByCustomerIDForUpdateStmt, err = db.PrepareContext(ctx, getStoreCreditByCustomerIDForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetStoreCreditByCustomerIDForUpdate: %w", err)
+	}
+	if q.getSupplierByIDStmt, err = db.PrepareContext(ctx, getSupplierByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSupplierByID: %w", err)
+	}
+	if q.getTaxRateByIDStmt, err = db.PrepareContext(ctx, getTaxRateByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTaxRateByID: %w", err)
+	}
+	if q.getTaxRateByProductIDStmt, err = db.PrepareContext(ctx, getTaxRateByProductID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTaxRateByProductID: %w", err)
+	}
+	if q.getTaxSummaryStmt, err = db.PrepareContext(ctx, getTaxSummary); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTaxSummary: %w", err)
+	}
 	if q.getTopCashiersStmt, err = db.PrepareContext(ctx, getTopCashiers); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTopCashiers: %w", err)
 	}
 	if q.getTopCustomersStmt, err = db.PrepareContext(ctx, getTopCustomers); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTopCustomers: %w", err)
 	}
+	if q.getUnnotifiedStockAlertsStmt, err = db.PrepareContext(ctx, getUnnotifiedStockAlerts); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUnnotifiedStockAlerts: %w", err)
+	}
 	if q.getUserByIDStmt, err = db.PrepareContext(ctx, getUserByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserByID: %w", err)
 	}
@@ -147,62 +600,325 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getUserByUsernameExceptIDStmt, err = db.PrepareContext(ctx, getUserByUsernameExceptID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserByUsernameExceptID: %w", err)
 	}
+	if q.getVoucherByCodeStmt, err = db.PrepareContext(ctx, getVoucherByCode); err != nil {
+		return nil, fmt.Errorf("error preparing query GetVoucherByCode: %w", err)
+	}
+	if q.getVoucherByCodeForUpdateStmt, err = db.PrepareContext(ctx, getVoucherByCodeForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetVoucherByCodeForUpdate: %w", err)
+	}
+	if q.getVoucherByIDStmt, err = db.PrepareContext(ctx, getVoucherByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetVoucherByID: %w", err)
+	}
+	if q.getVoucherRedemptionByOrderIDStmt, err = db.PrepareContext(ctx, getVoucherRedemptionByOrderID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetVoucherRedemptionByOrderID: %w", err)
+	}
+	if q.incrementGiftCardPinAttemptsStmt, err = db.PrepareContext(ctx, incrementGiftCardPinAttempts); err != nil {
+		return nil, fmt.Errorf("error preparing query IncrementGiftCardPinAttempts: %w", err)
+	}
+	if q.incrementProductStockStmt, err = db.PrepareContext(ctx, incrementProductStock); err != nil {
+		return nil, fmt.Errorf("error preparing query IncrementProductStock: %w", err)
+	}
+	if q.incrementReceiptPrintCountStmt, err = db.PrepareContext(ctx, incrementReceiptPrintCount); err != nil {
+		return nil, fmt.Errorf("error preparing query IncrementReceiptPrintCount: %w", err)
+	}
+	if q.incrementVoucherUsageStmt, err = db.PrepareContext(ctx, incrementVoucherUsage); err != nil {
+		return nil, fmt.Errorf("error preparing query IncrementVoucherUsage: %w", err)
+	}
+	if q.lockGiftCardPinStmt, err = db.PrepareContext(ctx, lockGiftCardPin); err != nil {
+		return nil, fmt.Errorf("error preparing query LockGiftCardPin: %w", err)
+	}
+	if q.markNotificationAttemptFailedStmt, err = db.PrepareContext(ctx, markNotificationAttemptFailed); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkNotificationAttemptFailed: %w", err)
+	}
+	if q.markNotificationSentStmt, err = db.PrepareContext(ctx, markNotificationSent); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkNotificationSent: %w", err)
+	}
+	if q.markPaymentChargeLatePaidStmt, err = db.PrepareContext(ctx, markPaymentChargeLatePaid); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkPaymentChargeLatePaid: %w", err)
+	}
+	if q.markPaymentChargeRefundedStmt, err = db.PrepareContext(ctx, markPaymentChargeRefunded); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkPaymentChargeRefunded: %w", err)
+	}
+	if q.markPaymentRefundAttemptFailedStmt, err = db.PrepareContext(ctx, markPaymentRefundAttemptFailed); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkPaymentRefundAttemptFailed: %w", err)
+	}
+	if q.markPaymentRefundSucceededStmt, err = db.PrepareContext(ctx, markPaymentRefundSucceeded); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkPaymentRefundSucceeded: %w", err)
+	}
+	if q.markStockAlertNotifiedStmt, err = db.PrepareContext(ctx, markStockAlertNotified); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkStockAlertNotified: %w", err)
+	}
+	if q.postStockTakeStmt, err = db.PrepareContext(ctx, postStockTake); err != nil {
+		return nil, fmt.Errorf("error preparing query PostStockTake: %w", err)
+	}
+	if q.postponeNotificationStmt, err = db.PrepareContext(ctx, postponeNotification); err != nil {
+		return nil, fmt.Errorf("error preparing query PostponeNotification: %w", err)
+	}
+	if q.reassignCustomerGiftCardsStmt, err = db.PrepareContext(ctx, reassignCustomerGiftCards); err != nil {
+		return nil, fmt.Errorf("error preparing query ReassignCustomerGiftCards: %w", err)
+	}
+	if q.reassignCustomerLoyaltyPointsStmt, err = db.PrepareContext(ctx, reassignCustomerLoyaltyPoints); err != nil {
+		return nil, fmt.Errorf("error preparing query ReassignCustomerLoyaltyPoints: %w", err)
+	}
+	if q.reassignCustomerOrdersStmt, err = db.PrepareContext(ctx, reassignCustomerOrders); err != nil {
+		return nil, fmt.Errorf("error preparing query ReassignCustomerOrders: %w", err)
+	}
+	if q.reassignCustomerParkedOrdersStmt, err = db.PrepareContext(ctx, reassignCustomerParkedOrders); err != nil {
+		return nil, fmt.Errorf("error preparing query ReassignCustomerParkedOrders: %w", err)
+	}
+	if q.reassignCustomerTierChangesStmt, err = db.PrepareContext(ctx, reassignCustomerTierChanges); err != nil {
+		return nil, fmt.Errorf("error preparing query ReassignCustomerTierChanges: %w", err)
+	}
+	if q.reassignCustomerVoucherRedemptionsStmt, err = db.PrepareContext(ctx, reassignCustomerVoucherRedemptions); err != nil {
+		return nil, fmt.Errorf("error preparing query ReassignCustomerVoucherRedemptions: %w", err)
+	}
+	if q.receivePurchaseOrderItemStmt, err = db.PrepareContext(ctx, receivePurchaseOrderItem); err != nil {
+		return nil, fmt.Errorf("error preparing query ReceivePurchaseOrderItem: %w", err)
+	}
+	if q.releaseProductStockStmt, err = db.PrepareContext(ctx, releaseProductStock); err != nil {
+		return nil, fmt.Errorf("error preparing query ReleaseProductStock: %w", err)
+	}
+	if q.reserveProductStockStmt, err = db.PrepareContext(ctx, reserveProductStock); err != nil {
+		return nil, fmt.Errorf("error preparing query ReserveProductStock: %w", err)
+	}
+	if q.resetGiftCardPinAttemptsStmt, err = db.PrepareContext(ctx, resetGiftCardPinAttempts); err != nil {
+		return nil, fmt.Errorf("error preparing query ResetGiftCardPinAttempts: %w", err)
+	}
+	if q.resolveStockAlertsStmt, err = db.PrepareContext(ctx, resolveStockAlerts); err != nil {
+		return nil, fmt.Errorf("error preparing query ResolveStockAlerts: %w", err)
+	}
+	if q.resumeParkedOrderStmt, err = db.PrepareContext(ctx, resumeParkedOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query ResumeParkedOrder: %w", err)
+	}
+	if q.reverseVoucherRedemptionStmt, err = db.PrepareContext(ctx, reverseVoucherRedemption); err != nil {
+		return nil, fmt.Errorf("error preparing query ReverseVoucherRedemption: %w", err)
+	}
+	if q.searchCustomersStmt, err = db.PrepareContext(ctx, searchCustomers); err != nil {
+		return nil, fmt.Errorf("error preparing query SearchCustomers: %w", err)
+	}
+	if q.sendPurchaseOrderStmt, err = db.PrepareContext(ctx, sendPurchaseOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query SendPurchaseOrder: %w", err)
+	}
 	if q.setCurrentTokenStmt, err = db.PrepareContext(ctx, setCurrentToken); err != nil {
 		return nil, fmt.Errorf("error preparing query SetCurrentToken: %w", err)
 	}
+	if q.setCustomerTierStmt, err = db.PrepareContext(ctx, setCustomerTier); err != nil {
+		return nil, fmt.Errorf("error preparing query SetCustomerTier: %w", err)
+	}
 	if q.softDeleteCategoryByIDStmt, err = db.PrepareContext(ctx, softDeleteCategoryByID); err != nil {
 		return nil, fmt.Errorf("error preparing query SoftDeleteCategoryByID: %w", err)
 	}
 	if q.softDeleteCustomerByIDStmt, err = db.PrepareContext(ctx, softDeleteCustomerByID); err != nil {
 		return nil, fmt.Errorf("error preparing query SoftDeleteCustomerByID: %w", err)
 	}
+	if q.softDeletePaymentMethodByIDStmt, err = db.PrepareContext(ctx, softDeletePaymentMethodByID); err != nil {
+		return nil, fmt.Errorf("error preparing query SoftDeletePaymentMethodByID: %w", err)
+	}
 	if q.softDeleteProductByIDStmt, err = db.PrepareContext(ctx, softDeleteProductByID); err != nil {
 		return nil, fmt.Errorf("error preparing query SoftDeleteProductByID: %w", err)
 	}
+	if q.softDeletePromotionByIDStmt, err = db.PrepareContext(ctx, softDeletePromotionByID); err != nil {
+		return nil, fmt.Errorf("error preparing query SoftDeletePromotionByID: %w", err)
+	}
+	if q.softDeleteSupplierByIDStmt, err = db.PrepareContext(ctx, softDeleteSupplierByID); err != nil {
+		return nil, fmt.Errorf("error preparing query SoftDeleteSupplierByID: %w", err)
+	}
+	if q.softDeleteTaxRateByIDStmt, err = db.PrepareContext(ctx, softDeleteTaxRateByID); err != nil {
+		return nil, fmt.Errorf("error preparing query SoftDeleteTaxRateByID: %w", err)
+	}
 	if q.softDeleteUserByIDStmt, err = db.PrepareContext(ctx, softDeleteUserByID); err != nil {
 		return nil, fmt.Errorf("error preparing query SoftDeleteUserByID: %w", err)
 	}
+	if q.softDeleteVoucherByIDStmt, err = db.PrepareContext(ctx, softDeleteVoucherByID); err != nil {
+		return nil, fmt.Errorf("error preparing query SoftDeleteVoucherByID: %w", err)
+	}
 	if q.updateCategoryStmt, err = db.PrepareContext(ctx, updateCategory); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateCategory: %w", err)
 	}
+	if q.updateCostLayerRemainingStmt, err = db.PrepareContext(ctx, updateCostLayerRemaining); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateCostLayerRemaining: %w", err)
+	}
 	if q.updateCustomerStmt, err = db.PrepareContext(ctx, updateCustomer); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateCustomer: %w", err)
 	}
+	if q.updateCustomerConsentStmt, err = db.PrepareContext(ctx, updateCustomerConsent); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateCustomerConsent: %w", err)
+	}
+	if q.updateCustomerTierStmt, err = db.PrepareContext(ctx, updateCustomerTier); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateCustomerTier: %w", err)
+	}
+	if q.updateGiftCardBalanceStmt, err = db.PrepareContext(ctx, updateGiftCardBalance); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateGiftCardBalance: %w", err)
+	}
+	if q.updateLoyaltyPointRemainingStmt, err = db.PrepareContext(ctx, updateLoyaltyPointRemaining); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateLoyaltyPointRemaining: %w", err)
+	}
 	if q.updateOrderStatusStmt, err = db.PrepareContext(ctx, updateOrderStatus); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateOrderStatus: %w", err)
 	}
+	if q.updateParkedOrderStatusStmt, err = db.PrepareContext(ctx, updateParkedOrderStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateParkedOrderStatus: %w", err)
+	}
+	if q.updatePaymentChargeStatusStmt, err = db.PrepareContext(ctx, updatePaymentChargeStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePaymentChargeStatus: %w", err)
+	}
+	if q.updatePaymentMethodStmt, err = db.PrepareContext(ctx, updatePaymentMethod); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePaymentMethod: %w", err)
+	}
 	if q.updateProductStmt, err = db.PrepareContext(ctx, updateProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateProduct: %w", err)
 	}
+	if q.updateProductCostStmt, err = db.PrepareContext(ctx, updateProductCost); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateProductCost: %w", err)
+	}
 	if q.updateProductStockStmt, err = db.PrepareContext(ctx, updateProductStock); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateProductStock: %w", err)
 	}
+	if q.updatePromotionStmt, err = db.PrepareContext(ctx, updatePromotion); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePromotion: %w", err)
+	}
+	if q.updatePurchaseOrderStmt, err = db.PrepareContext(ctx, updatePurchaseOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePurchaseOrder: %w", err)
+	}
+	if q.updatePurchaseOrderReceiveStatusStmt, err = db.PrepareContext(ctx, updatePurchaseOrderReceiveStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePurchaseOrderReceiveStatus: %w", err)
+	}
+	if q.updateStockTakeItemResultStmt, err = db.PrepareContext(ctx, updateStockTakeItemResult); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateStockTakeItemResult: %w", err)
+	}
+	if q.updateSupplierStmt, err = db.PrepareContext(ctx, updateSupplier); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateSupplier: %w", err)
+	}
+	if q.updateTaxRateStmt, err = db.PrepareContext(ctx, updateTaxRate); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateTaxRate: %w", err)
+	}
 	if q.updateUserStmt, err = db.PrepareContext(ctx, updateUser); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateUser: %w", err)
 	}
 	if q.updateUserWithPasswordStmt, err = db.PrepareContext(ctx, updateUserWithPassword); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateUserWithPassword: %w", err)
 	}
+	if q.updateVoucherStmt, err = db.PrepareContext(ctx, updateVoucher); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateVoucher: %w", err)
+	}
+	if q.upsertCustomerTierPriceStmt, err = db.PrepareContext(ctx, upsertCustomerTierPrice); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertCustomerTierPrice: %w", err)
+	}
 	return &q, nil
 }
 
 func (q *Queries) Close() error {
 	var err error
+	if q.addStockTakeCountStmt != nil {
+		if cerr := q.addStockTakeCountStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addStockTakeCountStmt: %w", cerr)
+		}
+	}
+	if q.anonymizeCustomerStmt != nil {
+		if cerr := q.anonymizeCustomerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing anonymizeCustomerStmt: %w", cerr)
+		}
+	}
+	if q.anonymizeCustomerMergesStmt != nil {
+		if cerr := q.anonymizeCustomerMergesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing anonymizeCustomerMergesStmt: %w", cerr)
+		}
+	}
+	if q.anonymizeCustomerNotificationsStmt != nil {
+		if cerr := q.anonymizeCustomerNotificationsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing anonymizeCustomerNotificationsStmt: %w", cerr)
+		}
+	}
+	if q.anonymizeCustomerParkedOrdersStmt != nil {
+		if cerr := q.anonymizeCustomerParkedOrdersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing anonymizeCustomerParkedOrdersStmt: %w", cerr)
+		}
+	}
+	if q.cancelPurchaseOrderStmt != nil {
+		if cerr := q.cancelPurchaseOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing cancelPurchaseOrderStmt: %w", cerr)
+		}
+	}
+	if q.cancelStockTakeStmt != nil {
+		if cerr := q.cancelStockTakeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing cancelStockTakeStmt: %w", cerr)
+		}
+	}
 	if q.checkTokenStmt != nil {
 		if cerr := q.checkTokenStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing checkTokenStmt: %w", cerr)
 		}
 	}
+	if q.claimDueNotificationsStmt != nil {
+		if cerr := q.claimDueNotificationsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimDueNotificationsStmt: %w", cerr)
+		}
+	}
+	if q.closeShiftStmt != nil {
+		if cerr := q.closeShiftStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing closeShiftStmt: %w", cerr)
+		}
+	}
+	if q.countCustomerOrdersStmt != nil {
+		if cerr := q.countCustomerOrdersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countCustomerOrdersStmt: %w", cerr)
+		}
+	}
+	if q.countCustomerVoucherRedemptionsStmt != nil {
+		if cerr := q.countCustomerVoucherRedemptionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countCustomerVoucherRedemptionsStmt: %w", cerr)
+		}
+	}
 	if q.createCategoryStmt != nil {
 		if cerr := q.createCategoryStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createCategoryStmt: %w", cerr)
 		}
 	}
+	if q.createCostLayerStmt != nil {
+		if cerr := q.createCostLayerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createCostLayerStmt: %w", cerr)
+		}
+	}
 	if q.createCustomerStmt != nil {
 		if cerr := q.createCustomerStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createCustomerStmt: %w", cerr)
 		}
 	}
+	if q.createCustomerConsentStmt != nil {
+		if cerr := q.createCustomerConsentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createCustomerConsentStmt: %w", cerr)
+		}
+	}
+	if q.createCustomerMergeStmt != nil {
+		if cerr := q.createCustomerMergeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createCustomerMergeStmt: %w", cerr)
+		}
+	}
+	if q.createCustomerTierChangeStmt != nil {
+		if cerr := q.createCustomerTierChangeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createCustomerTierChangeStmt: %w", cerr)
+		}
+	}
+	if q.createGiftCardStmt != nil {
+		if cerr := q.createGiftCardStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createGiftCardStmt: %w", cerr)
+		}
+	}
+	if q.createGiftCardTransactionStmt != nil {
+		if cerr := q.createGiftCardTransactionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createGiftCardTransactionStmt: %w", cerr)
+		}
+	}
+	if q.createLoyaltyPointStmt != nil {
+		if cerr := q.createLoyaltyPointStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createLoyaltyPointStmt: %w", cerr)
+		}
+	}
+	if q.createNotificationStmt != nil {
+		if cerr := q.createNotificationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createNotificationStmt: %w", cerr)
+		}
+	}
 	if q.createOrderStmt != nil {
 		if cerr := q.createOrderStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createOrderStmt: %w", cerr)
@@ -213,26 +929,156 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createOrderItemStmt: %w", cerr)
 		}
 	}
-	if q.createProductStmt != nil {
-		if cerr := q.createProductStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing createProductStmt: %w", cerr)
+	if q.createOrderPaymentStmt != nil {
+		if cerr := q.createOrderPaymentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createOrderPaymentStmt: %w", cerr)
 		}
 	}
-	if q.createProductHistoryStmt != nil {
-		if cerr := q.createProductHistoryStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing createProductHistoryStmt: %w", cerr)
+	if q.createOrderPromotionStmt != nil {
+		if cerr := q.createOrderPromotionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createOrderPromotionStmt: %w", cerr)
 		}
 	}
-	if q.createRefundStmt != nil {
-		if cerr := q.createRefundStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing createRefundStmt: %w", cerr)
+	if q.createParkedOrderStmt != nil {
+		if cerr := q.createParkedOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createParkedOrderStmt: %w", cerr)
 		}
 	}
-	if q.createUserStmt != nil {
-		if cerr := q.createUserStmt.Close(); cerr != nil {
+	if q.createParkedOrderItemStmt != nil {
+		if cerr := q.createParkedOrderItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createParkedOrderItemStmt: %w", cerr)
+		}
+	}
+	if q.createPaymentChargeStmt != nil {
+		if cerr := q.createPaymentChargeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPaymentChargeStmt: %w", cerr)
+		}
+	}
+	if q.createPaymentMethodStmt != nil {
+		if cerr := q.createPaymentMethodStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPaymentMethodStmt: %w", cerr)
+		}
+	}
+	if q.createPaymentRefundStmt != nil {
+		if cerr := q.createPaymentRefundStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPaymentRefundStmt: %w", cerr)
+		}
+	}
+	if q.createProductStmt != nil {
+		if cerr := q.createProductStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProductStmt: %w", cerr)
+		}
+	}
+	if q.createProductHistoryStmt != nil {
+		if cerr := q.createProductHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProductHistoryStmt: %w", cerr)
+		}
+	}
+	if q.createPromotionStmt != nil {
+		if cerr := q.createPromotionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPromotionStmt: %w", cerr)
+		}
+	}
+	if q.createPromotionProductStmt != nil {
+		if cerr := q.createPromotionProductStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPromotionProductStmt: %w", cerr)
+		}
+	}
+	if q.createPurchaseOrderStmt != nil {
+		if cerr := q.createPurchaseOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPurchaseOrderStmt: %w", cerr)
+		}
+	}
+	if q.createPurchaseOrderItemStmt != nil {
+		if cerr := q.createPurchaseOrderItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPurchaseOrderItemStmt: %w", cerr)
+		}
+	}
+	if q.createPurchaseReceiptStmt != nil {
+		if cerr := q.createPurchaseReceiptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPurchaseReceiptStmt: %w", cerr)
+		}
+	}
+	if q.createPurchaseReceiptItemStmt != nil {
+		if cerr := q.createPurchaseReceiptItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPurchaseReceiptItemStmt: %w", cerr)
+		}
+	}
+	if q.createRefundStmt != nil {
+		if cerr := q.createRefundStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createRefundStmt: %w", cerr)
+		}
+	}
+	if q.createShiftStmt != nil {
+		if cerr := q.createShiftStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createShiftStmt: %w", cerr)
+		}
+	}
+	if q.createShiftCashMovementStmt != nil {
+		if cerr := q.createShiftCashMovementStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createShiftCashMovementStmt: %w", cerr)
+		}
+	}
+	if q.createStockAlertStmt != nil {
+		if cerr := q.createStockAlertStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createStockAlertStmt: %w", cerr)
+		}
+	}
+	if q.createStockCostMovementStmt != nil {
+		if cerr := q.createStockCostMovementStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createStockCostMovementStmt: %w", cerr)
+		}
+	}
+	if q.createStockTakeStmt != nil {
+		if cerr := q.createStockTakeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createStockTakeStmt: %w", cerr)
+		}
+	}
+	if q.createStockTakeCountStmt != nil {
+		if cerr := q.createStockTakeCountStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createStockTakeCountStmt: %w", cerr)
+		}
+	}
+	if q.createStockTakeItemsStmt != nil {
+		if cerr := q.createStockTakeItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createStockTakeItemsStmt: %w", cerr)
+		}
+	}
+	if q.createSupplierStmt != nil {
+		if cerr := q.createSupplierStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createSupplierStmt: %w", cerr)
+		}
+	}
+	if q.createTaxRateStmt != nil {
+		if cerr := q.createTaxRateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createTaxRateStmt: %w", cerr)
+		}
+	}
+	if q.createUserStmt != nil {
+		if cerr := q.createUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createUserStmt: %w", cerr)
 		}
 	}
+	if q.createVoucherStmt != nil {
+		if cerr := q.createVoucherStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createVoucherStmt: %w", cerr)
+		}
+	}
+	if q.createVoucherRedemptionStmt != nil {
+		if cerr := q.createVoucherRedemptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createVoucherRedemptionStmt: %w", cerr)
+		}
+	}
+	if q.decrementProductStockStmt != nil {
+		if cerr := q.decrementProductStockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing decrementProductStockStmt: %w", cerr)
+		}
+	}
+	if q.decrementVoucherUsageStmt != nil {
+		if cerr := q.decrementVoucherUsageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing decrementVoucherUsageStmt: %w", cerr)
+		}
+	}
 	if q.deleteCategoryByIDStmt != nil {
 		if cerr := q.deleteCategoryByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteCategoryByIDStmt: %w", cerr)
@@ -243,11 +1089,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteCustomerByIDStmt: %w", cerr)
 		}
 	}
+	if q.deleteCustomerTierPriceStmt != nil {
+		if cerr := q.deleteCustomerTierPriceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteCustomerTierPriceStmt: %w", cerr)
+		}
+	}
 	if q.deleteProductByIDStmt != nil {
 		if cerr := q.deleteProductByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteProductByIDStmt: %w", cerr)
 		}
 	}
+	if q.deletePromotionProductsByPromotionIDStmt != nil {
+		if cerr := q.deletePromotionProductsByPromotionIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deletePromotionProductsByPromotionIDStmt: %w", cerr)
+		}
+	}
+	if q.deletePurchaseOrderItemsStmt != nil {
+		if cerr := q.deletePurchaseOrderItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deletePurchaseOrderItemsStmt: %w", cerr)
+		}
+	}
 	if q.deleteUserByIDStmt != nil {
 		if cerr := q.deleteUserByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteUserByIDStmt: %w", cerr)
@@ -258,11 +1119,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing generateMemberCodeStmt: %w", cerr)
 		}
 	}
+	if q.getActivePromotionsStmt != nil {
+		if cerr := q.getActivePromotionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getActivePromotionsStmt: %w", cerr)
+		}
+	}
 	if q.getAllCategoriesStmt != nil {
 		if cerr := q.getAllCategoriesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getAllCategoriesStmt: %w", cerr)
 		}
 	}
+	if q.getAllCustomerTiersStmt != nil {
+		if cerr := q.getAllCustomerTiersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAllCustomerTiersStmt: %w", cerr)
+		}
+	}
 	if q.getAllCustomersStmt != nil {
 		if cerr := q.getAllCustomersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getAllCustomersStmt: %w", cerr)
@@ -288,11 +1159,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getAllDeletedUsersStmt: %w", cerr)
 		}
 	}
+	if q.getAllGiftCardsStmt != nil {
+		if cerr := q.getAllGiftCardsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAllGiftCardsStmt: %w", cerr)
+		}
+	}
 	if q.getAllOrdersStmt != nil {
 		if cerr := q.getAllOrdersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getAllOrdersStmt: %w", cerr)
 		}
 	}
+	if q.getAllParkedOrdersStmt != nil {
+		if cerr := q.getAllParkedOrdersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAllParkedOrdersStmt: %w", cerr)
+		}
+	}
+	if q.getAllPaymentMethodsStmt != nil {
+		if cerr := q.getAllPaymentMethodsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAllPaymentMethodsStmt: %w", cerr)
+		}
+	}
 	if q.getAllProductHistoryStmt != nil {
 		if cerr := q.getAllProductHistoryStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getAllProductHistoryStmt: %w", cerr)
@@ -303,11 +1189,51 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getAllProductsStmt: %w", cerr)
 		}
 	}
+	if q.getAllPromotionsStmt != nil {
+		if cerr := q.getAllPromotionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAllPromotionsStmt: %w", cerr)
+		}
+	}
+	if q.getAllPurchaseOrdersStmt != nil {
+		if cerr := q.getAllPurchaseOrdersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAllPurchaseOrdersStmt: %w", cerr)
+		}
+	}
+	if q.getAllShiftsStmt != nil {
+		if cerr := q.getAllShiftsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAllShiftsStmt: %w", cerr)
+		}
+	}
+	if q.getAllStockAlertsStmt != nil {
+		if cerr := q.getAllStockAlertsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAllStockAlertsStmt: %w", cerr)
+		}
+	}
+	if q.getAllStockTakesStmt != nil {
+		if cerr := q.getAllStockTakesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAllStockTakesStmt: %w", cerr)
+		}
+	}
+	if q.getAllSuppliersStmt != nil {
+		if cerr := q.getAllSuppliersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAllSuppliersStmt: %w", cerr)
+		}
+	}
+	if q.getAllTaxRatesStmt != nil {
+		if cerr := q.getAllTaxRatesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAllTaxRatesStmt: %w", cerr)
+		}
+	}
 	if q.getAllUsersStmt != nil {
 		if cerr := q.getAllUsersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getAllUsersStmt: %w", cerr)
 		}
 	}
+	if q.getAllVouchersStmt != nil {
+		if cerr := q.getAllVouchersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAllVouchersStmt: %w", cerr)
+		}
+	}
 	if q.getCategoryByIDStmt != nil {
 		if cerr := q.getCategoryByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCategoryByIDStmt: %w", cerr)
@@ -338,36 +1264,481 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getCustomerByPhoneExceptIDStmt: %w", cerr)
 		}
 	}
+	if q.getCustomerConsentsStmt != nil {
+		if cerr := q.getCustomerConsentsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerConsentsStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerExpiringPointsStmt != nil {
+		if cerr := q.getCustomerExpiringPointsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerExpiringPointsStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerExportGiftCardsStmt != nil {
+		if cerr := q.getCustomerExportGiftCardsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerExportGiftCardsStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerExportLoyaltyPointsStmt != nil {
+		if cerr := q.getCustomerExportLoyaltyPointsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerExportLoyaltyPointsStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerExportNotificationsStmt != nil {
+		if cerr := q.getCustomerExportNotificationsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerExportNotificationsStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerExportOrderItemsStmt != nil {
+		if cerr := q.getCustomerExportOrderItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerExportOrderItemsStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerExportOrdersStmt != nil {
+		if cerr := q.getCustomerExportOrdersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerExportOrdersStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerExportParkedOrdersStmt != nil {
+		if cerr := q.getCustomerExportParkedOrdersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerExportParkedOrdersStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerFavoriteProductsStmt != nil {
+		if cerr := q.getCustomerFavoriteProductsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerFavoriteProductsStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerLastOrderStmt != nil {
+		if cerr := q.getCustomerLastOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerLastOrderStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerMergesStmt != nil {
+		if cerr := q.getCustomerMergesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerMergesStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerOrdersStmt != nil {
+		if cerr := q.getCustomerOrdersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerOrdersStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerPointBalanceStmt != nil {
+		if cerr := q.getCustomerPointBalanceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerPointBalanceStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerPointHistoryStmt != nil {
+		if cerr := q.getCustomerPointHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerPointHistoryStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerRollingSpendStmt != nil {
+		if cerr := q.getCustomerRollingSpendStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerRollingSpendStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerSummaryStmt != nil {
+		if cerr := q.getCustomerSummaryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerSummaryStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerTierByIDStmt != nil {
+		if cerr := q.getCustomerTierByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerTierByIDStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerTierChangesStmt != nil {
+		if cerr := q.getCustomerTierChangesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerTierChangesStmt: %w", cerr)
+		}
+	}
+	if q.getCustomerTierPricesStmt != nil {
+		if cerr := q.getCustomerTierPricesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomerTierPricesStmt: %w", cerr)
+		}
+	}
+	if q.getCustomersByMemberCodeOrPhoneStmt != nil {
+		if cerr := q.getCustomersByMemberCodeOrPhoneStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCustomersByMemberCodeOrPhoneStmt: %w", cerr)
+		}
+	}
+	if q.getDuePaymentRefundsStmt != nil {
+		if cerr := q.getDuePaymentRefundsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDuePaymentRefundsStmt: %w", cerr)
+		}
+	}
+	if q.getDuplicateCustomerCandidatesStmt != nil {
+		if cerr := q.getDuplicateCustomerCandidatesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDuplicateCustomerCandidatesStmt: %w", cerr)
+		}
+	}
+	if q.getExpiredGiftCardsStmt != nil {
+		if cerr := q.getExpiredGiftCardsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getExpiredGiftCardsStmt: %w", cerr)
+		}
+	}
+	if q.getExpiredLoyaltyLotsStmt != nil {
+		if cerr := q.getExpiredLoyaltyLotsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getExpiredLoyaltyLotsStmt: %w", cerr)
+		}
+	}
+	if q.getExpiredParkedOrdersStmt != nil {
+		if cerr := q.getExpiredParkedOrdersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getExpiredParkedOrdersStmt: %w", cerr)
+		}
+	}
+	if q.getExpiredPendingChargesStmt != nil {
+		if cerr := q.getExpiredPendingChargesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getExpiredPendingChargesStmt: %w", cerr)
+		}
+	}
 	if q.getFastMovingProductsStmt != nil {
 		if cerr := q.getFastMovingProductsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getFastMovingProductsStmt: %w", cerr)
 		}
 	}
+	if q.getGiftCardByCodeStmt != nil {
+		if cerr := q.getGiftCardByCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGiftCardByCodeStmt: %w", cerr)
+		}
+	}
+	if q.getGiftCardByCodeForUpdateStmt != nil {
+		if cerr := q.getGiftCardByCodeForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGiftCardByCodeForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getGiftCardByIDStmt != nil {
+		if cerr := q.getGiftCardByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGiftCardByIDStmt: %w", cerr)
+		}
+	}
+	if q.getGiftCardByIDForUpdateStmt != nil {
+		if cerr := q.getGiftCardByIDForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGiftCardByIDForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getGiftCardRedemptionsByOrderIDStmt != nil {
+		if cerr := q.getGiftCardRedemptionsByOrderIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGiftCardRedemptionsByOrderIDStmt: %w", cerr)
+		}
+	}
+	if q.getGiftCardTransactionsStmt != nil {
+		if cerr := q.getGiftCardTransactionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGiftCardTransactionsStmt: %w", cerr)
+		}
+	}
+	if q.getGiftCardsByOrderIDStmt != nil {
+		if cerr := q.getGiftCardsByOrderIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGiftCardsByOrderIDStmt: %w", cerr)
+		}
+	}
+	if q.getInventoryValuationStmt != nil {
+		if cerr := q.getInventoryValuationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getInventoryValuationStmt: %w", cerr)
+		}
+	}
+	if q.getLowStockProductsStmt != nil {
+		if cerr := q.getLowStockProductsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getLowStockProductsStmt: %w", cerr)
+		}
+	}
+	if q.getLoyaltyLotsForUpdateStmt != nil {
+		if cerr := q.getLoyaltyLotsForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getLoyaltyLotsForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getLoyaltyPointsByOrderIDStmt != nil {
+		if cerr := q.getLoyaltyPointsByOrderIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getLoyaltyPointsByOrderIDStmt: %w", cerr)
+		}
+	}
+	if q.getNotificationsByOrderIDStmt != nil {
+		if cerr := q.getNotificationsByOrderIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getNotificationsByOrderIDStmt: %w", cerr)
+		}
+	}
+	if q.getOpenCostLayersForUpdateStmt != nil {
+		if cerr := q.getOpenCostLayersForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOpenCostLayersForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getOpenShiftByCashierIDStmt != nil {
+		if cerr := q.getOpenShiftByCashierIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOpenShiftByCashierIDStmt: %w", cerr)
+		}
+	}
 	if q.getOrderByIDStmt != nil {
 		if cerr := q.getOrderByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getOrderByIDStmt: %w", cerr)
 		}
 	}
+	if q.getOrderByIDForUpdateStmt != nil {
+		if cerr := q.getOrderByIDForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOrderByIDForUpdateStmt: %w", cerr)
+		}
+	}
 	if q.getOrderByTrxNumberStmt != nil {
 		if cerr := q.getOrderByTrxNumberStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getOrderByTrxNumberStmt: %w", cerr)
 		}
 	}
+	if q.getOrderByTrxNumberForUpdateStmt != nil {
+		if cerr := q.getOrderByTrxNumberForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOrderByTrxNumberForUpdateStmt: %w", cerr)
+		}
+	}
 	if q.getOrderItemsByOrderIDStmt != nil {
 		if cerr := q.getOrderItemsByOrderIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getOrderItemsByOrderIDStmt: %w", cerr)
 		}
 	}
+	if q.getOrderPaymentsByOrderIDStmt != nil {
+		if cerr := q.getOrderPaymentsByOrderIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOrderPaymentsByOrderIDStmt: %w", cerr)
+		}
+	}
+	if q.getOrderPromotionsByOrderIDStmt != nil {
+		if cerr := q.getOrderPromotionsByOrderIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOrderPromotionsByOrderIDStmt: %w", cerr)
+		}
+	}
+	if q.getOrderStoreCreditRefundAmountStmt != nil {
+		if cerr := q.getOrderStoreCreditRefundAmountStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOrderStoreCreditRefundAmountStmt: %w", cerr)
+		}
+	}
+	if q.getParkedOrderByIDStmt != nil {
+		if cerr := q.getParkedOrderByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getParkedOrderByIDStmt: %w", cerr)
+		}
+	}
+	if q.getParkedOrderByIDForUpdateStmt != nil {
+		if cerr := q.getParkedOrderByIDForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getParkedOrderByIDForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getParkedOrderItemsStmt != nil {
+		if cerr := q.getParkedOrderItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getParkedOrderItemsStmt: %w", cerr)
+		}
+	}
+	if q.getPaymentChargeByChargeIDStmt != nil {
+		if cerr := q.getPaymentChargeByChargeIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPaymentChargeByChargeIDStmt: %w", cerr)
+		}
+	}
+	if q.getPaymentChargeByIDStmt != nil {
+		if cerr := q.getPaymentChargeByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPaymentChargeByIDStmt: %w", cerr)
+		}
+	}
+	if q.getPaymentChargeByOrderIDStmt != nil {
+		if cerr := q.getPaymentChargeByOrderIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPaymentChargeByOrderIDStmt: %w", cerr)
+		}
+	}
+	if q.getPaymentMethodByCodeStmt != nil {
+		if cerr := q.getPaymentMethodByCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPaymentMethodByCodeStmt: %w", cerr)
+		}
+	}
+	if q.getPaymentMethodByIDStmt != nil {
+		if cerr := q.getPaymentMethodByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPaymentMethodByIDStmt: %w", cerr)
+		}
+	}
+	if q.getProductByBarcodeStmt != nil {
+		if cerr := q.getProductByBarcodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductByBarcodeStmt: %w", cerr)
+		}
+	}
 	if q.getProductByIDStmt != nil {
 		if cerr := q.getProductByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getProductByIDStmt: %w", cerr)
 		}
 	}
+	if q.getProductByIDForUpdateStmt != nil {
+		if cerr := q.getProductByIDForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductByIDForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getProductCostLayersStmt != nil {
+		if cerr := q.getProductCostLayersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductCostLayersStmt: %w", cerr)
+		}
+	}
+	if q.getProductHistorySummaryStmt != nil {
+		if cerr := q.getProductHistorySummaryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductHistorySummaryStmt: %w", cerr)
+		}
+	}
+	if q.getProductLedgerStmt != nil {
+		if cerr := q.getProductLedgerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductLedgerStmt: %w", cerr)
+		}
+	}
+	if q.getProductLedgerTotalStmt != nil {
+		if cerr := q.getProductLedgerTotalStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductLedgerTotalStmt: %w", cerr)
+		}
+	}
+	if q.getProductStockBalancesStmt != nil {
+		if cerr := q.getProductStockBalancesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductStockBalancesStmt: %w", cerr)
+		}
+	}
+	if q.getProfitByCashierStmt != nil {
+		if cerr := q.getProfitByCashierStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProfitByCashierStmt: %w", cerr)
+		}
+	}
+	if q.getProfitByCategoryStmt != nil {
+		if cerr := q.getProfitByCategoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProfitByCategoryStmt: %w", cerr)
+		}
+	}
+	if q.getProfitByCustomerStmt != nil {
+		if cerr := q.getProfitByCustomerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProfitByCustomerStmt: %w", cerr)
+		}
+	}
+	if q.getProfitByDayStmt != nil {
+		if cerr := q.getProfitByDayStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProfitByDayStmt: %w", cerr)
+		}
+	}
+	if q.getProfitByProductStmt != nil {
+		if cerr := q.getProfitByProductStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProfitByProductStmt: %w", cerr)
+		}
+	}
+	if q.getPromotionByIDStmt != nil {
+		if cerr := q.getPromotionByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPromotionByIDStmt: %w", cerr)
+		}
+	}
+	if q.getPromotionProductsByPromotionIDStmt != nil {
+		if cerr := q.getPromotionProductsByPromotionIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPromotionProductsByPromotionIDStmt: %w", cerr)
+		}
+	}
+	if q.getPurchaseOrderByIDStmt != nil {
+		if cerr := q.getPurchaseOrderByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPurchaseOrderByIDStmt: %w", cerr)
+		}
+	}
+	if q.getPurchaseOrderByIDForUpdateStmt != nil {
+		if cerr := q.getPurchaseOrderByIDForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPurchaseOrderByIDForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getPurchaseOrderItemsStmt != nil {
+		if cerr := q.getPurchaseOrderItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPurchaseOrderItemsStmt: %w", cerr)
+		}
+	}
+	if q.getPurchaseReceiptItemsStmt != nil {
+		if cerr := q.getPurchaseReceiptItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPurchaseReceiptItemsStmt: %w", cerr)
+		}
+	}
+	if q.getPurchaseReceiptsByOrderIDStmt != nil {
+		if cerr := q.getPurchaseReceiptsByOrderIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPurchaseReceiptsByOrderIDStmt: %w", cerr)
+		}
+	}
+	if q.getReorderCandidatesStmt != nil {
+		if cerr := q.getReorderCandidatesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReorderCandidatesStmt: %w", cerr)
+		}
+	}
+	if q.getShiftByIDStmt != nil {
+		if cerr := q.getShiftByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getShiftByIDStmt: %w", cerr)
+		}
+	}
+	if q.getShiftCashMovementsStmt != nil {
+		if cerr := q.getShiftCashMovementsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getShiftCashMovementsStmt: %w", cerr)
+		}
+	}
+	if q.getShiftPaymentSummaryStmt != nil {
+		if cerr := q.getShiftPaymentSummaryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getShiftPaymentSummaryStmt: %w", cerr)
+		}
+	}
+	if q.getShiftRefundSummaryStmt != nil {
+		if cerr := q.getShiftRefundSummaryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getShiftRefundSummaryStmt: %w", cerr)
+		}
+	}
+	if q.getShiftSalesSummaryStmt != nil {
+		if cerr := q.getShiftSalesSummaryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getShiftSalesSummaryStmt: %w", cerr)
+		}
+	}
 	if q.getSlowMovingProductsStmt != nil {
 		if cerr := q.getSlowMovingProductsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getSlowMovingProductsStmt: %w", cerr)
 		}
 	}
+	if q.getStockAlertByIDStmt != nil {
+		if cerr := q.getStockAlertByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getStockAlertByIDStmt: %w", cerr)
+		}
+	}
+	if q.getStockTakeByIDStmt != nil {
+		if cerr := q.getStockTakeByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getStockTakeByIDStmt: %w", cerr)
+		}
+	}
+	if q.getStockTakeByIDForShareStmt != nil {
+		if cerr := q.getStockTakeByIDForShareStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getStockTakeByIDForShareStmt: %w", cerr)
+		}
+	}
+	if q.getStockTakeByIDForUpdateStmt != nil {
+		if cerr := q.getStockTakeByIDForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getStockTakeByIDForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getStockTakeItemsStmt != nil {
+		if cerr := q.getStockTakeItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getStockTakeItemsStmt: %w", cerr)
+		}
+	}
+	if q.getStoreCreditByCustomerIDStmt != nil {
+		if cerr := q.getStoreCreditByCustomerIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getStoreCreditByCustomerIDStmt: %w", cerr)
+		}
+	}
+	if q.getStoreCreditByCustomerIDForUpdateStmt != nil {
+		if cerr := q.getStoreCreditByCustomerIDForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getStoreCreditByCustomerIDForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getSupplierByIDStmt != nil {
+		if cerr := q.getSupplierByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSupplierByIDStmt: %w", cerr)
+		}
+	}
+	if q.getTaxRateByIDStmt != nil {
+		if cerr := q.getTaxRateByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTaxRateByIDStmt: %w", cerr)
+		}
+	}
+	if q.getTaxRateByProductIDStmt != nil {
+		if cerr := q.getTaxRateByProductIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTaxRateByProductIDStmt: %w", cerr)
+		}
+	}
+	if q.getTaxSummaryStmt != nil {
+		if cerr := q.getTaxSummaryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTaxSummaryStmt: %w", cerr)
+		}
+	}
 	if q.getTopCashiersStmt != nil {
 		if cerr := q.getTopCashiersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getTopCashiersStmt: %w", cerr)
@@ -378,6 +1749,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getTopCustomersStmt: %w", cerr)
 		}
 	}
+	if q.getUnnotifiedStockAlertsStmt != nil {
+		if cerr := q.getUnnotifiedStockAlertsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUnnotifiedStockAlertsStmt: %w", cerr)
+		}
+	}
 	if q.getUserByIDStmt != nil {
 		if cerr := q.getUserByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUserByIDStmt: %w", cerr)
@@ -393,11 +1769,181 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getUserByUsernameExceptIDStmt: %w", cerr)
 		}
 	}
+	if q.getVoucherByCodeStmt != nil {
+		if cerr := q.getVoucherByCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getVoucherByCodeStmt: %w", cerr)
+		}
+	}
+	if q.getVoucherByCodeForUpdateStmt != nil {
+		if cerr := q.getVoucherByCodeForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getVoucherByCodeForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getVoucherByIDStmt != nil {
+		if cerr := q.getVoucherByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getVoucherByIDStmt: %w", cerr)
+		}
+	}
+	if q.getVoucherRedemptionByOrderIDStmt != nil {
+		if cerr := q.getVoucherRedemptionByOrderIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getVoucherRedemptionByOrderIDStmt: %w", cerr)
+		}
+	}
+	if q.incrementGiftCardPinAttemptsStmt != nil {
+		if cerr := q.incrementGiftCardPinAttemptsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing incrementGiftCardPinAttemptsStmt: %w", cerr)
+		}
+	}
+	if q.incrementProductStockStmt != nil {
+		if cerr := q.incrementProductStockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing incrementProductStockStmt: %w", cerr)
+		}
+	}
+	if q.incrementReceiptPrintCountStmt != nil {
+		if cerr := q.incrementReceiptPrintCountStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing incrementReceiptPrintCountStmt: %w", cerr)
+		}
+	}
+	if q.incrementVoucherUsageStmt != nil {
+		if cerr := q.incrementVoucherUsageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing incrementVoucherUsageStmt: %w", cerr)
+		}
+	}
+	if q.lockGiftCardPinStmt != nil {
+		if cerr := q.lockGiftCardPinStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockGiftCardPinStmt: %w", cerr)
+		}
+	}
+	if q.markNotificationAttemptFailedStmt != nil {
+		if cerr := q.markNotificationAttemptFailedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markNotificationAttemptFailedStmt: %w", cerr)
+		}
+	}
+	if q.markNotificationSentStmt != nil {
+		if cerr := q.markNotificationSentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markNotificationSentStmt: %w", cerr)
+		}
+	}
+	if q.markPaymentChargeLatePaidStmt != nil {
+		if cerr := q.markPaymentChargeLatePaidStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markPaymentChargeLatePaidStmt: %w", cerr)
+		}
+	}
+	if q.markPaymentChargeRefundedStmt != nil {
+		if cerr := q.markPaymentChargeRefundedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markPaymentChargeRefundedStmt: %w", cerr)
+		}
+	}
+	if q.markPaymentRefundAttemptFailedStmt != nil {
+		if cerr := q.markPaymentRefundAttemptFailedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markPaymentRefundAttemptFailedStmt: %w", cerr)
+		}
+	}
+	if q.markPaymentRefundSucceededStmt != nil {
+		if cerr := q.markPaymentRefundSucceededStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markPaymentRefundSucceededStmt: %w", cerr)
+		}
+	}
+	if q.markStockAlertNotifiedStmt != nil {
+		if cerr := q.markStockAlertNotifiedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markStockAlertNotifiedStmt: %w", cerr)
+		}
+	}
+	if q.postStockTakeStmt != nil {
+		if cerr := q.postStockTakeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing postStockTakeStmt: %w", cerr)
+		}
+	}
+	if q.postponeNotificationStmt != nil {
+		if cerr := q.postponeNotificationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing postponeNotificationStmt: %w", cerr)
+		}
+	}
+	if q.reassignCustomerGiftCardsStmt != nil {
+		if cerr := q.reassignCustomerGiftCardsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reassignCustomerGiftCardsStmt: %w", cerr)
+		}
+	}
+	if q.reassignCustomerLoyaltyPointsStmt != nil {
+		if cerr := q.reassignCustomerLoyaltyPointsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reassignCustomerLoyaltyPointsStmt: %w", cerr)
+		}
+	}
+	if q.reassignCustomerOrdersStmt != nil {
+		if cerr := q.reassignCustomerOrdersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reassignCustomerOrdersStmt: %w", cerr)
+		}
+	}
+	if q.reassignCustomerParkedOrdersStmt != nil {
+		if cerr := q.reassignCustomerParkedOrdersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reassignCustomerParkedOrdersStmt: %w", cerr)
+		}
+	}
+	if q.reassignCustomerTierChangesStmt != nil {
+		if cerr := q.reassignCustomerTierChangesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reassignCustomerTierChangesStmt: %w", cerr)
+		}
+	}
+	if q.reassignCustomerVoucherRedemptionsStmt != nil {
+		if cerr := q.reassignCustomerVoucherRedemptionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reassignCustomerVoucherRedemptionsStmt: %w", cerr)
+		}
+	}
+	if q.receivePurchaseOrderItemStmt != nil {
+		if cerr := q.receivePurchaseOrderItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing receivePurchaseOrderItemStmt: %w", cerr)
+		}
+	}
+	if q.releaseProductStockStmt != nil {
+		if cerr := q.releaseProductStockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing releaseProductStockStmt: %w", cerr)
+		}
+	}
+	if q.reserveProductStockStmt != nil {
+		if cerr := q.reserveProductStockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reserveProductStockStmt: %w", cerr)
+		}
+	}
+	if q.resetGiftCardPinAttemptsStmt != nil {
+		if cerr := q.resetGiftCardPinAttemptsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing resetGiftCardPinAttemptsStmt: %w", cerr)
+		}
+	}
+	if q.resolveStockAlertsStmt != nil {
+		if cerr := q.resolveStockAlertsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing resolveStockAlertsStmt: %w", cerr)
+		}
+	}
+	if q.resumeParkedOrderStmt != nil {
+		if cerr := q.resumeParkedOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing resumeParkedOrderStmt: %w", cerr)
+		}
+	}
+	if q.reverseVoucherRedemptionStmt != nil {
+		if cerr := q.reverseVoucherRedemptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reverseVoucherRedemptionStmt: %w", cerr)
+		}
+	}
+	if q.searchCustomersStmt != nil {
+		if cerr := q.searchCustomersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing searchCustomersStmt: %w", cerr)
+		}
+	}
+	if q.sendPurchaseOrderStmt != nil {
+		if cerr := q.sendPurchaseOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing sendPurchaseOrderStmt: %w", cerr)
+		}
+	}
 	if q.setCurrentTokenStmt != nil {
 		if cerr := q.setCurrentTokenStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setCurrentTokenStmt: %w", cerr)
 		}
 	}
+	if q.setCustomerTierStmt != nil {
+		if cerr := q.setCustomerTierStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setCustomerTierStmt: %w", cerr)
+		}
+	}
 	if q.softDeleteCategoryByIDStmt != nil {
 		if cerr := q.softDeleteCategoryByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing softDeleteCategoryByIDStmt: %w", cerr)
@@ -408,41 +1954,141 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing softDeleteCustomerByIDStmt: %w", cerr)
 		}
 	}
+	if q.softDeletePaymentMethodByIDStmt != nil {
+		if cerr := q.softDeletePaymentMethodByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing softDeletePaymentMethodByIDStmt: %w", cerr)
+		}
+	}
 	if q.softDeleteProductByIDStmt != nil {
 		if cerr := q.softDeleteProductByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing softDeleteProductByIDStmt: %w", cerr)
 		}
 	}
+	if q.softDeletePromotionByIDStmt != nil {
+		if cerr := q.softDeletePromotionByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing softDeletePromotionByIDStmt: %w", cerr)
+		}
+	}
+	if q.softDeleteSupplierByIDStmt != nil {
+		if cerr := q.softDeleteSupplierByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing softDeleteSupplierByIDStmt: %w", cerr)
+		}
+	}
+	if q.softDeleteTaxRateByIDStmt != nil {
+		if cerr := q.softDeleteTaxRateByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing softDeleteTaxRateByIDStmt: %w", cerr)
+		}
+	}
 	if q.softDeleteUserByIDStmt != nil {
 		if cerr := q.softDeleteUserByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing softDeleteUserByIDStmt: %w", cerr)
 		}
 	}
+	if q.softDeleteVoucherByIDStmt != nil {
+		if cerr := q.softDeleteVoucherByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing softDeleteVoucherByIDStmt: %w", cerr)
+		}
+	}
 	if q.updateCategoryStmt != nil {
 		if cerr := q.updateCategoryStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateCategoryStmt: %w", cerr)
 		}
 	}
+	if q.updateCostLayerRemainingStmt != nil {
+		if cerr := q.updateCostLayerRemainingStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateCostLayerRemainingStmt: %w", cerr)
+		}
+	}
 	if q.updateCustomerStmt != nil {
 		if cerr := q.updateCustomerStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateCustomerStmt: %w", cerr)
 		}
 	}
+	if q.updateCustomerConsentStmt != nil {
+		if cerr := q.updateCustomerConsentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateCustomerConsentStmt: %w", cerr)
+		}
+	}
+	if q.updateCustomerTierStmt != nil {
+		if cerr := q.updateCustomerTierStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateCustomerTierStmt: %w", cerr)
+		}
+	}
+	if q.updateGiftCardBalanceStmt != nil {
+		if cerr := q.updateGiftCardBalanceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateGiftCardBalanceStmt: %w", cerr)
+		}
+	}
+	if q.updateLoyaltyPointRemainingStmt != nil {
+		if cerr := q.updateLoyaltyPointRemainingStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateLoyaltyPointRemainingStmt: %w", cerr)
+		}
+	}
 	if q.updateOrderStatusStmt != nil {
 		if cerr := q.updateOrderStatusStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateOrderStatusStmt: %w", cerr)
 		}
 	}
+	if q.updateParkedOrderStatusStmt != nil {
+		if cerr := q.updateParkedOrderStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateParkedOrderStatusStmt: %w", cerr)
+		}
+	}
+	if q.updatePaymentChargeStatusStmt != nil {
+		if cerr := q.updatePaymentChargeStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePaymentChargeStatusStmt: %w", cerr)
+		}
+	}
+	if q.updatePaymentMethodStmt != nil {
+		if cerr := q.updatePaymentMethodStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePaymentMethodStmt: %w", cerr)
+		}
+	}
 	if q.updateProductStmt != nil {
 		if cerr := q.updateProductStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateProductStmt: %w", cerr)
 		}
 	}
+	if q.updateProductCostStmt != nil {
+		if cerr := q.updateProductCostStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateProductCostStmt: %w", cerr)
+		}
+	}
 	if q.updateProductStockStmt != nil {
 		if cerr := q.updateProductStockStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateProductStockStmt: %w", cerr)
 		}
 	}
+	if q.updatePromotionStmt != nil {
+		if cerr := q.updatePromotionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePromotionStmt: %w", cerr)
+		}
+	}
+	if q.updatePurchaseOrderStmt != nil {
+		if cerr := q.updatePurchaseOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePurchaseOrderStmt: %w", cerr)
+		}
+	}
+	if q.updatePurchaseOrderReceiveStatusStmt != nil {
+		if cerr := q.updatePurchaseOrderReceiveStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePurchaseOrderReceiveStatusStmt: %w", cerr)
+		}
+	}
+	if q.updateStockTakeItemResultStmt != nil {
+		if cerr := q.updateStockTakeItemResultStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateStockTakeItemResultStmt: %w", cerr)
+		}
+	}
+	if q.updateSupplierStmt != nil {
+		if cerr := q.updateSupplierStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateSupplierStmt: %w", cerr)
+		}
+	}
+	if q.updateTaxRateStmt != nil {
+		if cerr := q.updateTaxRateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateTaxRateStmt: %w", cerr)
+		}
+	}
 	if q.updateUserStmt != nil {
 		if cerr := q.updateUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateUserStmt: %w", cerr)
@@ -453,6 +2099,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateUserWithPasswordStmt: %w", cerr)
 		}
 	}
+	if q.updateVoucherStmt != nil {
+		if cerr := q.updateVoucherStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateVoucherStmt: %w", cerr)
+		}
+	}
+	if q.upsertCustomerTierPriceStmt != nil {
+		if cerr := q.upsertCustomerTierPriceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertCustomerTierPriceStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -490,119 +2146,533 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                             DBTX
-	tx                             *sql.Tx
-	checkTokenStmt                 *sql.Stmt
-	createCategoryStmt             *sql.Stmt
-	createCustomerStmt             *sql.Stmt
-	createOrderStmt                *sql.Stmt
-	createOrderItemStmt            *sql.Stmt
-	createProductStmt              *sql.Stmt
-	createProductHistoryStmt       *sql.Stmt
-	createRefundStmt               *sql.Stmt
-	createUserStmt                 *sql.Stmt
-	deleteCategoryByIDStmt         *sql.Stmt
-	deleteCustomerByIDStmt         *sql.Stmt
-	deleteProductByIDStmt          *sql.Stmt
-	deleteUserByIDStmt             *sql.Stmt
-	generateMemberCodeStmt         *sql.Stmt
-	getAllCategoriesStmt           *sql.Stmt
-	getAllCustomersStmt            *sql.Stmt
-	getAllDeletedCategoriesStmt    *sql.Stmt
-	getAllDeletedCustomersStmt     *sql.Stmt
-	getAllDeletedProductsStmt      *sql.Stmt
-	getAllDeletedUsersStmt         *sql.Stmt
-	getAllOrdersStmt               *sql.Stmt
-	getAllProductHistoryStmt       *sql.Stmt
-	getAllProductsStmt             *sql.Stmt
-	getAllUsersStmt                *sql.Stmt
-	getCategoryByIDStmt            *sql.Stmt
-	getCustomerByEmailStmt         *sql.Stmt
-	getCustomerByEmailExceptIDStmt *sql.Stmt
-	getCustomerByIDStmt            *sql.Stmt
-	getCustomerByPhoneStmt         *sql.Stmt
-	getCustomerByPhoneExceptIDStmt *sql.Stmt
-	getFastMovingProductsStmt      *sql.Stmt
-	getOrderByIDStmt               *sql.Stmt
-	getOrderByTrxNumberStmt        *sql.Stmt
-	getOrderItemsByOrderIDStmt     *sql.Stmt
-	getProductByIDStmt             *sql.Stmt
-	getSlowMovingProductsStmt      *sql.Stmt
-	getTopCashiersStmt             *sql.Stmt
-	getTopCustomersStmt            *sql.Stmt
-	getUserByIDStmt                *sql.Stmt
-	getUserByUsernameStmt          *sql.Stmt
-	getUserByUsernameExceptIDStmt  *sql.Stmt
-	setCurrentTokenStmt            *sql.Stmt
-	softDeleteCategoryByIDStmt     *sql.Stmt
-	softDeleteCustomerByIDStmt     *sql.Stmt
-	softDeleteProductByIDStmt      *sql.Stmt
-	softDeleteUserByIDStmt         *sql.Stmt
-	updateCategoryStmt             *sql.Stmt
-	updateCustomerStmt             *sql.Stmt
-	updateOrderStatusStmt          *sql.Stmt
-	updateProductStmt              *sql.Stmt
-	updateProductStockStmt         *sql.Stmt
-	updateUserStmt                 *sql.Stmt
-	updateUserWithPasswordStmt     *sql.Stmt
+	db                                       DBTX
+	tx                                       *sql.Tx
+	addStockTakeCountStmt                    *sql.Stmt
+	anonymizeCustomerStmt                    *sql.Stmt
+	anonymizeCustomerMergesStmt              *sql.Stmt
+	anonymizeCustomerNotificationsStmt       *sql.Stmt
+	anonymizeCustomerParkedOrdersStmt        *sql.Stmt
+	cancelPurchaseOrderStmt                  *sql.Stmt
+	cancelStockTakeStmt                      *sql.Stmt
+	checkTokenStmt                           *sql.Stmt
+	claimDueNotificationsStmt                *sql.Stmt
+	closeShiftStmt                           *sql.Stmt
+	countCustomerOrdersStmt                  *sql.Stmt
+	countCustomerVoucherRedemptionsStmt      *sql.Stmt
+	createCategoryStmt                       *sql.Stmt
+	createCostLayerStmt                      *sql.Stmt
+	createCustomerStmt                       *sql.Stmt
+	createCustomerConsentStmt                *sql.Stmt
+	createCustomerMergeStmt                  *sql.Stmt
+	createCustomerTierChangeStmt             *sql.Stmt
+	createGiftCardStmt                       *sql.Stmt
+	createGiftCardTransactionStmt            *sql.Stmt
+	createLoyaltyPointStmt                   *sql.Stmt
+	createNotificationStmt                   *sql.Stmt
+	createOrderStmt                          *sql.Stmt
+	createOrderItemStmt                      *sql.Stmt
+	createOrderPaymentStmt                   *sql.Stmt
+	createOrderPromotionStmt                 *sql.Stmt
+	createParkedOrderStmt                    *sql.Stmt
+	createParkedOrderItemStmt                *sql.Stmt
+	createPaymentChargeStmt                  *sql.Stmt
+	createPaymentMethodStmt                  *sql.Stmt
+	createPaymentRefundStmt                  *sql.Stmt
+	createProductStmt                        *sql.Stmt
+	createProductHistoryStmt                 *sql.Stmt
+	createPromotionStmt                      *sql.Stmt
+	createPromotionProductStmt               *sql.Stmt
+	createPurchaseOrderStmt                  *sql.Stmt
+	createPurchaseOrderItemStmt              *sql.Stmt
+	createPurchaseReceiptStmt                *sql.Stmt
+	createPurchaseReceiptItemStmt            *sql.Stmt
+	createRefundStmt                         *sql.Stmt
+	createShiftStmt                          *sql.Stmt
+	createShiftCashMovementStmt              *sql.Stmt
+	createStockAlertStmt                     *sql.Stmt
+	createStockCostMovementStmt              *sql.Stmt
+	createStockTakeStmt                      *sql.Stmt
+	createStockTakeCountStmt                 *sql.Stmt
+	createStockTakeItemsStmt                 *sql.Stmt
+	createSupplierStmt                       *sql.Stmt
+	createTaxRateStmt                        *sql.Stmt
+	createUserStmt                           *sql.Stmt
+	createVoucherStmt                        *sql.Stmt
+	createVoucherRedemptionStmt              *sql.Stmt
+	decrementProductStockStmt                *sql.Stmt
+	decrementVoucherUsageStmt                *sql.Stmt
+	deleteCategoryByIDStmt                   *sql.Stmt
+	deleteCustomerByIDStmt                   *sql.Stmt
+	deleteCustomerTierPriceStmt              *sql.Stmt
+	deleteProductByIDStmt                    *sql.Stmt
+	deletePromotionProductsByPromotionIDStmt *sql.Stmt
+	deletePurchaseOrderItemsStmt             *sql.Stmt
+	deleteUserByIDStmt                       *sql.Stmt
+	generateMemberCodeStmt                   *sql.Stmt
+	getActivePromotionsStmt                  *sql.Stmt
+	getAllCategoriesStmt                     *sql.Stmt
+	getAllCustomerTiersStmt                  *sql.Stmt
+	getAllCustomersStmt                      *sql.Stmt
+	getAllDeletedCategoriesStmt              *sql.Stmt
+	getAllDeletedCustomersStmt               *sql.Stmt
+	getAllDeletedProductsStmt                *sql.Stmt
+	getAllDeletedUsersStmt                   *sql.Stmt
+	getAllGiftCardsStmt                      *sql.Stmt
+	getAllOrdersStmt                         *sql.Stmt
+	getAllParkedOrdersStmt                   *sql.Stmt
+	getAllPaymentMethodsStmt                 *sql.Stmt
+	getAllProductHistoryStmt                 *sql.Stmt
+	getAllProductsStmt                       *sql.Stmt
+	getAllPromotionsStmt                     *sql.Stmt
+	getAllPurchaseOrdersStmt                 *sql.Stmt
+	getAllShiftsStmt                         *sql.Stmt
+	getAllStockAlertsStmt                    *sql.Stmt
+	getAllStockTakesStmt                     *sql.Stmt
+	getAllSuppliersStmt                      *sql.Stmt
+	getAllTaxRatesStmt                       *sql.Stmt
+	getAllUsersStmt                          *sql.Stmt
+	getAllVouchersStmt                       *sql.Stmt
+	getCategoryByIDStmt                      *sql.Stmt
+	getCustomerByEmailStmt                   *sql.Stmt
+	getCustomerByEmailExceptIDStmt           *sql.Stmt
+	getCustomerByIDStmt                      *sql.Stmt
+	getCustomerByPhoneStmt                   *sql.Stmt
+	getCustomerByPhoneExceptIDStmt           *sql.Stmt
+	getCustomerConsentsStmt                  *sql.Stmt
+	getCustomerExpiringPointsStmt            *sql.Stmt
+	getCustomerExportGiftCardsStmt           *sql.Stmt
+	getCustomerExportLoyaltyPointsStmt       *sql.Stmt
+	getCustomerExportNotificationsStmt       *sql.Stmt
+	getCustomerExportOrderItemsStmt          *sql.Stmt
+	getCustomerExportOrdersStmt              *sql.Stmt
+	getCustomerExportParkedOrdersStmt        *sql.Stmt
+	getCustomerFavoriteProductsStmt          *sql.Stmt
+	getCustomerLastOrderStmt                 *sql.Stmt
+	getCustomerMergesStmt                    *sql.Stmt
+	getCustomerOrdersStmt                    *sql.Stmt
+	getCustomerPointBalanceStmt              *sql.Stmt
+	getCustomerPointHistoryStmt              *sql.Stmt
+	getCustomerRollingSpendStmt              *sql.Stmt
+	getCustomerSummaryStmt                   *sql.Stmt
+	getCustomerTierByIDStmt                  *sql.Stmt
+	getCustomerTierChangesStmt               *sql.Stmt
+	getCustomerTierPricesStmt                *sql.Stmt
+	getCustomersByMemberCodeOrPhoneStmt      *sql.Stmt
+	getDuePaymentRefundsStmt                 *sql.Stmt
+	getDuplicateCustomerCandidatesStmt       *sql.Stmt
+	getExpiredGiftCardsStmt                  *sql.Stmt
+	getExpiredLoyaltyLotsStmt                *sql.Stmt
+	getExpiredParkedOrdersStmt               *sql.Stmt
+	getExpiredPendingChargesStmt             *sql.Stmt
+	getFastMovingProductsStmt                *sql.Stmt
+	getGiftCardByCodeStmt                    *sql.Stmt
+	getGiftCardByCodeForUpdateStmt           *sql.Stmt
+	getGiftCardByIDStmt                      *sql.Stmt
+	getGiftCardByIDForUpdateStmt             *sql.Stmt
+	getGiftCardRedemptionsByOrderIDStmt      *sql.Stmt
+	getGiftCardTransactionsStmt              *sql.Stmt
+	getGiftCardsByOrderIDStmt                *sql.Stmt
+	getInventoryValuationStmt                *sql.Stmt
+	getLowStockProductsStmt                  *sql.Stmt
+	getLoyaltyLotsForUpdateStmt              *sql.Stmt
+	getLoyaltyPointsByOrderIDStmt            *sql.Stmt
+	getNotificationsByOrderIDStmt            *sql.Stmt
+	getOpenCostLayersForUpdateStmt           *sql.Stmt
+	getOpenShiftByCashierIDStmt              *sql.Stmt
+	getOrderByIDStmt                         *sql.Stmt
+	getOrderByIDForUpdateStmt                *sql.Stmt
+	getOrderByTrxNumberStmt                  *sql.Stmt
+	getOrderByTrxNumberForUpdateStmt         *sql.Stmt
+	getOrderItemsByOrderIDStmt               *sql.Stmt
+	getOrderPaymentsByOrderIDStmt            *sql.Stmt
+	getOrderPromotionsByOrderIDStmt          *sql.Stmt
+	getOrderStoreCreditRefundAmountStmt      *sql.Stmt
+	getParkedOrderByIDStmt                   *sql.Stmt
+	getParkedOrderByIDForUpdateStmt          *sql.Stmt
+	getParkedOrderItemsStmt                  *sql.Stmt
+	getPaymentChargeByChargeIDStmt           *sql.Stmt
+	getPaymentChargeByIDStmt                 *sql.Stmt
+	getPaymentChargeByOrderIDStmt            *sql.Stmt
+	getPaymentMethodByCodeStmt               *sql.Stmt
+	getPaymentMethodByIDStmt                 *sql.Stmt
+	getProductByBarcodeStmt                  *sql.Stmt
+	getProductByIDStmt                       *sql.Stmt
+	getProductByIDForUpdateStmt              *sql.Stmt
+	getProductCostLayersStmt                 *sql.Stmt
+	getProductHistorySummaryStmt             *sql.Stmt
+	getProductLedgerStmt                     *sql.Stmt
+	getProductLedgerTotalStmt                *sql.Stmt
+	getProductStockBalancesStmt              *sql.Stmt
+	getProfitByCashierStmt                   *sql.Stmt
+	getProfitByCategoryStmt                  *sql.Stmt
+	getProfitByCustomerStmt                  *sql.Stmt
+	getProfitByDayStmt                       *sql.Stmt
+	getProfitByProductStmt                   *sql.Stmt
+	getPromotionByIDStmt                     *sql.Stmt
+	getPromotionProductsByPromotionIDStmt    *sql.Stmt
+	getPurchaseOrderByIDStmt                 *sql.Stmt
+	getPurchaseOrderByIDForUpdateStmt        *sql.Stmt
+	getPurchaseOrderItemsStmt                *sql.Stmt
+	getPurchaseReceiptItemsStmt              *sql.Stmt
+	getPurchaseReceiptsByOrderIDStmt         *sql.Stmt
+	getReorderCandidatesStmt                 *sql.Stmt
+	getShiftByIDStmt                         *sql.Stmt
+	getShiftCashMovementsStmt                *sql.Stmt
+	getShiftPaymentSummaryStmt               *sql.Stmt
+	getShiftRefundSummaryStmt                *sql.Stmt
+	getShiftSalesSummaryStmt                 *sql.Stmt
+	getSlowMovingProductsStmt                *sql.Stmt
+	getStockAlertByIDStmt                    *sql.Stmt
+	getStockTakeByIDStmt                     *sql.Stmt
+	getStockTakeByIDForShareStmt             *sql.Stmt
+	getStockTakeByIDForUpdateStmt            *sql.Stmt
+	getStockTakeItemsStmt                    *sql.Stmt
+	getStoreCreditByCustomerIDStmt           *sql.Stmt
+	getStoreCreditByCustomerIDForUpdateStmt  *sql.Stmt
+	getSupplierByIDStmt                      *sql.Stmt
+	getTaxRateByIDStmt                       *sql.Stmt
+	getTaxRateByProductIDStmt                *sql.Stmt
+	getTaxSummaryStmt                        *sql.Stmt
+	getTopCashiersStmt                       *sql.Stmt
+	getTopCustomersStmt                      *sql.Stmt
+	getUnnotifiedStockAlertsStmt             *sql.Stmt
+	getUserByIDStmt                          *sql.Stmt
+	getUserByUsernameStmt                    *sql.Stmt
+	getUserByUsernameExceptIDStmt            *sql.Stmt
+	getVoucherByCodeStmt                     *sql.Stmt
+	getVoucherByCodeForUpdateStmt            *sql.Stmt
+	getVoucherByIDStmt                       *sql.Stmt
+	getVoucherRedemptionByOrderIDStmt        *sql.Stmt
+	incrementGiftCardPinAttemptsStmt         *sql.Stmt
+	incrementProductStockStmt                *sql.Stmt
+	incrementReceiptPrintCountStmt           *sql.Stmt
+	incrementVoucherUsageStmt                *sql.Stmt
+	lockGiftCardPinStmt                      *sql.Stmt
+	markNotificationAttemptFailedStmt        *sql.Stmt
+	markNotificationSentStmt                 *sql.Stmt
+	markPaymentChargeLatePaidStmt            *sql.Stmt
+	markPaymentChargeRefundedStmt            *sql.Stmt
+	markPaymentRefundAttemptFailedStmt       *sql.Stmt
+	markPaymentRefundSucceededStmt           *sql.Stmt
+	markStockAlertNotifiedStmt               *sql.Stmt
+	postStockTakeStmt                        *sql.Stmt
+	postponeNotificationStmt                 *sql.Stmt
+	reassignCustomerGiftCardsStmt            *sql.Stmt
+	reassignCustomerLoyaltyPointsStmt        *sql.Stmt
+	reassignCustomerOrdersStmt               *sql.Stmt
+	reassignCustomerParkedOrdersStmt         *sql.Stmt
+	reassignCustomerTierChangesStmt          *sql.Stmt
+	reassignCustomerVoucherRedemptionsStmt   *sql.Stmt
+	receivePurchaseOrderItemStmt             *sql.Stmt
+	releaseProductStockStmt                  *sql.Stmt
+	reserveProductStockStmt                  *sql.Stmt
+	resetGiftCardPinAttemptsStmt             *sql.Stmt
+	resolveStockAlertsStmt                   *sql.Stmt
+	resumeParkedOrderStmt                    *sql.Stmt
+	reverseVoucherRedemptionStmt             *sql.Stmt
+	searchCustomersStmt                      *sql.Stmt
+	sendPurchaseOrderStmt                    *sql.Stmt
+	setCurrentTokenStmt                      *sql.Stmt
+	setCustomerTierStmt                      *sql.Stmt
+	softDeleteCategoryByIDStmt               *sql.Stmt
+	softDeleteCustomerByIDStmt               *sql.Stmt
+	softDeletePaymentMethodByIDStmt          *sql.Stmt
+	softDeleteProductByIDStmt                *sql.Stmt
+	softDeletePromotionByIDStmt              *sql.Stmt
+	softDeleteSupplierByIDStmt               *sql.Stmt
+	softDeleteTaxRateByIDStmt                *sql.Stmt
+	softDeleteUserByIDStmt                   *sql.Stmt
+	softDeleteVoucherByIDStmt                *sql.Stmt
+	updateCategoryStmt                       *sql.Stmt
+	updateCostLayerRemainingStmt             *sql.Stmt
+	updateCustomerStmt                       *sql.Stmt
+	updateCustomerConsentStmt                *sql.Stmt
+	updateCustomerTierStmt                   *sql.Stmt
+	updateGiftCardBalanceStmt                *sql.Stmt
+	updateLoyaltyPointRemainingStmt          *sql.Stmt
+	updateOrderStatusStmt                    *sql.Stmt
+	updateParkedOrderStatusStmt              *sql.Stmt
+	updatePaymentChargeStatusStmt            *sql.Stmt
+	updatePaymentMethodStmt                  *sql.Stmt
+	updateProductStmt                        *sql.Stmt
+	updateProductCostStmt                    *sql.Stmt
+	updateProductStockStmt                   *sql.Stmt
+	updatePromotionStmt                      *sql.Stmt
+	updatePurchaseOrderStmt                  *sql.Stmt
+	updatePurchaseOrderReceiveStatusStmt     *sql.Stmt
+	updateStockTakeItemResultStmt            *sql.Stmt
+	updateSupplierStmt                       *sql.Stmt
+	updateTaxRateStmt                        *sql.Stmt
+	updateUserStmt                           *sql.Stmt
+	updateUserWithPasswordStmt               *sql.Stmt
+	updateVoucherStmt                        *sql.Stmt
+	upsertCustomerTierPriceStmt              *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                             tx,
-		tx:                             tx,
-		checkTokenStmt:                 q.checkTokenStmt,
-		createCategoryStmt:             q.createCategoryStmt,
-		createCustomerStmt:             q.createCustomerStmt,
-		createOrderStmt:                q.createOrderStmt,
-		createOrderItemStmt:            q.createOrderItemStmt,
-		createProductStmt:              q.createProductStmt,
-		createProductHistoryStmt:       q.createProductHistoryStmt,
-		createRefundStmt:               q.createRefundStmt,
-		createUserStmt:                 q.createUserStmt,
-		deleteCategoryByIDStmt:         q.deleteCategoryByIDStmt,
-		deleteCustomerByIDStmt:         q.deleteCustomerByIDStmt,
-		deleteProductByIDStmt:          q.deleteProductByIDStmt,
-		deleteUserByIDStmt:             q.deleteUserByIDStmt,
-		generateMemberCodeStmt:         q.generateMemberCodeStmt,
-		getAllCategoriesStmt:           q.getAllCategoriesStmt,
-		getAllCustomersStmt:            q.getAllCustomersStmt,
-		getAllDeletedCategoriesStmt:    q.getAllDeletedCategoriesStmt,
-		getAllDeletedCustomersStmt:     q.getAllDeletedCustomersStmt,
-		getAllDeletedProductsStmt:      q.getAllDeletedProductsStmt,
-		getAllDeletedUsersStmt:         q.getAllDeletedUsersStmt,
-		getAllOrdersStmt:               q.getAllOrdersStmt,
-		getAllProductHistoryStmt:       q.getAllProductHistoryStmt,
-		getAllProductsStmt:             q.getAllProductsStmt,
-		getAllUsersStmt:                q.getAllUsersStmt,
-		getCategoryByIDStmt:            q.getCategoryByIDStmt,
-		getCustomerByEmailStmt:         q.getCustomerByEmailStmt,
-		getCustomerByEmailExceptIDStmt: q.getCustomerByEmailExceptIDStmt,
-		getCustomerByIDStmt:            q.getCustomerByIDStmt,
-		getCustomerByPhoneStmt:         q.getCustomerByPhoneStmt,
-		getCustomerByPhoneExceptIDStmt: q.getCustomerByPhoneExceptIDStmt,
-		getFastMovingProductsStmt:      q.getFastMovingProductsStmt,
-		getOrderByIDStmt:               q.getOrderByIDStmt,
-		getOrderByTrxNumberStmt:        q.getOrderByTrxNumberStmt,
-		getOrderItemsByOrderIDStmt:     q.getOrderItemsByOrderIDStmt,
-		getProductByIDStmt:             q.getProductByIDStmt,
-		getSlowMovingProductsStmt:      q.getSlowMovingProductsStmt,
-		getTopCashiersStmt:             q.getTopCashiersStmt,
-		getTopCustomersStmt:            q.getTopCustomersStmt,
-		getUserByIDStmt:                q.getUserByIDStmt,
-		getUserByUsernameStmt:          q.getUserByUsernameStmt,
-		getUserByUsernameExceptIDStmt:  q.getUserByUsernameExceptIDStmt,
-		setCurrentTokenStmt:            q.setCurrentTokenStmt,
-		softDeleteCategoryByIDStmt:     q.softDeleteCategoryByIDStmt,
-		softDeleteCustomerByIDStmt:     q.softDeleteCustomerByIDStmt,
-		softDeleteProductByIDStmt:      q.softDeleteProductByIDStmt,
-		softDeleteUserByIDStmt:         q.softDeleteUserByIDStmt,
-		updateCategoryStmt:             q.updateCategoryStmt,
-		updateCustomerStmt:             q.updateCustomerStmt,
-		updateOrderStatusStmt:          q.updateOrderStatusStmt,
-		updateProductStmt:              q.updateProductStmt,
-		updateProductStockStmt:         q.updateProductStockStmt,
-		updateUserStmt:                 q.updateUserStmt,
-		updateUserWithPasswordStmt:     q.updateUserWithPasswordStmt,
+		db:                                       tx,
+		tx:                                       tx,
+		addStockTakeCountStmt:                    q.addStockTakeCountStmt,
+		anonymizeCustomerStmt:                    q.anonymizeCustomerStmt,
+		anonymizeCustomerMergesStmt:              q.anonymizeCustomerMergesStmt,
+		anonymizeCustomerNotificationsStmt:       q.anonymizeCustomerNotificationsStmt,
+		anonymizeCustomerParkedOrdersStmt:        q.anonymizeCustomerParkedOrdersStmt,
+		cancelPurchaseOrderStmt:                  q.cancelPurchaseOrderStmt,
+		cancelStockTakeStmt:                      q.cancelStockTakeStmt,
+		checkTokenStmt:                           q.checkTokenStmt,
+		claimDueNotificationsStmt:                q.claimDueNotificationsStmt,
+		closeShiftStmt:                           q.closeShiftStmt,
+		countCustomerOrdersStmt:                  q.countCustomerOrdersStmt,
+		countCustomerVoucherRedemptionsStmt:      q.countCustomerVoucherRedemptionsStmt,
+		createCategoryStmt:                       q.createCategoryStmt,
+		createCostLayerStmt:                      q.createCostLayerStmt,
+		createCustomerStmt:                       q.createCustomerStmt,
+		createCustomerConsentStmt:                q.createCustomerConsentStmt,
+		createCustomerMergeStmt:                  q.createCustomerMergeStmt,
+		createCustomerTierChangeStmt:             q.createCustomerTierChangeStmt,
+		createGiftCardStmt:                       q.createGiftCardStmt,
+		createGiftCardTransactionStmt:            q.createGiftCardTransactionStmt,
+		createLoyaltyPointStmt:                   q.createLoyaltyPointStmt,
+		createNotificationStmt:                   q.createNotificationStmt,
+		createOrderStmt:                          q.createOrderStmt,
+		createOrderItemStmt:                      q.createOrderItemStmt,
+		createOrderPaymentStmt:                   q.createOrderPaymentStmt,
+		createOrderPromotionStmt:                 q.createOrderPromotionStmt,
+		createParkedOrderStmt:                    q.createParkedOrderStmt,
+		createParkedOrderItemStmt:                q.createParkedOrderItemStmt,
+		createPaymentChargeStmt:                  q.createPaymentChargeStmt,
+		createPaymentMethodStmt:                  q.createPaymentMethodStmt,
+		createPaymentRefundStmt:                  q.createPaymentRefundStmt,
+		createProductStmt:                        q.createProductStmt,
+		createProductHistoryStmt:                 q.createProductHistoryStmt,
+		createPromotionStmt:                      q.createPromotionStmt,
+		createPromotionProductStmt:               q.createPromotionProductStmt,
+		createPurchaseOrderStmt:                  q.createPurchaseOrderStmt,
+		createPurchaseOrderItemStmt:              q.createPurchaseOrderItemStmt,
+		createPurchaseReceiptStmt:                q.createPurchaseReceiptStmt,
+		createPurchaseReceiptItemStmt:            q.createPurchaseReceiptItemStmt,
+		createRefundStmt:                         q.createRefundStmt,
+		createShiftStmt:                          q.createShiftStmt,
+		createShiftCashMovementStmt:              q.createShiftCashMovementStmt,
+		createStockAlertStmt:                     q.createStockAlertStmt,
+		createStockCostMovementStmt:              q.createStockCostMovementStmt,
+		createStockTakeStmt:                      q.createStockTakeStmt,
+		createStockTakeCountStmt:                 q.createStockTakeCountStmt,
+		createStockTakeItemsStmt:                 q.createStockTakeItemsStmt,
+		createSupplierStmt:                       q.createSupplierStmt,
+		createTaxRateStmt:                        q.createTaxRateStmt,
+		createUserStmt:                           q.createUserStmt,
+		createVoucherStmt:                        q.createVoucherStmt,
+		createVoucherRedemptionStmt:              q.createVoucherRedemptionStmt,
+		decrementProductStockStmt:                q.decrementProductStockStmt,
+		decrementVoucherUsageStmt:                q.decrementVoucherUsageStmt,
+		deleteCategoryByIDStmt:                   q.deleteCategoryByIDStmt,
+		deleteCustomerByIDStmt:                   q.deleteCustomerByIDStmt,
+		deleteCustomerTierPriceStmt:              q.deleteCustomerTierPriceStmt,
+		deleteProductByIDStmt:                    q.deleteProductByIDStmt,
+		deletePromotionProductsByPromotionIDStmt: q.deletePromotionProductsByPromotionIDStmt,
+		deletePurchaseOrderItemsStmt:             q.deletePurchaseOrderItemsStmt,
+		deleteUserByIDStmt:                       q.deleteUserByIDStmt,
+		generateMemberCodeStmt:                   q.generateMemberCodeStmt,
+		getActivePromotionsStmt:                  q.getActivePromotionsStmt,
+		getAllCategoriesStmt:                     q.getAllCategoriesStmt,
+		getAllCustomerTiersStmt:                  q.getAllCustomerTiersStmt,
+		getAllCustomersStmt:                      q.getAllCustomersStmt,
+		getAllDeletedCategoriesStmt:              q.getAllDeletedCategoriesStmt,
+		getAllDeletedCustomersStmt:               q.getAllDeletedCustomersStmt,
+		getAllDeletedProductsStmt:                q.getAllDeletedProductsStmt,
+		getAllDeletedUsersStmt:                   q.getAllDeletedUsersStmt,
+		getAllGiftCardsStmt:                      q.getAllGiftCardsStmt,
+		getAllOrdersStmt:                         q.getAllOrdersStmt,
+		getAllParkedOrdersStmt:                   q.getAllParkedOrdersStmt,
+		getAllPaymentMethodsStmt:                 q.getAllPaymentMethodsStmt,
+		getAllProductHistoryStmt:                 q.getAllProductHistoryStmt,
+		getAllProductsStmt:                       q.getAllProductsStmt,
+		getAllPromotionsStmt:                     q.getAllPromotionsStmt,
+		getAllPurchaseOrdersStmt:                 q.getAllPurchaseOrdersStmt,
+		getAllShiftsStmt:                         q.getAllShiftsStmt,
+		getAllStockAlertsStmt:                    q.getAllStockAlertsStmt,
+		getAllStockTakesStmt:                     q.getAllStockTakesStmt,
+		getAllSuppliersStmt:                      q.getAllSuppliersStmt,
+		getAllTaxRatesStmt:                       q.getAllTaxRatesStmt,
+		getAllUsersStmt:                          q.getAllUsersStmt,
+		getAllVouchersStmt:                       q.getAllVouchersStmt,
+		getCategoryByIDStmt:                      q.getCategoryByIDStmt,
+		getCustomerByEmailStmt:                   q.getCustomerByEmailStmt,
+		getCustomerByEmailExceptIDStmt:           q.getCustomerByEmailExceptIDStmt,
+		getCustomerByIDStmt:                      q.getCustomerByIDStmt,
+		getCustomerByPhoneStmt:                   q.getCustomerByPhoneStmt,
+		getCustomerByPhoneExceptIDStmt:           q.getCustomerByPhoneExceptIDStmt,
+		getCustomerConsentsStmt:                  q.getCustomerConsentsStmt,
+		getCustomerExpiringPointsStmt:            q.getCustomerExpiringPointsStmt,
+		getCustomerExportGiftCardsStmt:           q.getCustomerExportGiftCardsStmt,
+		getCustomerExportLoyaltyPointsStmt:       q.getCustomerExportLoyaltyPointsStmt,
+		getCustomerExportNotificationsStmt:       q.getCustomerExportNotificationsStmt,
+		getCustomerExportOrderItemsStmt:          q.getCustomerExportOrderItemsStmt,
+		getCustomerExportOrdersStmt:              q.getCustomerExportOrdersStmt,
+		getCustomerExportParkedOrdersStmt:        q.getCustomerExportParkedOrdersStmt,
+		getCustomerFavoriteProductsStmt:          q.getCustomerFavoriteProductsStmt,
+		getCustomerLastOrderStmt:                 q.getCustomerLastOrderStmt,
+		getCustomerMergesStmt:                    q.getCustomerMergesStmt,
+		getCustomerOrdersStmt:                    q.getCustomerOrdersStmt,
+		getCustomerPointBalanceStmt:              q.getCustomerPointBalanceStmt,
+		getCustomerPointHistoryStmt:              q.getCustomerPointHistoryStmt,
+		getCustomerRollingSpendStmt:              q.getCustomerRollingSpendStmt,
+		getCustomerSummaryStmt:                   q.getCustomerSummaryStmt,
+		getCustomerTierByIDStmt:                  q.getCustomerTierByIDStmt,
+		getCustomerTierChangesStmt:               q.getCustomerTierChangesStmt,
+		getCustomerTierPricesStmt:                q.getCustomerTierPricesStmt,
+		getCustomersByMemberCodeOrPhoneStmt:      q.getCustomersByMemberCodeOrPhoneStmt,
+		getDuePaymentRefundsStmt:                 q.getDuePaymentRefundsStmt,
+		getDuplicateCustomerCandidatesStmt:       q.getDuplicateCustomerCandidatesStmt,
+		getExpiredGiftCardsStmt:                  q.getExpiredGiftCardsStmt,
+		getExpiredLoyaltyLotsStmt:                q.getExpiredLoyaltyLotsStmt,
+		getExpiredParkedOrdersStmt:               q.getExpiredParkedOrdersStmt,
+		getExpiredPendingChargesStmt:             q.getExpiredPendingChargesStmt,
+		getFastMovingProductsStmt:                q.getFastMovingProductsStmt,
+		getGiftCardByCodeStmt:                    q.getGiftCardByCodeStmt,
+		getGiftCardByCodeForUpdateStmt:           q.getGiftCardByCodeForUpdateStmt,
+		getGiftCardByIDStmt:                      q.getGiftCardByIDStmt,
+		getGiftCardByIDForUpdateStmt:             q.getGiftCardByIDForUpdateStmt,
+		getGiftCardRedemptionsByOrderIDStmt:      q.getGiftCardRedemptionsByOrderIDStmt,
+		getGiftCardTransactionsStmt:              q.getGiftCardTransactionsStmt,
+		getGiftCardsByOrderIDStmt:                q.getGiftCardsByOrderIDStmt,
+		getInventoryValuationStmt:                q.getInventoryValuationStmt,
+		getLowStockProductsStmt:                  q.getLowStockProductsStmt,
+		getLoyaltyLotsForUpdateStmt:              q.getLoyaltyLotsForUpdateStmt,
+		getLoyaltyPointsByOrderIDStmt:            q.getLoyaltyPointsByOrderIDStmt,
+		getNotificationsByOrderIDStmt:            q.getNotificationsByOrderIDStmt,
+		getOpenCostLayersForUpdateStmt:           q.getOpenCostLayersForUpdateStmt,
+		getOpenShiftByCashierIDStmt:              q.getOpenShiftByCashierIDStmt,
+		getOrderByIDStmt:                         q.getOrderByIDStmt,
+		getOrderByIDForUpdateStmt:                q.getOrderByIDForUpdateStmt,
+		getOrderByTrxNumberStmt:                  q.getOrderByTrxNumberStmt,
+		getOrderByTrxNumberForUpdateStmt:         q.getOrderByTrxNumberForUpdateStmt,
+		getOrderItemsByOrderIDStmt:               q.getOrderItemsByOrderIDStmt,
+		getOrderPaymentsByOrderIDStmt:            q.getOrderPaymentsByOrderIDStmt,
+		getOrderPromotionsByOrderIDStmt:          q.getOrderPromotionsByOrderIDStmt,
+		getOrderStoreCreditRefundAmountStmt:      q.getOrderStoreCreditRefundAmountStmt,
+		getParkedOrderByIDStmt:                   q.getParkedOrderByIDStmt,
+		getParkedOrderByIDForUpdateStmt:          q.getParkedOrderByIDForUpdateStmt,
+		getParkedOrderItemsStmt:                  q.getParkedOrderItemsStmt,
+		getPaymentChargeByChargeIDStmt:           q.getPaymentChargeByChargeIDStmt,
+		getPaymentChargeByIDStmt:                 q.getPaymentChargeByIDStmt,
+		getPaymentChargeByOrderIDStmt:            q.getPaymentChargeByOrderIDStmt,
+		getPaymentMethodByCodeStmt:               q.getPaymentMethodByCodeStmt,
+		getPaymentMethodByIDStmt:                 q.getPaymentMethodByIDStmt,
+		getProductByBarcodeStmt:                  q.getProductByBarcodeStmt,
+		getProductByIDStmt:                       q.getProductByIDStmt,
+		getProductByIDForUpdateStmt:              q.getProductByIDForUpdateStmt,
+		getProductCostLayersStmt:                 q.getProductCostLayersStmt,
+		getProductHistorySummaryStmt:             q.getProductHistorySummaryStmt,
+		getProductLedgerStmt:                     q.getProductLedgerStmt,
+		getProductLedgerTotalStmt:                q.getProductLedgerTotalStmt,
+		getProductStockBalancesStmt:              q.getProductStockBalancesStmt,
+		getProfitByCashierStmt:                   q.getProfitByCashierStmt,
+		getProfitByCategoryStmt:                  q.getProfitByCategoryStmt,
+		getProfitByCustomerStmt:                  q.getProfitByCustomerStmt,
+		getProfitByDayStmt:                       q.getProfitByDayStmt,
+		getProfitByProductStmt:                   q.getProfitByProductStmt,
+		getPromotionByIDStmt:                     q.getPromotionByIDStmt,
+		getPromotionProductsByPromotionIDStmt:    q.getPromotionProductsByPromotionIDStmt,
+		getPurchaseOrderByIDStmt:                 q.getPurchaseOrderByIDStmt,
+		getPurchaseOrderByIDForUpdateStmt:        q.getPurchaseOrderByIDForUpdateStmt,
+		getPurchaseOrderItemsStmt:                q.getPurchaseOrderItemsStmt,
+		getPurchaseReceiptItemsStmt:              q.getPurchaseReceiptItemsStmt,
+		getPurchaseReceiptsByOrderIDStmt:         q.getPurchaseReceiptsByOrderIDStmt,
+		getReorderCandidatesStmt:                 q.getReorderCandidatesStmt,
+		getShiftByIDStmt:                         q.getShiftByIDStmt,
+		getShiftCashMovementsStmt:                q.getShiftCashMovementsStmt,
+		getShiftPaymentSummaryStmt:               q.getShiftPaymentSummaryStmt,
+		getShiftRefundSummaryStmt:                q.getShiftRefundSummaryStmt,
+		getShiftSalesSummaryStmt:                 q.getShiftSalesSummaryStmt,
+		getSlowMovingProductsStmt:                q.getSlowMovingProductsStmt,
+		getStockAlertByIDStmt:                    q.getStockAlertByIDStmt,
+		getStockTakeByIDStmt:                     q.getStockTakeByIDStmt,
+		getStockTakeByIDForShareStmt:             q.getStockTakeByIDForShareStmt,
+		getStockTakeByIDForUpdateStmt:            q.getStockTakeByIDForUpdateStmt,
+		getStockTakeItemsStmt:                    q.getStockTakeItemsStmt,
+		getStoreCreditByCustomerIDStmt:           q.getStoreCreditByCustomerIDStmt,
+		getStoreCreditByCustomerIDForUpdateStmt:  q.getStoreCreditByCustomerIDForUpdateStmt,
+		getSupplierByIDStmt:                      q.getSupplierByIDStmt,
+		getTaxRateByIDStmt:                       q.getTaxRateByIDStmt,
+		getTaxRateByProductIDStmt:                q.getTaxRateByProductIDStmt,
+		getTaxSummaryStmt:                        q.getTaxSummaryStmt,
+		getTopCashiersStmt:                       q.getTopCashiersStmt,
+		getTopCustomersStmt:                      q.getTopCustomersStmt,
+		getUnnotifiedStockAlertsStmt:             q.getUnnotifiedStockAlertsStmt,
+		getUserByIDStmt:                          q.getUserByIDStmt,
+		getUserByUsernameStmt:                    q.getUserByUsernameStmt,
+		getUserByUsernameExceptIDStmt:            q.getUserByUsernameExceptIDStmt,
+		getVoucherByCodeStmt:                     q.getVoucherByCodeStmt,
+		getVoucherByCodeForUpdateStmt:            q.getVoucherByCodeForUpdateStmt,
+		getVoucherByIDStmt:                       q.getVoucherByIDStmt,
+		getVoucherRedemptionByOrderIDStmt:        q.getVoucherRedemptionByOrderIDStmt,
+		incrementGiftCardPinAttemptsStmt:         q.incrementGiftCardPinAttemptsStmt,
+		incrementProductStockStmt:                q.incrementProductStockStmt,
+		incrementReceiptPrintCountStmt:           q.incrementReceiptPrintCountStmt,
+		incrementVoucherUsageStmt:                q.incrementVoucherUsageStmt,
+		lockGiftCardPinStmt:                      q.lockGiftCardPinStmt,
+		markNotificationAttemptFailedStmt:        q.markNotificationAttemptFailedStmt,
+		markNotificationSentStmt:                 q.markNotificationSentStmt,
+		markPaymentChargeLatePaidStmt:            q.markPaymentChargeLatePaidStmt,
+		markPaymentChargeRefundedStmt:            q.markPaymentChargeRefundedStmt,
+		markPaymentRefundAttemptFailedStmt:       q.markPaymentRefundAttemptFailedStmt,
+		markPaymentRefundSucceededStmt:           q.markPaymentRefundSucceededStmt,
+		markStockAlertNotifiedStmt:               q.markStockAlertNotifiedStmt,
+		postStockTakeStmt:                        q.postStockTakeStmt,
+		postponeNotificationStmt:                 q.postponeNotificationStmt,
+		reassignCustomerGiftCardsStmt:            q.reassignCustomerGiftCardsStmt,
+		reassignCustomerLoyaltyPointsStmt:        q.reassignCustomerLoyaltyPointsStmt,
+		reassignCustomerOrdersStmt:               q.reassignCustomerOrdersStmt,
+		reassignCustomerParkedOrdersStmt:         q.reassignCustomerParkedOrdersStmt,
+		reassignCustomerTierChangesStmt:          q.reassignCustomerTierChangesStmt,
+		reassignCustomerVoucherRedemptionsStmt:   q.reassignCustomerVoucherRedemptionsStmt,
+		receivePurchaseOrderItemStmt:             q.receivePurchaseOrderItemStmt,
+		releaseProductStockStmt:                  q.releaseProductStockStmt,
+		reserveProductStockStmt:                  q.reserveProductStockStmt,
+		resetGiftCardPinAttemptsStmt:             q.resetGiftCardPinAttemptsStmt,
+		resolveStockAlertsStmt:                   q.resolveStockAlertsStmt,
+		resumeParkedOrderStmt:                    q.resumeParkedOrderStmt,
+		reverseVoucherRedemptionStmt:             q.reverseVoucherRedemptionStmt,
+		searchCustomersStmt:                      q.searchCustomersStmt,
+		sendPurchaseOrderStmt:                    q.sendPurchaseOrderStmt,
+		setCurrentTokenStmt:                      q.setCurrentTokenStmt,
+		setCustomerTierStmt:                      q.setCustomerTierStmt,
+		softDeleteCategoryByIDStmt:               q.softDeleteCategoryByIDStmt,
+		softDeleteCustomerByIDStmt:               q.softDeleteCustomerByIDStmt,
+		softDeletePaymentMethodByIDStmt:          q.softDeletePaymentMethodByIDStmt,
+		softDeleteProductByIDStmt:                q.softDeleteProductByIDStmt,
+		softDeletePromotionByIDStmt:              q.softDeletePromotionByIDStmt,
+		softDeleteSupplierByIDStmt:               q.softDeleteSupplierByIDStmt,
+		softDeleteTaxRateByIDStmt:                q.softDeleteTaxRateByIDStmt,
+		softDeleteUserByIDStmt:                   q.softDeleteUserByIDStmt,
+		softDeleteVoucherByIDStmt:                q.softDeleteVoucherByIDStmt,
+		updateCategoryStmt:                       q.updateCategoryStmt,
+		updateCostLayerRemainingStmt:             q.updateCostLayerRemainingStmt,
+		updateCustomerStmt:                       q.updateCustomerStmt,
+		updateCustomerConsentStmt:                q.updateCustomerConsentStmt,
+		updateCustomerTierStmt:                   q.updateCustomerTierStmt,
+		updateGiftCardBalanceStmt:                q.updateGiftCardBalanceStmt,
+		updateLoyaltyPointRemainingStmt:          q.updateLoyaltyPointRemainingStmt,
+		updateOrderStatusStmt:                    q.updateOrderStatusStmt,
+		updateParkedOrderStatusStmt:              q.updateParkedOrderStatusStmt,
+		updatePaymentChargeStatusStmt:            q.updatePaymentChargeStatusStmt,
+		updatePaymentMethodStmt:                  q.updatePaymentMethodStmt,
+		updateProductStmt:                        q.updateProductStmt,
+		updateProductCostStmt:                    q.updateProductCostStmt,
+		updateProductStockStmt:                   q.updateProductStockStmt,
+		updatePromotionStmt:                      q.updatePromotionStmt,
+		updatePurchaseOrderStmt:                  q.updatePurchaseOrderStmt,
+		updatePurchaseOrderReceiveStatusStmt:     q.updatePurchaseOrderReceiveStatusStmt,
+		updateStockTakeItemResultStmt:            q.updateStockTakeItemResultStmt,
+		updateSupplierStmt:                       q.updateSupplierStmt,
+		updateTaxRateStmt:                        q.updateTaxRateStmt,
+		updateUserStmt:                           q.updateUserStmt,
+		updateUserWithPasswordStmt:               q.updateUserWithPasswordStmt,
+		updateVoucherStmt:                        q.updateVoucherStmt,
+		upsertCustomerTierPriceStmt:              q.upsertCustomerTierPriceStmt,
 	}
 }
@@ -6,69 +6,330 @@ package db
 
 import (
 	"database/sql"
+	"time"
 )
 
 type Category struct {
-	ID        int64         `json:"id"`
-	Name      string        `json:"name"`
-	CreatedBy sql.NullInt64 `json:"created_by"`
-	UpdatedBy sql.NullInt64 `json:"updated_by"`
-	DeletedBy sql.NullInt64 `json:"deleted_by"`
-	CreatedAt sql.NullTime  `json:"created_at"`
-	UpdatedAt sql.NullTime  `json:"updated_at"`
-	DeletedAt sql.NullTime  `json:"deleted_at"`
+	ID               int64         `json:"id"`
+	Name             string        `json:"name"`
+	CreatedBy        sql.NullInt64 `json:"created_by"`
+	UpdatedBy        sql.NullInt64 `json:"updated_by"`
+	DeletedBy        sql.NullInt64 `json:"deleted_by"`
+	CreatedAt        sql.NullTime  `json:"created_at"`
+	UpdatedAt        sql.NullTime  `json:"updated_at"`
+	DeletedAt        sql.NullTime  `json:"deleted_at"`
+	TaxRateID        sql.NullInt64 `json:"tax_rate_id"`
+	PointsMultiplier string        `json:"points_multiplier"`
+}
+
+type CostLayer struct {
+	ID        int64        `json:"id"`
+	ProductID int64        `json:"product_id"`
+	Source    string       `json:"source"`
+	TrxRef    string       `json:"trx_ref"`
+	Quantity  int32        `json:"quantity"`
+	Remaining int32        `json:"remaining"`
+	UnitCost  string       `json:"unit_cost"`
+	CreatedAt sql.NullTime `json:"created_at"`
 }
 
 type Customer struct {
+	ID                       int64          `json:"id"`
+	MemberCode               string         `json:"member_code"`
+	Name                     string         `json:"name"`
+	Phone                    sql.NullString `json:"phone"`
+	Email                    sql.NullString `json:"email"`
+	CreatedBy                sql.NullInt64  `json:"created_by"`
+	UpdatedBy                sql.NullInt64  `json:"updated_by"`
+	DeletedBy                sql.NullInt64  `json:"deleted_by"`
+	CreatedAt                sql.NullTime   `json:"created_at"`
+	UpdatedAt                sql.NullTime   `json:"updated_at"`
+	DeletedAt                sql.NullTime   `json:"deleted_at"`
+	TierID                   sql.NullInt64  `json:"tier_id"`
+	TierUpdatedAt            sql.NullTime   `json:"tier_updated_at"`
+	EmailMarketingConsent    bool           `json:"email_marketing_consent"`
+	WhatsappMarketingConsent bool           `json:"whatsapp_marketing_consent"`
+	ConsentUpdatedAt         sql.NullTime   `json:"consent_updated_at"`
+	AnonymizedAt             sql.NullTime   `json:"anonymized_at"`
+}
+
+type CustomerConsent struct {
 	ID         int64          `json:"id"`
-	MemberCode string         `json:"member_code"`
-	Name       string         `json:"name"`
-	Phone      sql.NullString `json:"phone"`
-	Email      sql.NullString `json:"email"`
+	CustomerID int64          `json:"customer_id"`
+	Channel    string         `json:"channel"`
+	Granted    bool           `json:"granted"`
+	Source     sql.NullString `json:"source"`
 	CreatedBy  sql.NullInt64  `json:"created_by"`
-	UpdatedBy  sql.NullInt64  `json:"updated_by"`
-	DeletedBy  sql.NullInt64  `json:"deleted_by"`
 	CreatedAt  sql.NullTime   `json:"created_at"`
-	UpdatedAt  sql.NullTime   `json:"updated_at"`
-	DeletedAt  sql.NullTime   `json:"deleted_at"`
+}
+
+type CustomerMerge struct {
+	ID               int64          `json:"id"`
+	CustomerID       int64          `json:"customer_id"`
+	MergedCustomerID sql.NullInt64  `json:"merged_customer_id"`
+	MergedMemberCode string         `json:"merged_member_code"`
+	MergedName       string         `json:"merged_name"`
+	MergedPhone      sql.NullString `json:"merged_phone"`
+	MergedEmail      sql.NullString `json:"merged_email"`
+	OrdersMoved      int32          `json:"orders_moved"`
+	PointsMoved      int32          `json:"points_moved"`
+	MergedBy         sql.NullInt64  `json:"merged_by"`
+	MergedAt         sql.NullTime   `json:"merged_at"`
+}
+
+type CustomerTier struct {
+	ID              int64         `json:"id"`
+	Code            string        `json:"code"`
+	Name            string        `json:"name"`
+	MinSpend        string        `json:"min_spend"`
+	DiscountPercent string        `json:"discount_percent"`
+	UpdatedBy       sql.NullInt64 `json:"updated_by"`
+	CreatedAt       sql.NullTime  `json:"created_at"`
+	UpdatedAt       sql.NullTime  `json:"updated_at"`
+}
+
+type CustomerTierChange struct {
+	ID           int64         `json:"id"`
+	CustomerID   int64         `json:"customer_id"`
+	OldTierID    sql.NullInt64 `json:"old_tier_id"`
+	NewTierID    sql.NullInt64 `json:"new_tier_id"`
+	RollingSpend string        `json:"rolling_spend"`
+	ChangedAt    sql.NullTime  `json:"changed_at"`
+}
+
+type CustomerTierPrice struct {
+	ID        int64         `json:"id"`
+	TierID    int64         `json:"tier_id"`
+	ProductID int64         `json:"product_id"`
+	Price     string        `json:"price"`
+	CreatedBy sql.NullInt64 `json:"created_by"`
+	CreatedAt sql.NullTime  `json:"created_at"`
+}
+
+type GiftCard struct {
+	ID                int64          `json:"id"`
+	Code              string         `json:"code"`
+	PinHash           sql.NullString `json:"pin_hash"`
+	FailedPinAttempts int32          `json:"failed_pin_attempts"`
+	LockedUntil       sql.NullTime   `json:"locked_until"`
+	Type              string         `json:"type"`
+	CustomerID        sql.NullInt64  `json:"customer_id"`
+	OrderID           sql.NullInt64  `json:"order_id"`
+	InitialAmount     string         `json:"initial_amount"`
+	Balance           string         `json:"balance"`
+	Status            string         `json:"status"`
+	ExpiresAt         sql.NullTime   `json:"expires_at"`
+	CreatedBy         sql.NullInt64  `json:"created_by"`
+	CreatedAt         sql.NullTime   `json:"created_at"`
+	UpdatedAt         sql.NullTime   `json:"updated_at"`
+}
+
+type GiftCardTransaction struct {
+	ID          int64          `json:"id"`
+	GiftCardID  int64          `json:"gift_card_id"`
+	OrderID     sql.NullInt64  `json:"order_id"`
+	Type        string         `json:"type"`
+	Amount      string         `json:"amount"`
+	Balance     string         `json:"balance"`
+	Description sql.NullString `json:"description"`
+	CreatedBy   sql.NullInt64  `json:"created_by"`
+	CreatedAt   sql.NullTime   `json:"created_at"`
+}
+
+type LoyaltyPoint struct {
+	ID          int64          `json:"id"`
+	CustomerID  int64          `json:"customer_id"`
+	OrderID     sql.NullInt64  `json:"order_id"`
+	Type        string         `json:"type"`
+	Points      int32          `json:"points"`
+	Remaining   int32          `json:"remaining"`
+	ExpiresAt   sql.NullTime   `json:"expires_at"`
+	Description sql.NullString `json:"description"`
+	CreatedBy   sql.NullInt64  `json:"created_by"`
+	CreatedAt   sql.NullTime   `json:"created_at"`
+}
+
+type NotificationOutbox struct {
+	ID            int64          `json:"id"`
+	OrderID       sql.NullInt64  `json:"order_id"`
+	Kind          string         `json:"kind"`
+	Channel       string         `json:"channel"`
+	Recipient     string         `json:"recipient"`
+	Subject       sql.NullString `json:"subject"`
+	Status        string         `json:"status"`
+	Attempts      int32          `json:"attempts"`
+	MaxAttempts   int32          `json:"max_attempts"`
+	NextAttemptAt time.Time      `json:"next_attempt_at"`
+	LastError     sql.NullString `json:"last_error"`
+	SentAt        sql.NullTime   `json:"sent_at"`
+	CreatedBy     sql.NullInt64  `json:"created_by"`
+	CreatedAt     sql.NullTime   `json:"created_at"`
+	UpdatedAt     sql.NullTime   `json:"updated_at"`
+	StockAlertID  sql.NullInt64  `json:"stock_alert_id"`
 }
 
 type Order struct {
-	ID            int64         `json:"id"`
-	TrxNumber     string        `json:"trx_number"`
-	CashierID     sql.NullInt64 `json:"cashier_id"`
-	CustomerID    sql.NullInt64 `json:"customer_id"`
-	TotalAmount   string        `json:"total_amount"`
-	PaymentMethod string        `json:"payment_method"`
-	Status        string        `json:"status"`
-	OrderDate     sql.NullTime  `json:"order_date"`
-	UpdatedBy     sql.NullInt64 `json:"updated_by"`
-	UpdatedAt     sql.NullTime  `json:"updated_at"`
+	ID                int64          `json:"id"`
+	TrxNumber         string         `json:"trx_number"`
+	CashierID         sql.NullInt64  `json:"cashier_id"`
+	CustomerID        sql.NullInt64  `json:"customer_id"`
+	TotalAmount       string         `json:"total_amount"`
+	PaymentMethod     string         `json:"payment_method"`
+	Status            string         `json:"status"`
+	OrderDate         sql.NullTime   `json:"order_date"`
+	UpdatedBy         sql.NullInt64  `json:"updated_by"`
+	UpdatedAt         sql.NullTime   `json:"updated_at"`
+	Subtotal          string         `json:"subtotal"`
+	DiscountAmount    string         `json:"discount_amount"`
+	VoucherCode       sql.NullString `json:"voucher_code"`
+	VoucherDiscount   string         `json:"voucher_discount"`
+	TaxAmount         string         `json:"tax_amount"`
+	ChangeAmount      string         `json:"change_amount"`
+	ShiftID           sql.NullInt64  `json:"shift_id"`
+	ReceiptPrintCount int32          `json:"receipt_print_count"`
+	LastPrintedAt     sql.NullTime   `json:"last_printed_at"`
 }
 
 type OrderItem struct {
-	ID         int64          `json:"id"`
-	OrderID    sql.NullInt64  `json:"order_id"`
-	ProductID  sql.NullInt64  `json:"product_id"`
-	OldProduct sql.NullString `json:"old_product"`
-	Quantity   int32          `json:"quantity"`
-	UnitPrice  string         `json:"unit_price"`
-	CreatedBy  sql.NullInt64  `json:"created_by"`
-	CreatedAt  sql.NullTime   `json:"created_at"`
+	ID             int64          `json:"id"`
+	OrderID        sql.NullInt64  `json:"order_id"`
+	ProductID      sql.NullInt64  `json:"product_id"`
+	OldProduct     sql.NullString `json:"old_product"`
+	Quantity       int32          `json:"quantity"`
+	UnitPrice      string         `json:"unit_price"`
+	CreatedBy      sql.NullInt64  `json:"created_by"`
+	CreatedAt      sql.NullTime   `json:"created_at"`
+	DiscountAmount string         `json:"discount_amount"`
+	TaxName        sql.NullString `json:"tax_name"`
+	TaxRate        string         `json:"tax_rate"`
+	TaxInclusive   bool           `json:"tax_inclusive"`
+	TaxAmount      string         `json:"tax_amount"`
+	UnitCost       string         `json:"unit_cost"`
+	Cogs           string         `json:"cogs"`
+}
+
+type OrderPayment struct {
+	ID              int64          `json:"id"`
+	OrderID         sql.NullInt64  `json:"order_id"`
+	PaymentMethodID sql.NullInt64  `json:"payment_method_id"`
+	PaymentMethod   string         `json:"payment_method"`
+	Amount          string         `json:"amount"`
+	TenderedAmount  string         `json:"tendered_amount"`
+	ChangeAmount    string         `json:"change_amount"`
+	Reference       sql.NullString `json:"reference"`
+	CreatedBy       sql.NullInt64  `json:"created_by"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+}
+
+type OrderPromotion struct {
+	ID             int64         `json:"id"`
+	OrderID        sql.NullInt64 `json:"order_id"`
+	PromotionID    sql.NullInt64 `json:"promotion_id"`
+	PromotionName  string        `json:"promotion_name"`
+	PromotionType  string        `json:"promotion_type"`
+	DiscountAmount string        `json:"discount_amount"`
+	CreatedAt      sql.NullTime  `json:"created_at"`
+}
+
+type ParkedOrder struct {
+	ID              int64          `json:"id"`
+	ParkNumber      string         `json:"park_number"`
+	Label           string         `json:"label"`
+	StoreCode       string         `json:"store_code"`
+	Terminal        sql.NullString `json:"terminal"`
+	CustomerType    string         `json:"customer_type"`
+	CustomerID      sql.NullInt64  `json:"customer_id"`
+	CustomerName    sql.NullString `json:"customer_name"`
+	CustomerPhone   sql.NullString `json:"customer_phone"`
+	CustomerEmail   sql.NullString `json:"customer_email"`
+	VoucherCode     sql.NullString `json:"voucher_code"`
+	ReserveStock    bool           `json:"reserve_stock"`
+	Status          string         `json:"status"`
+	OrderID         sql.NullInt64  `json:"order_id"`
+	ExpiresAt       sql.NullTime   `json:"expires_at"`
+	ResumedBy       sql.NullInt64  `json:"resumed_by"`
+	ResumedTerminal sql.NullString `json:"resumed_terminal"`
+	ResumedAt       sql.NullTime   `json:"resumed_at"`
+	CreatedBy       sql.NullInt64  `json:"created_by"`
+	UpdatedBy       sql.NullInt64  `json:"updated_by"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	UpdatedAt       sql.NullTime   `json:"updated_at"`
+}
+
+type ParkedOrderItem struct {
+	ID            int64         `json:"id"`
+	ParkedOrderID sql.NullInt64 `json:"parked_order_id"`
+	ProductID     sql.NullInt64 `json:"product_id"`
+	Quantity      int32         `json:"quantity"`
+}
+
+type PaymentCharge struct {
+	ID            int64          `json:"id"`
+	OrderID       sql.NullInt64  `json:"order_id"`
+	Provider      string         `json:"provider"`
+	ChargeID      string         `json:"charge_id"`
+	PaymentMethod string         `json:"payment_method"`
+	Amount        string         `json:"amount"`
+	Status        string         `json:"status"`
+	PaymentUrl    sql.NullString `json:"payment_url"`
+	QrString      sql.NullString `json:"qr_string"`
+	ExpiresAt     sql.NullTime   `json:"expires_at"`
+	PaidAt        sql.NullTime   `json:"paid_at"`
+	CreatedAt     sql.NullTime   `json:"created_at"`
+	UpdatedAt     sql.NullTime   `json:"updated_at"`
+}
+
+type PaymentMethod struct {
+	ID                int64         `json:"id"`
+	Code              string        `json:"code"`
+	Name              string        `json:"name"`
+	Type              string        `json:"type"`
+	RequiresReference bool          `json:"requires_reference"`
+	IsActive          bool          `json:"is_active"`
+	CreatedBy         sql.NullInt64 `json:"created_by"`
+	UpdatedBy         sql.NullInt64 `json:"updated_by"`
+	DeletedBy         sql.NullInt64 `json:"deleted_by"`
+	CreatedAt         sql.NullTime  `json:"created_at"`
+	UpdatedAt         sql.NullTime  `json:"updated_at"`
+	DeletedAt         sql.NullTime  `json:"deleted_at"`
+	Gateway           bool          `json:"gateway"`
+}
+
+type PaymentRefund struct {
+	ID              int64          `json:"id"`
+	PaymentChargeID int64          `json:"payment_charge_id"`
+	OrderID         sql.NullInt64  `json:"order_id"`
+	Amount          string         `json:"amount"`
+	Status          string         `json:"status"`
+	Attempts        int32          `json:"attempts"`
+	NextAttemptAt   time.Time      `json:"next_attempt_at"`
+	LastError       sql.NullString `json:"last_error"`
+	RefundedAt      sql.NullTime   `json:"refunded_at"`
+	CreatedBy       sql.NullInt64  `json:"created_by"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	UpdatedAt       sql.NullTime   `json:"updated_at"`
 }
 
 type Product struct {
-	ID         int64         `json:"id"`
-	Name       string        `json:"name"`
-	Price      string        `json:"price"`
-	Stock      int32         `json:"stock"`
-	CategoryID sql.NullInt64 `json:"category_id"`
-	CreatedBy  sql.NullInt64 `json:"created_by"`
-	UpdatedBy  sql.NullInt64 `json:"updated_by"`
-	DeletedBy  sql.NullInt64 `json:"deleted_by"`
-	CreatedAt  sql.NullTime  `json:"created_at"`
-	UpdatedAt  sql.NullTime  `json:"updated_at"`
-	DeletedAt  sql.NullTime  `json:"deleted_at"`
+	ID              int64          `json:"id"`
+	Name            string         `json:"name"`
+	Price           string         `json:"price"`
+	Stock           int32          `json:"stock"`
+	CategoryID      sql.NullInt64  `json:"category_id"`
+	CreatedBy       sql.NullInt64  `json:"created_by"`
+	UpdatedBy       sql.NullInt64  `json:"updated_by"`
+	DeletedBy       sql.NullInt64  `json:"deleted_by"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	UpdatedAt       sql.NullTime   `json:"updated_at"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	TaxRateID       sql.NullInt64  `json:"tax_rate_id"`
+	ReservedStock   int32          `json:"reserved_stock"`
+	IsGiftCard      bool           `json:"is_gift_card"`
+	Cost            string         `json:"cost"`
+	Barcode         sql.NullString `json:"barcode"`
+	MinStock        int32          `json:"min_stock"`
+	ReorderQuantity int32          `json:"reorder_quantity"`
+	SupplierID      sql.NullInt64  `json:"supplier_id"`
 }
 
 type ProductHistory struct {
@@ -80,14 +341,238 @@ type ProductHistory struct {
 	Reason         sql.NullString `json:"reason"`
 	CreatedBy      sql.NullInt64  `json:"created_by"`
 	CreatedAt      sql.NullTime   `json:"created_at"`
+	ReasonCode     sql.NullString `json:"reason_code"`
+}
+
+type ProfitLine struct {
+	ProductID  sql.NullInt64  `json:"product_id"`
+	OldProduct sql.NullString `json:"old_product"`
+	OrderID    int64          `json:"order_id"`
+	CashierID  sql.NullInt64  `json:"cashier_id"`
+	CustomerID sql.NullInt64  `json:"customer_id"`
+	TrxDate    sql.NullTime   `json:"trx_date"`
+	Quantity   int64          `json:"quantity"`
+	Revenue    string         `json:"revenue"`
+	Discount   string         `json:"discount"`
+	Tax        string         `json:"tax"`
+	Refund     string         `json:"refund"`
+	Cogs       string         `json:"cogs"`
+}
+
+type Promotion struct {
+	ID              int64          `json:"id"`
+	Name            string         `json:"name"`
+	Type            string         `json:"type"`
+	Priority        int32          `json:"priority"`
+	Stackable       bool           `json:"stackable"`
+	IsActive        bool           `json:"is_active"`
+	CategoryID      sql.NullInt64  `json:"category_id"`
+	BuyQuantity     int32          `json:"buy_quantity"`
+	GetQuantity     int32          `json:"get_quantity"`
+	BundlePrice     string         `json:"bundle_price"`
+	DiscountPercent string         `json:"discount_percent"`
+	DiscountAmount  string         `json:"discount_amount"`
+	MinSpend        string         `json:"min_spend"`
+	DailyStart      sql.NullString `json:"daily_start"`
+	DailyEnd        sql.NullString `json:"daily_end"`
+	DaysOfWeek      sql.NullString `json:"days_of_week"`
+	StartAt         sql.NullTime   `json:"start_at"`
+	EndAt           sql.NullTime   `json:"end_at"`
+	CreatedBy       sql.NullInt64  `json:"created_by"`
+	UpdatedBy       sql.NullInt64  `json:"updated_by"`
+	DeletedBy       sql.NullInt64  `json:"deleted_by"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	UpdatedAt       sql.NullTime   `json:"updated_at"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+}
+
+type PromotionProduct struct {
+	ID          int64         `json:"id"`
+	PromotionID sql.NullInt64 `json:"promotion_id"`
+	ProductID   sql.NullInt64 `json:"product_id"`
+	Quantity    int32         `json:"quantity"`
+}
+
+type PurchaseOrder struct {
+	ID           int64          `json:"id"`
+	PoNumber     string         `json:"po_number"`
+	SupplierID   int64          `json:"supplier_id"`
+	Status       string         `json:"status"`
+	ExpectedAt   sql.NullTime   `json:"expected_at"`
+	Notes        sql.NullString `json:"notes"`
+	TotalAmount  string         `json:"total_amount"`
+	SentBy       sql.NullInt64  `json:"sent_by"`
+	SentAt       sql.NullTime   `json:"sent_at"`
+	CancelledBy  sql.NullInt64  `json:"cancelled_by"`
+	CancelledAt  sql.NullTime   `json:"cancelled_at"`
+	CancelReason sql.NullString `json:"cancel_reason"`
+	CreatedBy    sql.NullInt64  `json:"created_by"`
+	UpdatedBy    sql.NullInt64  `json:"updated_by"`
+	CreatedAt    sql.NullTime   `json:"created_at"`
+	UpdatedAt    sql.NullTime   `json:"updated_at"`
+	ReceivedAt   sql.NullTime   `json:"received_at"`
+}
+
+type PurchaseOrderItem struct {
+	ID               int64        `json:"id"`
+	PurchaseOrderID  int64        `json:"purchase_order_id"`
+	ProductID        int64        `json:"product_id"`
+	Quantity         int32        `json:"quantity"`
+	UnitCost         string       `json:"unit_cost"`
+	CreatedAt        sql.NullTime `json:"created_at"`
+	QuantityReceived int32        `json:"quantity_received"`
+}
+
+type PurchaseReceipt struct {
+	ID              int64          `json:"id"`
+	ReceiptNumber   string         `json:"receipt_number"`
+	PurchaseOrderID int64          `json:"purchase_order_id"`
+	Notes           sql.NullString `json:"notes"`
+	HasDiscrepancy  bool           `json:"has_discrepancy"`
+	ReceivedBy      sql.NullInt64  `json:"received_by"`
+	ReceivedAt      sql.NullTime   `json:"received_at"`
+}
+
+type PurchaseReceiptItem struct {
+	ID                  int64          `json:"id"`
+	PurchaseReceiptID   int64          `json:"purchase_receipt_id"`
+	PurchaseOrderItemID int64          `json:"purchase_order_item_id"`
+	ProductID           int64          `json:"product_id"`
+	QuantityExpected    int32          `json:"quantity_expected"`
+	QuantityReceived    int32          `json:"quantity_received"`
+	ExpectedUnitCost    string         `json:"expected_unit_cost"`
+	UnitCost            string         `json:"unit_cost"`
+	BatchNumber         sql.NullString `json:"batch_number"`
+	ExpiresAt           sql.NullTime   `json:"expires_at"`
+	Discrepancy         sql.NullString `json:"discrepancy"`
+	CreatedAt           sql.NullTime   `json:"created_at"`
 }
 
 type Refund struct {
+	ID           int64         `json:"id"`
+	OrderID      sql.NullInt64 `json:"order_id"`
+	Reason       string        `json:"reason"`
+	RefundAt     sql.NullTime  `json:"refund_at"`
+	CreatedBy    sql.NullInt64 `json:"created_by"`
+	ShiftID      sql.NullInt64 `json:"shift_id"`
+	RefundMethod string        `json:"refund_method"`
+}
+
+type Shift struct {
+	ID           int64          `json:"id"`
+	ShiftNumber  string         `json:"shift_number"`
+	CashierID    sql.NullInt64  `json:"cashier_id"`
+	OpeningFloat string         `json:"opening_float"`
+	ExpectedCash sql.NullString `json:"expected_cash"`
+	CountedCash  sql.NullString `json:"counted_cash"`
+	Variance     sql.NullString `json:"variance"`
+	Status       string         `json:"status"`
+	Note         sql.NullString `json:"note"`
+	OpenedAt     sql.NullTime   `json:"opened_at"`
+	ClosedAt     sql.NullTime   `json:"closed_at"`
+	ClosedBy     sql.NullInt64  `json:"closed_by"`
+}
+
+type ShiftCashMovement struct {
 	ID        int64         `json:"id"`
-	OrderID   sql.NullInt64 `json:"order_id"`
+	ShiftID   sql.NullInt64 `json:"shift_id"`
+	Type      string        `json:"type"`
+	Amount    string        `json:"amount"`
 	Reason    string        `json:"reason"`
-	RefundAt  sql.NullTime  `json:"refund_at"`
 	CreatedBy sql.NullInt64 `json:"created_by"`
+	CreatedAt sql.NullTime  `json:"created_at"`
+}
+
+type StockAlert struct {
+	ID         int64         `json:"id"`
+	ProductID  int64         `json:"product_id"`
+	TrxRef     string        `json:"trx_ref"`
+	Stock      int32         `json:"stock"`
+	MinStock   int32         `json:"min_stock"`
+	Status     string        `json:"status"`
+	NotifiedAt sql.NullTime  `json:"notified_at"`
+	ResolvedAt sql.NullTime  `json:"resolved_at"`
+	CreatedBy  sql.NullInt64 `json:"created_by"`
+	CreatedAt  sql.NullTime  `json:"created_at"`
+}
+
+type StockCostMovement struct {
+	ID        int64        `json:"id"`
+	ProductID int64        `json:"product_id"`
+	TrxRef    string       `json:"trx_ref"`
+	Quantity  int32        `json:"quantity"`
+	UnitCost  string       `json:"unit_cost"`
+	Amount    string       `json:"amount"`
+	Method    string       `json:"method"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
+type StockTake struct {
+	ID            int64          `json:"id"`
+	SessionNumber string         `json:"session_number"`
+	Scope         string         `json:"scope"`
+	CategoryID    sql.NullInt64  `json:"category_id"`
+	Status        string         `json:"status"`
+	Notes         sql.NullString `json:"notes"`
+	StartedBy     sql.NullInt64  `json:"started_by"`
+	StartedAt     sql.NullTime   `json:"started_at"`
+	PostedBy      sql.NullInt64  `json:"posted_by"`
+	PostedAt      sql.NullTime   `json:"posted_at"`
+	CancelledBy   sql.NullInt64  `json:"cancelled_by"`
+	CancelledAt   sql.NullTime   `json:"cancelled_at"`
+}
+
+type StockTakeCount struct {
+	ID          int64          `json:"id"`
+	StockTakeID int64          `json:"stock_take_id"`
+	ProductID   int64          `json:"product_id"`
+	Barcode     sql.NullString `json:"barcode"`
+	Quantity    int32          `json:"quantity"`
+	Replace     bool           `json:"replace"`
+	DeviceID    sql.NullString `json:"device_id"`
+	CountedBy   sql.NullInt64  `json:"counted_by"`
+	CountedAt   sql.NullTime   `json:"counted_at"`
+}
+
+type StockTakeItem struct {
+	ID               int64          `json:"id"`
+	StockTakeID      int64          `json:"stock_take_id"`
+	ProductID        int64          `json:"product_id"`
+	ExpectedQuantity int32          `json:"expected_quantity"`
+	CountedQuantity  sql.NullInt32  `json:"counted_quantity"`
+	UnitCost         string         `json:"unit_cost"`
+	ReasonCode       sql.NullString `json:"reason_code"`
+	CountedAt        sql.NullTime   `json:"counted_at"`
+}
+
+type Supplier struct {
+	ID          int64          `json:"id"`
+	Name        string         `json:"name"`
+	ContactName sql.NullString `json:"contact_name"`
+	Phone       sql.NullString `json:"phone"`
+	Email       sql.NullString `json:"email"`
+	Address     sql.NullString `json:"address"`
+	Notes       sql.NullString `json:"notes"`
+	CreatedBy   sql.NullInt64  `json:"created_by"`
+	UpdatedBy   sql.NullInt64  `json:"updated_by"`
+	DeletedBy   sql.NullInt64  `json:"deleted_by"`
+	CreatedAt   sql.NullTime   `json:"created_at"`
+	UpdatedAt   sql.NullTime   `json:"updated_at"`
+	DeletedAt   sql.NullTime   `json:"deleted_at"`
+}
+
+type TaxRate struct {
+	ID          int64         `json:"id"`
+	Name        string        `json:"name"`
+	Rate        string        `json:"rate"`
+	IsInclusive bool          `json:"is_inclusive"`
+	IsActive    bool          `json:"is_active"`
+	CreatedBy   sql.NullInt64 `json:"created_by"`
+	UpdatedBy   sql.NullInt64 `json:"updated_by"`
+	DeletedBy   sql.NullInt64 `json:"deleted_by"`
+	CreatedAt   sql.NullTime  `json:"created_at"`
+	UpdatedAt   sql.NullTime  `json:"updated_at"`
+	DeletedAt   sql.NullTime  `json:"deleted_at"`
 }
 
 type User struct {
@@ -104,3 +589,36 @@ type User struct {
 	UpdatedAt    sql.NullTime   `json:"updated_at"`
 	DeletedAt    sql.NullTime   `json:"deleted_at"`
 }
+
+type Voucher struct {
+	ID               int64          `json:"id"`
+	Code             string         `json:"code"`
+	Description      sql.NullString `json:"description"`
+	ValueType        string         `json:"value_type"`
+	Value            string         `json:"value"`
+	MaxDiscount      string         `json:"max_discount"`
+	MinSpend         string         `json:"min_spend"`
+	UsageLimit       int32          `json:"usage_limit"`
+	UsagePerCustomer int32          `json:"usage_per_customer"`
+	UsedCount        int32          `json:"used_count"`
+	IsActive         bool           `json:"is_active"`
+	StartAt          sql.NullTime   `json:"start_at"`
+	EndAt            sql.NullTime   `json:"end_at"`
+	CreatedBy        sql.NullInt64  `json:"created_by"`
+	UpdatedBy        sql.NullInt64  `json:"updated_by"`
+	DeletedBy        sql.NullInt64  `json:"deleted_by"`
+	CreatedAt        sql.NullTime   `json:"created_at"`
+	UpdatedAt        sql.NullTime   `json:"updated_at"`
+	DeletedAt        sql.NullTime   `json:"deleted_at"`
+}
+
+type VoucherRedemption struct {
+	ID             int64         `json:"id"`
+	VoucherID      sql.NullInt64 `json:"voucher_id"`
+	OrderID        sql.NullInt64 `json:"order_id"`
+	CustomerID     sql.NullInt64 `json:"customer_id"`
+	DiscountAmount string        `json:"discount_amount"`
+	Status         string        `json:"status"`
+	CreatedAt      sql.NullTime  `json:"created_at"`
+	ReversedAt     sql.NullTime  `json:"reversed_at"`
+}
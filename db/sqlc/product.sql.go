@@ -11,17 +11,23 @@ import (
 )
 
 const createProduct = `-- name: CreateProduct :one
-INSERT INTO products (name, price, stock, category_id, created_by, created_at)
-VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP)
-RETURNING id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+INSERT INTO products (name, price, stock, category_id, tax_rate_id, is_gift_card, barcode, min_stock, reorder_quantity, supplier_id, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, CURRENT_TIMESTAMP)
+RETURNING id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, reserved_stock, is_gift_card, cost, barcode, min_stock, reorder_quantity, supplier_id
 `
 
 type CreateProductParams struct {
-	Name       string        `json:"name"`
-	Price      string        `json:"price"`
-	Stock      int32         `json:"stock"`
-	CategoryID sql.NullInt64 `json:"category_id"`
-	CreatedBy  sql.NullInt64 `json:"created_by"`
+	Name            string         `json:"name"`
+	Price           string         `json:"price"`
+	Stock           int32          `json:"stock"`
+	CategoryID      sql.NullInt64  `json:"category_id"`
+	TaxRateID       sql.NullInt64  `json:"tax_rate_id"`
+	IsGiftCard      bool           `json:"is_gift_card"`
+	Barcode         sql.NullString `json:"barcode"`
+	MinStock        int32          `json:"min_stock"`
+	ReorderQuantity int32          `json:"reorder_quantity"`
+	SupplierID      sql.NullInt64  `json:"supplier_id"`
+	CreatedBy       sql.NullInt64  `json:"created_by"`
 }
 
 func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (Product, error) {
@@ -30,6 +36,12 @@ func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (P
 		arg.Price,
 		arg.Stock,
 		arg.CategoryID,
+		arg.TaxRateID,
+		arg.IsGiftCard,
+		arg.Barcode,
+		arg.MinStock,
+		arg.ReorderQuantity,
+		arg.SupplierID,
 		arg.CreatedBy,
 	)
 	var i Product
@@ -45,6 +57,57 @@ func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (P
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.ReservedStock,
+		&i.IsGiftCard,
+		&i.Cost,
+		&i.Barcode,
+		&i.MinStock,
+		&i.ReorderQuantity,
+		&i.SupplierID,
+	)
+	return i, err
+}
+
+const decrementProductStock = `-- name: DecrementProductStock :one
+UPDATE products
+SET stock = stock - $1::INT,
+    updated_by = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $3 AND stock - reserved_stock >= $1::INT
+RETURNING id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, reserved_stock, is_gift_card, cost, barcode, min_stock, reorder_quantity, supplier_id
+`
+
+type DecrementProductStockParams struct {
+	Quantity  int32         `json:"quantity"`
+	UpdatedBy sql.NullInt64 `json:"updated_by"`
+	ID        int64         `json:"id"`
+}
+
+// Tidak ada baris berarti stok yang belum direservasi tidak cukup
+func (q *Queries) DecrementProductStock(ctx context.Context, arg DecrementProductStockParams) (Product, error) {
+	row := q.queryRow(ctx, q.decrementProductStockStmt, decrementProductStock, arg.Quantity, arg.UpdatedBy, arg.ID)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Price,
+		&i.Stock,
+		&i.CategoryID,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.DeletedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.ReservedStock,
+		&i.IsGiftCard,
+		&i.Cost,
+		&i.Barcode,
+		&i.MinStock,
+		&i.ReorderQuantity,
+		&i.SupplierID,
 	)
 	return i, err
 }
@@ -62,7 +125,7 @@ func (q *Queries) DeleteProductByID(ctx context.Context, id int64) (int64, error
 }
 
 const getAllDeletedProducts = `-- name: GetAllDeletedProducts :many
-SELECT id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at 
+SELECT id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, reserved_stock, is_gift_card, cost, barcode, min_stock, reorder_quantity, supplier_id 
 FROM products
 WHERE deleted_at IS NOT NULL
 ORDER BY created_at DESC
@@ -95,6 +158,14 @@ func (q *Queries) GetAllDeletedProducts(ctx context.Context, arg GetAllDeletedPr
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.TaxRateID,
+			&i.ReservedStock,
+			&i.IsGiftCard,
+			&i.Cost,
+			&i.Barcode,
+			&i.MinStock,
+			&i.ReorderQuantity,
+			&i.SupplierID,
 		); err != nil {
 			return nil, err
 		}
@@ -111,7 +182,7 @@ func (q *Queries) GetAllDeletedProducts(ctx context.Context, arg GetAllDeletedPr
 
 const getAllProducts = `-- name: GetAllProducts :many
 
-SELECT id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at 
+SELECT id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, reserved_stock, is_gift_card, cost, barcode, min_stock, reorder_quantity, supplier_id 
 FROM products
 WHERE deleted_at IS NULL
 ORDER BY created_at DESC
@@ -145,6 +216,14 @@ func (q *Queries) GetAllProducts(ctx context.Context, arg GetAllProductsParams)
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.TaxRateID,
+			&i.ReservedStock,
+			&i.IsGiftCard,
+			&i.Cost,
+			&i.Barcode,
+			&i.MinStock,
+			&i.ReorderQuantity,
+			&i.SupplierID,
 		); err != nil {
 			return nil, err
 		}
@@ -159,8 +238,117 @@ func (q *Queries) GetAllProducts(ctx context.Context, arg GetAllProductsParams)
 	return items, nil
 }
 
+const getLowStockProducts = `-- name: GetLowStockProducts :many
+SELECT p.id, p.name, p.price, p.stock, p.category_id, p.created_by, p.updated_by, p.deleted_by, p.created_at, p.updated_at, p.deleted_at, p.tax_rate_id, p.reserved_stock, p.is_gift_card, p.cost, p.barcode, p.min_stock, p.reorder_quantity, p.supplier_id, s.name AS supplier_name
+FROM products p
+LEFT JOIN suppliers s ON p.supplier_id = s.id
+WHERE p.deleted_at IS NULL AND p.min_stock > 0 AND p.stock <= p.min_stock
+    AND ($1::BIGINT = 0 OR p.supplier_id = $1::BIGINT)
+ORDER BY p.stock - p.min_stock, p.id
+`
+
+type GetLowStockProductsRow struct {
+	ID              int64          `json:"id"`
+	Name            string         `json:"name"`
+	Price           string         `json:"price"`
+	Stock           int32          `json:"stock"`
+	CategoryID      sql.NullInt64  `json:"category_id"`
+	CreatedBy       sql.NullInt64  `json:"created_by"`
+	UpdatedBy       sql.NullInt64  `json:"updated_by"`
+	DeletedBy       sql.NullInt64  `json:"deleted_by"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	UpdatedAt       sql.NullTime   `json:"updated_at"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	TaxRateID       sql.NullInt64  `json:"tax_rate_id"`
+	ReservedStock   int32          `json:"reserved_stock"`
+	IsGiftCard      bool           `json:"is_gift_card"`
+	Cost            string         `json:"cost"`
+	Barcode         sql.NullString `json:"barcode"`
+	MinStock        int32          `json:"min_stock"`
+	ReorderQuantity int32          `json:"reorder_quantity"`
+	SupplierID      sql.NullInt64  `json:"supplier_id"`
+	SupplierName    sql.NullString `json:"supplier_name"`
+}
+
+func (q *Queries) GetLowStockProducts(ctx context.Context, supplierID int64) ([]GetLowStockProductsRow, error) {
+	rows, err := q.query(ctx, q.getLowStockProductsStmt, getLowStockProducts, supplierID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetLowStockProductsRow{}
+	for rows.Next() {
+		var i GetLowStockProductsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Price,
+			&i.Stock,
+			&i.CategoryID,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.DeletedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TaxRateID,
+			&i.ReservedStock,
+			&i.IsGiftCard,
+			&i.Cost,
+			&i.Barcode,
+			&i.MinStock,
+			&i.ReorderQuantity,
+			&i.SupplierID,
+			&i.SupplierName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProductByBarcode = `-- name: GetProductByBarcode :one
+SELECT id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, reserved_stock, is_gift_card, cost, barcode, min_stock, reorder_quantity, supplier_id
+FROM products
+WHERE barcode = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetProductByBarcode(ctx context.Context, barcode sql.NullString) (Product, error) {
+	row := q.queryRow(ctx, q.getProductByBarcodeStmt, getProductByBarcode, barcode)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Price,
+		&i.Stock,
+		&i.CategoryID,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.DeletedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.ReservedStock,
+		&i.IsGiftCard,
+		&i.Cost,
+		&i.Barcode,
+		&i.MinStock,
+		&i.ReorderQuantity,
+		&i.SupplierID,
+	)
+	return i, err
+}
+
 const getProductByID = `-- name: GetProductByID :one
-SELECT id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+SELECT id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, reserved_stock, is_gift_card, cost, barcode, min_stock, reorder_quantity, supplier_id
 FROM products
 WHERE id = $1
 `
@@ -180,6 +368,90 @@ func (q *Queries) GetProductByID(ctx context.Context, id int64) (Product, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.ReservedStock,
+		&i.IsGiftCard,
+		&i.Cost,
+		&i.Barcode,
+		&i.MinStock,
+		&i.ReorderQuantity,
+		&i.SupplierID,
+	)
+	return i, err
+}
+
+const getProductByIDForUpdate = `-- name: GetProductByIDForUpdate :one
+SELECT id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, reserved_stock, is_gift_card, cost, barcode, min_stock, reorder_quantity, supplier_id
+FROM products
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetProductByIDForUpdate(ctx context.Context, id int64) (Product, error) {
+	row := q.queryRow(ctx, q.getProductByIDForUpdateStmt, getProductByIDForUpdate, id)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Price,
+		&i.Stock,
+		&i.CategoryID,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.DeletedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.ReservedStock,
+		&i.IsGiftCard,
+		&i.Cost,
+		&i.Barcode,
+		&i.MinStock,
+		&i.ReorderQuantity,
+		&i.SupplierID,
+	)
+	return i, err
+}
+
+const incrementProductStock = `-- name: IncrementProductStock :one
+UPDATE products
+SET stock = stock + $1::INT,
+    updated_by = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $3
+RETURNING id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, reserved_stock, is_gift_card, cost, barcode, min_stock, reorder_quantity, supplier_id
+`
+
+type IncrementProductStockParams struct {
+	Quantity  int32         `json:"quantity"`
+	UpdatedBy sql.NullInt64 `json:"updated_by"`
+	ID        int64         `json:"id"`
+}
+
+func (q *Queries) IncrementProductStock(ctx context.Context, arg IncrementProductStockParams) (Product, error) {
+	row := q.queryRow(ctx, q.incrementProductStockStmt, incrementProductStock, arg.Quantity, arg.UpdatedBy, arg.ID)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Price,
+		&i.Stock,
+		&i.CategoryID,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.DeletedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.ReservedStock,
+		&i.IsGiftCard,
+		&i.Cost,
+		&i.Barcode,
+		&i.MinStock,
+		&i.ReorderQuantity,
+		&i.SupplierID,
 	)
 	return i, err
 }
@@ -188,7 +460,7 @@ const softDeleteProductByID = `-- name: SoftDeleteProductByID :one
 UPDATE products
 SET deleted_by = $2, deleted_at = CURRENT_TIMESTAMP
 WHERE id = $1
-RETURNING id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+RETURNING id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, reserved_stock, is_gift_card, cost, barcode, min_stock, reorder_quantity, supplier_id
 `
 
 type SoftDeleteProductByIDParams struct {
@@ -211,23 +483,37 @@ func (q *Queries) SoftDeleteProductByID(ctx context.Context, arg SoftDeleteProdu
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.ReservedStock,
+		&i.IsGiftCard,
+		&i.Cost,
+		&i.Barcode,
+		&i.MinStock,
+		&i.ReorderQuantity,
+		&i.SupplierID,
 	)
 	return i, err
 }
 
 const updateProduct = `-- name: UpdateProduct :one
 UPDATE products
-SET name = $2, price = $3, category_id = $4, updated_by = $5, updated_at = CURRENT_TIMESTAMP
+SET name = $2, price = $3, category_id = $4, tax_rate_id = $5, is_gift_card = $6, barcode = $7, min_stock = $8, reorder_quantity = $9, supplier_id = $10, updated_by = $11, updated_at = CURRENT_TIMESTAMP
 WHERE id = $1
-RETURNING id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+RETURNING id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, reserved_stock, is_gift_card, cost, barcode, min_stock, reorder_quantity, supplier_id
 `
 
 type UpdateProductParams struct {
-	ID         int64         `json:"id"`
-	Name       string        `json:"name"`
-	Price      string        `json:"price"`
-	CategoryID sql.NullInt64 `json:"category_id"`
-	UpdatedBy  sql.NullInt64 `json:"updated_by"`
+	ID              int64          `json:"id"`
+	Name            string         `json:"name"`
+	Price           string         `json:"price"`
+	CategoryID      sql.NullInt64  `json:"category_id"`
+	TaxRateID       sql.NullInt64  `json:"tax_rate_id"`
+	IsGiftCard      bool           `json:"is_gift_card"`
+	Barcode         sql.NullString `json:"barcode"`
+	MinStock        int32          `json:"min_stock"`
+	ReorderQuantity int32          `json:"reorder_quantity"`
+	SupplierID      sql.NullInt64  `json:"supplier_id"`
+	UpdatedBy       sql.NullInt64  `json:"updated_by"`
 }
 
 func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (Product, error) {
@@ -236,6 +522,12 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (P
 		arg.Name,
 		arg.Price,
 		arg.CategoryID,
+		arg.TaxRateID,
+		arg.IsGiftCard,
+		arg.Barcode,
+		arg.MinStock,
+		arg.ReorderQuantity,
+		arg.SupplierID,
 		arg.UpdatedBy,
 	)
 	var i Product
@@ -251,6 +543,14 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (P
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.ReservedStock,
+		&i.IsGiftCard,
+		&i.Cost,
+		&i.Barcode,
+		&i.MinStock,
+		&i.ReorderQuantity,
+		&i.SupplierID,
 	)
 	return i, err
 }
@@ -261,7 +561,7 @@ SET stock = $2,
     updated_by = $3, 
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 
-RETURNING id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at
+RETURNING id, name, price, stock, category_id, created_by, updated_by, deleted_by, created_at, updated_at, deleted_at, tax_rate_id, reserved_stock, is_gift_card, cost, barcode, min_stock, reorder_quantity, supplier_id
 `
 
 type UpdateProductStockParams struct {
@@ -285,6 +585,14 @@ func (q *Queries) UpdateProductStock(ctx context.Context, arg UpdateProductStock
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.ReservedStock,
+		&i.IsGiftCard,
+		&i.Cost,
+		&i.Barcode,
+		&i.MinStock,
+		&i.ReorderQuantity,
+		&i.SupplierID,
 	)
 	return i, err
 }
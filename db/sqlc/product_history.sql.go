@@ -8,12 +8,13 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 const createProductHistory = `-- name: CreateProductHistory :one
-INSERT INTO product_history (trx_ref, product_id, quantity_change, type, reason, created_by, created_at)
-VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP)
-RETURNING id, trx_ref, product_id, quantity_change, type, reason, created_by, created_at
+INSERT INTO product_history (trx_ref, product_id, quantity_change, type, reason, reason_code, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP)
+RETURNING id, trx_ref, product_id, quantity_change, type, reason, created_by, created_at, reason_code
 `
 
 type CreateProductHistoryParams struct {
@@ -22,6 +23,7 @@ type CreateProductHistoryParams struct {
 	QuantityChange int32          `json:"quantity_change"`
 	Type           string         `json:"type"`
 	Reason         sql.NullString `json:"reason"`
+	ReasonCode     sql.NullString `json:"reason_code"`
 	CreatedBy      sql.NullInt64  `json:"created_by"`
 }
 
@@ -32,6 +34,7 @@ func (q *Queries) CreateProductHistory(ctx context.Context, arg CreateProductHis
 		arg.QuantityChange,
 		arg.Type,
 		arg.Reason,
+		arg.ReasonCode,
 		arg.CreatedBy,
 	)
 	var i ProductHistory
@@ -44,26 +47,50 @@ func (q *Queries) CreateProductHistory(ctx context.Context, arg CreateProductHis
 		&i.Reason,
 		&i.CreatedBy,
 		&i.CreatedAt,
+		&i.ReasonCode,
 	)
 	return i, err
 }
 
 const getAllProductHistory = `-- name: GetAllProductHistory :many
 
-SELECT id, trx_ref, product_id, quantity_change, type, reason, created_by, created_at
+SELECT id, trx_ref, product_id, quantity_change, type, reason, created_by, created_at, reason_code
 FROM product_history
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2
+WHERE ($1::BIGINT = 0 OR product_id = $1::BIGINT)
+    AND ($2::VARCHAR = '' OR type = $2::VARCHAR)
+    AND ($3::VARCHAR = '' OR reason_code = $3::VARCHAR)
+    AND ($4::VARCHAR = '' OR trx_ref = $4::VARCHAR)
+    AND ($5::BIGINT = 0 OR created_by = $5::BIGINT)
+    AND created_at >= $6::TIMESTAMP AND created_at < $7::TIMESTAMP
+ORDER BY created_at DESC, id DESC
+LIMIT $8 OFFSET $9
 `
 
 type GetAllProductHistoryParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	ProductID    int64     `json:"product_id"`
+	MovementType string    `json:"movement_type"`
+	ReasonCode   string    `json:"reason_code"`
+	TrxRef       string    `json:"trx_ref"`
+	CreatedBy    int64     `json:"created_by"`
+	StartDate    time.Time `json:"start_date"`
+	EndDate      time.Time `json:"end_date"`
+	Limit        int32     `json:"limit"`
+	Offset       int32     `json:"offset"`
 }
 
 // #PRODUCT_HISTORY
 func (q *Queries) GetAllProductHistory(ctx context.Context, arg GetAllProductHistoryParams) ([]ProductHistory, error) {
-	rows, err := q.query(ctx, q.getAllProductHistoryStmt, getAllProductHistory, arg.Limit, arg.Offset)
+	rows, err := q.query(ctx, q.getAllProductHistoryStmt, getAllProductHistory,
+		arg.ProductID,
+		arg.MovementType,
+		arg.ReasonCode,
+		arg.TrxRef,
+		arg.CreatedBy,
+		arg.StartDate,
+		arg.EndDate,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +107,138 @@ func (q *Queries) GetAllProductHistory(ctx context.Context, arg GetAllProductHis
 			&i.Reason,
 			&i.CreatedBy,
 			&i.CreatedAt,
+			&i.ReasonCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProductHistorySummary = `-- name: GetProductHistorySummary :many
+SELECT type,
+    COALESCE(reason_code, '')::VARCHAR AS reason_code,
+    COUNT(*)::BIGINT AS movements,
+    COALESCE(SUM(CASE WHEN quantity_change > 0 THEN quantity_change ELSE 0 END), 0)::BIGINT AS quantity_in,
+    COALESCE(SUM(CASE WHEN quantity_change < 0 THEN -quantity_change ELSE 0 END), 0)::BIGINT AS quantity_out,
+    COALESCE(SUM(quantity_change), 0)::BIGINT AS net_quantity
+FROM product_history
+WHERE ($1::VARCHAR = '' OR type = $1::VARCHAR)
+GROUP BY type, reason_code
+ORDER BY type, reason_code
+`
+
+type GetProductHistorySummaryRow struct {
+	Type        string `json:"type"`
+	ReasonCode  string `json:"reason_code"`
+	Movements   int64  `json:"movements"`
+	QuantityIn  int64  `json:"quantity_in"`
+	QuantityOut int64  `json:"quantity_out"`
+	NetQuantity int64  `json:"net_quantity"`
+}
+
+func (q *Queries) GetProductHistorySummary(ctx context.Context, movementType string) ([]GetProductHistorySummaryRow, error) {
+	rows, err := q.query(ctx, q.getProductHistorySummaryStmt, getProductHistorySummary, movementType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetProductHistorySummaryRow{}
+	for rows.Next() {
+		var i GetProductHistorySummaryRow
+		if err := rows.Scan(
+			&i.Type,
+			&i.ReasonCode,
+			&i.Movements,
+			&i.QuantityIn,
+			&i.QuantityOut,
+			&i.NetQuantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProductLedger = `-- name: GetProductLedger :many
+WITH ledger AS (
+    SELECT ph.id, ph.trx_ref, ph.quantity_change, ph.type, ph.reason_code, ph.reason, ph.created_by, ph.created_at,
+        SUM(ph.quantity_change) OVER (ORDER BY ph.created_at, ph.id)::BIGINT AS balance_after
+    FROM product_history ph
+    WHERE ph.product_id = $1::BIGINT
+)
+SELECT id, trx_ref, quantity_change, type, reason_code, reason, created_by, created_at,
+    (balance_after - quantity_change)::BIGINT AS balance_before,
+    balance_after
+FROM ledger
+WHERE created_at >= $2::TIMESTAMP AND created_at < $3::TIMESTAMP
+ORDER BY created_at, id
+LIMIT $4 OFFSET $5
+`
+
+type GetProductLedgerParams struct {
+	ProductID int64     `json:"product_id"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Limit     int32     `json:"limit"`
+	Offset    int32     `json:"offset"`
+}
+
+type GetProductLedgerRow struct {
+	ID             int64          `json:"id"`
+	TrxRef         string         `json:"trx_ref"`
+	QuantityChange int32          `json:"quantity_change"`
+	Type           string         `json:"type"`
+	ReasonCode     sql.NullString `json:"reason_code"`
+	Reason         sql.NullString `json:"reason"`
+	CreatedBy      sql.NullInt64  `json:"created_by"`
+	CreatedAt      sql.NullTime   `json:"created_at"`
+	BalanceBefore  int64          `json:"balance_before"`
+	BalanceAfter   int64          `json:"balance_after"`
+}
+
+// Saldo dihitung maju dari 0 dengan menjumlahkan seluruh product history, selisih
+// dengan stok produk dilaporkan terpisah sebagai drift
+func (q *Queries) GetProductLedger(ctx context.Context, arg GetProductLedgerParams) ([]GetProductLedgerRow, error) {
+	rows, err := q.query(ctx, q.getProductLedgerStmt, getProductLedger,
+		arg.ProductID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetProductLedgerRow{}
+	for rows.Next() {
+		var i GetProductLedgerRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TrxRef,
+			&i.QuantityChange,
+			&i.Type,
+			&i.ReasonCode,
+			&i.Reason,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.BalanceBefore,
+			&i.BalanceAfter,
 		); err != nil {
 			return nil, err
 		}
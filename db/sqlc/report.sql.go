@@ -8,11 +8,12 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 const getAllOrders = `-- name: GetAllOrders :many
 SELECT 
-    o.id, o.trx_number, o.cashier_id, o.customer_id, o.total_amount, o.payment_method, o.status, o.order_date, o.updated_by, o.updated_at,
+    o.id, o.trx_number, o.cashier_id, o.customer_id, o.total_amount, o.payment_method, o.status, o.order_date, o.updated_by, o.updated_at, o.subtotal, o.discount_amount, o.voucher_code, o.voucher_discount, o.tax_amount, o.change_amount, o.shift_id, o.receipt_print_count, o.last_printed_at,
     c.name as customer_name,
     u.username as cashier_name
 FROM orders o
@@ -28,18 +29,27 @@ type GetAllOrdersParams struct {
 }
 
 type GetAllOrdersRow struct {
-	ID            int64          `json:"id"`
-	TrxNumber     string         `json:"trx_number"`
-	CashierID     sql.NullInt64  `json:"cashier_id"`
-	CustomerID    sql.NullInt64  `json:"customer_id"`
-	TotalAmount   string         `json:"total_amount"`
-	PaymentMethod string         `json:"payment_method"`
-	Status        string         `json:"status"`
-	OrderDate     sql.NullTime   `json:"order_date"`
-	UpdatedBy     sql.NullInt64  `json:"updated_by"`
-	UpdatedAt     sql.NullTime   `json:"updated_at"`
-	CustomerName  sql.NullString `json:"customer_name"`
-	CashierName   sql.NullString `json:"cashier_name"`
+	ID                int64          `json:"id"`
+	TrxNumber         string         `json:"trx_number"`
+	CashierID         sql.NullInt64  `json:"cashier_id"`
+	CustomerID        sql.NullInt64  `json:"customer_id"`
+	TotalAmount       string         `json:"total_amount"`
+	PaymentMethod     string         `json:"payment_method"`
+	Status            string         `json:"status"`
+	OrderDate         sql.NullTime   `json:"order_date"`
+	UpdatedBy         sql.NullInt64  `json:"updated_by"`
+	UpdatedAt         sql.NullTime   `json:"updated_at"`
+	Subtotal          string         `json:"subtotal"`
+	DiscountAmount    string         `json:"discount_amount"`
+	VoucherCode       sql.NullString `json:"voucher_code"`
+	VoucherDiscount   string         `json:"voucher_discount"`
+	TaxAmount         string         `json:"tax_amount"`
+	ChangeAmount      string         `json:"change_amount"`
+	ShiftID           sql.NullInt64  `json:"shift_id"`
+	ReceiptPrintCount int32          `json:"receipt_print_count"`
+	LastPrintedAt     sql.NullTime   `json:"last_printed_at"`
+	CustomerName      sql.NullString `json:"customer_name"`
+	CashierName       sql.NullString `json:"cashier_name"`
 }
 
 func (q *Queries) GetAllOrders(ctx context.Context, arg GetAllOrdersParams) ([]GetAllOrdersRow, error) {
@@ -62,6 +72,15 @@ func (q *Queries) GetAllOrders(ctx context.Context, arg GetAllOrdersParams) ([]G
 			&i.OrderDate,
 			&i.UpdatedBy,
 			&i.UpdatedAt,
+			&i.Subtotal,
+			&i.DiscountAmount,
+			&i.VoucherCode,
+			&i.VoucherDiscount,
+			&i.TaxAmount,
+			&i.ChangeAmount,
+			&i.ShiftID,
+			&i.ReceiptPrintCount,
+			&i.LastPrintedAt,
 			&i.CustomerName,
 			&i.CashierName,
 		); err != nil {
@@ -91,7 +110,7 @@ FROM products p
 LEFT JOIN order_items oi ON p.id = oi.product_id
 LEFT JOIN orders o ON oi.order_id = o.id AND
     (o.order_date >= $1 AND  o.order_date < $2) AND
-    o.status = 'order'
+    o.status IN ('order', 'paid')
 LEFT JOIN categories c ON p.category_id = c.id
 GROUP BY p.id, p.name, p.category_id, c.name
 ORDER BY total_quantity DESC
@@ -143,7 +162,7 @@ func (q *Queries) GetFastMovingProducts(ctx context.Context, arg GetFastMovingPr
 }
 
 const getOrderByID = `-- name: GetOrderByID :one
-SELECT id, trx_number, cashier_id, customer_id, total_amount, payment_method, status, order_date, updated_by, updated_at FROM orders
+SELECT id, trx_number, cashier_id, customer_id, total_amount, payment_method, status, order_date, updated_by, updated_at, subtotal, discount_amount, voucher_code, voucher_discount, tax_amount, change_amount, shift_id, receipt_print_count, last_printed_at FROM orders
 WHERE id = $1 LIMIT 1
 `
 
@@ -161,10 +180,388 @@ func (q *Queries) GetOrderByID(ctx context.Context, id int64) (Order, error) {
 		&i.OrderDate,
 		&i.UpdatedBy,
 		&i.UpdatedAt,
+		&i.Subtotal,
+		&i.DiscountAmount,
+		&i.VoucherCode,
+		&i.VoucherDiscount,
+		&i.TaxAmount,
+		&i.ChangeAmount,
+		&i.ShiftID,
+		&i.ReceiptPrintCount,
+		&i.LastPrintedAt,
 	)
 	return i, err
 }
 
+const getOrderByIDForUpdate = `-- name: GetOrderByIDForUpdate :one
+SELECT id, trx_number, cashier_id, customer_id, total_amount, payment_method, status, order_date, updated_by, updated_at, subtotal, discount_amount, voucher_code, voucher_discount, tax_amount, change_amount, shift_id, receipt_print_count, last_printed_at
+FROM orders
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetOrderByIDForUpdate(ctx context.Context, id int64) (Order, error) {
+	row := q.queryRow(ctx, q.getOrderByIDForUpdateStmt, getOrderByIDForUpdate, id)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.TrxNumber,
+		&i.CashierID,
+		&i.CustomerID,
+		&i.TotalAmount,
+		&i.PaymentMethod,
+		&i.Status,
+		&i.OrderDate,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+		&i.Subtotal,
+		&i.DiscountAmount,
+		&i.VoucherCode,
+		&i.VoucherDiscount,
+		&i.TaxAmount,
+		&i.ChangeAmount,
+		&i.ShiftID,
+		&i.ReceiptPrintCount,
+		&i.LastPrintedAt,
+	)
+	return i, err
+}
+
+const getProfitByCashier = `-- name: GetProfitByCashier :many
+SELECT l.cashier_id,
+    COALESCE(u.username, '')::VARCHAR AS name,
+    COALESCE(SUM(l.quantity), 0)::BIGINT AS quantity,
+    COUNT(DISTINCT l.order_id) FILTER (WHERE l.quantity > 0)::BIGINT AS total_orders,
+    COALESCE(SUM(l.revenue), 0)::DECIMAL AS revenue,
+    COALESCE(SUM(l.discount), 0)::DECIMAL AS discount,
+    COALESCE(SUM(l.tax), 0)::DECIMAL AS tax,
+    COALESCE(SUM(l.refund), 0)::DECIMAL AS refund,
+    COALESCE(SUM(l.cogs), 0)::DECIMAL AS cogs
+FROM profit_lines l
+LEFT JOIN users u ON l.cashier_id = u.id
+WHERE l.trx_date >= $1::TIMESTAMP AND l.trx_date < $2::TIMESTAMP
+GROUP BY l.cashier_id, u.username
+ORDER BY SUM(l.revenue - l.discount - l.tax - l.cogs) DESC
+`
+
+type GetProfitByCashierParams struct {
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+type GetProfitByCashierRow struct {
+	CashierID   sql.NullInt64 `json:"cashier_id"`
+	Name        string        `json:"name"`
+	Quantity    int64         `json:"quantity"`
+	TotalOrders int64         `json:"total_orders"`
+	Revenue     string        `json:"revenue"`
+	Discount    string        `json:"discount"`
+	Tax         string        `json:"tax"`
+	Refund      string        `json:"refund"`
+	Cogs        string        `json:"cogs"`
+}
+
+func (q *Queries) GetProfitByCashier(ctx context.Context, arg GetProfitByCashierParams) ([]GetProfitByCashierRow, error) {
+	rows, err := q.query(ctx, q.getProfitByCashierStmt, getProfitByCashier, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetProfitByCashierRow{}
+	for rows.Next() {
+		var i GetProfitByCashierRow
+		if err := rows.Scan(
+			&i.CashierID,
+			&i.Name,
+			&i.Quantity,
+			&i.TotalOrders,
+			&i.Revenue,
+			&i.Discount,
+			&i.Tax,
+			&i.Refund,
+			&i.Cogs,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProfitByCategory = `-- name: GetProfitByCategory :many
+SELECT c.id AS category_id,
+    COALESCE(c.name, 'Uncategorized')::VARCHAR AS name,
+    COALESCE(SUM(l.quantity), 0)::BIGINT AS quantity,
+    COUNT(DISTINCT l.order_id) FILTER (WHERE l.quantity > 0)::BIGINT AS total_orders,
+    COALESCE(SUM(l.revenue), 0)::DECIMAL AS revenue,
+    COALESCE(SUM(l.discount), 0)::DECIMAL AS discount,
+    COALESCE(SUM(l.tax), 0)::DECIMAL AS tax,
+    COALESCE(SUM(l.refund), 0)::DECIMAL AS refund,
+    COALESCE(SUM(l.cogs), 0)::DECIMAL AS cogs
+FROM profit_lines l
+LEFT JOIN products p ON l.product_id = p.id
+LEFT JOIN categories c ON p.category_id = c.id
+WHERE l.trx_date >= $1::TIMESTAMP AND l.trx_date < $2::TIMESTAMP
+GROUP BY c.id, c.name
+ORDER BY SUM(l.revenue - l.discount - l.tax - l.cogs) DESC
+`
+
+type GetProfitByCategoryParams struct {
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+type GetProfitByCategoryRow struct {
+	CategoryID  sql.NullInt64 `json:"category_id"`
+	Name        string        `json:"name"`
+	Quantity    int64         `json:"quantity"`
+	TotalOrders int64         `json:"total_orders"`
+	Revenue     string        `json:"revenue"`
+	Discount    string        `json:"discount"`
+	Tax         string        `json:"tax"`
+	Refund      string        `json:"refund"`
+	Cogs        string        `json:"cogs"`
+}
+
+func (q *Queries) GetProfitByCategory(ctx context.Context, arg GetProfitByCategoryParams) ([]GetProfitByCategoryRow, error) {
+	rows, err := q.query(ctx, q.getProfitByCategoryStmt, getProfitByCategory, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetProfitByCategoryRow{}
+	for rows.Next() {
+		var i GetProfitByCategoryRow
+		if err := rows.Scan(
+			&i.CategoryID,
+			&i.Name,
+			&i.Quantity,
+			&i.TotalOrders,
+			&i.Revenue,
+			&i.Discount,
+			&i.Tax,
+			&i.Refund,
+			&i.Cogs,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProfitByCustomer = `-- name: GetProfitByCustomer :many
+SELECT l.customer_id,
+    COALESCE(c.name, 'Guest')::VARCHAR AS name,
+    COALESCE(SUM(l.quantity), 0)::BIGINT AS quantity,
+    COUNT(DISTINCT l.order_id) FILTER (WHERE l.quantity > 0)::BIGINT AS total_orders,
+    COALESCE(SUM(l.revenue), 0)::DECIMAL AS revenue,
+    COALESCE(SUM(l.discount), 0)::DECIMAL AS discount,
+    COALESCE(SUM(l.tax), 0)::DECIMAL AS tax,
+    COALESCE(SUM(l.refund), 0)::DECIMAL AS refund,
+    COALESCE(SUM(l.cogs), 0)::DECIMAL AS cogs
+FROM profit_lines l
+LEFT JOIN customers c ON l.customer_id = c.id
+WHERE l.trx_date >= $1::TIMESTAMP AND l.trx_date < $2::TIMESTAMP
+GROUP BY l.customer_id, c.name
+ORDER BY SUM(l.revenue - l.discount - l.tax - l.cogs) DESC
+`
+
+type GetProfitByCustomerParams struct {
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+type GetProfitByCustomerRow struct {
+	CustomerID  sql.NullInt64 `json:"customer_id"`
+	Name        string        `json:"name"`
+	Quantity    int64         `json:"quantity"`
+	TotalOrders int64         `json:"total_orders"`
+	Revenue     string        `json:"revenue"`
+	Discount    string        `json:"discount"`
+	Tax         string        `json:"tax"`
+	Refund      string        `json:"refund"`
+	Cogs        string        `json:"cogs"`
+}
+
+func (q *Queries) GetProfitByCustomer(ctx context.Context, arg GetProfitByCustomerParams) ([]GetProfitByCustomerRow, error) {
+	rows, err := q.query(ctx, q.getProfitByCustomerStmt, getProfitByCustomer, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetProfitByCustomerRow{}
+	for rows.Next() {
+		var i GetProfitByCustomerRow
+		if err := rows.Scan(
+			&i.CustomerID,
+			&i.Name,
+			&i.Quantity,
+			&i.TotalOrders,
+			&i.Revenue,
+			&i.Discount,
+			&i.Tax,
+			&i.Refund,
+			&i.Cogs,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProfitByDay = `-- name: GetProfitByDay :many
+SELECT l.trx_date::DATE AS date,
+    COALESCE(SUM(l.quantity), 0)::BIGINT AS quantity,
+    COUNT(DISTINCT l.order_id) FILTER (WHERE l.quantity > 0)::BIGINT AS total_orders,
+    COALESCE(SUM(l.revenue), 0)::DECIMAL AS revenue,
+    COALESCE(SUM(l.discount), 0)::DECIMAL AS discount,
+    COALESCE(SUM(l.tax), 0)::DECIMAL AS tax,
+    COALESCE(SUM(l.refund), 0)::DECIMAL AS refund,
+    COALESCE(SUM(l.cogs), 0)::DECIMAL AS cogs
+FROM profit_lines l
+WHERE l.trx_date >= $1::TIMESTAMP AND l.trx_date < $2::TIMESTAMP
+GROUP BY l.trx_date::DATE
+ORDER BY l.trx_date::DATE ASC
+`
+
+type GetProfitByDayParams struct {
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+type GetProfitByDayRow struct {
+	Date        time.Time `json:"date"`
+	Quantity    int64     `json:"quantity"`
+	TotalOrders int64     `json:"total_orders"`
+	Revenue     string    `json:"revenue"`
+	Discount    string    `json:"discount"`
+	Tax         string    `json:"tax"`
+	Refund      string    `json:"refund"`
+	Cogs        string    `json:"cogs"`
+}
+
+func (q *Queries) GetProfitByDay(ctx context.Context, arg GetProfitByDayParams) ([]GetProfitByDayRow, error) {
+	rows, err := q.query(ctx, q.getProfitByDayStmt, getProfitByDay, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetProfitByDayRow{}
+	for rows.Next() {
+		var i GetProfitByDayRow
+		if err := rows.Scan(
+			&i.Date,
+			&i.Quantity,
+			&i.TotalOrders,
+			&i.Revenue,
+			&i.Discount,
+			&i.Tax,
+			&i.Refund,
+			&i.Cogs,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProfitByProduct = `-- name: GetProfitByProduct :many
+
+SELECT l.product_id,
+    COALESCE(p.name, MAX(l.old_product), '')::VARCHAR AS name,
+    COALESCE(SUM(l.quantity), 0)::BIGINT AS quantity,
+    COUNT(DISTINCT l.order_id) FILTER (WHERE l.quantity > 0)::BIGINT AS total_orders,
+    COALESCE(SUM(l.revenue), 0)::DECIMAL AS revenue,
+    COALESCE(SUM(l.discount), 0)::DECIMAL AS discount,
+    COALESCE(SUM(l.tax), 0)::DECIMAL AS tax,
+    COALESCE(SUM(l.refund), 0)::DECIMAL AS refund,
+    COALESCE(SUM(l.cogs), 0)::DECIMAL AS cogs
+FROM profit_lines l
+LEFT JOIN products p ON l.product_id = p.id
+WHERE l.trx_date >= $1::TIMESTAMP AND l.trx_date < $2::TIMESTAMP
+GROUP BY l.product_id, p.name
+ORDER BY SUM(l.revenue - l.discount - l.tax - l.cogs) DESC
+`
+
+type GetProfitByProductParams struct {
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+type GetProfitByProductRow struct {
+	ProductID   sql.NullInt64 `json:"product_id"`
+	Name        string        `json:"name"`
+	Quantity    int64         `json:"quantity"`
+	TotalOrders int64         `json:"total_orders"`
+	Revenue     string        `json:"revenue"`
+	Discount    string        `json:"discount"`
+	Tax         string        `json:"tax"`
+	Refund      string        `json:"refund"`
+	Cogs        string        `json:"cogs"`
+}
+
+// Laporan laba kotor dari view profit_lines: penjualan pada periode dikurangi refund
+// yang terjadi pada periode yang sama
+func (q *Queries) GetProfitByProduct(ctx context.Context, arg GetProfitByProductParams) ([]GetProfitByProductRow, error) {
+	rows, err := q.query(ctx, q.getProfitByProductStmt, getProfitByProduct, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetProfitByProductRow{}
+	for rows.Next() {
+		var i GetProfitByProductRow
+		if err := rows.Scan(
+			&i.ProductID,
+			&i.Name,
+			&i.Quantity,
+			&i.TotalOrders,
+			&i.Revenue,
+			&i.Discount,
+			&i.Tax,
+			&i.Refund,
+			&i.Cogs,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSlowMovingProducts = `-- name: GetSlowMovingProducts :many
 SELECT 
     p.id,
@@ -177,7 +574,7 @@ FROM products p
 LEFT JOIN order_items oi ON p.id = oi.product_id
 LEFT JOIN orders o ON oi.order_id = o.id AND
     (o.order_date >= $1 AND  o.order_date < $2) AND
-    o.status = 'order'
+    o.status IN ('order', 'paid')
 LEFT JOIN categories c ON p.category_id = c.id
 GROUP BY p.id, p.name, p.category_id, c.name
 ORDER BY total_quantity ASC
@@ -228,6 +625,70 @@ func (q *Queries) GetSlowMovingProducts(ctx context.Context, arg GetSlowMovingPr
 	return items, nil
 }
 
+const getTaxSummary = `-- name: GetTaxSummary :many
+SELECT
+    COALESCE(oi.tax_name, 'Non Taxable')::VARCHAR as tax_name,
+    oi.tax_rate,
+    oi.tax_inclusive,
+    COUNT(DISTINCT o.id) as total_orders,
+    SUM(oi.unit_price * oi.quantity - oi.discount_amount)::DECIMAL as sales_amount,
+    SUM(CASE WHEN oi.tax_inclusive THEN oi.unit_price * oi.quantity - oi.discount_amount - oi.tax_amount ELSE oi.unit_price * oi.quantity - oi.discount_amount END)::DECIMAL as taxable_amount,
+    SUM(oi.tax_amount)::DECIMAL as tax_amount
+FROM order_items oi
+JOIN orders o ON oi.order_id = o.id
+WHERE 
+    (o.order_date >= $1 AND  o.order_date < $2) AND
+    o.status IN ('order', 'paid')
+GROUP BY oi.tax_name, oi.tax_rate, oi.tax_inclusive
+ORDER BY oi.tax_rate DESC
+`
+
+type GetTaxSummaryParams struct {
+	OrderDate   sql.NullTime `json:"order_date"`
+	OrderDate_2 sql.NullTime `json:"order_date_2"`
+}
+
+type GetTaxSummaryRow struct {
+	TaxName       string `json:"tax_name"`
+	TaxRate       string `json:"tax_rate"`
+	TaxInclusive  bool   `json:"tax_inclusive"`
+	TotalOrders   int64  `json:"total_orders"`
+	SalesAmount   string `json:"sales_amount"`
+	TaxableAmount string `json:"taxable_amount"`
+	TaxAmount     string `json:"tax_amount"`
+}
+
+func (q *Queries) GetTaxSummary(ctx context.Context, arg GetTaxSummaryParams) ([]GetTaxSummaryRow, error) {
+	rows, err := q.query(ctx, q.getTaxSummaryStmt, getTaxSummary, arg.OrderDate, arg.OrderDate_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTaxSummaryRow{}
+	for rows.Next() {
+		var i GetTaxSummaryRow
+		if err := rows.Scan(
+			&i.TaxName,
+			&i.TaxRate,
+			&i.TaxInclusive,
+			&i.TotalOrders,
+			&i.SalesAmount,
+			&i.TaxableAmount,
+			&i.TaxAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTopCashiers = `-- name: GetTopCashiers :many
 SELECT 
     u.id as cashier_id,
@@ -240,7 +701,7 @@ FROM users u
 JOIN orders o ON u.id = o.cashier_id
 WHERE 
     (o.order_date >= $1 AND  o.order_date < $2) AND
-    o.status = 'order'
+    o.status IN ('order', 'paid')
 GROUP BY u.id, u.username, u.full_name
 ORDER BY total_amount DESC
 LIMIT 10
@@ -304,7 +765,7 @@ FROM customers c
 JOIN orders o ON c.id = o.customer_id
 WHERE 
     (o.order_date >= $1 AND  o.order_date < $2) AND
-    o.status = 'order'
+    o.status IN ('order', 'paid')
 GROUP BY c.id, c.member_code, c.name, c.phone, c.email
 ORDER BY total_spent DESC
 LIMIT 10
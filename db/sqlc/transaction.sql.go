@@ -15,21 +15,35 @@ INSERT INTO orders (
     trx_number,
     cashier_id,
     customer_id,
+    subtotal,
+    discount_amount,
+    voucher_code,
+    voucher_discount,
+    tax_amount,
     total_amount,
+    change_amount,
     payment_method,
-    status
+    status,
+    shift_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
-) RETURNING id, trx_number, cashier_id, customer_id, total_amount, payment_method, status, order_date, updated_by, updated_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+) RETURNING id, trx_number, cashier_id, customer_id, total_amount, payment_method, status, order_date, updated_by, updated_at, subtotal, discount_amount, voucher_code, voucher_discount, tax_amount, change_amount, shift_id, receipt_print_count, last_printed_at
 `
 
 type CreateOrderParams struct {
-	TrxNumber     string        `json:"trx_number"`
-	CashierID     sql.NullInt64 `json:"cashier_id"`
-	CustomerID    sql.NullInt64 `json:"customer_id"`
-	TotalAmount   string        `json:"total_amount"`
-	PaymentMethod string        `json:"payment_method"`
-	Status        string        `json:"status"`
+	TrxNumber       string         `json:"trx_number"`
+	CashierID       sql.NullInt64  `json:"cashier_id"`
+	CustomerID      sql.NullInt64  `json:"customer_id"`
+	Subtotal        string         `json:"subtotal"`
+	DiscountAmount  string         `json:"discount_amount"`
+	VoucherCode     sql.NullString `json:"voucher_code"`
+	VoucherDiscount string         `json:"voucher_discount"`
+	TaxAmount       string         `json:"tax_amount"`
+	TotalAmount     string         `json:"total_amount"`
+	ChangeAmount    string         `json:"change_amount"`
+	PaymentMethod   string         `json:"payment_method"`
+	Status          string         `json:"status"`
+	ShiftID         sql.NullInt64  `json:"shift_id"`
 }
 
 func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order, error) {
@@ -37,9 +51,16 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order
 		arg.TrxNumber,
 		arg.CashierID,
 		arg.CustomerID,
+		arg.Subtotal,
+		arg.DiscountAmount,
+		arg.VoucherCode,
+		arg.VoucherDiscount,
+		arg.TaxAmount,
 		arg.TotalAmount,
+		arg.ChangeAmount,
 		arg.PaymentMethod,
 		arg.Status,
+		arg.ShiftID,
 	)
 	var i Order
 	err := row.Scan(
@@ -53,6 +74,15 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order
 		&i.OrderDate,
 		&i.UpdatedBy,
 		&i.UpdatedAt,
+		&i.Subtotal,
+		&i.DiscountAmount,
+		&i.VoucherCode,
+		&i.VoucherDiscount,
+		&i.TaxAmount,
+		&i.ChangeAmount,
+		&i.ShiftID,
+		&i.ReceiptPrintCount,
+		&i.LastPrintedAt,
 	)
 	return i, err
 }
@@ -64,19 +94,33 @@ INSERT INTO order_items (
     old_product,
     quantity,
     unit_price,
+    discount_amount,
+    tax_name,
+    tax_rate,
+    tax_inclusive,
+    tax_amount,
+    unit_cost,
+    cogs,
     created_by
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
-) RETURNING id, order_id, product_id, old_product, quantity, unit_price, created_by, created_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+) RETURNING id, order_id, product_id, old_product, quantity, unit_price, created_by, created_at, discount_amount, tax_name, tax_rate, tax_inclusive, tax_amount, unit_cost, cogs
 `
 
 type CreateOrderItemParams struct {
-	OrderID    sql.NullInt64  `json:"order_id"`
-	ProductID  sql.NullInt64  `json:"product_id"`
-	OldProduct sql.NullString `json:"old_product"`
-	Quantity   int32          `json:"quantity"`
-	UnitPrice  string         `json:"unit_price"`
-	CreatedBy  sql.NullInt64  `json:"created_by"`
+	OrderID        sql.NullInt64  `json:"order_id"`
+	ProductID      sql.NullInt64  `json:"product_id"`
+	OldProduct     sql.NullString `json:"old_product"`
+	Quantity       int32          `json:"quantity"`
+	UnitPrice      string         `json:"unit_price"`
+	DiscountAmount string         `json:"discount_amount"`
+	TaxName        sql.NullString `json:"tax_name"`
+	TaxRate        string         `json:"tax_rate"`
+	TaxInclusive   bool           `json:"tax_inclusive"`
+	TaxAmount      string         `json:"tax_amount"`
+	UnitCost       string         `json:"unit_cost"`
+	Cogs           string         `json:"cogs"`
+	CreatedBy      sql.NullInt64  `json:"created_by"`
 }
 
 func (q *Queries) CreateOrderItem(ctx context.Context, arg CreateOrderItemParams) (OrderItem, error) {
@@ -86,6 +130,13 @@ func (q *Queries) CreateOrderItem(ctx context.Context, arg CreateOrderItemParams
 		arg.OldProduct,
 		arg.Quantity,
 		arg.UnitPrice,
+		arg.DiscountAmount,
+		arg.TaxName,
+		arg.TaxRate,
+		arg.TaxInclusive,
+		arg.TaxAmount,
+		arg.UnitCost,
+		arg.Cogs,
 		arg.CreatedBy,
 	)
 	var i OrderItem
@@ -98,6 +149,13 @@ func (q *Queries) CreateOrderItem(ctx context.Context, arg CreateOrderItemParams
 		&i.UnitPrice,
 		&i.CreatedBy,
 		&i.CreatedAt,
+		&i.DiscountAmount,
+		&i.TaxName,
+		&i.TaxRate,
+		&i.TaxInclusive,
+		&i.TaxAmount,
+		&i.UnitCost,
+		&i.Cogs,
 	)
 	return i, err
 }
@@ -106,20 +164,30 @@ const createRefund = `-- name: CreateRefund :one
 INSERT INTO refunds (
     order_id,
     reason,
-    created_by
+    created_by,
+    shift_id,
+    refund_method
 ) VALUES (
-    $1, $2, $3
-) RETURNING id, order_id, reason, refund_at, created_by
+    $1, $2, $3, $4, $5
+) RETURNING id, order_id, reason, refund_at, created_by, shift_id, refund_method
 `
 
 type CreateRefundParams struct {
-	OrderID   sql.NullInt64 `json:"order_id"`
-	Reason    string        `json:"reason"`
-	CreatedBy sql.NullInt64 `json:"created_by"`
+	OrderID      sql.NullInt64 `json:"order_id"`
+	Reason       string        `json:"reason"`
+	CreatedBy    sql.NullInt64 `json:"created_by"`
+	ShiftID      sql.NullInt64 `json:"shift_id"`
+	RefundMethod string        `json:"refund_method"`
 }
 
 func (q *Queries) CreateRefund(ctx context.Context, arg CreateRefundParams) (Refund, error) {
-	row := q.queryRow(ctx, q.createRefundStmt, createRefund, arg.OrderID, arg.Reason, arg.CreatedBy)
+	row := q.queryRow(ctx, q.createRefundStmt, createRefund,
+		arg.OrderID,
+		arg.Reason,
+		arg.CreatedBy,
+		arg.ShiftID,
+		arg.RefundMethod,
+	)
 	var i Refund
 	err := row.Scan(
 		&i.ID,
@@ -127,12 +195,14 @@ func (q *Queries) CreateRefund(ctx context.Context, arg CreateRefundParams) (Ref
 		&i.Reason,
 		&i.RefundAt,
 		&i.CreatedBy,
+		&i.ShiftID,
+		&i.RefundMethod,
 	)
 	return i, err
 }
 
 const getOrderByTrxNumber = `-- name: GetOrderByTrxNumber :one
-SELECT id, trx_number, cashier_id, customer_id, total_amount, payment_method, status, order_date, updated_by, updated_at FROM orders 
+SELECT id, trx_number, cashier_id, customer_id, total_amount, payment_method, status, order_date, updated_by, updated_at, subtotal, discount_amount, voucher_code, voucher_discount, tax_amount, change_amount, shift_id, receipt_print_count, last_printed_at FROM orders 
 WHERE trx_number = $1 
 LIMIT 1
 `
@@ -152,12 +222,56 @@ func (q *Queries) GetOrderByTrxNumber(ctx context.Context, trxNumber string) (Or
 		&i.OrderDate,
 		&i.UpdatedBy,
 		&i.UpdatedAt,
+		&i.Subtotal,
+		&i.DiscountAmount,
+		&i.VoucherCode,
+		&i.VoucherDiscount,
+		&i.TaxAmount,
+		&i.ChangeAmount,
+		&i.ShiftID,
+		&i.ReceiptPrintCount,
+		&i.LastPrintedAt,
+	)
+	return i, err
+}
+
+const getOrderByTrxNumberForUpdate = `-- name: GetOrderByTrxNumberForUpdate :one
+SELECT id, trx_number, cashier_id, customer_id, total_amount, payment_method, status, order_date, updated_by, updated_at, subtotal, discount_amount, voucher_code, voucher_discount, tax_amount, change_amount, shift_id, receipt_print_count, last_printed_at FROM orders
+WHERE trx_number = $1
+LIMIT 1
+FOR UPDATE
+`
+
+// GetOrderByTrxNumberForUpdate
+func (q *Queries) GetOrderByTrxNumberForUpdate(ctx context.Context, trxNumber string) (Order, error) {
+	row := q.queryRow(ctx, q.getOrderByTrxNumberForUpdateStmt, getOrderByTrxNumberForUpdate, trxNumber)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.TrxNumber,
+		&i.CashierID,
+		&i.CustomerID,
+		&i.TotalAmount,
+		&i.PaymentMethod,
+		&i.Status,
+		&i.OrderDate,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+		&i.Subtotal,
+		&i.DiscountAmount,
+		&i.VoucherCode,
+		&i.VoucherDiscount,
+		&i.TaxAmount,
+		&i.ChangeAmount,
+		&i.ShiftID,
+		&i.ReceiptPrintCount,
+		&i.LastPrintedAt,
 	)
 	return i, err
 }
 
 const getOrderItemsByOrderID = `-- name: GetOrderItemsByOrderID :many
-SELECT id, order_id, product_id, old_product, quantity, unit_price, created_by, created_at FROM order_items 
+SELECT id, order_id, product_id, old_product, quantity, unit_price, created_by, created_at, discount_amount, tax_name, tax_rate, tax_inclusive, tax_amount, unit_cost, cogs FROM order_items 
 WHERE order_id = $1
 `
 
@@ -180,6 +294,13 @@ func (q *Queries) GetOrderItemsByOrderID(ctx context.Context, orderID sql.NullIn
 			&i.UnitPrice,
 			&i.CreatedBy,
 			&i.CreatedAt,
+			&i.DiscountAmount,
+			&i.TaxName,
+			&i.TaxRate,
+			&i.TaxInclusive,
+			&i.TaxAmount,
+			&i.UnitCost,
+			&i.Cogs,
 		); err != nil {
 			return nil, err
 		}
@@ -200,7 +321,7 @@ SET status = $1,
     updated_by = $2, 
     updated_at = CURRENT_TIMESTAMP 
 WHERE id = $3 
-RETURNING id, trx_number, cashier_id, customer_id, total_amount, payment_method, status, order_date, updated_by, updated_at
+RETURNING id, trx_number, cashier_id, customer_id, total_amount, payment_method, status, order_date, updated_by, updated_at, subtotal, discount_amount, voucher_code, voucher_discount, tax_amount, change_amount, shift_id, receipt_print_count, last_printed_at
 `
 
 type UpdateOrderStatusParams struct {
@@ -224,6 +345,15 @@ func (q *Queries) UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusPa
 		&i.OrderDate,
 		&i.UpdatedBy,
 		&i.UpdatedAt,
+		&i.Subtotal,
+		&i.DiscountAmount,
+		&i.VoucherCode,
+		&i.VoucherDiscount,
+		&i.TaxAmount,
+		&i.ChangeAmount,
+		&i.ShiftID,
+		&i.ReceiptPrintCount,
+		&i.LastPrintedAt,
 	)
 	return i, err
 }
@@ -1,10 +1,21 @@
 package main
 
 import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
 	"log"
+	"os"
+	"text/tabwriter"
+
+	"pos-api/app/controllers"
 	"pos-api/app/server"
+	dbCon "pos-api/db/sqlc"
 	"pos-api/util/config"
 	"pos-api/util/dbmigrate"
+
+	_ "github.com/lib/pq"
 )
 
 // @securityDefinitions.apikey BearerAuth
@@ -19,6 +30,10 @@ func main() {
 		log.Fatalf("failed to run migrations: %v", err)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "stock-check" {
+		os.Exit(runStockCheck(config, os.Args[2:]))
+	}
+
 	appServer := server.NewServer(config)
 	err = appServer.Run()
 	if err != nil {
@@ -26,3 +41,47 @@ func main() {
 	}
 
 }
+
+// runStockCheck menjalankan perintah `stock-check [-repair]` yang mencetak produk
+// dengan stok berbeda dari product history. Exit code 1 jika ada selisih yang
+// belum diperbaiki.
+func runStockCheck(config config.Config, args []string) int {
+	flags := flag.NewFlagSet("stock-check", flag.ExitOnError)
+	repair := flags.Bool("repair", false, "write correcting adjustment entries in a single transaction")
+	flags.Parse(args)
+
+	conn, err := sql.Open(config.DbDriver, config.DbSource)
+	if err != nil {
+		log.Fatalf("Could not connect to database: %v", err)
+	}
+	defer conn.Close()
+
+	ctx := context.Background()
+	stockCheckController := controllers.NewStockCheckController(dbCon.New(conn), conn, ctx)
+	data, err := stockCheckController.CheckStock(ctx, *repair, 0)
+	if err != nil {
+		log.Printf("stock check failed: %v", err)
+		return 2
+	}
+
+	fmt.Printf("checked %d products, %d mismatched\n", data.Checked, len(data.Mismatches))
+	if len(data.Mismatches) > 0 {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "PRODUCT ID\tNAME\tSTOCK\tEXPECTED\tDIFFERENCE")
+		for _, mismatch := range data.Mismatches {
+			fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%+d\n", mismatch.ProductID, mismatch.Name, mismatch.Stock, mismatch.ExpectedStock, mismatch.Difference)
+		}
+		w.Flush()
+	}
+
+	if data.Repaired {
+		if data.TrxRef != "" {
+			fmt.Printf("correcting entries written with trx_ref %s\n", data.TrxRef)
+		}
+		return 0
+	}
+	if len(data.Mismatches) > 0 {
+		return 1
+	}
+	return 0
+}
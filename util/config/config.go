@@ -12,6 +12,40 @@ type Config struct {
 	PostgresDb       string `mapstructure:"POSTGRES_DB"`
 	ServerAddress    string `mapstructure:"SERVER_ADDRESS"`
 	JwtSecret        string `mapstructure:"JWT_SECRET"`
+
+	PaymentProvider      string `mapstructure:"PAYMENT_PROVIDER"`
+	PaymentWebhookSecret string `mapstructure:"PAYMENT_WEBHOOK_SECRET"`
+	PaymentExpiryMinutes int    `mapstructure:"PAYMENT_EXPIRY_MINUTES"`
+
+	ParkedOrderExpiryMinutes int `mapstructure:"PARKED_ORDER_EXPIRY_MINUTES"`
+
+	StoreName     string `mapstructure:"STORE_NAME"`
+	StoreAddress  string `mapstructure:"STORE_ADDRESS"`
+	StorePhone    string `mapstructure:"STORE_PHONE"`
+	ReceiptHeader string `mapstructure:"RECEIPT_HEADER"`
+	ReceiptFooter string `mapstructure:"RECEIPT_FOOTER"`
+	ReceiptWidth  int    `mapstructure:"RECEIPT_WIDTH"`
+
+	SmtpHost      string `mapstructure:"SMTP_HOST"`
+	SmtpPort      int    `mapstructure:"SMTP_PORT"`
+	SmtpUsername  string `mapstructure:"SMTP_USERNAME"`
+	SmtpPassword  string `mapstructure:"SMTP_PASSWORD"`
+	SmtpFrom      string `mapstructure:"SMTP_FROM"`
+	WhatsappUrl   string `mapstructure:"WHATSAPP_URL"`
+	WhatsappToken string `mapstructure:"WHATSAPP_TOKEN"`
+
+	LoyaltyEarnAmount      float64 `mapstructure:"LOYALTY_EARN_AMOUNT"`
+	LoyaltyPointValue      float64 `mapstructure:"LOYALTY_POINT_VALUE"`
+	LoyaltyPointExpiryDays int     `mapstructure:"LOYALTY_POINT_EXPIRY_DAYS"`
+
+	CustomerTierWindowDays int `mapstructure:"CUSTOMER_TIER_WINDOW_DAYS"`
+
+	GiftCardExpiryDays int `mapstructure:"GIFT_CARD_EXPIRY_DAYS"`
+
+	InventoryValuationMethod string `mapstructure:"INVENTORY_VALUATION_METHOD"`
+
+	LowStockAlertEmail    string `mapstructure:"LOW_STOCK_ALERT_EMAIL"`
+	LowStockAlertWhatsapp string `mapstructure:"LOW_STOCK_ALERT_WHATSAPP"`
 }
 
 func LoadConfig() (config Config, err error) {
@@ -0,0 +1,20 @@
+package contact
+
+import "strconv"
+
+// Kanal pemasaran yang memerlukan persetujuan pelanggan
+const (
+	ChannelEmail    = "email"
+	ChannelWhatsapp = "whatsapp"
+)
+
+// Nilai pengganti data pribadi pelanggan yang dianonimkan
+const (
+	AnonymizedName      = "Anonymized Customer"
+	AnonymizedRecipient = "anonymized"
+)
+
+// AnonymizedMemberCode membuat kode member pengganti yang tetap unik per pelanggan
+func AnonymizedMemberCode(customerID int64) string {
+	return "ANON-" + strconv.FormatInt(customerID, 10)
+}
@@ -0,0 +1,12 @@
+package contact
+
+import "testing"
+
+func TestAnonymizedMemberCode(t *testing.T) {
+	if got := AnonymizedMemberCode(42); got != "ANON-42" {
+		t.Errorf("AnonymizedMemberCode(42) = %q, want ANON-42", got)
+	}
+	if AnonymizedMemberCode(1) == AnonymizedMemberCode(11) {
+		t.Errorf("AnonymizedMemberCode() is not unique per customer")
+	}
+}
@@ -0,0 +1,65 @@
+package contact
+
+import (
+	"math"
+	"strings"
+)
+
+// Alasan dua pelanggan dianggap duplikat
+const (
+	ReasonPhone = "phone"
+	ReasonEmail = "email"
+	ReasonName  = "name"
+)
+
+// Bobot skor duplikat, total maksimal 100
+const (
+	phoneWeight = 45
+	emailWeight = 35
+	nameWeight  = 20
+)
+
+// NameThreshold adalah batas kemiripan nama (0-1) agar nama dihitung sebagai alasan duplikat
+const NameThreshold = 0.5
+
+// Contact adalah data kontak pelanggan yang dibandingkan
+type Contact struct {
+	Name  string
+	Phone string
+	Email string
+}
+
+// Match adalah hasil perbandingan dua pelanggan
+type Match struct {
+	Score   int
+	Reasons []string
+}
+
+// Score menilai kemungkinan dua pelanggan adalah orang yang sama. Nomor telepon
+// dan email yang sama memberi bobot terbesar, kemiripan nama (trigram dari
+// database) menambah skor sebanding dengan kemiripannya.
+func Score(a, b Contact, nameSimilarity float64) Match {
+	match := Match{Reasons: []string{}}
+	score := 0.0
+
+	if phone := NormalizePhone(a.Phone); phone != "" && phone == NormalizePhone(b.Phone) {
+		score += phoneWeight
+		match.Reasons = append(match.Reasons, ReasonPhone)
+	}
+
+	if email := strings.TrimSpace(a.Email); email != "" && strings.EqualFold(email, strings.TrimSpace(b.Email)) {
+		score += emailWeight
+		match.Reasons = append(match.Reasons, ReasonEmail)
+	}
+
+	if strings.EqualFold(strings.TrimSpace(a.Name), strings.TrimSpace(b.Name)) {
+		nameSimilarity = 1
+	}
+	if nameSimilarity >= NameThreshold {
+		match.Reasons = append(match.Reasons, ReasonName)
+	}
+	score += nameWeight * math.Max(0, math.Min(nameSimilarity, 1))
+
+	match.Score = int(math.Round(score))
+	return match
+}
@@ -0,0 +1,59 @@
+package contact
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		name       string
+		a, b       Contact
+		similarity float64
+		want       Match
+	}{
+		{
+			name:       "same phone in another format, email and name",
+			a:          Contact{Name: "Budi Santoso", Phone: "08123456789", Email: "budi@example.com"},
+			b:          Contact{Name: "budi santoso ", Phone: "+62 812-3456-789", Email: "BUDI@example.com"},
+			similarity: 0.8,
+			want:       Match{Score: 100, Reasons: []string{ReasonPhone, ReasonEmail, ReasonName}},
+		},
+		{
+			name:       "same phone with a similar name",
+			a:          Contact{Name: "Budi Santoso", Phone: "08123456789"},
+			b:          Contact{Name: "Budi S", Phone: "628123456789"},
+			similarity: 0.5,
+			want:       Match{Score: 55, Reasons: []string{ReasonPhone, ReasonName}},
+		},
+		{
+			name:       "similar name below the threshold still adds to the score",
+			a:          Contact{Name: "Budi", Email: "budi@example.com"},
+			b:          Contact{Name: "Badu", Email: "budi@example.com"},
+			similarity: 0.25,
+			want:       Match{Score: 40, Reasons: []string{ReasonEmail}},
+		},
+		{
+			name:       "empty phone and email are not a match",
+			a:          Contact{Name: "Ani"},
+			b:          Contact{Name: "Joko"},
+			similarity: 0,
+			want:       Match{Score: 0, Reasons: []string{}},
+		},
+		{
+			name:       "similarity above 1 is capped",
+			a:          Contact{Name: "Ani"},
+			b:          Contact{Name: "Ani Putri"},
+			similarity: 1.5,
+			want:       Match{Score: 20, Reasons: []string{ReasonName}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Score(tt.a, tt.b, tt.similarity); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Score() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package contact
+
+import "strings"
+
+// NormalizePhone menyeragamkan nomor telepon Indonesia agar +62 812-3456,
+// 628123456 dan 08123456 dianggap sama. Harus sama dengan fungsi SQL normalize_phone.
+func NormalizePhone(phone string) string {
+	var b strings.Builder
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+
+	digits := b.String()
+	if strings.HasPrefix(digits, "62") {
+		digits = "0" + digits[2:]
+	}
+	if strings.HasPrefix(digits, "8") {
+		digits = "0" + digits
+	}
+	return digits
+}
+
+// LooksLikePhone mengecek apakah kata kunci pencarian berupa nomor telepon
+func LooksLikePhone(query string) bool {
+	digits := 0
+	for _, r := range query {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == '+' || r == '-' || r == ' ' || r == '(' || r == ')':
+		default:
+			return false
+		}
+	}
+	return digits >= 4
+}
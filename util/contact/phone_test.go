@@ -0,0 +1,45 @@
+package contact
+
+import "testing"
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		phone string
+		want  string
+	}{
+		{"08123456789", "08123456789"},
+		{"+62 812-3456-789", "08123456789"},
+		{"628123456789", "08123456789"},
+		{"8123456789", "08123456789"},
+		{"(021) 555 1234", "0215551234"},
+		{"", ""},
+		{"abc", ""},
+	}
+
+	for _, tt := range tests {
+		if got := NormalizePhone(tt.phone); got != tt.want {
+			t.Errorf("NormalizePhone(%q) = %q, want %q", tt.phone, got, tt.want)
+		}
+	}
+}
+
+func TestLooksLikePhone(t *testing.T) {
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"0812", true},
+		{"+62 812-3456", true},
+		{"(021) 555", true},
+		{"081", false},
+		{"M0001", false},
+		{"budi", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := LooksLikePhone(tt.query); got != tt.want {
+			t.Errorf("LooksLikePhone(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package costing
+
+import (
+	"errors"
+	"math"
+)
+
+// Metode penilaian persediaan
+const (
+	MethodFIFO    = "fifo"
+	MethodAverage = "average"
+)
+
+// Sumber cost layer
+const (
+	SourcePurchase   = "purchase"
+	SourceReturn     = "return"
+	SourceAdjustment = "adjustment"
+)
+
+var ErrUnknownMethod = errors.New("unknown inventory valuation method")
+
+// Validate memeriksa metode penilaian persediaan dari konfigurasi
+func Validate(method string) error {
+	switch method {
+	case MethodFIFO, MethodAverage:
+		return nil
+	}
+	return ErrUnknownMethod
+}
+
+// Layer adalah sisa stok dari satu penerimaan beserta harga belinya
+type Layer struct {
+	ID        int64
+	Remaining int32
+	UnitCost  float64
+}
+
+// Draw adalah jumlah stok yang diambil dari satu layer
+type Draw struct {
+	LayerID  int64
+	Quantity int32
+	UnitCost float64
+}
+
+// Issue adalah hasil perhitungan harga pokok untuk stok yang keluar
+type Issue struct {
+	Draws     []Draw
+	Shortfall int32   // jumlah yang tidak tertutup layer, misalnya saat stok minus
+	UnitCost  float64 // harga pokok rata-rata per unit yang keluar
+	Total     float64 // harga pokok seluruh unit yang keluar
+}
+
+// IssueStock menghitung harga pokok stok yang keluar. Layer selalu diambil
+// dari yang paling lama agar sisa layer tetap sama dengan stok, sedangkan
+// nilainya mengikuti metode: FIFO memakai harga tiap layer, average memakai
+// harga rata-rata produk. Kekurangan layer dinilai dengan harga rata-rata.
+func IssueStock(method string, layers []Layer, quantity int32, averageCost float64) Issue {
+	issue := Issue{Draws: make([]Draw, 0)}
+	if quantity <= 0 {
+		return issue
+	}
+
+	remaining := quantity
+	layerTotal := 0.0
+	for _, layer := range layers {
+		if remaining == 0 {
+			break
+		}
+		if layer.Remaining <= 0 {
+			continue
+		}
+		take := layer.Remaining
+		if take > remaining {
+			take = remaining
+		}
+		issue.Draws = append(issue.Draws, Draw{LayerID: layer.ID, Quantity: take, UnitCost: layer.UnitCost})
+		layerTotal += float64(take) * layer.UnitCost
+		remaining -= take
+	}
+	issue.Shortfall = remaining
+
+	if method == MethodAverage {
+		issue.Total = Round(float64(quantity) * averageCost)
+	} else {
+		issue.Total = Round(layerTotal + float64(issue.Shortfall)*averageCost)
+	}
+	issue.UnitCost = RoundCost(issue.Total / float64(quantity))
+	return issue
+}
+
+// Average menghitung harga rata-rata bergerak setelah stok bertambah.
+// Jika stok sebelumnya kosong atau minus, harga baru langsung dipakai.
+func Average(stock int32, cost float64, quantity int32, unitCost float64) float64 {
+	if quantity <= 0 {
+		return cost
+	}
+	if stock <= 0 {
+		return RoundCost(unitCost)
+	}
+	total := float64(stock)*cost + float64(quantity)*unitCost
+	return RoundCost(total / float64(stock+quantity))
+}
+
+// Round membulatkan nilai uang ke dua angka desimal
+func Round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+// RoundCost membulatkan harga pokok per unit ke empat angka desimal
+func RoundCost(value float64) float64 {
+	return math.Round(value*10000) / 10000
+}
@@ -0,0 +1,137 @@
+package costing
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIssueStock(t *testing.T) {
+	layers := []Layer{
+		{ID: 1, Remaining: 5, UnitCost: 1000},
+		{ID: 2, Remaining: 0, UnitCost: 9999},
+		{ID: 3, Remaining: 10, UnitCost: 1200},
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		layers      []Layer
+		quantity    int32
+		averageCost float64
+		want        Issue
+	}{
+		{
+			name:        "fifo takes the oldest layers first",
+			method:      MethodFIFO,
+			layers:      layers,
+			quantity:    8,
+			averageCost: 1100,
+			want: Issue{
+				Draws:    []Draw{{LayerID: 1, Quantity: 5, UnitCost: 1000}, {LayerID: 3, Quantity: 3, UnitCost: 1200}},
+				UnitCost: 1075,
+				Total:    8600,
+			},
+		},
+		{
+			name:        "fifo shortfall is valued at the average cost",
+			method:      MethodFIFO,
+			layers:      []Layer{{ID: 1, Remaining: 3, UnitCost: 1000}},
+			quantity:    5,
+			averageCost: 1100,
+			want: Issue{
+				Draws:     []Draw{{LayerID: 1, Quantity: 3, UnitCost: 1000}},
+				Shortfall: 2,
+				UnitCost:  1040,
+				Total:     5200,
+			},
+		},
+		{
+			name:        "no layers at all",
+			method:      MethodFIFO,
+			layers:      nil,
+			quantity:    2,
+			averageCost: 1500,
+			want: Issue{
+				Draws:     []Draw{},
+				Shortfall: 2,
+				UnitCost:  1500,
+				Total:     3000,
+			},
+		},
+		{
+			name:        "average still draws layers but values at the average cost",
+			method:      MethodAverage,
+			layers:      layers,
+			quantity:    8,
+			averageCost: 1100.5,
+			want: Issue{
+				Draws:    []Draw{{LayerID: 1, Quantity: 5, UnitCost: 1000}, {LayerID: 3, Quantity: 3, UnitCost: 1200}},
+				UnitCost: 1100.5,
+				Total:    8804,
+			},
+		},
+		{
+			name:     "unit cost is rounded to 4 decimals",
+			method:   MethodFIFO,
+			layers:   []Layer{{ID: 1, Remaining: 1, UnitCost: 1000}, {ID: 2, Remaining: 2, UnitCost: 1001}},
+			quantity: 3,
+			want: Issue{
+				Draws:    []Draw{{LayerID: 1, Quantity: 1, UnitCost: 1000}, {LayerID: 2, Quantity: 2, UnitCost: 1001}},
+				UnitCost: 1000.6667,
+				Total:    3002,
+			},
+		},
+		{
+			name:     "nothing issued",
+			method:   MethodFIFO,
+			layers:   layers,
+			quantity: 0,
+			want:     Issue{Draws: []Draw{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IssueStock(tt.method, tt.layers, tt.quantity, tt.averageCost)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IssueStock() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAverage(t *testing.T) {
+	tests := []struct {
+		name     string
+		stock    int32
+		cost     float64
+		quantity int32
+		unitCost float64
+		want     float64
+	}{
+		{"weighted by quantity", 10, 1000, 10, 1200, 1100},
+		{"rounded to 4 decimals", 2, 1000, 1, 1001, 1000.3333},
+		{"empty stock takes the new cost", 0, 1000, 5, 1234.56789, 1234.5679},
+		{"negative stock takes the new cost", -3, 1000, 5, 1200, 1200},
+		{"nothing received keeps the cost", 10, 1000, 0, 1200, 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Average(tt.stock, tt.cost, tt.quantity, tt.unitCost); got != tt.want {
+				t.Errorf("Average() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, method := range []string{MethodFIFO, MethodAverage} {
+		if err := Validate(method); err != nil {
+			t.Errorf("Validate(%q) error = %v", method, err)
+		}
+	}
+	if err := Validate("lifo"); err != ErrUnknownMethod {
+		t.Errorf("Validate(lifo) error = %v, want %v", err, ErrUnknownMethod)
+	}
+}
@@ -0,0 +1,134 @@
+package giftcard
+
+import (
+	"crypto/rand"
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Jenis saldo prabayar, sama dengan jenis metode pembayarannya
+const (
+	TypeGiftCard    = "gift_card"    // kartu hadiah dengan kode dan PIN
+	TypeStoreCredit = "store_credit" // saldo pelanggan, misalnya dari refund
+)
+
+// Status kartu
+const (
+	StatusPending = "pending" // dijual lewat order yang belum dibayar
+	StatusActive  = "active"
+	StatusVoid    = "void" // dibatalkan karena order penjualannya di-refund/kedaluwarsa
+	StatusExpired = "expired"
+)
+
+// Jenis mutasi saldo pada ledger
+const (
+	TxIssue    = "issue"    // saldo diterbitkan
+	TxRedeem   = "redeem"   // saldo dipakai sebagai pembayaran
+	TxReverse  = "reverse"  // saldo dari order yang di-refund/kedaluwarsa dikembalikan
+	TxExpire   = "expire"   // saldo hangus karena melewati masa berlaku
+	TxVoid     = "void"     // kartu dibatalkan
+	TxTransfer = "transfer" // saldo dipindahkan ke akun lain, misalnya saat pelanggan digabung
+)
+
+var (
+	ErrInvalidCard         = errors.New("gift card code or pin is invalid")
+	ErrInactive            = errors.New("gift card is not active")
+	ErrExpired             = errors.New("gift card has expired")
+	ErrInsufficientBalance = errors.New("gift card balance is not enough")
+	ErrMemberRequired      = errors.New("store credit can only be used by a member customer")
+	ErrNoStoreCredit       = errors.New("store credit balance is not enough")
+	ErrCardUsed            = errors.New("gift card sold in this order has already been used")
+	ErrCardLocked          = errors.New("gift card is locked after too many wrong pin attempts, try again later")
+)
+
+// Batas PIN salah sebelum gift card dikunci dan lama penguncian
+const (
+	MaxPINAttempts = 5
+	PINLockout     = 15 * time.Minute
+)
+
+// alfabet kode tanpa karakter yang mudah tertukar (0/O, 1/I/L)
+const codeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+// GenerateCode membuat kode unik dengan format PREFIX-XXXX-XXXX-XXXX
+func GenerateCode(prefix string) (string, error) {
+	groups := make([]string, 3)
+	for i := range groups {
+		group, err := random(codeAlphabet, 4)
+		if err != nil {
+			return "", err
+		}
+		groups[i] = group
+	}
+	return prefix + "-" + strings.Join(groups, "-"), nil
+}
+
+// GeneratePIN membuat PIN 6 digit
+func GeneratePIN() (string, error) {
+	return random("0123456789", 6)
+}
+
+// HashPIN meng-hash PIN sebelum disimpan
+func HashPIN(pin string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(pin), bcrypt.DefaultCost)
+	return string(hash), err
+}
+
+// CheckPIN membandingkan PIN dengan hash yang tersimpan
+func CheckPIN(hash string, pin string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(pin)) == nil
+}
+
+// Locked menandakan gift card masih dikunci karena terlalu banyak PIN salah
+func Locked(lockedUntil time.Time, now time.Time) bool {
+	return !lockedUntil.IsZero() && now.Before(lockedUntil)
+}
+
+// NormalizeCode menyeragamkan kode yang diketik kasir
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Usable mengecek apakah kartu dapat dipakai untuk membayar nominal tertentu
+func Usable(status string, expiresAt time.Time, balance float64, amount float64, now time.Time) error {
+	if status != StatusActive {
+		return ErrInactive
+	}
+	if !expiresAt.IsZero() && !now.Before(expiresAt) {
+		return ErrExpired
+	}
+	if Round(balance) < Round(amount) {
+		return ErrInsufficientBalance
+	}
+	return nil
+}
+
+// ExpiresAt mengembalikan tanggal hangus kartu yang diterbitkan pada waktu tertentu
+func ExpiresAt(from time.Time, days int) (time.Time, bool) {
+	if days <= 0 {
+		return time.Time{}, false
+	}
+	return from.AddDate(0, 0, days), true
+}
+
+func Round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+func random(alphabet string, length int) (string, error) {
+	b := make([]byte, length)
+	max := big.NewInt(int64(len(alphabet)))
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = alphabet[n.Int64()]
+	}
+	return string(b), nil
+}
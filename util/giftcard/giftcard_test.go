@@ -0,0 +1,102 @@
+package giftcard
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestUsable(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		status    string
+		expiresAt time.Time
+		balance   float64
+		amount    float64
+		err       error
+	}{
+		{"enough balance", StatusActive, time.Time{}, 50000, 20000, nil},
+		{"exact balance", StatusActive, now.Add(time.Hour), 20000, 20000, nil},
+		{"balance compared after rounding", StatusActive, time.Time{}, 19999.999, 20000, nil},
+		{"not enough balance", StatusActive, time.Time{}, 19999.99, 20000, ErrInsufficientBalance},
+		{"pending card", StatusPending, time.Time{}, 50000, 20000, ErrInactive},
+		{"void card", StatusVoid, time.Time{}, 50000, 20000, ErrInactive},
+		{"expires now", StatusActive, now, 50000, 20000, ErrExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Usable(tt.status, tt.expiresAt, tt.balance, tt.amount, now); !errors.Is(err, tt.err) {
+				t.Errorf("Usable() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestLocked(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		lockedUntil time.Time
+		want        bool
+	}{
+		{"never locked", time.Time{}, false},
+		{"still locked", now.Add(PINLockout), true},
+		{"lock ended", now.Add(-time.Second), false},
+		{"lock ends now", now, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Locked(tt.lockedUntil, now); got != tt.want {
+				t.Errorf("Locked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpiresAt(t *testing.T) {
+	from := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	if got, ok := ExpiresAt(from, 365); !ok || !got.Equal(time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ExpiresAt(365) = %v, %v", got, ok)
+	}
+	if _, ok := ExpiresAt(from, 0); ok {
+		t.Errorf("ExpiresAt(0) should never expire")
+	}
+}
+
+func TestGenerateCode(t *testing.T) {
+	code, err := GenerateCode("GC")
+	if err != nil {
+		t.Fatalf("GenerateCode() error = %v", err)
+	}
+	if !regexp.MustCompile(`^GC-[A-HJKMNP-Z2-9]{4}-[A-HJKMNP-Z2-9]{4}-[A-HJKMNP-Z2-9]{4}$`).MatchString(code) {
+		t.Errorf("GenerateCode() = %q", code)
+	}
+	if NormalizeCode(" gc-abcd-efgh-jkmn ") != "GC-ABCD-EFGH-JKMN" {
+		t.Errorf("NormalizeCode() did not normalize the code")
+	}
+}
+
+func TestPIN(t *testing.T) {
+	pin, err := GeneratePIN()
+	if err != nil || !regexp.MustCompile(`^[0-9]{6}$`).MatchString(pin) {
+		t.Fatalf("GeneratePIN() = %q, %v", pin, err)
+	}
+
+	hash, err := HashPIN(pin)
+	if err != nil {
+		t.Fatalf("HashPIN() error = %v", err)
+	}
+	if !CheckPIN(hash, pin) {
+		t.Errorf("CheckPIN() rejected the right pin")
+	}
+	if CheckPIN(hash, pin+"0") {
+		t.Errorf("CheckPIN() accepted a wrong pin")
+	}
+}
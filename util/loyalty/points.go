@@ -0,0 +1,132 @@
+package loyalty
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// Jenis mutasi poin pada ledger
+const (
+	TypeEarn    = "earn"    // poin didapat dari order
+	TypeRedeem  = "redeem"  // poin dipakai sebagai pembayaran
+	TypeReverse = "reverse" // poin dari order yang di-refund/kedaluwarsa ditarik kembali
+	TypeReturn  = "return"  // poin yang dipakai order yang di-refund dikembalikan
+	TypeExpire  = "expire"  // poin hangus karena melewati masa berlaku
+)
+
+var (
+	ErrMemberRequired     = errors.New("points can only be redeemed by a member customer")
+	ErrInsufficientPoints = errors.New("customer points are not enough")
+	ErrRedemptionDisabled = errors.New("points redemption is not enabled")
+)
+
+// Rules adalah aturan perolehan dan penukaran poin
+type Rules struct {
+	EarnAmount float64 // nominal belanja (Rp) untuk 1 poin, 0 berarti tidak ada perolehan poin
+	PointValue float64 // nilai 1 poin (Rp) saat ditukar sebagai pembayaran
+	ExpiryDays int     // masa berlaku poin, 0 berarti tidak pernah hangus
+}
+
+// Line adalah satu item order yang dihitung untuk perolehan poin
+type Line struct {
+	Amount     float64 // nominal item setelah diskon
+	Multiplier float64 // pengali poin kategori produk
+}
+
+// Earn menghitung poin yang didapat dari item order. Nominal yang dibayar
+// dengan poin tidak menghasilkan poin baru.
+func (r Rules) Earn(lines []Line, total float64, paidWithPoints float64) int32 {
+	if r.EarnAmount <= 0 || total <= 0 {
+		return 0
+	}
+
+	eligible := 0.0
+	for _, l := range lines {
+		multiplier := l.Multiplier
+		if multiplier < 0 {
+			multiplier = 0
+		}
+		eligible += l.Amount * multiplier
+	}
+
+	share := math.Max(total-paidWithPoints, 0) / total
+	return int32(math.Floor(eligible * share / r.EarnAmount))
+}
+
+// PointsFor menghitung poin yang dibutuhkan untuk membayar nominal tertentu
+func (r Rules) PointsFor(amount float64) (int32, error) {
+	if r.PointValue <= 0 {
+		return 0, ErrRedemptionDisabled
+	}
+	return int32(math.Ceil(math.Round(amount/r.PointValue*100) / 100)), nil
+}
+
+// ExpiresAt mengembalikan tanggal hangus poin yang didapat pada waktu tertentu
+func (r Rules) ExpiresAt(from time.Time) (time.Time, bool) {
+	if r.ExpiryDays <= 0 {
+		return time.Time{}, false
+	}
+	return from.AddDate(0, 0, r.ExpiryDays), true
+}
+
+// Lot adalah saldo poin dari satu mutasi masuk (earn/return) yang belum terpakai
+type Lot struct {
+	ID        int64
+	Remaining int32
+	ExpiresAt time.Time // zero berarti tidak pernah hangus
+}
+
+// Usage adalah poin yang diambil dari satu lot
+type Usage struct {
+	LotID     int64
+	Points    int32
+	Remaining int32
+}
+
+// Consume mengambil poin dari lot sesuai urutan (lot yang paling cepat hangus
+// lebih dulu). Dengan partial, poin yang kurang diabaikan dan jumlah yang
+// berhasil diambil dikembalikan.
+func Consume(lots []Lot, points int32, partial bool) ([]Usage, int32, error) {
+	usages := make([]Usage, 0)
+	needed := points
+	for _, lot := range lots {
+		if needed <= 0 {
+			break
+		}
+		if lot.Remaining <= 0 {
+			continue
+		}
+		take := lot.Remaining
+		if take > needed {
+			take = needed
+		}
+		usages = append(usages, Usage{LotID: lot.ID, Points: take, Remaining: lot.Remaining - take})
+		needed -= take
+	}
+
+	if needed > 0 && !partial {
+		return nil, 0, ErrInsufficientPoints
+	}
+	return usages, points - needed, nil
+}
+
+// LatestExpiry mengembalikan tanggal hangus terlama dari lot yang dipakai,
+// dipakai sebagai masa berlaku poin yang dikembalikan saat refund
+func LatestExpiry(lots []Lot, usages []Usage) (time.Time, bool) {
+	latest := time.Time{}
+	for _, u := range usages {
+		for _, lot := range lots {
+			if lot.ID != u.LotID {
+				continue
+			}
+			if lot.ExpiresAt.IsZero() {
+				return time.Time{}, false
+			}
+			if lot.ExpiresAt.After(latest) {
+				latest = lot.ExpiresAt
+			}
+		}
+	}
+	return latest, !latest.IsZero()
+}
@@ -0,0 +1,169 @@
+package loyalty
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConsume(t *testing.T) {
+	lots := []Lot{
+		{ID: 1, Remaining: 50},
+		{ID: 2, Remaining: 0},
+		{ID: 3, Remaining: 100},
+	}
+
+	tests := []struct {
+		name     string
+		points   int32
+		partial  bool
+		usages   []Usage
+		consumed int32
+		err      error
+	}{
+		{
+			name:     "first lot first",
+			points:   30,
+			usages:   []Usage{{LotID: 1, Points: 30, Remaining: 20}},
+			consumed: 30,
+		},
+		{
+			name:   "spans lots and skips empty ones",
+			points: 120,
+			usages: []Usage{
+				{LotID: 1, Points: 50, Remaining: 0},
+				{LotID: 3, Points: 70, Remaining: 30},
+			},
+			consumed: 120,
+		},
+		{
+			name:   "not enough points",
+			points: 151,
+			err:    ErrInsufficientPoints,
+		},
+		{
+			name:    "partial takes what is left",
+			points:  200,
+			partial: true,
+			usages: []Usage{
+				{LotID: 1, Points: 50, Remaining: 0},
+				{LotID: 3, Points: 100, Remaining: 0},
+			},
+			consumed: 150,
+		},
+		{
+			name:     "nothing to consume",
+			points:   0,
+			usages:   []Usage{},
+			consumed: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usages, consumed, err := Consume(lots, tt.points, tt.partial)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Consume() error = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if !reflect.DeepEqual(usages, tt.usages) {
+				t.Errorf("usages = %+v, want %+v", usages, tt.usages)
+			}
+			if consumed != tt.consumed {
+				t.Errorf("consumed = %d, want %d", consumed, tt.consumed)
+			}
+		})
+	}
+}
+
+func TestEarn(t *testing.T) {
+	rules := Rules{EarnAmount: 10000, PointValue: 100}
+	lines := []Line{
+		{Amount: 25000, Multiplier: 1},
+		{Amount: 10000, Multiplier: 2},
+	}
+
+	tests := []struct {
+		name           string
+		rules          Rules
+		lines          []Line
+		total          float64
+		paidWithPoints float64
+		want           int32
+	}{
+		{"multiplier per line, rounded down", rules, lines, 35000, 0, 4},
+		{"amount paid with points earns nothing", rules, lines, 35000, 17500, 2},
+		{"fully paid with points", rules, lines, 35000, 35000, 0},
+		{"negative multiplier counts as zero", rules, []Line{{Amount: 50000, Multiplier: -1}}, 50000, 0, 0},
+		{"earning disabled", Rules{PointValue: 100}, lines, 35000, 0, 0},
+		{"empty order", rules, nil, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rules.Earn(tt.lines, tt.total, tt.paidWithPoints); got != tt.want {
+				t.Errorf("Earn() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPointsFor(t *testing.T) {
+	tests := []struct {
+		name   string
+		rules  Rules
+		amount float64
+		want   int32
+		err    error
+	}{
+		{"exact", Rules{PointValue: 100}, 1000, 10, nil},
+		{"partial point is rounded up", Rules{PointValue: 100}, 1050, 11, nil},
+		{"float noise is not rounded up", Rules{PointValue: 0.1}, 0.3, 3, nil},
+		{"redemption disabled", Rules{}, 1000, 0, ErrRedemptionDisabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.rules.PointsFor(tt.amount)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("PointsFor() error = %v, want %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("PointsFor() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLatestExpiry(t *testing.T) {
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	lots := []Lot{
+		{ID: 1, Remaining: 10, ExpiresAt: jan},
+		{ID: 2, Remaining: 10, ExpiresAt: mar},
+		{ID: 3, Remaining: 10},
+	}
+
+	tests := []struct {
+		name   string
+		usages []Usage
+		want   time.Time
+		ok     bool
+	}{
+		{"latest of the used lots", []Usage{{LotID: 1}, {LotID: 2}}, mar, true},
+		{"lot without expiry never expires", []Usage{{LotID: 1}, {LotID: 3}}, time.Time{}, false},
+		{"no usage", nil, time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := LatestExpiry(lots, tt.usages)
+			if !got.Equal(tt.want) || ok != tt.ok {
+				t.Errorf("LatestExpiry() = %v, %v, want %v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package movement
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Tipe pergerakan stok pada product history
+const (
+	TypeSale           = "sale"
+	TypeRefund         = "refund"
+	TypePurchase       = "purchase"
+	TypeAdjustment     = "adjustment"
+	TypeTransfer       = "transfer"
+	TypeDamage         = "damage"
+	TypeExpiry         = "expiry"
+	TypeSupplierReturn = "supplier_return"
+)
+
+// Tipe lama sebelum pergerakan stok dibedakan, masih diterima oleh CreateProductHistory
+const (
+	LegacyIn  = "in"
+	LegacyOut = "out"
+)
+
+// Kode alasan pergerakan stok
+const (
+	ReasonOrder          = "order"
+	ReasonCustomerReturn = "customer_return"
+	ReasonPaymentExpired = "payment_expired"
+	ReasonPurchaseOrder  = "purchase_order"
+	ReasonShrinkage      = "shrinkage"
+	ReasonDamaged        = "damaged"
+	ReasonExpired        = "expired"
+	ReasonTheft          = "theft"
+	ReasonCountError     = "count_error"
+	ReasonFound          = "found"
+	ReasonCorrection     = "correction"
+	ReasonTransferIn     = "transfer_in"
+	ReasonTransferOut    = "transfer_out"
+	ReasonDefective      = "defective"
+	ReasonWrongItem      = "wrong_item"
+	ReasonOverstock      = "overstock"
+)
+
+// Arah pergerakan stok
+const (
+	DirectionIn   = 1  // stok bertambah
+	DirectionOut  = -1 // stok berkurang
+	DirectionBoth = 0  // arah mengikuti tanda jumlah
+)
+
+// Rule adalah arah dan kode alasan yang boleh dipakai sebuah tipe pergerakan
+type Rule struct {
+	Direction int
+	Reasons   []string
+	System    bool // hanya dicatat oleh transaksinya sendiri (order, refund, penerimaan barang)
+}
+
+// Rules adalah daftar tipe pergerakan stok yang dikenal
+var Rules = map[string]Rule{
+	TypeSale:           {Direction: DirectionOut, Reasons: []string{ReasonOrder}, System: true},
+	TypeRefund:         {Direction: DirectionIn, Reasons: []string{ReasonCustomerReturn, ReasonPaymentExpired}, System: true},
+	TypePurchase:       {Direction: DirectionIn, Reasons: []string{ReasonPurchaseOrder}, System: true},
+	TypeAdjustment:     {Direction: DirectionBoth, Reasons: []string{ReasonShrinkage, ReasonDamaged, ReasonExpired, ReasonTheft, ReasonCountError, ReasonFound, ReasonCorrection}},
+	TypeTransfer:       {Direction: DirectionBoth, Reasons: []string{ReasonTransferIn, ReasonTransferOut}},
+	TypeDamage:         {Direction: DirectionOut, Reasons: []string{ReasonDamaged}},
+	TypeExpiry:         {Direction: DirectionOut, Reasons: []string{ReasonExpired}},
+	TypeSupplierReturn: {Direction: DirectionOut, Reasons: []string{ReasonDefective, ReasonWrongItem, ReasonOverstock}},
+}
+
+var (
+	ErrUnknownType    = errors.New("type must be one of sale, refund, purchase, adjustment, transfer, damage, expiry or supplier_return")
+	ErrSystemType     = errors.New("sale, refund and purchase movements are recorded by their own transactions")
+	ErrZeroQuantity   = errors.New("quantity_change must not be zero")
+	ErrNegativeAmount = errors.New("quantity_change must be positive for this movement type")
+)
+
+// Movement adalah pergerakan stok yang sudah dinormalisasi, Quantity bertanda
+// positif untuk stok masuk dan negatif untuk stok keluar
+type Movement struct {
+	Type       string
+	ReasonCode string
+	Quantity   int32
+}
+
+// Normalize memvalidasi pergerakan stok yang diinput manual. Tipe lama "in"/"out"
+// diubah menjadi adjustment dengan alasan correction. Tipe satu arah menerima
+// jumlah positif, tipe dua arah memakai tanda jumlah sebagai arah.
+func Normalize(movementType string, reasonCode string, quantity int32) (Movement, error) {
+	switch movementType {
+	case LegacyIn, LegacyOut:
+		if quantity <= 0 {
+			return Movement{}, ErrNegativeAmount
+		}
+		if reasonCode == "" {
+			reasonCode = ReasonCorrection
+		}
+		if movementType == LegacyOut {
+			quantity = -quantity
+		}
+		movementType = TypeAdjustment
+	}
+
+	rule, ok := Rules[movementType]
+	if !ok {
+		return Movement{}, ErrUnknownType
+	}
+	if rule.System {
+		return Movement{}, ErrSystemType
+	}
+	if quantity == 0 {
+		return Movement{}, ErrZeroQuantity
+	}
+	if err := ValidateReason(movementType, reasonCode); err != nil {
+		return Movement{}, err
+	}
+
+	switch rule.Direction {
+	case DirectionOut:
+		if quantity < 0 {
+			return Movement{}, ErrNegativeAmount
+		}
+		quantity = -quantity
+	case DirectionIn:
+		if quantity < 0 {
+			return Movement{}, ErrNegativeAmount
+		}
+	}
+	return Movement{Type: movementType, ReasonCode: reasonCode, Quantity: quantity}, nil
+}
+
+// ValidateReason memeriksa apakah kode alasan boleh dipakai tipe pergerakan tersebut
+func ValidateReason(movementType string, reasonCode string) error {
+	rule, ok := Rules[movementType]
+	if !ok {
+		return ErrUnknownType
+	}
+	for _, reason := range rule.Reasons {
+		if reason == reasonCode {
+			return nil
+		}
+	}
+	return fmt.Errorf("reason_code for %s must be one of %v", movementType, rule.Reasons)
+}
+
+// ValidateType memeriksa tipe pergerakan stok, dipakai untuk filter
+func ValidateType(movementType string) error {
+	if _, ok := Rules[movementType]; !ok {
+		return ErrUnknownType
+	}
+	return nil
+}
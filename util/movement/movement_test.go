@@ -0,0 +1,134 @@
+package movement
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name       string
+		typ        string
+		reasonCode string
+		quantity   int32
+		want       Movement
+		err        error
+		anyErr     bool
+	}{
+		{
+			name:     "legacy in becomes a positive correction",
+			typ:      LegacyIn,
+			quantity: 5,
+			want:     Movement{Type: TypeAdjustment, ReasonCode: ReasonCorrection, Quantity: 5},
+		},
+		{
+			name:     "legacy out becomes a negative correction",
+			typ:      LegacyOut,
+			quantity: 5,
+			want:     Movement{Type: TypeAdjustment, ReasonCode: ReasonCorrection, Quantity: -5},
+		},
+		{
+			name:       "legacy in keeps a given adjustment reason",
+			typ:        LegacyIn,
+			reasonCode: ReasonFound,
+			quantity:   2,
+			want:       Movement{Type: TypeAdjustment, ReasonCode: ReasonFound, Quantity: 2},
+		},
+		{
+			name:     "legacy out with a signed quantity",
+			typ:      LegacyOut,
+			quantity: -5,
+			err:      ErrNegativeAmount,
+		},
+		{
+			name:     "legacy in with zero quantity",
+			typ:      LegacyIn,
+			quantity: 0,
+			err:      ErrNegativeAmount,
+		},
+		{
+			name:       "outgoing type is negated",
+			typ:        TypeDamage,
+			reasonCode: ReasonDamaged,
+			quantity:   3,
+			want:       Movement{Type: TypeDamage, ReasonCode: ReasonDamaged, Quantity: -3},
+		},
+		{
+			name:       "outgoing type rejects a signed quantity",
+			typ:        TypeSupplierReturn,
+			reasonCode: ReasonDefective,
+			quantity:   -3,
+			err:        ErrNegativeAmount,
+		},
+		{
+			name:       "adjustment keeps the sign",
+			typ:        TypeAdjustment,
+			reasonCode: ReasonShrinkage,
+			quantity:   -4,
+			want:       Movement{Type: TypeAdjustment, ReasonCode: ReasonShrinkage, Quantity: -4},
+		},
+		{
+			name:       "transfer out",
+			typ:        TypeTransfer,
+			reasonCode: ReasonTransferOut,
+			quantity:   -4,
+			want:       Movement{Type: TypeTransfer, ReasonCode: ReasonTransferOut, Quantity: -4},
+		},
+		{
+			name:       "system type",
+			typ:        TypeSale,
+			reasonCode: ReasonOrder,
+			quantity:   -1,
+			err:        ErrSystemType,
+		},
+		{
+			name:     "unknown type",
+			typ:      "lost",
+			quantity: 1,
+			err:      ErrUnknownType,
+		},
+		{
+			name:       "zero quantity",
+			typ:        TypeAdjustment,
+			reasonCode: ReasonCountError,
+			quantity:   0,
+			err:        ErrZeroQuantity,
+		},
+		{
+			name:       "reason not allowed for the type",
+			typ:        TypeDamage,
+			reasonCode: ReasonTheft,
+			quantity:   1,
+			anyErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.typ, tt.reasonCode, tt.quantity)
+			if tt.anyErr {
+				if err == nil {
+					t.Fatalf("Normalize() error = nil, want an error")
+				}
+				return
+			}
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Normalize() error = %v, want %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateType(t *testing.T) {
+	for typ := range Rules {
+		if err := ValidateType(typ); err != nil {
+			t.Errorf("ValidateType(%q) error = %v", typ, err)
+		}
+	}
+	if err := ValidateType(LegacyIn); err != ErrUnknownType {
+		t.Errorf("ValidateType(%q) error = %v, want %v", LegacyIn, err, ErrUnknownType)
+	}
+}
@@ -0,0 +1,64 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// HTTPNotifier mengirim pesan ke WhatsApp gateway generik lewat HTTP POST JSON
+// {"to": "...", "message": "..."} dengan header Authorization Bearer token.
+type HTTPNotifier struct {
+	channel string
+	url     string
+	token   string
+	client  *http.Client
+}
+
+func NewHTTPNotifier(channel string, url string, token string) *HTTPNotifier {
+	return &HTTPNotifier{
+		channel: channel,
+		url:     url,
+		token:   token,
+		client:  &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+func (n *HTTPNotifier) Channel() string {
+	return n.channel
+}
+
+func (n *HTTPNotifier) Send(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(map[string]string{
+		"to":      msg.To,
+		"message": msg.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if n.token != "" {
+		req.Header.Set("Authorization", "Bearer "+n.token)
+	}
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("%s gateway responded %d: %s", n.channel, res.StatusCode, bytes.TrimSpace(detail))
+	}
+	return nil
+}
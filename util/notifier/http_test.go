@@ -0,0 +1,39 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHTTPNotifierSend(t *testing.T) {
+	var got map[string]string
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		json.NewDecoder(r.Body).Decode(&got)
+		if got["to"] == "0800" {
+			http.Error(w, "invalid number", http.StatusUnprocessableEntity)
+		}
+	}))
+	defer server.Close()
+
+	n := NewHTTPNotifier(ChannelWhatsApp, server.URL, "token")
+	if err := n.Send(context.Background(), Message{To: "08123456789", Body: "Total 10000"}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if got["to"] != "08123456789" || got["message"] != "Total 10000" {
+		t.Errorf("payload = %v", got)
+	}
+	if auth != "Bearer token" {
+		t.Errorf("Authorization = %q, want Bearer token", auth)
+	}
+
+	err := n.Send(context.Background(), Message{To: "0800", Body: "Total 10000"})
+	if err == nil || !strings.Contains(err.Error(), "422") || !strings.Contains(err.Error(), "invalid number") {
+		t.Errorf("Send() error = %v, want the gateway status and detail", err)
+	}
+}
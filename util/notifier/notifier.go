@@ -0,0 +1,87 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+)
+
+// Channel pengiriman notifikasi
+const (
+	ChannelEmail    = "email"
+	ChannelWhatsApp = "whatsapp"
+)
+
+// Status notifikasi di outbox
+const (
+	StatusPending = "pending"
+	StatusSent    = "sent"
+	StatusFailed  = "failed"
+)
+
+// MaxAttempts adalah batas percobaan kirim sebelum pesan ditandai gagal
+const MaxAttempts = 5
+
+var (
+	ErrUnknownChannel = errors.New("notification channel is not configured")
+	ErrNoRecipient    = errors.New("notification recipient is required")
+)
+
+// Message adalah pesan yang dikirim ke pelanggan
+type Message struct {
+	To      string
+	Subject string
+	Body    string // isi pesan teks biasa
+	HTML    string // isi pesan HTML, hanya dipakai email
+}
+
+// Notifier adalah kontrak untuk setiap channel pengiriman (SMTP, WhatsApp gateway, dll)
+type Notifier interface {
+	Channel() string
+	Send(ctx context.Context, msg Message) error
+}
+
+// Registry menyimpan notifier yang aktif per channel
+type Registry map[string]Notifier
+
+// NewRegistry membuat registry dari notifier yang dikonfigurasi
+func NewRegistry(notifiers ...Notifier) Registry {
+	r := make(Registry)
+	for _, n := range notifiers {
+		if n != nil {
+			r[n.Channel()] = n
+		}
+	}
+	return r
+}
+
+// Enabled menandakan channel sudah dikonfigurasi
+func (r Registry) Enabled(channel string) bool {
+	_, ok := r[channel]
+	return ok
+}
+
+// Send mengirim pesan lewat notifier channel yang dipilih
+func (r Registry) Send(ctx context.Context, channel string, msg Message) error {
+	n, ok := r[channel]
+	if !ok {
+		return ErrUnknownChannel
+	}
+	if msg.To == "" {
+		return ErrNoRecipient
+	}
+	return n.Send(ctx, msg)
+}
+
+// Backoff adalah jeda sebelum percobaan kirim berikutnya: 1, 2, 4, 8 ... menit, maksimal 1 jam
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	delay := time.Duration(math.Pow(2, float64(attempt-1))) * time.Minute
+	if delay > time.Hour {
+		return time.Hour
+	}
+	return delay
+}
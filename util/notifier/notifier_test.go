@@ -0,0 +1,73 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, time.Minute},
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{5, 16 * time.Minute},
+		{7, time.Hour},
+		{20, time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := Backoff(tt.attempt); got != tt.want {
+			t.Errorf("Backoff(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+type recordNotifier struct {
+	sent []Message
+}
+
+func (n *recordNotifier) Channel() string {
+	return ChannelWhatsApp
+}
+
+func (n *recordNotifier) Send(ctx context.Context, msg Message) error {
+	n.sent = append(n.sent, msg)
+	return nil
+}
+
+func TestRegistrySend(t *testing.T) {
+	whatsapp := &recordNotifier{}
+	registry := NewRegistry(whatsapp, nil)
+
+	tests := []struct {
+		name    string
+		channel string
+		to      string
+		err     error
+	}{
+		{"configured channel", ChannelWhatsApp, "08123456789", nil},
+		{"channel not configured", ChannelEmail, "customer@example.com", ErrUnknownChannel},
+		{"missing recipient", ChannelWhatsApp, "", ErrNoRecipient},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := registry.Send(context.Background(), tt.channel, Message{To: tt.to}); !errors.Is(err, tt.err) {
+				t.Errorf("Send() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+
+	if len(whatsapp.sent) != 1 || whatsapp.sent[0].To != "08123456789" {
+		t.Errorf("sent = %+v, want one message to 08123456789", whatsapp.sent)
+	}
+	if registry.Enabled(ChannelEmail) || !registry.Enabled(ChannelWhatsApp) {
+		t.Errorf("Enabled() does not match the configured channels")
+	}
+}
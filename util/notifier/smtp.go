@@ -0,0 +1,69 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// SMTPNotifier mengirim email lewat server SMTP. Tanpa username, email dikirim
+// tanpa autentikasi sehingga bisa diuji dengan SMTP sink lokal (MailHog, Mailpit).
+type SMTPNotifier struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+}
+
+func NewSMTPNotifier(host string, port int, username string, password string, from string) *SMTPNotifier {
+	return &SMTPNotifier{host, port, username, password, from}
+}
+
+func (n *SMTPNotifier) Channel() string {
+	return ChannelEmail
+}
+
+func (n *SMTPNotifier) Send(ctx context.Context, msg Message) error {
+	var auth smtp.Auth
+	if n.username != "" {
+		auth = smtp.PlainAuth("", n.username, n.password, n.host)
+	}
+
+	// SMTP_FROM boleh berisi nama, contoh "POS Store <no-reply@pos.local>"
+	from, err := mail.ParseAddress(n.from)
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(n.host, strconv.Itoa(n.port))
+	return smtp.SendMail(addr, auth, from.Address, []string{msg.To}, n.build(msg))
+}
+
+func (n *SMTPNotifier) build(msg Message) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", n.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	if msg.HTML == "" {
+		buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+		buf.WriteString(msg.Body)
+		return buf.Bytes()
+	}
+
+	boundary := fmt.Sprintf("pos-%d", time.Now().UnixNano())
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", boundary)
+	fmt.Fprintf(&buf, "--%s\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n%s\r\n", boundary, msg.Body)
+	fmt.Fprintf(&buf, "--%s\r\nContent-Type: text/html; charset=utf-8\r\n\r\n%s\r\n", boundary, msg.HTML)
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+	return buf.Bytes()
+}
@@ -0,0 +1,172 @@
+package notifier
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// smtpSink adalah server SMTP lokal di dalam proses test yang menyimpan email yang diterima
+type smtpSink struct {
+	ln         net.Listener
+	rejectRcpt bool
+
+	mu   sync.Mutex
+	from string
+	to   []string
+	data string
+}
+
+func newSMTPSink(t *testing.T) *smtpSink {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	s := &smtpSink{ln: ln}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.handle(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpSink) port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpSink) handle(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 sink ready")
+
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			tp.PrintfLine("250 sink")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			s.mu.Lock()
+			s.from = address(line)
+			s.mu.Unlock()
+			tp.PrintfLine("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			if s.rejectRcpt {
+				tp.PrintfLine("550 mailbox unavailable")
+				continue
+			}
+			s.mu.Lock()
+			s.to = append(s.to, address(line))
+			s.mu.Unlock()
+			tp.PrintfLine("250 OK")
+		case command == "DATA":
+			tp.PrintfLine("354 end data with <CR><LF>.<CR><LF>")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.data = string(data)
+			s.mu.Unlock()
+			tp.PrintfLine("250 OK")
+		case command == "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("250 OK")
+		}
+	}
+}
+
+func address(line string) string {
+	start, end := strings.Index(line, "<"), strings.Index(line, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return line[start+1 : end]
+}
+
+func TestSMTPNotifierSend(t *testing.T) {
+	tests := []struct {
+		name     string
+		msg      Message
+		contains []string
+	}{
+		{
+			name: "plain text",
+			msg:  Message{To: "customer@example.com", Subject: "Struk TRX-1", Body: "Total 10000"},
+			contains: []string{
+				"From: POS Store <no-reply@pos.local>",
+				"To: customer@example.com",
+				"Subject: Struk TRX-1",
+				"Content-Type: text/plain; charset=utf-8",
+				"Total 10000",
+			},
+		},
+		{
+			name: "html with text alternative",
+			msg:  Message{To: "customer@example.com", Subject: "Struk TRX-2", Body: "Total 25000", HTML: "<p>Total 25000</p>"},
+			contains: []string{
+				"Content-Type: multipart/alternative",
+				"Content-Type: text/plain; charset=utf-8\n\nTotal 25000",
+				"Content-Type: text/html; charset=utf-8\n\n<p>Total 25000</p>",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := newSMTPSink(t)
+			n := NewSMTPNotifier("127.0.0.1", sink.port(), "", "", "POS Store <no-reply@pos.local>")
+
+			if err := n.Send(context.Background(), tt.msg); err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+
+			sink.mu.Lock()
+			defer sink.mu.Unlock()
+			if sink.from != "no-reply@pos.local" {
+				t.Errorf("MAIL FROM = %q, want no-reply@pos.local", sink.from)
+			}
+			if len(sink.to) != 1 || sink.to[0] != tt.msg.To {
+				t.Errorf("RCPT TO = %v, want %s", sink.to, tt.msg.To)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(sink.data, want) {
+					t.Errorf("message does not contain %q:\n%s", want, sink.data)
+				}
+			}
+		})
+	}
+}
+
+func TestSMTPNotifierErrors(t *testing.T) {
+	sink := newSMTPSink(t)
+	sink.rejectRcpt = true
+
+	n := NewSMTPNotifier("127.0.0.1", sink.port(), "", "", "no-reply@pos.local")
+	if err := n.Send(context.Background(), Message{To: "unknown@example.com", Body: "Total"}); err == nil {
+		t.Errorf("Send() to a rejected recipient error = nil")
+	}
+
+	n = NewSMTPNotifier("127.0.0.1", sink.port(), "", "", "not an address")
+	if err := n.Send(context.Background(), Message{To: "customer@example.com", Body: "Total"}); err == nil {
+		t.Errorf("Send() with an invalid SMTP_FROM error = nil")
+	}
+}
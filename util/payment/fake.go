@@ -0,0 +1,118 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// FakeProvider adalah payment gateway tiruan untuk pengembangan lokal.
+// Charge disimpan di memori dan status diubah lewat webhook yang ditandatangani
+// dengan HMAC-SHA256 dari body menggunakan secret yang sama.
+type FakeProvider struct {
+	secret  string
+	expiry  time.Duration
+	mu      sync.Mutex
+	charges map[string]Charge
+	refunds map[string]string // idempotency key refund ke charge id
+}
+
+type fakeWebhook struct {
+	ChargeID string    `json:"charge_id"`
+	Status   string    `json:"status"`
+	PaidAt   time.Time `json:"paid_at"`
+}
+
+func NewFakeProvider(secret string, expiry time.Duration) *FakeProvider {
+	return &FakeProvider{secret: secret, expiry: expiry, charges: make(map[string]Charge), refunds: make(map[string]string)}
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakeProvider) CreateCharge(ctx context.Context, req ChargeRequest) (Charge, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	id := fmt.Sprintf("FAKE-%s-%d", req.Reference, time.Now().UnixNano())
+	charge := Charge{
+		ID:         id,
+		Reference:  req.Reference,
+		Amount:     req.Amount,
+		Status:     StatusPending,
+		PaymentURL: "https://fake-gateway.local/pay/" + id,
+		QRString:   fmt.Sprintf("FAKEQRIS|%s|%.2f", id, req.Amount),
+		ExpiresAt:  time.Now().Add(p.expiry),
+	}
+	p.charges[id] = charge
+	return charge, nil
+}
+
+func (p *FakeProvider) QueryStatus(ctx context.Context, chargeID string) (Charge, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	charge, ok := p.charges[chargeID]
+	if !ok {
+		return Charge{}, ErrChargeNotFound
+	}
+	if charge.Status == StatusPending && !charge.ExpiresAt.IsZero() && time.Now().After(charge.ExpiresAt) {
+		charge.Status = StatusExpired
+		p.charges[chargeID] = charge
+	}
+	return charge, nil
+}
+
+func (p *FakeProvider) Refund(ctx context.Context, chargeID string, amount float64, key string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if refunded, ok := p.refunds[key]; ok && refunded == chargeID {
+		return nil
+	}
+	charge, ok := p.charges[chargeID]
+	if !ok {
+		return ErrChargeNotFound
+	}
+	if charge.Status != StatusPaid || amount > charge.Amount {
+		return ErrNotRefundable
+	}
+	charge.Status = StatusRefunded
+	p.charges[chargeID] = charge
+	p.refunds[key] = chargeID
+	return nil
+}
+
+func (p *FakeProvider) ParseWebhook(body []byte, signature string) (Event, error) {
+	if !hmac.Equal([]byte(p.Sign(body)), []byte(signature)) {
+		return Event{}, ErrInvalidSignature
+	}
+
+	var payload fakeWebhook
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return Event{}, err
+	}
+
+	p.mu.Lock()
+	if charge, ok := p.charges[payload.ChargeID]; ok {
+		charge.Status = payload.Status
+		charge.PaidAt = payload.PaidAt
+		p.charges[payload.ChargeID] = charge
+	}
+	p.mu.Unlock()
+
+	return Event{ChargeID: payload.ChargeID, Status: payload.Status, PaidAt: payload.PaidAt}, nil
+}
+
+// Sign menghasilkan signature webhook (hex HMAC-SHA256) untuk body yang diberikan
+func (p *FakeProvider) Sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(p.secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
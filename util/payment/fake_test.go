@@ -0,0 +1,90 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestFakeProviderParseWebhook(t *testing.T) {
+	provider := NewFakeProvider("secret", time.Minute)
+	body := []byte(`{"charge_id":"FAKE-1","status":"paid"}`)
+
+	tests := []struct {
+		name      string
+		body      []byte
+		signature string
+		err       error
+	}{
+		{"valid signature", body, provider.Sign(body), nil},
+		{"missing signature", body, "", ErrInvalidSignature},
+		{"signed with another secret", body, NewFakeProvider("other", time.Minute).Sign(body), ErrInvalidSignature},
+		{"body changed after signing", []byte(`{"charge_id":"FAKE-1","status":"expired"}`), provider.Sign(body), ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := provider.ParseWebhook(tt.body, tt.signature)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseWebhook() error = %v, want %v", err, tt.err)
+			}
+			if tt.err == nil && (event.ChargeID != "FAKE-1" || event.Status != StatusPaid) {
+				t.Errorf("ParseWebhook() = %+v, want FAKE-1 paid", event)
+			}
+		})
+	}
+}
+
+func TestFakeProviderRefund(t *testing.T) {
+	ctx := context.Background()
+	provider := NewFakeProvider("secret", time.Minute)
+
+	charge, err := provider.CreateCharge(ctx, ChargeRequest{Reference: "TRX-1", Method: "qris", Amount: 20000})
+	if err != nil {
+		t.Fatalf("CreateCharge() error = %v", err)
+	}
+	if err := provider.Refund(ctx, charge.ID, 20000, RefundKey(1)); !errors.Is(err, ErrNotRefundable) {
+		t.Fatalf("Refund() of an unpaid charge error = %v, want %v", err, ErrNotRefundable)
+	}
+
+	pay := []byte(fmt.Sprintf(`{"charge_id":%q,"status":"paid"}`, charge.ID))
+	if _, err := provider.ParseWebhook(pay, provider.Sign(pay)); err != nil {
+		t.Fatalf("ParseWebhook() error = %v", err)
+	}
+
+	if err := provider.Refund(ctx, charge.ID, 20000, RefundKey(1)); err != nil {
+		t.Fatalf("Refund() error = %v", err)
+	}
+	// retry dengan key yang sama tidak mengembalikan dana dua kali
+	if err := provider.Refund(ctx, charge.ID, 20000, RefundKey(1)); err != nil {
+		t.Errorf("Refund() retry error = %v, want nil", err)
+	}
+	if err := provider.Refund(ctx, charge.ID, 20000, RefundKey(2)); !errors.Is(err, ErrNotRefundable) {
+		t.Errorf("Refund() with another key error = %v, want %v", err, ErrNotRefundable)
+	}
+
+	remote, err := provider.QueryStatus(ctx, charge.ID)
+	if err != nil || remote.Status != StatusRefunded {
+		t.Errorf("QueryStatus() = %q, %v, want %q", remote.Status, err, StatusRefunded)
+	}
+}
+
+func TestFakeProviderExpiry(t *testing.T) {
+	ctx := context.Background()
+	provider := NewFakeProvider("secret", -time.Second)
+
+	charge, err := provider.CreateCharge(ctx, ChargeRequest{Reference: "TRX-1", Method: "qris", Amount: 20000})
+	if err != nil {
+		t.Fatalf("CreateCharge() error = %v", err)
+	}
+
+	remote, err := provider.QueryStatus(ctx, charge.ID)
+	if err != nil || remote.Status != StatusExpired {
+		t.Errorf("QueryStatus() = %q, %v, want %q", remote.Status, err, StatusExpired)
+	}
+	if _, err := provider.QueryStatus(ctx, "FAKE-unknown"); !errors.Is(err, ErrChargeNotFound) {
+		t.Errorf("QueryStatus() error = %v, want %v", err, ErrChargeNotFound)
+	}
+}
@@ -0,0 +1,106 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Status charge pada payment gateway
+const (
+	StatusPending  = "pending"
+	StatusPaid     = "paid"
+	StatusExpired  = "expired"
+	StatusFailed   = "failed"
+	StatusRefunded = "refunded"
+)
+
+// Status refund yang dicatat sebelum payment gateway dipanggil
+const (
+	RefundPending   = "pending"
+	RefundSucceeded = "succeeded"
+	RefundFailed    = "failed"
+)
+
+// MaxRefundAttempts adalah batas percobaan refund ke payment gateway sebelum
+// refund ditandai gagal dan perlu ditangani manual
+const MaxRefundAttempts = 5
+
+var (
+	ErrChargeNotFound   = errors.New("charge not found")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrNotRefundable    = errors.New("charge can not be refunded")
+)
+
+// ChargeRequest adalah permintaan pembayaran ke payment gateway
+type ChargeRequest struct {
+	Reference string // nomor transaksi order
+	Method    string // kode metode pembayaran, contoh qris
+	Amount    float64
+}
+
+// Charge adalah data pembayaran yang dikembalikan payment gateway
+type Charge struct {
+	ID         string
+	Reference  string
+	Amount     float64
+	Status     string
+	PaymentURL string
+	QRString   string
+	ExpiresAt  time.Time
+	PaidAt     time.Time
+}
+
+// Event adalah notifikasi perubahan status dari webhook payment gateway
+type Event struct {
+	ChargeID string
+	Status   string
+	PaidAt   time.Time
+}
+
+// Provider adalah kontrak yang harus dipenuhi setiap integrasi payment gateway
+// (QRIS, e-wallet, dll)
+type Provider interface {
+	Name() string
+	CreateCharge(ctx context.Context, req ChargeRequest) (Charge, error)
+	QueryStatus(ctx context.Context, chargeID string) (Charge, error)
+	// Refund harus idempoten terhadap key: panggilan ulang dengan key yang sama
+	// tidak boleh mengembalikan dana dua kali
+	Refund(ctx context.Context, chargeID string, amount float64, key string) error
+	// ParseWebhook memverifikasi signature lalu mengurai body webhook
+	ParseWebhook(body []byte, signature string) (Event, error)
+}
+
+// RefundKey adalah idempotency key refund yang diturunkan dari id baris refund
+func RefundKey(refundID int64) string {
+	return fmt.Sprintf("refund-%d", refundID)
+}
+
+// IsFinal menandakan status charge tidak akan berubah lagi
+func IsFinal(status string) bool {
+	return status != StatusPending
+}
+
+// Tindakan saat status charge diterima dari webhook atau query ke payment gateway
+const (
+	ActionIgnore     = "ignore"      // status sama atau charge sudah final
+	ActionApply      = "apply"       // charge pending berpindah status
+	ActionRefundLate = "refund_late" // dana masuk setelah charge ditutup, harus dikembalikan
+)
+
+// Transition menentukan tindakan untuk status baru sebuah charge. Pembayaran yang
+// masuk setelah charge kedaluwarsa atau gagal tidak diabaikan karena pelanggan
+// sudah membayar, sementara ordernya sudah ditutup dan stoknya dilepas.
+func Transition(current string, incoming string) string {
+	if current == incoming {
+		return ActionIgnore
+	}
+	if current == StatusPending {
+		return ActionApply
+	}
+	if incoming == StatusPaid && (current == StatusExpired || current == StatusFailed) {
+		return ActionRefundLate
+	}
+	return ActionIgnore
+}
@@ -0,0 +1,30 @@
+package payment
+
+import "testing"
+
+func TestTransition(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		incoming string
+		want     string
+	}{
+		{"pending charge is paid", StatusPending, StatusPaid, ActionApply},
+		{"pending charge expires", StatusPending, StatusExpired, ActionApply},
+		{"pending charge fails", StatusPending, StatusFailed, ActionApply},
+		{"repeated paid webhook", StatusPaid, StatusPaid, ActionIgnore},
+		{"repeated pending status", StatusPending, StatusPending, ActionIgnore},
+		{"paid after expiry is refunded", StatusExpired, StatusPaid, ActionRefundLate},
+		{"paid after failure is refunded", StatusFailed, StatusPaid, ActionRefundLate},
+		{"paid charge can not expire", StatusPaid, StatusExpired, ActionIgnore},
+		{"refunded charge stays refunded", StatusRefunded, StatusPaid, ActionIgnore},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Transition(tt.current, tt.incoming); got != tt.want {
+				t.Errorf("Transition(%q, %q) = %q, want %q", tt.current, tt.incoming, got, tt.want)
+			}
+		})
+	}
+}
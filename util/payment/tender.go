@@ -0,0 +1,130 @@
+package payment
+
+import (
+	"errors"
+	"math"
+)
+
+// Jenis metode pembayaran
+const (
+	TypeCash        = "cash"
+	TypeCard        = "card"
+	TypeEWallet     = "ewallet"
+	TypeTransfer    = "transfer"
+	TypePoints      = "points"       // ditukar dengan poin loyalti pelanggan
+	TypeGiftCard    = "gift_card"    // saldo gift card, kode di reference dan PIN
+	TypeStoreCredit = "store_credit" // saldo store credit pelanggan member
+	TypeOther       = "other"
+)
+
+var (
+	ErrNoTender          = errors.New("at least one payment is required")
+	ErrUnknownMethod     = errors.New("payment method is not available")
+	ErrInvalidAmount     = errors.New("payment amount must be greater than 0")
+	ErrUnderpaid         = errors.New("payments do not cover the order total")
+	ErrOverpaid          = errors.New("non cash payments exceed the order total")
+	ErrReferenceRequired = errors.New("payment reference is required for this payment method")
+	ErrMultipleGateway   = errors.New("only one payment gateway tender is allowed per order")
+)
+
+// Tender adalah satu pembayaran yang diserahkan pelanggan
+type Tender struct {
+	MethodID          int64
+	Method            string
+	Type              string
+	Amount            float64 // untuk tunai adalah uang yang diterima kasir
+	Reference         string
+	Pin               string // PIN gift card
+	RequiresReference bool
+	Gateway           bool // dibayar lewat payment gateway
+}
+
+// Payment adalah tender yang sudah dihitung porsi pembayarannya
+type Payment struct {
+	Tender
+	Applied  float64 // nominal yang dipakai untuk membayar order
+	Tendered float64
+	Change   float64
+}
+
+// Settlement adalah hasil pembagian tender terhadap total order
+type Settlement struct {
+	Payments []Payment
+	Paid     float64
+	Change   float64
+}
+
+// Settle memvalidasi tender terhadap total order dan menghitung kembalian.
+// Kembalian hanya bisa diberikan dari pembayaran tunai, sehingga tender non
+// tunai tidak boleh melebihi total order. Order dengan total 0 (misalnya
+// tertutup penuh oleh promosi atau voucher) menerima tender bernominal 0.
+func Settle(total float64, tenders []Tender) (Settlement, error) {
+	if len(tenders) == 0 {
+		return Settlement{}, ErrNoTender
+	}
+
+	total = round(total)
+	tendered, cash, nonCash := 0.0, 0.0, 0.0
+	gateways := 0
+	for _, t := range tenders {
+		if t.Amount < 0 || (t.Amount == 0 && total > 0) {
+			return Settlement{}, ErrInvalidAmount
+		}
+		if t.RequiresReference && t.Reference == "" {
+			return Settlement{}, ErrReferenceRequired
+		}
+		if t.Gateway {
+			gateways++
+			if gateways > 1 {
+				return Settlement{}, ErrMultipleGateway
+			}
+		}
+		tendered += t.Amount
+		if t.Type == TypeCash {
+			cash += t.Amount
+		} else {
+			nonCash += t.Amount
+		}
+	}
+
+	if round(nonCash) > total {
+		return Settlement{}, ErrOverpaid
+	}
+	if round(tendered) < total {
+		return Settlement{}, ErrUnderpaid
+	}
+
+	change := round(tendered - total)
+	settlement := Settlement{Paid: total, Change: change}
+
+	// kembalian diambil dari tender tunai terakhir ke depan
+	remaining := change
+	payments := make([]Payment, len(tenders))
+	for i := len(tenders) - 1; i >= 0; i-- {
+		t := tenders[i]
+		p := Payment{Tender: t, Applied: t.Amount, Tendered: t.Amount}
+		if t.Type == TypeCash && remaining > 0 {
+			p.Change = round(math.Min(remaining, t.Amount))
+			p.Applied = round(t.Amount - p.Change)
+			remaining = round(remaining - p.Change)
+		}
+		payments[i] = p
+	}
+	settlement.Payments = payments
+	return settlement, nil
+}
+
+// GatewayPayment mengembalikan pembayaran yang harus ditagihkan lewat payment gateway.
+// Tender gateway bernominal 0 tidak perlu ditagihkan.
+func (s Settlement) GatewayPayment() (Payment, bool) {
+	for _, p := range s.Payments {
+		if p.Gateway && p.Applied > 0 {
+			return p, true
+		}
+	}
+	return Payment{}, false
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
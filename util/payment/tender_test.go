@@ -0,0 +1,179 @@
+package payment
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSettle(t *testing.T) {
+	cash := func(amount float64) Tender { return Tender{Method: "cash", Type: TypeCash, Amount: amount} }
+	card := func(amount float64) Tender { return Tender{Method: "debit", Type: TypeCard, Amount: amount} }
+	qris := func(amount float64) Tender {
+		return Tender{Method: "qris", Type: TypeEWallet, Amount: amount, Gateway: true}
+	}
+
+	tests := []struct {
+		name    string
+		total   float64
+		tenders []Tender
+		err     error
+		change  float64
+		applied []float64
+		changes []float64
+	}{
+		{
+			name:  "no tender",
+			total: 10000,
+			err:   ErrNoTender,
+		},
+		{
+			name:    "exact cash",
+			total:   10000,
+			tenders: []Tender{cash(10000)},
+			applied: []float64{10000},
+			changes: []float64{0},
+		},
+		{
+			name:    "cash with change",
+			total:   37500,
+			tenders: []Tender{cash(50000)},
+			change:  12500,
+			applied: []float64{37500},
+			changes: []float64{12500},
+		},
+		{
+			name:    "split card and cash, change from cash",
+			total:   37500,
+			tenders: []Tender{card(20000), cash(20000)},
+			change:  2500,
+			applied: []float64{20000, 17500},
+			changes: []float64{0, 2500},
+		},
+		{
+			name:    "change is taken from the last cash tender first",
+			total:   35000,
+			tenders: []Tender{cash(10000), card(10000), cash(20000)},
+			change:  5000,
+			applied: []float64{10000, 10000, 15000},
+			changes: []float64{0, 0, 5000},
+		},
+		{
+			name:    "change larger than the last cash tender",
+			total:   8000,
+			tenders: []Tender{cash(10000), cash(3000)},
+			change:  5000,
+			applied: []float64{8000, 0},
+			changes: []float64{2000, 3000},
+		},
+		{
+			name:    "total is rounded before comparing",
+			total:   10000.004,
+			tenders: []Tender{card(10000)},
+			applied: []float64{10000},
+			changes: []float64{0},
+		},
+		{
+			name:    "zero total with the legacy single tender",
+			total:   0,
+			tenders: []Tender{card(0)},
+			applied: []float64{0},
+			changes: []float64{0},
+		},
+		{
+			name:    "zero total returns cash as change",
+			total:   0,
+			tenders: []Tender{cash(5000)},
+			change:  5000,
+			applied: []float64{0},
+			changes: []float64{5000},
+		},
+		{
+			name:    "negative amount on a zero total",
+			total:   0,
+			tenders: []Tender{cash(-1)},
+			err:     ErrInvalidAmount,
+		},
+		{
+			name:    "non cash can not exceed the total",
+			total:   37500,
+			tenders: []Tender{card(40000)},
+			err:     ErrOverpaid,
+		},
+		{
+			name:    "underpaid",
+			total:   37500,
+			tenders: []Tender{cash(20000), card(10000)},
+			err:     ErrUnderpaid,
+		},
+		{
+			name:    "zero amount",
+			total:   10000,
+			tenders: []Tender{cash(10000), card(0)},
+			err:     ErrInvalidAmount,
+		},
+		{
+			name:    "reference required",
+			total:   10000,
+			tenders: []Tender{{Method: "transfer", Type: TypeTransfer, Amount: 10000, RequiresReference: true}},
+			err:     ErrReferenceRequired,
+		},
+		{
+			name:    "only one gateway tender",
+			total:   10000,
+			tenders: []Tender{qris(5000), qris(5000)},
+			err:     ErrMultipleGateway,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settlement, err := Settle(tt.total, tt.tenders)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Settle() error = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+
+			if settlement.Change != tt.change {
+				t.Errorf("change = %v, want %v", settlement.Change, tt.change)
+			}
+			applied := make([]float64, len(settlement.Payments))
+			changes := make([]float64, len(settlement.Payments))
+			for i, p := range settlement.Payments {
+				applied[i] = p.Applied
+				changes[i] = p.Change
+			}
+			if !reflect.DeepEqual(applied, tt.applied) {
+				t.Errorf("applied = %v, want %v", applied, tt.applied)
+			}
+			if !reflect.DeepEqual(changes, tt.changes) {
+				t.Errorf("changes = %v, want %v", changes, tt.changes)
+			}
+		})
+	}
+}
+
+func TestGatewayPayment(t *testing.T) {
+	settlement, err := Settle(30000, []Tender{
+		{Method: "cash", Type: TypeCash, Amount: 10000},
+		{Method: "qris", Type: TypeEWallet, Amount: 20000, Gateway: true},
+	})
+	if err != nil {
+		t.Fatalf("Settle() error = %v", err)
+	}
+
+	p, ok := settlement.GatewayPayment()
+	if !ok || p.Method != "qris" || p.Applied != 20000 {
+		t.Errorf("GatewayPayment() = %+v, %v, want qris 20000", p, ok)
+	}
+
+	settlement, err = Settle(0, []Tender{{Method: "qris", Type: TypeEWallet, Gateway: true}})
+	if err != nil {
+		t.Fatalf("Settle() error = %v", err)
+	}
+	if p, ok := settlement.GatewayPayment(); ok {
+		t.Errorf("GatewayPayment() = %+v, want nothing to charge on a zero total", p)
+	}
+}
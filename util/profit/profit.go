@@ -0,0 +1,74 @@
+package profit
+
+import (
+	"errors"
+	"math"
+)
+
+// Pengelompokan laporan laba kotor
+const (
+	GroupProduct  = "product"
+	GroupCategory = "category"
+	GroupCashier  = "cashier"
+	GroupCustomer = "customer"
+	GroupDay      = "day"
+)
+
+var ErrUnknownGroup = errors.New("group_by must be one of product, category, cashier, customer or day")
+
+// ValidateGroup memeriksa pengelompokan laporan yang diminta
+func ValidateGroup(group string) error {
+	switch group {
+	case GroupProduct, GroupCategory, GroupCashier, GroupCustomer, GroupDay:
+		return nil
+	}
+	return ErrUnknownGroup
+}
+
+// Figures adalah angka penjualan satu kelompok setelah dikurangi refund pada periode yang sama
+type Figures struct {
+	Quantity    int64
+	TotalOrders int64
+	Revenue     float64 // harga jual sebelum potongan
+	Discount    float64 // potongan promosi, member dan voucher
+	Tax         float64 // pajak yang sudah termasuk di harga jual
+	Refund      float64 // penjualan bersih yang dikembalikan, sudah dikurangkan dari angka lain
+	Cogs        float64 // harga pokok penjualan
+}
+
+// NetSales adalah penjualan bersih setelah potongan dan tanpa pajak
+func (f Figures) NetSales() float64 {
+	return Round(f.Revenue - f.Discount - f.Tax)
+}
+
+// GrossProfit adalah penjualan bersih dikurangi harga pokok penjualan
+func (f Figures) GrossProfit() float64 {
+	return Round(f.NetSales() - f.Cogs)
+}
+
+// Margin adalah laba kotor dalam persen dari penjualan bersih
+func (f Figures) Margin() float64 {
+	net := f.NetSales()
+	if net == 0 {
+		return 0
+	}
+	return Round(f.GrossProfit() / net * 100)
+}
+
+// Add menjumlahkan angka dua kelompok untuk total laporan. Jumlah order tidak
+// ikut dijumlahkan karena satu order bisa masuk ke beberapa kelompok.
+func (f Figures) Add(other Figures) Figures {
+	return Figures{
+		Quantity: f.Quantity + other.Quantity,
+		Revenue:  Round(f.Revenue + other.Revenue),
+		Discount: Round(f.Discount + other.Discount),
+		Tax:      Round(f.Tax + other.Tax),
+		Refund:   Round(f.Refund + other.Refund),
+		Cogs:     Round(f.Cogs + other.Cogs),
+	}
+}
+
+// Round membulatkan nilai uang ke dua angka desimal
+func Round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,85 @@
+package profit
+
+import "testing"
+
+func TestFigures(t *testing.T) {
+	tests := []struct {
+		name   string
+		f      Figures
+		net    float64
+		profit float64
+		margin float64
+	}{
+		{
+			name:   "sale with discount and inclusive tax",
+			f:      Figures{Revenue: 111000, Discount: 0, Tax: 11000, Cogs: 60000},
+			net:    100000,
+			profit: 40000,
+			margin: 40,
+		},
+		{
+			name:   "discount reduces net sales",
+			f:      Figures{Revenue: 50000, Discount: 10000, Cogs: 30000},
+			net:    40000,
+			profit: 10000,
+			margin: 25,
+		},
+		{
+			name:   "sale sold below cost",
+			f:      Figures{Revenue: 10000, Cogs: 12500},
+			net:    10000,
+			profit: -2500,
+			margin: -25,
+		},
+		{
+			name:   "fully refunded sale has no margin",
+			f:      Figures{Revenue: 0, Refund: 20000, Cogs: 0},
+			net:    0,
+			profit: 0,
+			margin: 0,
+		},
+		{
+			name:   "margin is rounded",
+			f:      Figures{Revenue: 30000, Cogs: 20000},
+			net:    30000,
+			profit: 10000,
+			margin: 33.33,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.NetSales(); got != tt.net {
+				t.Errorf("NetSales() = %v, want %v", got, tt.net)
+			}
+			if got := tt.f.GrossProfit(); got != tt.profit {
+				t.Errorf("GrossProfit() = %v, want %v", got, tt.profit)
+			}
+			if got := tt.f.Margin(); got != tt.margin {
+				t.Errorf("Margin() = %v, want %v", got, tt.margin)
+			}
+		})
+	}
+}
+
+func TestFiguresAdd(t *testing.T) {
+	a := Figures{Quantity: 2, TotalOrders: 1, Revenue: 20000.10, Discount: 1000, Tax: 0, Cogs: 12000}
+	b := Figures{Quantity: -1, TotalOrders: 1, Revenue: -10000, Discount: -500, Refund: 9500, Cogs: -6000}
+
+	got := a.Add(b)
+	want := Figures{Quantity: 1, Revenue: 10000.10, Discount: 500, Refund: 9500, Cogs: 6000}
+	if got != want {
+		t.Errorf("Add() = %+v, want %+v", got, want)
+	}
+}
+
+func TestValidateGroup(t *testing.T) {
+	for _, group := range []string{GroupProduct, GroupCategory, GroupCashier, GroupCustomer, GroupDay} {
+		if err := ValidateGroup(group); err != nil {
+			t.Errorf("ValidateGroup(%q) error = %v", group, err)
+		}
+	}
+	if err := ValidateGroup("month"); err != ErrUnknownGroup {
+		t.Errorf("ValidateGroup(month) error = %v, want %v", err, ErrUnknownGroup)
+	}
+}
@@ -0,0 +1,439 @@
+package promotion
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Jenis promosi yang didukung
+const (
+	TypeBuyXGetY           = "buy_x_get_y"
+	TypeBundle             = "bundle"
+	TypeCategoryPercentage = "category_percentage"
+	TypeTimeWindow         = "time_window"
+	TypeMinSpend           = "min_spend"
+)
+
+// Line adalah satu baris item pada keranjang yang akan dihitung promosinya
+type Line struct {
+	ProductID  int64
+	CategoryID int64
+	Quantity   int32
+	UnitPrice  float64
+}
+
+// RuleProduct adalah produk yang menjadi syarat promosi (bundle, buy X get Y, time window)
+type RuleProduct struct {
+	ProductID int64
+	Quantity  int32
+}
+
+// Rule adalah aturan promosi yang sudah dimuat dari database
+type Rule struct {
+	ID              int64
+	Name            string
+	Type            string
+	Priority        int32
+	Stackable       bool
+	CategoryID      int64
+	BuyQuantity     int32
+	GetQuantity     int32
+	BundlePrice     float64
+	DiscountPercent float64
+	DiscountAmount  float64
+	MinSpend        float64
+	DailyStart      string // format "15:04"
+	DailyEnd        string // format "15:04"
+	DaysOfWeek      string // contoh "1,2,3,4,5" (1 = Senin, 7 = Minggu)
+	Products        []RuleProduct
+}
+
+// Applied adalah promosi yang berhasil diterapkan beserta total potongannya
+type Applied struct {
+	PromotionID int64
+	Name        string
+	Type        string
+	Discount    float64
+}
+
+// Result adalah hasil perhitungan promosi untuk satu keranjang.
+// LineDiscounts sudah termasuk alokasi potongan level order (min spend),
+// sehingga jumlahnya selalu sama dengan TotalDiscount.
+type Result struct {
+	LineDiscounts []float64
+	Applied       []Applied
+	TotalDiscount float64
+}
+
+// Validate memeriksa kelengkapan field sesuai jenis promosi
+func Validate(rule Rule) error {
+	if rule.DiscountPercent < 0 || rule.DiscountPercent > 100 {
+		return errors.New("discount_percent must be between 0 and 100")
+	}
+	if rule.DiscountAmount < 0 || rule.BundlePrice < 0 || rule.MinSpend < 0 {
+		return errors.New("amounts must be positive numbers")
+	}
+	if (rule.DailyStart == "") != (rule.DailyEnd == "") {
+		return errors.New("daily_start and daily_end must be set together")
+	}
+	if rule.DailyStart != "" {
+		if _, err := time.Parse("15:04", rule.DailyStart); err != nil {
+			return errors.New("invalid daily_start (format HH:MM)")
+		}
+		if _, err := time.Parse("15:04", rule.DailyEnd); err != nil {
+			return errors.New("invalid daily_end (format HH:MM)")
+		}
+	}
+	if rule.DaysOfWeek != "" {
+		if _, err := parseDays(rule.DaysOfWeek); err != nil {
+			return err
+		}
+	}
+
+	switch rule.Type {
+	case TypeBuyXGetY:
+		if rule.BuyQuantity < 1 || rule.GetQuantity < 1 {
+			return errors.New("buy_quantity and get_quantity are required")
+		}
+		if len(rule.Products) == 0 && rule.CategoryID == 0 {
+			return errors.New("products or category_id is required")
+		}
+	case TypeBundle:
+		if len(rule.Products) == 0 {
+			return errors.New("products is required")
+		}
+		if rule.BundlePrice <= 0 {
+			return errors.New("bundle_price is required")
+		}
+	case TypeCategoryPercentage:
+		if rule.CategoryID == 0 {
+			return errors.New("category_id is required")
+		}
+		if rule.DiscountPercent <= 0 {
+			return errors.New("discount_percent is required")
+		}
+	case TypeTimeWindow:
+		if rule.DailyStart == "" {
+			return errors.New("daily_start and daily_end are required")
+		}
+		if len(rule.Products) == 0 && rule.CategoryID == 0 {
+			return errors.New("products or category_id is required")
+		}
+		if rule.DiscountPercent <= 0 && rule.DiscountAmount <= 0 {
+			return errors.New("discount_percent or discount_amount is required")
+		}
+	case TypeMinSpend:
+		if rule.MinSpend <= 0 {
+			return errors.New("min_spend is required")
+		}
+		if rule.DiscountPercent <= 0 && rule.DiscountAmount <= 0 {
+			return errors.New("discount_percent or discount_amount is required")
+		}
+	default:
+		return errors.New("unknown promotion type")
+	}
+	return nil
+}
+
+// Apply menghitung potongan promosi untuk keranjang.
+// Promosi diproses berdasarkan prioritas tertinggi terlebih dahulu. Promosi yang
+// tidak stackable tidak akan digabung dengan promosi lain pada baris yang sama;
+// promosi level order (min spend) dianggap menyentuh semua baris.
+func Apply(lines []Line, rules []Rule, now time.Time) Result {
+	sorted := make([]Rule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority > sorted[j].Priority
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	discounts := make([]float64, len(lines))
+	touched := make([]bool, len(lines))
+	locked := make([]bool, len(lines))
+	result := Result{LineDiscounts: discounts, Applied: []Applied{}}
+
+	eligible := func(i int, rule Rule) bool {
+		if locked[i] {
+			return false
+		}
+		return !touched[i] || rule.Stackable
+	}
+
+	for _, rule := range sorted {
+		if !inWindow(rule, now) {
+			continue
+		}
+
+		lineDiscounts := make(map[int]float64)
+		switch rule.Type {
+		case TypeCategoryPercentage:
+			for i, line := range lines {
+				if !eligible(i, rule) || line.CategoryID != rule.CategoryID {
+					continue
+				}
+				lineDiscounts[i] = gross(line) * rule.DiscountPercent / 100
+			}
+		case TypeTimeWindow:
+			for i, line := range lines {
+				if !eligible(i, rule) || !matches(rule, line) {
+					continue
+				}
+				if rule.DiscountPercent > 0 {
+					lineDiscounts[i] = gross(line) * rule.DiscountPercent / 100
+				} else {
+					lineDiscounts[i] = math.Min(rule.DiscountAmount, line.UnitPrice) * float64(line.Quantity)
+				}
+			}
+		case TypeBuyXGetY:
+			if rule.CategoryID != 0 {
+				lineDiscounts = categoryFreeUnits(lines, rule, eligible)
+				break
+			}
+			for i, line := range lines {
+				if !eligible(i, rule) || !matches(rule, line) {
+					continue
+				}
+				sets := line.Quantity / (rule.BuyQuantity + rule.GetQuantity)
+				if sets > 0 {
+					lineDiscounts[i] = float64(sets*rule.GetQuantity) * line.UnitPrice
+				}
+			}
+		case TypeBundle:
+			lineDiscounts = bundleDiscounts(lines, rule, eligible)
+		case TypeMinSpend:
+			blocked := false
+			for i := range lines {
+				if !eligible(i, rule) {
+					blocked = true
+					break
+				}
+			}
+			if blocked {
+				continue
+			}
+			net := 0.0
+			for i, line := range lines {
+				net += gross(line) - discounts[i]
+			}
+			if net <= 0 || net < rule.MinSpend {
+				continue
+			}
+			orderDiscount := math.Min(rule.DiscountAmount, net)
+			if rule.DiscountPercent > 0 {
+				orderDiscount = net * rule.DiscountPercent / 100
+			}
+			lineDiscounts = Allocate(lines, discounts, round(orderDiscount))
+		}
+
+		total := 0.0
+		for i, d := range lineDiscounts {
+			// potongan tidak boleh melebihi sisa nilai baris
+			d = round(math.Min(d, gross(lines[i])-discounts[i]))
+			if d <= 0 {
+				delete(lineDiscounts, i)
+				continue
+			}
+			lineDiscounts[i] = d
+			total += d
+		}
+		if total <= 0 {
+			continue
+		}
+
+		for i, d := range lineDiscounts {
+			discounts[i] = round(discounts[i] + d)
+			touched[i] = true
+			if !rule.Stackable {
+				locked[i] = true
+			}
+		}
+		result.Applied = append(result.Applied, Applied{
+			PromotionID: rule.ID,
+			Name:        rule.Name,
+			Type:        rule.Type,
+			Discount:    round(total),
+		})
+		result.TotalDiscount = round(result.TotalDiscount + total)
+	}
+
+	return result
+}
+
+// categoryFreeUnits menghitung buy X get Y untuk satu kategori. Jumlah barang
+// dijumlahkan dari semua baris yang cocok, lalu barang gratis diambil dari
+// harga termurah terlebih dahulu.
+func categoryFreeUnits(lines []Line, rule Rule, eligible func(int, Rule) bool) map[int]float64 {
+	out := make(map[int]float64)
+	indexes := make([]int, 0)
+	var quantity int32
+	for i, line := range lines {
+		if !eligible(i, rule) || !matches(rule, line) {
+			continue
+		}
+		indexes = append(indexes, i)
+		quantity += line.Quantity
+	}
+
+	free := quantity / (rule.BuyQuantity + rule.GetQuantity) * rule.GetQuantity
+	sort.SliceStable(indexes, func(a, b int) bool {
+		return lines[indexes[a]].UnitPrice < lines[indexes[b]].UnitPrice
+	})
+	for _, i := range indexes {
+		if free <= 0 {
+			break
+		}
+		units := lines[i].Quantity
+		if units > free {
+			units = free
+		}
+		out[i] = float64(units) * lines[i].UnitPrice
+		free -= units
+	}
+	return out
+}
+
+func bundleDiscounts(lines []Line, rule Rule, eligible func(int, Rule) bool) map[int]float64 {
+	out := make(map[int]float64)
+	sets := int32(math.MaxInt32)
+	used := make(map[int]int32)
+	normal := 0.0
+
+	for _, p := range rule.Products {
+		required := p.Quantity
+		if required < 1 {
+			required = 1
+		}
+		index := -1
+		for i, line := range lines {
+			if line.ProductID == p.ProductID && eligible(i, rule) {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			return out
+		}
+		if n := lines[index].Quantity / required; n < sets {
+			sets = n
+		}
+		used[index] = required
+		normal += float64(required) * lines[index].UnitPrice
+	}
+	if sets == 0 || sets == math.MaxInt32 {
+		return out
+	}
+
+	discount := (normal - rule.BundlePrice) * float64(sets)
+	if discount <= 0 {
+		return out
+	}
+	for i, required := range used {
+		share := float64(required) * lines[i].UnitPrice / normal
+		out[i] = discount * share
+	}
+	return out
+}
+
+// Allocate membagi potongan level order ke setiap baris secara proporsional
+// berdasarkan sisa nilai baris setelah potongan sebelumnya
+func Allocate(lines []Line, discounts []float64, amount float64) map[int]float64 {
+	out := make(map[int]float64)
+	net := 0.0
+	last := -1
+	for i, line := range lines {
+		if remaining := gross(line) - discounts[i]; remaining > 0 {
+			net += remaining
+			last = i
+		}
+	}
+	if net <= 0 {
+		return out
+	}
+
+	rest := amount
+	for i, line := range lines {
+		remaining := gross(line) - discounts[i]
+		if remaining <= 0 {
+			continue
+		}
+		if i == last {
+			out[i] = round(rest)
+			break
+		}
+		share := round(amount * remaining / net)
+		out[i] = share
+		rest -= share
+	}
+	return out
+}
+
+func matches(rule Rule, line Line) bool {
+	for _, p := range rule.Products {
+		if p.ProductID == line.ProductID {
+			return true
+		}
+	}
+	return rule.CategoryID != 0 && rule.CategoryID == line.CategoryID
+}
+
+func inWindow(rule Rule, now time.Time) bool {
+	if rule.DaysOfWeek != "" {
+		days, err := parseDays(rule.DaysOfWeek)
+		if err != nil {
+			return false
+		}
+		weekday := int(now.Weekday())
+		if weekday == 0 {
+			weekday = 7
+		}
+		if !days[weekday] {
+			return false
+		}
+	}
+	if rule.DailyStart == "" || rule.DailyEnd == "" {
+		return true
+	}
+
+	start, err := time.Parse("15:04", rule.DailyStart)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse("15:04", rule.DailyEnd)
+	if err != nil {
+		return false
+	}
+	current := now.Hour()*60 + now.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+	if from <= to {
+		return current >= from && current < to
+	}
+	// jendela waktu melewati tengah malam, contoh 22:00 - 02:00
+	return current >= from || current < to
+}
+
+func parseDays(value string) (map[int]bool, error) {
+	days := make(map[int]bool)
+	for _, part := range strings.Split(value, ",") {
+		day, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || day < 1 || day > 7 {
+			return nil, errors.New("invalid days_of_week (use 1-7 separated by comma)")
+		}
+		days[day] = true
+	}
+	return days, nil
+}
+
+func gross(line Line) float64 {
+	return line.UnitPrice * float64(line.Quantity)
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
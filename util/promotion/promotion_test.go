@@ -0,0 +1,249 @@
+package promotion
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Senin, 1 Januari 2024
+var monday = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name      string
+		lines     []Line
+		rules     []Rule
+		now       time.Time
+		discounts []float64
+		total     float64
+		applied   int
+	}{
+		{
+			name:      "category percentage",
+			lines:     []Line{{ProductID: 1, CategoryID: 1, Quantity: 3, UnitPrice: 1000}, {ProductID: 2, CategoryID: 2, Quantity: 1, UnitPrice: 500}},
+			rules:     []Rule{{ID: 1, Type: TypeCategoryPercentage, CategoryID: 1, DiscountPercent: 12.5}},
+			now:       monday,
+			discounts: []float64{375, 0},
+			total:     375,
+			applied:   1,
+		},
+		{
+			name:      "buy 2 get 1 counts complete sets only",
+			lines:     []Line{{ProductID: 1, Quantity: 7, UnitPrice: 1000}},
+			rules:     []Rule{{ID: 1, Type: TypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1, Products: []RuleProduct{{ProductID: 1}}}},
+			now:       monday,
+			discounts: []float64{2000},
+			total:     2000,
+			applied:   1,
+		},
+		{
+			name: "buy 2 get 1 sums quantities across the category",
+			lines: []Line{
+				{ProductID: 1, CategoryID: 3, Quantity: 1, UnitPrice: 5000},
+				{ProductID: 2, CategoryID: 3, Quantity: 1, UnitPrice: 3000},
+				{ProductID: 3, CategoryID: 3, Quantity: 1, UnitPrice: 4000},
+				{ProductID: 4, CategoryID: 9, Quantity: 3, UnitPrice: 1000},
+			},
+			rules:     []Rule{{ID: 1, Type: TypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1, CategoryID: 3}},
+			now:       monday,
+			discounts: []float64{0, 3000, 0, 0},
+			total:     3000,
+			applied:   1,
+		},
+		{
+			name: "category free units span the cheapest lines",
+			lines: []Line{
+				{ProductID: 1, CategoryID: 3, Quantity: 4, UnitPrice: 2000},
+				{ProductID: 2, CategoryID: 3, Quantity: 1, UnitPrice: 1500},
+				{ProductID: 3, CategoryID: 3, Quantity: 1, UnitPrice: 1000},
+			},
+			rules:     []Rule{{ID: 1, Type: TypeBuyXGetY, BuyQuantity: 1, GetQuantity: 1, CategoryID: 3}},
+			now:       monday,
+			discounts: []float64{2000, 1500, 1000},
+			total:     4500,
+			applied:   1,
+		},
+		{
+			name:      "category below the buy quantity",
+			lines:     []Line{{ProductID: 1, CategoryID: 3, Quantity: 1, UnitPrice: 5000}, {ProductID: 2, CategoryID: 3, Quantity: 1, UnitPrice: 3000}},
+			rules:     []Rule{{ID: 1, Type: TypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1, CategoryID: 3}},
+			now:       monday,
+			discounts: []float64{0, 0},
+		},
+		{
+			name: "bundle discount is shared by normal price",
+			lines: []Line{
+				{ProductID: 1, Quantity: 2, UnitPrice: 6000},
+				{ProductID: 2, Quantity: 1, UnitPrice: 4000},
+			},
+			rules:     []Rule{{ID: 1, Type: TypeBundle, BundlePrice: 8000, Products: []RuleProduct{{ProductID: 1, Quantity: 1}, {ProductID: 2, Quantity: 1}}}},
+			now:       monday,
+			discounts: []float64{1200, 800},
+			total:     2000,
+			applied:   1,
+		},
+		{
+			name:      "bundle without every product is skipped",
+			lines:     []Line{{ProductID: 1, Quantity: 2, UnitPrice: 6000}},
+			rules:     []Rule{{ID: 1, Type: TypeBundle, BundlePrice: 8000, Products: []RuleProduct{{ProductID: 1, Quantity: 1}, {ProductID: 2, Quantity: 1}}}},
+			now:       monday,
+			discounts: []float64{0},
+		},
+		{
+			name:  "non stackable promotion blocks lower priority",
+			lines: []Line{{ProductID: 1, CategoryID: 1, Quantity: 1, UnitPrice: 1000}},
+			rules: []Rule{
+				{ID: 1, Type: TypeCategoryPercentage, CategoryID: 1, DiscountPercent: 20, Priority: 1, Stackable: true},
+				{ID: 2, Type: TypeCategoryPercentage, CategoryID: 1, DiscountPercent: 10, Priority: 5},
+			},
+			now:       monday,
+			discounts: []float64{100},
+			total:     100,
+			applied:   1,
+		},
+		{
+			name:  "stackable promotions are combined",
+			lines: []Line{{ProductID: 1, CategoryID: 1, Quantity: 1, UnitPrice: 1000}},
+			rules: []Rule{
+				{ID: 1, Type: TypeCategoryPercentage, CategoryID: 1, DiscountPercent: 10, Priority: 5, Stackable: true},
+				{ID: 2, Type: TypeMinSpend, MinSpend: 500, DiscountAmount: 50, Stackable: true},
+			},
+			now:       monday,
+			discounts: []float64{150},
+			total:     150,
+			applied:   2,
+		},
+		{
+			name: "min spend allocation keeps the rounding remainder on the last line",
+			lines: []Line{
+				{ProductID: 1, Quantity: 1, UnitPrice: 1000},
+				{ProductID: 2, Quantity: 1, UnitPrice: 1000},
+				{ProductID: 3, Quantity: 1, UnitPrice: 1000},
+			},
+			rules:     []Rule{{ID: 1, Type: TypeMinSpend, MinSpend: 3000, DiscountAmount: 100}},
+			now:       monday,
+			discounts: []float64{33.33, 33.33, 33.34},
+			total:     100,
+			applied:   1,
+		},
+		{
+			name:      "min spend below threshold",
+			lines:     []Line{{ProductID: 1, Quantity: 1, UnitPrice: 1000}},
+			rules:     []Rule{{ID: 1, Type: TypeMinSpend, MinSpend: 5000, DiscountPercent: 10}},
+			now:       monday,
+			discounts: []float64{0},
+		},
+		{
+			name:      "fixed discount does not exceed the unit price",
+			lines:     []Line{{ProductID: 1, Quantity: 2, UnitPrice: 300}},
+			rules:     []Rule{{ID: 1, Type: TypeTimeWindow, DailyStart: "09:00", DailyEnd: "11:00", DiscountAmount: 500, Products: []RuleProduct{{ProductID: 1}}}},
+			now:       monday,
+			discounts: []float64{600},
+			total:     600,
+			applied:   1,
+		},
+		{
+			name:      "outside the time window",
+			lines:     []Line{{ProductID: 1, Quantity: 1, UnitPrice: 1000}},
+			rules:     []Rule{{ID: 1, Type: TypeTimeWindow, DailyStart: "14:00", DailyEnd: "16:00", DiscountPercent: 10, Products: []RuleProduct{{ProductID: 1}}}},
+			now:       monday,
+			discounts: []float64{0},
+		},
+		{
+			name:      "time window across midnight",
+			lines:     []Line{{ProductID: 1, Quantity: 1, UnitPrice: 1000}},
+			rules:     []Rule{{ID: 1, Type: TypeTimeWindow, DailyStart: "22:00", DailyEnd: "02:00", DiscountPercent: 10, Products: []RuleProduct{{ProductID: 1}}}},
+			now:       time.Date(2024, 1, 2, 1, 30, 0, 0, time.UTC),
+			discounts: []float64{100},
+			total:     100,
+			applied:   1,
+		},
+		{
+			name:      "wrong day of week",
+			lines:     []Line{{ProductID: 1, CategoryID: 1, Quantity: 1, UnitPrice: 1000}},
+			rules:     []Rule{{ID: 1, Type: TypeCategoryPercentage, CategoryID: 1, DiscountPercent: 10, DaysOfWeek: "6,7"}},
+			now:       monday,
+			discounts: []float64{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Apply(tt.lines, tt.rules, tt.now)
+			if !reflect.DeepEqual(result.LineDiscounts, tt.discounts) {
+				t.Errorf("line discounts = %v, want %v", result.LineDiscounts, tt.discounts)
+			}
+			if result.TotalDiscount != tt.total {
+				t.Errorf("total discount = %v, want %v", result.TotalDiscount, tt.total)
+			}
+			if len(result.Applied) != tt.applied {
+				t.Errorf("applied promotions = %d, want %d", len(result.Applied), tt.applied)
+			}
+		})
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name      string
+		lines     []Line
+		discounts []float64
+		amount    float64
+		want      map[int]float64
+	}{
+		{
+			name:      "proportional to remaining value",
+			lines:     []Line{{Quantity: 1, UnitPrice: 3000}, {Quantity: 1, UnitPrice: 1000}},
+			discounts: []float64{0, 0},
+			amount:    400,
+			want:      map[int]float64{0: 300, 1: 100},
+		},
+		{
+			name:      "fully discounted lines are skipped",
+			lines:     []Line{{Quantity: 1, UnitPrice: 1000}, {Quantity: 1, UnitPrice: 1000}},
+			discounts: []float64{1000, 0},
+			amount:    100,
+			want:      map[int]float64{1: 100},
+		},
+		{
+			name:      "nothing left to discount",
+			lines:     []Line{{Quantity: 1, UnitPrice: 1000}},
+			discounts: []float64{1000},
+			amount:    100,
+			want:      map[int]float64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Allocate(tt.lines, tt.discounts, tt.amount)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Allocate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Rule
+		wantErr bool
+	}{
+		{"valid category percentage", Rule{Type: TypeCategoryPercentage, CategoryID: 1, DiscountPercent: 10}, false},
+		{"percent above 100", Rule{Type: TypeCategoryPercentage, CategoryID: 1, DiscountPercent: 120}, true},
+		{"bundle without price", Rule{Type: TypeBundle, Products: []RuleProduct{{ProductID: 1}}}, true},
+		{"daily start without end", Rule{Type: TypeMinSpend, MinSpend: 100, DiscountAmount: 10, DailyStart: "10:00"}, true},
+		{"invalid day of week", Rule{Type: TypeMinSpend, MinSpend: 100, DiscountAmount: 10, DaysOfWeek: "0,8"}, true},
+		{"unknown type", Rule{Type: "free_shipping"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(tt.rule); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
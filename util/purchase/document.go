@@ -0,0 +1,128 @@
+package purchase
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"html/template"
+	"strconv"
+	"strings"
+	"time"
+
+	"pos-api/util/receipt"
+)
+
+// Format dokumen purchase order yang didukung
+const (
+	FormatHTML = "html"
+	FormatCSV  = "csv"
+)
+
+var ErrUnknownFormat = errors.New("document format must be html or csv")
+
+// Document adalah data dokumen purchase order yang dikirim ke supplier
+type Document struct {
+	StoreName       string
+	StoreAddress    string
+	StorePhone      string
+	Number          string
+	Status          string
+	Date            time.Time
+	ExpectedAt      time.Time
+	SupplierName    string
+	SupplierContact string
+	SupplierPhone   string
+	SupplierEmail   string
+	SupplierAddress string
+	Notes           string
+	Lines           []Line
+}
+
+// Total menjumlahkan nilai seluruh baris dokumen
+func (d Document) Total() float64 {
+	return Total(d.Lines)
+}
+
+var htmlTemplate = template.Must(template.New("purchase_order").Funcs(template.FuncMap{
+	"money": receipt.Money,
+	"add": func(i int) int {
+		return i + 1
+	},
+	"status": func(status string) string {
+		return strings.ToUpper(strings.ReplaceAll(status, "_", " "))
+	},
+}).Parse(`<!doctype html>
+<html>
+<head>
+<meta charset="utf-8" />
+<title>{{.Number}}</title>
+<style>
+body { font-family: sans-serif; font-size: 12px; max-width: 800px; margin: 0 auto; }
+h1 { font-size: 18px; margin: 16px 0 4px; }
+.right { text-align: right; }
+.muted { color: #555; }
+table { width: 100%; border-collapse: collapse; }
+.info td { vertical-align: top; padding: 2px 8px 2px 0; }
+.lines th, .lines td { border-bottom: 1px solid #ccc; padding: 4px; }
+.lines th { text-align: left; background: #f2f2f2; }
+.total td { font-weight: bold; border-bottom: none; }
+</style>
+</head>
+<body>
+<div>{{if .StoreName}}<strong>{{.StoreName}}</strong>{{end}}{{if .StoreAddress}}<div>{{.StoreAddress}}</div>{{end}}{{if .StorePhone}}<div>{{.StorePhone}}</div>{{end}}</div>
+<h1>PURCHASE ORDER</h1>
+<div class="muted">{{status .Status}}</div>
+<table class="info">
+<tr><td>
+<div>No: <strong>{{.Number}}</strong></div>
+<div>Tanggal: {{.Date.Format "02/01/2006"}}</div>
+{{if not .ExpectedAt.IsZero}}<div>Tanggal kirim: {{.ExpectedAt.Format "02/01/2006"}}</div>{{end}}
+</td><td>
+<div>Kepada: <strong>{{.SupplierName}}</strong></div>
+{{if .SupplierContact}}<div>{{.SupplierContact}}</div>{{end}}
+{{if .SupplierAddress}}<div>{{.SupplierAddress}}</div>{{end}}
+{{if .SupplierPhone}}<div>{{.SupplierPhone}}</div>{{end}}
+{{if .SupplierEmail}}<div>{{.SupplierEmail}}</div>{{end}}
+</td></tr>
+</table>
+<br />
+<table class="lines">
+<tr><th>#</th><th>Produk</th><th class="right">Qty</th><th class="right">Harga</th><th class="right">Jumlah</th></tr>
+{{range $i, $line := .Lines}}<tr><td>{{add $i}}</td><td>{{$line.ProductName}}</td><td class="right">{{$line.Quantity}}</td><td class="right">{{money $line.UnitCost}}</td><td class="right">{{money $line.Total}}</td></tr>
+{{end}}<tr class="total"><td colspan="4" class="right">TOTAL</td><td class="right">{{money .Total}}</td></tr>
+</table>
+{{if .Notes}}<p>Catatan: {{.Notes}}</p>{{end}}
+</body>
+</html>
+`))
+
+// HTML merender purchase order menjadi halaman HTML yang siap dicetak atau dikirim ke supplier
+func HTML(d Document) (string, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, d); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// CSV merender baris purchase order untuk diolah di spreadsheet
+func CSV(d Document) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	rows := [][]string{{"po_number", "supplier", "product_id", "product_name", "quantity", "unit_cost", "total"}}
+	for _, line := range d.Lines {
+		rows = append(rows, []string{
+			d.Number,
+			d.SupplierName,
+			strconv.FormatInt(line.ProductID, 10),
+			line.ProductName,
+			strconv.Itoa(int(line.Quantity)),
+			strconv.FormatFloat(line.UnitCost, 'f', 2, 64),
+			strconv.FormatFloat(line.Total(), 'f', 2, 64),
+		})
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,60 @@
+package purchase
+
+import (
+	"encoding/csv"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func sampleDocument() Document {
+	return Document{
+		StoreName:    "Toko Maju",
+		Number:       "PO-20240131150405-7",
+		Status:       StatusPartiallyReceived,
+		Date:         time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		SupplierName: "PT Sumber, Jaya",
+		Notes:        "Kirim <pagi>",
+		Lines: []Line{
+			{ProductID: 1, ProductName: "Gula 1kg", Quantity: 10, UnitCost: 14500},
+			{ProductID: 2, ProductName: `Kopi "Bubuk"`, Quantity: 3, UnitCost: 3333.33},
+		},
+	}
+}
+
+func TestCSV(t *testing.T) {
+	out, err := CSV(sampleDocument())
+	if err != nil {
+		t.Fatalf("CSV() error = %v", err)
+	}
+
+	rows, err := csv.NewReader(strings.NewReader(string(out))).ReadAll()
+	if err != nil {
+		t.Fatalf("read CSV: %v", err)
+	}
+	want := [][]string{
+		{"po_number", "supplier", "product_id", "product_name", "quantity", "unit_cost", "total"},
+		{"PO-20240131150405-7", "PT Sumber, Jaya", "1", "Gula 1kg", "10", "14500.00", "145000.00"},
+		{"PO-20240131150405-7", "PT Sumber, Jaya", "2", `Kopi "Bubuk"`, "3", "3333.33", "9999.99"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("CSV() rows = %q, want %q", rows, want)
+	}
+}
+
+func TestHTML(t *testing.T) {
+	out, err := HTML(sampleDocument())
+	if err != nil {
+		t.Fatalf("HTML() error = %v", err)
+	}
+
+	for _, want := range []string{"<title>PO-20240131150405-7</title>", "PARTIALLY RECEIVED", "Tanggal: 31/01/2024", "145.000", "154.999,99", "Kirim &lt;pagi&gt;"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("HTML() does not contain %q", want)
+		}
+	}
+	if strings.Contains(out, "Tanggal kirim") {
+		t.Errorf("HTML() shows an expected date that is not set")
+	}
+}
@@ -0,0 +1,84 @@
+package purchase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Status purchase order
+const (
+	StatusDraft             = "draft"
+	StatusSent              = "sent"
+	StatusPartiallyReceived = "partially_received"
+	StatusReceived          = "received"
+	StatusCancelled         = "cancelled"
+)
+
+var (
+	ErrNotEditable   = errors.New("only draft purchase orders can be changed")
+	ErrNotSendable   = errors.New("only draft purchase orders can be sent")
+	ErrNotCancelled  = errors.New("only draft or sent purchase orders can be cancelled")
+	ErrDuplicateLine = errors.New("each product can only appear once in a purchase order")
+)
+
+// Line adalah satu baris produk yang dipesan ke supplier
+type Line struct {
+	ProductID   int64
+	ProductName string
+	Quantity    int32
+	UnitCost    float64 // harga beli yang disepakati dengan supplier
+}
+
+// Total adalah nilai baris sebelum pajak
+func (l Line) Total() float64 {
+	return Round(l.UnitCost * float64(l.Quantity))
+}
+
+// Total menjumlahkan nilai seluruh baris purchase order
+func Total(lines []Line) float64 {
+	total := 0.0
+	for _, line := range lines {
+		total += line.Total()
+	}
+	return Round(total)
+}
+
+// ValidateLines memastikan setiap produk hanya dipesan sekali
+func ValidateLines(lines []Line) error {
+	seen := make(map[int64]bool, len(lines))
+	for _, line := range lines {
+		if seen[line.ProductID] {
+			return ErrDuplicateLine
+		}
+		seen[line.ProductID] = true
+	}
+	return nil
+}
+
+// CanEdit menentukan apakah isi purchase order masih boleh diubah
+func CanEdit(status string) bool {
+	return status == StatusDraft
+}
+
+// CanSend menentukan apakah purchase order boleh dikirim ke supplier
+func CanSend(status string) bool {
+	return status == StatusDraft
+}
+
+// CanCancel menentukan apakah purchase order boleh dibatalkan. Purchase order
+// yang sudah diterima sebagian tidak bisa dibatalkan karena stok sudah masuk.
+func CanCancel(status string) bool {
+	return status == StatusDraft || status == StatusSent
+}
+
+// GenerateNumber membuat nomor purchase order, contoh PO-20240131150405-7
+func GenerateNumber(userID int64, now time.Time) string {
+	return fmt.Sprintf("PO-%s-%d", now.Format("20060102150405"), userID)
+}
+
+// Round membulatkan nominal ke 2 angka desimal
+func Round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,82 @@
+package purchase
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStatusWorkflow(t *testing.T) {
+	tests := []struct {
+		status  string
+		edit    bool
+		send    bool
+		cancel  bool
+		receive bool
+	}{
+		{StatusDraft, true, true, true, false},
+		{StatusSent, false, false, true, true},
+		{StatusPartiallyReceived, false, false, false, true},
+		{StatusReceived, false, false, false, false},
+		{StatusCancelled, false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			if got := CanEdit(tt.status); got != tt.edit {
+				t.Errorf("CanEdit() = %v, want %v", got, tt.edit)
+			}
+			if got := CanSend(tt.status); got != tt.send {
+				t.Errorf("CanSend() = %v, want %v", got, tt.send)
+			}
+			if got := CanCancel(tt.status); got != tt.cancel {
+				t.Errorf("CanCancel() = %v, want %v", got, tt.cancel)
+			}
+			if got := CanReceive(tt.status); got != tt.receive {
+				t.Errorf("CanReceive() = %v, want %v", got, tt.receive)
+			}
+		})
+	}
+}
+
+func TestTotal(t *testing.T) {
+	lines := []Line{
+		{ProductID: 1, Quantity: 3, UnitCost: 3333.33},
+		{ProductID: 2, Quantity: 10, UnitCost: 1500},
+	}
+	if got := lines[0].Total(); got != 9999.99 {
+		t.Errorf("Line.Total() = %v, want 9999.99", got)
+	}
+	if got := Total(lines); got != 24999.99 {
+		t.Errorf("Total() = %v, want 24999.99", got)
+	}
+	if got := Total(nil); got != 0 {
+		t.Errorf("Total(nil) = %v, want 0", got)
+	}
+}
+
+func TestValidateLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []Line
+		err   error
+	}{
+		{"different products", []Line{{ProductID: 1}, {ProductID: 2}}, nil},
+		{"same product twice", []Line{{ProductID: 1}, {ProductID: 2}, {ProductID: 1}}, ErrDuplicateLine},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateLines(tt.lines); !errors.Is(err, tt.err) {
+				t.Errorf("ValidateLines() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestGenerateNumber(t *testing.T) {
+	now := time.Date(2024, 1, 31, 15, 4, 5, 0, time.UTC)
+	if got := GenerateNumber(7, now); got != "PO-20240131150405-7" {
+		t.Errorf("GenerateNumber() = %q, want PO-20240131150405-7", got)
+	}
+}
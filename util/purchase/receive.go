@@ -0,0 +1,78 @@
+package purchase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Jenis selisih antara barang yang diterima dan yang dipesan
+const (
+	DiscrepancyShort = "short" // jumlah diterima kurang dari sisa pesanan
+	DiscrepancyOver  = "over"  // jumlah diterima melebihi sisa pesanan
+	DiscrepancyCost  = "cost"  // harga beli berbeda dari harga yang disepakati
+)
+
+var (
+	ErrNotReceivable = errors.New("only sent or partially received purchase orders can be received")
+	ErrNotOrdered    = errors.New("product is not on this purchase order")
+)
+
+// Receipt adalah penerimaan satu baris purchase order pada sebuah pengiriman
+type Receipt struct {
+	Ordered      int32   // jumlah yang dipesan
+	Received     int32   // jumlah yang sudah diterima pada pengiriman sebelumnya
+	Quantity     int32   // jumlah yang diterima pada pengiriman ini
+	ExpectedCost float64 // harga beli pada purchase order
+	UnitCost     float64 // harga beli pada faktur supplier
+}
+
+// Outstanding adalah sisa pesanan yang belum diterima sebelum pengiriman ini
+func (r Receipt) Outstanding() int32 {
+	if r.Received >= r.Ordered {
+		return 0
+	}
+	return r.Ordered - r.Received
+}
+
+// Discrepancies membandingkan penerimaan dengan sisa pesanan dan harga yang disepakati
+func (r Receipt) Discrepancies() []string {
+	discrepancies := make([]string, 0)
+	switch outstanding := r.Outstanding(); {
+	case r.Quantity < outstanding:
+		discrepancies = append(discrepancies, DiscrepancyShort)
+	case r.Quantity > outstanding:
+		discrepancies = append(discrepancies, DiscrepancyOver)
+	}
+	if Round(r.UnitCost) != Round(r.ExpectedCost) {
+		discrepancies = append(discrepancies, DiscrepancyCost)
+	}
+	return discrepancies
+}
+
+// CanReceive menentukan apakah barang dari purchase order boleh diterima
+func CanReceive(status string) bool {
+	return status == StatusSent || status == StatusPartiallyReceived
+}
+
+// Progress adalah jumlah dipesan dan diterima untuk satu baris purchase order
+type Progress struct {
+	Ordered  int32
+	Received int32
+}
+
+// ReceiveStatus menentukan status purchase order setelah penerimaan, purchase order
+// dianggap diterima seluruhnya bila semua baris sudah diterima sesuai pesanan
+func ReceiveStatus(lines []Progress) string {
+	for _, line := range lines {
+		if line.Received < line.Ordered {
+			return StatusPartiallyReceived
+		}
+	}
+	return StatusReceived
+}
+
+// GenerateReceiptNumber membuat nomor penerimaan barang, contoh GRN-20240131150405-7
+func GenerateReceiptNumber(userID int64, now time.Time) string {
+	return fmt.Sprintf("GRN-%s-%d", now.Format("20060102150405"), userID)
+}
@@ -0,0 +1,98 @@
+package purchase
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiscrepancies(t *testing.T) {
+	tests := []struct {
+		name    string
+		receipt Receipt
+		want    []string
+	}{
+		{
+			name:    "matches the order",
+			receipt: Receipt{Ordered: 10, Quantity: 10, ExpectedCost: 5000, UnitCost: 5000},
+			want:    []string{},
+		},
+		{
+			name:    "short against the outstanding quantity",
+			receipt: Receipt{Ordered: 10, Received: 4, Quantity: 5, ExpectedCost: 5000, UnitCost: 5000},
+			want:    []string{DiscrepancyShort},
+		},
+		{
+			name:    "completes an earlier partial delivery",
+			receipt: Receipt{Ordered: 10, Received: 4, Quantity: 6, ExpectedCost: 5000, UnitCost: 5000},
+			want:    []string{},
+		},
+		{
+			name:    "over after the order was fully received",
+			receipt: Receipt{Ordered: 10, Received: 10, Quantity: 1, ExpectedCost: 5000, UnitCost: 5000},
+			want:    []string{DiscrepancyOver},
+		},
+		{
+			name:    "cost differs",
+			receipt: Receipt{Ordered: 10, Quantity: 10, ExpectedCost: 5000, UnitCost: 5250},
+			want:    []string{DiscrepancyCost},
+		},
+		{
+			name:    "cost compared after rounding",
+			receipt: Receipt{Ordered: 10, Quantity: 10, ExpectedCost: 5000, UnitCost: 5000.004},
+			want:    []string{},
+		},
+		{
+			name:    "short and cost",
+			receipt: Receipt{Ordered: 10, Quantity: 8, ExpectedCost: 5000, UnitCost: 4900},
+			want:    []string{DiscrepancyShort, DiscrepancyCost},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.receipt.Discrepancies(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Discrepancies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReceiveStatus(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []Progress
+		want  string
+	}{
+		{"all lines received", []Progress{{Ordered: 10, Received: 10}, {Ordered: 5, Received: 5}}, StatusReceived},
+		{"over received counts as received", []Progress{{Ordered: 10, Received: 12}}, StatusReceived},
+		{"one line outstanding", []Progress{{Ordered: 10, Received: 10}, {Ordered: 5, Received: 2}}, StatusPartiallyReceived},
+		{"nothing received yet", []Progress{{Ordered: 10}}, StatusPartiallyReceived},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReceiveStatus(tt.lines); got != tt.want {
+				t.Errorf("ReceiveStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanReceive(t *testing.T) {
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{StatusSent, true},
+		{StatusPartiallyReceived, true},
+		{StatusReceived, false},
+		{StatusDraft, false},
+		{StatusCancelled, false},
+	}
+
+	for _, tt := range tests {
+		if got := CanReceive(tt.status); got != tt.want {
+			t.Errorf("CanReceive(%q) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package receipt
+
+import "bytes"
+
+// Perintah ESC/POS yang dipakai
+var (
+	escInit    = []byte{0x1b, 0x40}             // ESC @ reset printer
+	escBoldOn  = []byte{0x1b, 0x45, 0x01}       // ESC E 1
+	escBoldOff = []byte{0x1b, 0x45, 0x00}       // ESC E 0
+	escFeed    = []byte{0x1b, 0x64, 0x04}       // ESC d 4 feed 4 baris
+	escCut     = []byte{0x1d, 0x56, 0x42, 0x00} // GS V 66 0 partial cut
+)
+
+// ESCPOS merender struk menjadi perintah ESC/POS untuk printer thermal.
+// Karakter di luar ASCII diganti ? karena code page printer berbeda-beda.
+func ESCPOS(r Receipt, width int) []byte {
+	var out bytes.Buffer
+	out.Write(escInit)
+
+	for _, row := range lines(r, width) {
+		if row.Bold {
+			out.Write(escBoldOn)
+		}
+		for _, c := range row.Text {
+			if c > 127 {
+				c = '?'
+			}
+			out.WriteByte(byte(c))
+		}
+		out.WriteByte('\n')
+		if row.Bold {
+			out.Write(escBoldOff)
+		}
+	}
+
+	out.Write(escFeed)
+	out.Write(escCut)
+	return out.Bytes()
+}
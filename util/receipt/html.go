@@ -0,0 +1,80 @@
+package receipt
+
+import (
+	"bytes"
+	"html/template"
+	"strconv"
+	"strings"
+)
+
+var htmlTemplate = template.Must(template.New("receipt").Funcs(template.FuncMap{
+	"money": Money,
+	"rate":  Rate,
+	"upper": strings.ToUpper,
+	"reprint": func(count int32) string {
+		return strconv.Itoa(int(count - 1))
+	},
+	"flagged": func(status string) bool {
+		return status != "" && status != "order" && status != "paid"
+	},
+	"status": func(status string) string {
+		return strings.ToUpper(strings.ReplaceAll(status, "_", " "))
+	},
+}).Parse(`<!doctype html>
+<html>
+<head>
+<meta charset="utf-8" />
+<title>{{.TrxNumber}}</title>
+<style>
+body { font-family: monospace; font-size: 12px; width: 300px; margin: 0 auto; }
+.center { text-align: center; }
+.right { text-align: right; }
+.bold { font-weight: bold; }
+.marker { font-weight: bold; font-size: 14px; margin: 4px 0; }
+table { width: 100%; border-collapse: collapse; }
+td { vertical-align: top; padding: 1px 0; }
+hr { border: none; border-top: 1px dashed #000; }
+</style>
+</head>
+<body>
+<div class="center">{{range .Header}}<div>{{.}}</div>{{end}}</div>
+{{if .Copy}}<div class="center marker">*** COPY ***</div><div class="center">Cetak ulang ke-{{reprint .PrintCount}}</div>{{end}}
+{{if flagged .Status}}<div class="center marker">*** {{status .Status}} ***</div>{{end}}
+<hr />
+<table>
+<tr><td>No</td><td>{{.TrxNumber}}</td></tr>
+<tr><td>Tgl</td><td>{{.Date.Format "02/01/2006 15:04"}}</td></tr>
+{{if .Cashier}}<tr><td>Kasir</td><td>{{.Cashier}}</td></tr>{{end}}
+{{if .Customer}}<tr><td>Member</td><td>{{.Customer}}</td></tr>{{end}}
+</table>
+<hr />
+<table>
+{{range .Items}}<tr><td colspan="2">{{.Name}}</td></tr>
+<tr><td>&nbsp;&nbsp;{{.Quantity}} x {{money .UnitPrice}}</td><td class="right">{{money .Total}}</td></tr>
+{{end}}</table>
+<hr />
+<table>
+<tr><td>Subtotal</td><td class="right">{{money .Subtotal}}</td></tr>
+{{range .Discounts}}<tr><td>{{.Name}}</td><td class="right">-{{money .Amount}}</td></tr>
+{{end}}{{range .Taxes}}<tr><td>{{.Name}} {{rate .Rate}}{{if .Inclusive}} (termasuk){{end}}</td><td class="right">{{money .Amount}}</td></tr>
+{{end}}<tr class="bold"><td>TOTAL</td><td class="right">{{money .Total}}</td></tr>
+</table>
+<hr />
+<table>
+{{range .Payments}}<tr><td>{{upper .Method}}{{if .Reference}}<br />&nbsp;&nbsp;Ref: {{.Reference}}{{end}}</td><td class="right">{{money .Tendered}}</td></tr>
+{{end}}<tr><td>Kembali</td><td class="right">{{money .Change}}</td></tr>
+</table>
+{{if .Footer}}<hr />
+<div class="center">{{range .Footer}}<div>{{.}}</div>{{end}}</div>{{end}}
+</body>
+</html>
+`))
+
+// HTML merender struk menjadi halaman HTML yang siap dicetak dari browser
+func HTML(r Receipt) (string, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, r); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
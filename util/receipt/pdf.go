@@ -0,0 +1,83 @@
+package receipt
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	pdfFontSize = 8.0
+	pdfLeading  = 10.0
+	pdfMargin   = 12.0
+	pdfCharSize = pdfFontSize * 0.6 // lebar karakter font Courier
+)
+
+// PDF merender struk menjadi dokumen PDF satu halaman dengan font Courier,
+// ukuran halaman mengikuti lebar dan jumlah baris struk
+func PDF(r Receipt, width int) []byte {
+	if width <= 0 {
+		width = DefaultWidth
+	}
+	rows := lines(r, width)
+
+	pageWidth := float64(width)*pdfCharSize + 2*pdfMargin
+	pageHeight := float64(len(rows))*pdfLeading + 2*pdfMargin
+
+	var content bytes.Buffer
+	content.WriteString("BT\n")
+	fmt.Fprintf(&content, "%.2f TL\n", pdfLeading)
+	fmt.Fprintf(&content, "%.2f %.2f Td\n", pdfMargin, pageHeight-pdfMargin-pdfFontSize)
+	for _, row := range rows {
+		font := "F1"
+		if row.Bold {
+			font = "F2"
+		}
+		fmt.Fprintf(&content, "/%s %.1f Tf\n(%s) Tj T*\n", font, pdfFontSize, pdfEscape(row.Text))
+	}
+	content.WriteString("ET\n")
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 4 0 R /F2 5 0 R >> >> /Contents 6 0 R >>", pageWidth, pageHeight),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return out.Bytes()
+}
+
+// pdfEscape meng-escape karakter khusus string PDF, karakter di luar Latin-1 diganti ?
+func pdfEscape(text string) string {
+	var out strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			out.WriteByte('\\')
+			out.WriteRune(r)
+		case r > 255:
+			out.WriteByte('?')
+		case r > 127:
+			fmt.Fprintf(&out, "\\%03o", r)
+		default:
+			out.WriteRune(r)
+		}
+	}
+	return out.String()
+}
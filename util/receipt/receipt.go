@@ -0,0 +1,198 @@
+package receipt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Format struk yang didukung
+const (
+	FormatHTML   = "html"
+	FormatText   = "text"
+	FormatPDF    = "pdf"
+	FormatESCPOS = "escpos"
+)
+
+// DefaultWidth adalah jumlah karakter per baris printer thermal 80mm
+const DefaultWidth = 42
+
+var ErrUnknownFormat = errors.New("receipt format must be html, text, pdf or escpos")
+
+// Item adalah satu baris produk pada struk
+type Item struct {
+	Name         string
+	Quantity     int32
+	UnitPrice    float64
+	TaxName      string
+	TaxRate      float64
+	TaxInclusive bool
+	TaxAmount    float64
+}
+
+// Total adalah harga item sebelum diskon
+func (i Item) Total() float64 {
+	return i.UnitPrice * float64(i.Quantity)
+}
+
+// Discount adalah potongan promo atau voucher pada struk
+type Discount struct {
+	Name   string
+	Amount float64
+}
+
+// Payment adalah pembayaran (tender) pada struk
+type Payment struct {
+	Method    string
+	Amount    float64
+	Tendered  float64
+	Change    float64
+	Reference string
+}
+
+// Tax adalah rekap pajak per tarif pada struk
+type Tax struct {
+	Name      string
+	Rate      float64
+	Inclusive bool
+	Amount    float64
+}
+
+// Receipt adalah data struk yang siap dicetak
+type Receipt struct {
+	Header     []string
+	Footer     []string
+	TrxNumber  string
+	Cashier    string
+	Customer   string
+	Status     string
+	Date       time.Time
+	Items      []Item
+	Discounts  []Discount
+	Subtotal   float64
+	Tax        float64
+	Total      float64
+	Change     float64
+	Payments   []Payment
+	Copy       bool // struk cetak ulang diberi tanda COPY
+	PrintCount int32
+}
+
+// Taxes merekap pajak item per nama, tarif dan jenis pajak
+func (r Receipt) Taxes() []Tax {
+	taxes := make([]Tax, 0)
+	for _, item := range r.Items {
+		if item.TaxName == "" || item.TaxAmount == 0 {
+			continue
+		}
+		found := false
+		for i := range taxes {
+			if taxes[i].Name == item.TaxName && taxes[i].Rate == item.TaxRate && taxes[i].Inclusive == item.TaxInclusive {
+				taxes[i].Amount += item.TaxAmount
+				found = true
+				break
+			}
+		}
+		if !found {
+			taxes = append(taxes, Tax{Name: item.TaxName, Rate: item.TaxRate, Inclusive: item.TaxInclusive, Amount: item.TaxAmount})
+		}
+	}
+	return taxes
+}
+
+// TemplateData adalah data yang bisa dipakai di template header dan footer struk
+type TemplateData struct {
+	StoreName    string
+	StoreAddress string
+	StorePhone   string
+	TrxNumber    string
+	Cashier      string
+	Customer     string
+	Date         time.Time
+}
+
+// Template adalah pengaturan toko, header, footer dan lebar struk
+type Template struct {
+	StoreName    string
+	StoreAddress string
+	StorePhone   string
+	Header       string
+	Footer       string
+	Width        int
+}
+
+// Render menjalankan template header dan footer lalu memecahnya per baris
+func (t Template) Render(data TemplateData) (header []string, footer []string, err error) {
+	data.StoreName = t.StoreName
+	data.StoreAddress = t.StoreAddress
+	data.StorePhone = t.StorePhone
+
+	header, err = renderLines("header", t.Header, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	footer, err = renderLines("footer", t.Footer, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return header, footer, nil
+}
+
+func renderLines(name string, text string, data TemplateData) ([]string, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+
+	tpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid receipt %s template: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("invalid receipt %s template: %w", name, err)
+	}
+
+	lines := make([]string, 0)
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+// Money memformat nominal dengan pemisah ribuan titik, contoh 12.500 atau 12.500,50
+func Money(value float64) string {
+	negative := value < 0
+	cents := int64(math.Round(math.Abs(value) * 100))
+	whole, fraction := cents/100, cents%100
+
+	digits := fmt.Sprintf("%d", whole)
+	var out strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			out.WriteByte('.')
+		}
+		out.WriteRune(d)
+	}
+
+	result := out.String()
+	if fraction != 0 {
+		result += fmt.Sprintf(",%02d", fraction)
+	}
+	if negative {
+		result = "-" + result
+	}
+	return result
+}
+
+// Rate memformat persentase pajak, contoh 11% atau 2,5%
+func Rate(rate float64) string {
+	text := strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", rate), "0"), ".")
+	return strings.Replace(text, ".", ",", 1) + "%"
+}
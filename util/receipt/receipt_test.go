@@ -0,0 +1,217 @@
+package receipt
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// sample adalah struk dengan diskon, pajak termasuk dan pembayaran tunai
+func sample() Receipt {
+	return Receipt{
+		Header:    []string{"Toko Maju"},
+		Footer:    []string{"Terima kasih"},
+		TrxNumber: "TRX-1",
+		Cashier:   "Budi",
+		Status:    "order",
+		Date:      time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC),
+		Items: []Item{
+			{Name: "Kopi Susu Gula Aren Ukuran Besar Sekali", Quantity: 2, UnitPrice: 18500, TaxName: "PPN", TaxRate: 11, TaxInclusive: true, TaxAmount: 3666.67},
+			{Name: "Roti", Quantity: 1, UnitPrice: 12000, TaxName: "PPN", TaxRate: 11, TaxInclusive: true, TaxAmount: 1189.19},
+		},
+		Discounts: []Discount{{Name: "Promo Pagi", Amount: 5000}},
+		Subtotal:  49000,
+		Total:     44000,
+		Change:    6000,
+		Payments:  []Payment{{Method: "cash", Amount: 44000, Tendered: 50000, Change: 6000}},
+	}
+}
+
+func TestMoney(t *testing.T) {
+	tests := []struct {
+		value float64
+		want  string
+	}{
+		{0, "0"},
+		{500, "500"},
+		{12500, "12.500"},
+		{1234567, "1.234.567"},
+		{12500.5, "12.500,50"},
+		{-12500, "-12.500"},
+		{0.005, "0,01"},
+	}
+
+	for _, tt := range tests {
+		if got := Money(tt.value); got != tt.want {
+			t.Errorf("Money(%v) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestRate(t *testing.T) {
+	tests := []struct {
+		rate float64
+		want string
+	}{
+		{11, "11%"},
+		{2.5, "2,5%"},
+		{0, "0%"},
+		{12.75, "12,75%"},
+	}
+
+	for _, tt := range tests {
+		if got := Rate(tt.rate); got != tt.want {
+			t.Errorf("Rate(%v) = %q, want %q", tt.rate, got, tt.want)
+		}
+	}
+}
+
+func TestTaxes(t *testing.T) {
+	r := Receipt{Items: []Item{
+		{TaxName: "PPN", TaxRate: 11, TaxInclusive: true, TaxAmount: 1000},
+		{TaxName: "PPN", TaxRate: 11, TaxInclusive: true, TaxAmount: 500},
+		{TaxName: "PPN", TaxRate: 11, TaxInclusive: false, TaxAmount: 200},
+		{TaxName: "PB1", TaxRate: 10, TaxAmount: 300},
+		{TaxName: "", TaxAmount: 100},
+		{TaxName: "PPN", TaxRate: 11, TaxAmount: 0},
+	}}
+
+	want := []Tax{
+		{Name: "PPN", Rate: 11, Inclusive: true, Amount: 1500},
+		{Name: "PPN", Rate: 11, Inclusive: false, Amount: 200},
+		{Name: "PB1", Rate: 10, Amount: 300},
+	}
+	if got := r.Taxes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Taxes() = %+v, want %+v", got, want)
+	}
+}
+
+func TestTemplateRender(t *testing.T) {
+	tpl := Template{
+		StoreName:  "Toko Maju",
+		StorePhone: "021-555",
+		Header:     "{{.StoreName}}\n\n  Telp {{.StorePhone}}  \n",
+		Footer:     "Terima kasih {{.Customer}}",
+	}
+
+	header, footer, err := tpl.Render(TemplateData{Customer: "Ani"})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if want := []string{"Toko Maju", "Telp 021-555"}; !reflect.DeepEqual(header, want) {
+		t.Errorf("header = %q, want %q", header, want)
+	}
+	if want := []string{"Terima kasih Ani"}; !reflect.DeepEqual(footer, want) {
+		t.Errorf("footer = %q, want %q", footer, want)
+	}
+
+	for _, invalid := range []Template{{Header: "{{.StoreName"}, {Footer: "{{.Unknown}}"}} {
+		if _, _, err := invalid.Render(TemplateData{}); err == nil {
+			t.Errorf("Render(%+v) error = nil", invalid)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	const width = 32
+	text := Text(sample(), width)
+
+	for _, row := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+		if utf8.RuneCountInString(row) > width {
+			t.Errorf("row %q is wider than %d", row, width)
+		}
+	}
+	for _, want := range []string{
+		"No    : TRX-1",
+		"Kopi Susu Gula Aren Ukuran Besar\nSekali\n",
+		"  2 x 18.500              37.000",
+		"Promo Pagi                -5.000",
+		"PPN 11% (termasuk)      4.855,86",
+		"TOTAL                     44.000",
+		"CASH                      50.000",
+		"Kembali                    6.000",
+		"Terima kasih",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Text() does not contain %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "COPY") {
+		t.Errorf("Text() of the first print contains a COPY marker")
+	}
+
+	r := sample()
+	r.Copy, r.PrintCount, r.Status = true, 3, "pending_payment"
+	text = Text(r, width)
+	for _, want := range []string{"*** COPY ***", "Cetak ulang ke-2", "*** PENDING PAYMENT ***"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Text() of a reprint does not contain %q", want)
+		}
+	}
+}
+
+func TestESCPOS(t *testing.T) {
+	r := sample()
+	r.Customer = "Ánita"
+	out := ESCPOS(r, 32)
+
+	if !bytes.HasPrefix(out, escInit) || !bytes.HasSuffix(out, append(append([]byte{}, escFeed...), escCut...)) {
+		t.Errorf("ESCPOS() does not start with init and end with feed and cut")
+	}
+	if !bytes.Contains(out, append(append([]byte{}, escBoldOn...), []byte("TOTAL")...)) {
+		t.Errorf("ESCPOS() does not print the total in bold")
+	}
+	if !bytes.Contains(out, []byte("Member: ?nita")) {
+		t.Errorf("ESCPOS() does not replace non ASCII characters")
+	}
+}
+
+func TestPDF(t *testing.T) {
+	r := sample()
+	r.Items[1].Name = "Roti (isi) \\ coklat"
+	out := PDF(r, 32)
+
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatalf("PDF() is not a PDF document")
+	}
+	if !bytes.Contains(out, []byte(`(Roti \(isi\) \\ coklat) Tj`)) {
+		t.Errorf("PDF() does not escape special characters")
+	}
+
+	// startxref harus menunjuk ke tabel xref
+	idx := bytes.LastIndex(out, []byte("startxref\n"))
+	var offset int
+	if _, err := fmt.Sscan(string(out[idx+len("startxref\n"):]), &offset); err != nil {
+		t.Fatalf("read startxref: %v", err)
+	}
+	if !bytes.HasPrefix(out[offset:], []byte("xref\n")) {
+		t.Errorf("startxref %d does not point to the xref table", offset)
+	}
+
+	if got := pdfEscape("Café ✓"); got != `Caf\351 ?` {
+		t.Errorf("pdfEscape() = %q", got)
+	}
+}
+
+func TestHTML(t *testing.T) {
+	r := sample()
+	r.Customer = "<script>alert(1)</script>"
+	r.Copy, r.PrintCount = true, 2
+
+	out, err := HTML(r)
+	if err != nil {
+		t.Fatalf("HTML() error = %v", err)
+	}
+	if strings.Contains(out, "<script>") {
+		t.Errorf("HTML() does not escape the customer name")
+	}
+	for _, want := range []string{"<title>TRX-1</title>", "*** COPY ***", "Cetak ulang ke-1", "PPN 11% (termasuk)", "44.000"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("HTML() does not contain %q", want)
+		}
+	}
+}
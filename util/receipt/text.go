@@ -0,0 +1,162 @@
+package receipt
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// line adalah satu baris struk yang sudah diratakan sesuai lebar kertas
+type line struct {
+	Text string
+	Bold bool
+}
+
+// Text merender struk menjadi teks biasa dengan lebar tetap
+func Text(r Receipt, width int) string {
+	var out strings.Builder
+	for _, l := range lines(r, width) {
+		out.WriteString(l.Text)
+		out.WriteByte('\n')
+	}
+	return out.String()
+}
+
+func lines(r Receipt, width int) []line {
+	if width <= 0 {
+		width = DefaultWidth
+	}
+
+	out := make([]line, 0)
+	add := func(text string, bold bool) {
+		out = append(out, line{Text: text, Bold: bold})
+	}
+	separator := strings.Repeat("-", width)
+
+	for _, h := range r.Header {
+		for _, w := range wrap(h, width) {
+			add(center(w, width), false)
+		}
+	}
+	if r.Copy {
+		add(center("*** COPY ***", width), true)
+		add(center("Cetak ulang ke-"+strconv.Itoa(int(r.PrintCount-1)), width), false)
+	}
+	if r.Status != "" && r.Status != "order" && r.Status != "paid" {
+		add(center("*** "+strings.ToUpper(strings.ReplaceAll(r.Status, "_", " "))+" ***", width), true)
+	}
+
+	add(separator, false)
+	add(truncate("No    : "+r.TrxNumber, width), false)
+	add(truncate("Tgl   : "+r.Date.Format("02/01/2006 15:04"), width), false)
+	if r.Cashier != "" {
+		add(truncate("Kasir : "+r.Cashier, width), false)
+	}
+	if r.Customer != "" {
+		add(truncate("Member: "+r.Customer, width), false)
+	}
+	add(separator, false)
+
+	for _, item := range r.Items {
+		for _, w := range wrap(item.Name, width) {
+			add(w, false)
+		}
+		qty := "  " + strconv.Itoa(int(item.Quantity)) + " x " + Money(item.UnitPrice)
+		add(columns(qty, Money(item.Total()), width), false)
+	}
+	add(separator, false)
+
+	add(columns("Subtotal", Money(r.Subtotal), width), false)
+	for _, d := range r.Discounts {
+		add(columns(truncate(d.Name, width-len(Money(d.Amount))-2), "-"+Money(d.Amount), width), false)
+	}
+	for _, t := range r.Taxes() {
+		label := t.Name + " " + Rate(t.Rate)
+		if t.Inclusive {
+			label += " (termasuk)"
+		}
+		add(columns(label, Money(t.Amount), width), false)
+	}
+	add(columns("TOTAL", Money(r.Total), width), true)
+	add(separator, false)
+
+	for _, p := range r.Payments {
+		add(columns(strings.ToUpper(p.Method), Money(p.Tendered), width), false)
+		if p.Reference != "" {
+			add(truncate("  Ref: "+p.Reference, width), false)
+		}
+	}
+	add(columns("Kembali", Money(r.Change), width), false)
+
+	if len(r.Footer) > 0 {
+		add(separator, false)
+		for _, f := range r.Footer {
+			for _, w := range wrap(f, width) {
+				add(center(w, width), false)
+			}
+		}
+	}
+	return out
+}
+
+// columns menaruh teks kiri dan kanan pada satu baris
+func columns(left string, right string, width int) string {
+	space := width - utf8.RuneCountInString(left) - utf8.RuneCountInString(right)
+	if space < 1 {
+		left = truncate(left, width-utf8.RuneCountInString(right)-1)
+		space = 1
+	}
+	return left + strings.Repeat(" ", space) + right
+}
+
+func center(text string, width int) string {
+	pad := (width - utf8.RuneCountInString(text)) / 2
+	if pad <= 0 {
+		return text
+	}
+	return strings.Repeat(" ", pad) + text
+}
+
+func truncate(text string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	return string(runes[:width])
+}
+
+// wrap memecah teks panjang per kata agar tidak melebihi lebar kertas
+func wrap(text string, width int) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return nil
+	}
+
+	out := make([]string, 0)
+	current := ""
+	for _, word := range words {
+		for utf8.RuneCountInString(word) > width {
+			if current != "" {
+				out = append(out, current)
+				current = ""
+			}
+			out = append(out, string([]rune(word)[:width]))
+			word = string([]rune(word)[width:])
+		}
+		if current == "" {
+			current = word
+		} else if utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) <= width {
+			current += " " + word
+		} else {
+			out = append(out, current)
+			current = word
+		}
+	}
+	if current != "" {
+		out = append(out, current)
+	}
+	return out
+}
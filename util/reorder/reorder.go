@@ -0,0 +1,148 @@
+package reorder
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Status alert stok menipis
+const (
+	StatusOpen     = "open"
+	StatusResolved = "resolved"
+)
+
+// KindLowStock adalah jenis pesan outbox untuk alert stok menipis
+const KindLowStock = "low_stock"
+
+var (
+	ErrUnknownStatus = errors.New("status must be open or resolved")
+	ErrNoSuggestions = errors.New("no products with a supplier need to be reordered")
+)
+
+// ValidateStatus memeriksa filter status alert
+func ValidateStatus(status string) error {
+	switch status {
+	case StatusOpen, StatusResolved:
+		return nil
+	}
+	return ErrUnknownStatus
+}
+
+// IsLow menandakan stok sudah mencapai batas minimum. Produk dengan min_stock 0
+// tidak dipantau.
+func IsLow(stock int32, minStock int32) bool {
+	return minStock > 0 && stock <= minStock
+}
+
+// Crossed menandakan perubahan stok membuat produk turun ke batas minimum
+func Crossed(before int32, after int32, minStock int32) bool {
+	return !IsLow(before, minStock) && IsLow(after, minStock)
+}
+
+// Recovered menandakan perubahan stok membuat produk kembali di atas batas minimum
+func Recovered(before int32, after int32, minStock int32) bool {
+	return IsLow(before, minStock) && !IsLow(after, minStock)
+}
+
+// SuggestQuantity menghitung jumlah yang perlu dipesan agar stok ditambah barang
+// yang masih dipesan naik di atas batas minimum. Reorder quantity dipakai sebagai
+// jumlah pesanan minimal.
+func SuggestQuantity(stock int32, onOrder int32, minStock int32, reorderQuantity int32) int32 {
+	available := stock + onOrder
+	if !IsLow(available, minStock) {
+		return 0
+	}
+	needed := minStock - available + 1
+	if reorderQuantity > needed {
+		return reorderQuantity
+	}
+	return needed
+}
+
+// Candidate adalah produk di bawah batas minimum beserta supplier utamanya
+type Candidate struct {
+	ProductID       int64
+	ProductName     string
+	SupplierID      int64 // 0 jika produk belum punya supplier
+	SupplierName    string
+	Stock           int32
+	OnOrder         int32 // jumlah yang belum diterima dari purchase order terbuka
+	MinStock        int32
+	ReorderQuantity int32
+	UnitCost        float64
+}
+
+// Line adalah satu produk yang disarankan untuk dipesan
+type Line struct {
+	Candidate
+	Quantity int32
+}
+
+// Total adalah perkiraan nilai baris dengan harga pokok produk
+func (l Line) Total() float64 {
+	return Round(float64(l.Quantity) * l.UnitCost)
+}
+
+// Group adalah saran purchase order untuk satu supplier
+type Group struct {
+	SupplierID   int64
+	SupplierName string
+	Lines        []Line
+}
+
+// Total adalah perkiraan nilai purchase order
+func (g Group) Total() float64 {
+	total := 0.0
+	for _, line := range g.Lines {
+		total += line.Total()
+	}
+	return Round(total)
+}
+
+// Suggest mengelompokkan produk yang perlu dipesan per supplier. Produk yang
+// kebutuhannya sudah tertutup purchase order terbuka dilewati. Produk tanpa
+// supplier dikumpulkan pada grup dengan SupplierID 0 di urutan terakhir.
+func Suggest(candidates []Candidate) []Group {
+	index := make(map[int64]int)
+	groups := make([]Group, 0)
+	for _, candidate := range candidates {
+		quantity := SuggestQuantity(candidate.Stock, candidate.OnOrder, candidate.MinStock, candidate.ReorderQuantity)
+		if quantity <= 0 {
+			continue
+		}
+
+		i, ok := index[candidate.SupplierID]
+		if !ok {
+			i = len(groups)
+			index[candidate.SupplierID] = i
+			groups = append(groups, Group{SupplierID: candidate.SupplierID, SupplierName: candidate.SupplierName})
+		}
+		groups[i].Lines = append(groups[i].Lines, Line{Candidate: candidate, Quantity: quantity})
+	}
+
+	sort.SliceStable(groups, func(a, b int) bool {
+		if (groups[a].SupplierID == 0) != (groups[b].SupplierID == 0) {
+			return groups[b].SupplierID == 0
+		}
+		return strings.ToLower(groups[a].SupplierName) < strings.ToLower(groups[b].SupplierName)
+	})
+	return groups
+}
+
+// Message menyusun isi pesan alert stok menipis
+func Message(storeName string, productName string, stock int32, minStock int32) (string, string) {
+	subject := "Stok menipis: " + productName
+	if storeName != "" {
+		subject += " - " + storeName
+	}
+	body := fmt.Sprintf("Stok %s tinggal %d (batas minimum %d). Segera lakukan pemesanan ulang.", productName, stock, minStock)
+	return subject, body
+}
+
+// Round membulatkan nominal ke 2 angka desimal
+func Round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
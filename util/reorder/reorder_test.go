@@ -0,0 +1,128 @@
+package reorder
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSuggestQuantity(t *testing.T) {
+	tests := []struct {
+		name            string
+		stock           int32
+		onOrder         int32
+		minStock        int32
+		reorderQuantity int32
+		want            int32
+	}{
+		{"above minimum", 11, 0, 10, 20, 0},
+		{"at minimum orders the reorder quantity", 10, 0, 10, 20, 20},
+		{"need larger than reorder quantity", 2, 0, 10, 5, 9},
+		{"open purchase order covers the need", 4, 7, 10, 20, 0},
+		{"open purchase order partly covers the need", 4, 3, 10, 0, 4},
+		{"negative stock", -3, 0, 10, 0, 14},
+		{"not monitored", 0, 0, 0, 20, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SuggestQuantity(tt.stock, tt.onOrder, tt.minStock, tt.reorderQuantity); got != tt.want {
+				t.Errorf("SuggestQuantity() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStockCrossing(t *testing.T) {
+	tests := []struct {
+		name      string
+		before    int32
+		after     int32
+		minStock  int32
+		crossed   bool
+		recovered bool
+	}{
+		{"drops to minimum", 11, 10, 10, true, false},
+		{"already low", 10, 5, 10, false, false},
+		{"restocked above minimum", 5, 11, 10, false, true},
+		{"restocked but still low", 5, 10, 10, false, false},
+		{"not monitored", 5, 0, 0, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Crossed(tt.before, tt.after, tt.minStock); got != tt.crossed {
+				t.Errorf("Crossed() = %v, want %v", got, tt.crossed)
+			}
+			if got := Recovered(tt.before, tt.after, tt.minStock); got != tt.recovered {
+				t.Errorf("Recovered() = %v, want %v", got, tt.recovered)
+			}
+		})
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	candidates := []Candidate{
+		{ProductID: 1, SupplierID: 2, SupplierName: "sumber jaya", Stock: 2, MinStock: 5, ReorderQuantity: 10, UnitCost: 1500},
+		{ProductID: 2, SupplierID: 0, Stock: 0, MinStock: 3},
+		{ProductID: 3, SupplierID: 1, SupplierName: "Abadi", Stock: 1, MinStock: 5, UnitCost: 2000},
+		{ProductID: 4, SupplierID: 2, SupplierName: "sumber jaya", Stock: 1, OnOrder: 10, MinStock: 5},
+		{ProductID: 5, SupplierID: 2, SupplierName: "sumber jaya", Stock: 0, MinStock: 2, UnitCost: 1000},
+	}
+
+	groups := Suggest(candidates)
+
+	var suppliers []int64
+	var products [][]int64
+	var quantities [][]int32
+	for _, group := range groups {
+		suppliers = append(suppliers, group.SupplierID)
+		var ids []int64
+		var qty []int32
+		for _, line := range group.Lines {
+			ids = append(ids, line.ProductID)
+			qty = append(qty, line.Quantity)
+		}
+		products = append(products, ids)
+		quantities = append(quantities, qty)
+	}
+
+	if want := []int64{1, 2, 0}; !reflect.DeepEqual(suppliers, want) {
+		t.Errorf("suppliers = %v, want %v", suppliers, want)
+	}
+	if want := [][]int64{{3}, {1, 5}, {2}}; !reflect.DeepEqual(products, want) {
+		t.Errorf("products = %v, want %v", products, want)
+	}
+	if want := [][]int32{{5}, {10, 3}, {4}}; !reflect.DeepEqual(quantities, want) {
+		t.Errorf("quantities = %v, want %v", quantities, want)
+	}
+	if got := groups[1].Total(); got != 18000 {
+		t.Errorf("Group.Total() = %v, want 18000", got)
+	}
+}
+
+func TestSuggestNothingToOrder(t *testing.T) {
+	groups := Suggest([]Candidate{{ProductID: 1, SupplierID: 1, Stock: 10, MinStock: 5}})
+	if len(groups) != 0 {
+		t.Errorf("Suggest() = %+v, want no groups", groups)
+	}
+}
+
+func TestLineTotal(t *testing.T) {
+	tests := []struct {
+		name string
+		line Line
+		want float64
+	}{
+		{"whole amount", Line{Candidate: Candidate{UnitCost: 1500}, Quantity: 3}, 4500},
+		{"rounded to 2 decimals", Line{Candidate: Candidate{UnitCost: 1000.3333}, Quantity: 3}, 3001},
+		{"half cent rounds up", Line{Candidate: Candidate{UnitCost: 0.125}, Quantity: 1}, 0.13},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.line.Total(); got != tt.want {
+				t.Errorf("Total() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package shift
+
+import (
+	"errors"
+	"math"
+)
+
+// Status shift kasir
+const (
+	StatusOpen   = "open"
+	StatusClosed = "closed"
+)
+
+// Jenis pergerakan kas di luar penjualan
+const (
+	MovementCashIn  = "cash_in"  // contoh: tambahan uang kembalian
+	MovementCashOut = "cash_out" // contoh: kas kecil, setoran ke brankas
+)
+
+// Jenis laporan shift
+const (
+	ReportX = "x" // laporan berjalan, shift masih terbuka
+	ReportZ = "z" // laporan penutupan, shift sudah ditutup
+)
+
+var (
+	ErrInvalidAmount       = errors.New("amount must be greater than 0")
+	ErrInvalidFloat        = errors.New("opening float can not be negative")
+	ErrInvalidMovementType = errors.New("movement type must be cash_in or cash_out")
+	ErrShiftClosed         = errors.New("shift is already closed")
+	ErrShiftOpen           = errors.New("z report is only available for closed shift")
+	ErrShiftAlreadyOpen    = errors.New("cashier already has an open shift")
+	ErrNoOpenShift         = errors.New("cashier has no open shift, open a shift first")
+)
+
+// Movement adalah uang yang masuk atau keluar laci kas di luar transaksi penjualan
+type Movement struct {
+	Type   string
+	Amount float64
+}
+
+// Drawer adalah rekap uang tunai di laci kas selama satu shift
+type Drawer struct {
+	OpeningFloat float64
+	CashSales    float64
+	CashRefunds  float64
+	CashIn       float64
+	CashOut      float64
+}
+
+// ValidateMovement memastikan jenis dan nominal pergerakan kas benar
+func ValidateMovement(m Movement) error {
+	if m.Type != MovementCashIn && m.Type != MovementCashOut {
+		return ErrInvalidMovementType
+	}
+	if m.Amount <= 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+// NewDrawer menghitung rekap laci kas dari modal awal, penjualan tunai, refund tunai
+// dan pergerakan kas
+func NewDrawer(openingFloat, cashSales, cashRefunds float64, movements []Movement) Drawer {
+	d := Drawer{OpeningFloat: openingFloat, CashSales: cashSales, CashRefunds: cashRefunds}
+	for _, m := range movements {
+		switch m.Type {
+		case MovementCashIn:
+			d.CashIn += m.Amount
+		case MovementCashOut:
+			d.CashOut += m.Amount
+		}
+	}
+	d.CashIn = round(d.CashIn)
+	d.CashOut = round(d.CashOut)
+	return d
+}
+
+// Expected adalah uang tunai yang seharusnya ada di laci kas
+func (d Drawer) Expected() float64 {
+	return round(d.OpeningFloat + d.CashSales - d.CashRefunds + d.CashIn - d.CashOut)
+}
+
+// Variance adalah selisih uang yang dihitung kasir terhadap uang yang seharusnya ada.
+// Nilai negatif berarti kas kurang, nilai positif berarti kas lebih.
+func (d Drawer) Variance(counted float64) float64 {
+	return round(counted - d.Expected())
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
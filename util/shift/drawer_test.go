@@ -0,0 +1,96 @@
+package shift
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDrawer(t *testing.T) {
+	tests := []struct {
+		name      string
+		float     float64
+		sales     float64
+		refunds   float64
+		movements []Movement
+		counted   float64
+		cashIn    float64
+		cashOut   float64
+		expected  float64
+		variance  float64
+	}{
+		{
+			name:     "sales only",
+			float:    200000,
+			sales:    350000,
+			counted:  550000,
+			expected: 550000,
+		},
+		{
+			name:    "refunds and cash movements",
+			float:   200000,
+			sales:   350000,
+			refunds: 50000,
+			movements: []Movement{
+				{Type: MovementCashIn, Amount: 100000},
+				{Type: MovementCashOut, Amount: 75000},
+				{Type: MovementCashOut, Amount: 25000},
+			},
+			counted:  490000,
+			cashIn:   100000,
+			cashOut:  100000,
+			expected: 500000,
+			variance: -10000,
+		},
+		{
+			name:     "cash over",
+			float:    100000,
+			sales:    12500.5,
+			counted:  113000,
+			expected: 112500.5,
+			variance: 499.5,
+		},
+		{
+			name:      "movement totals are rounded",
+			movements: []Movement{{Type: MovementCashIn, Amount: 0.1}, {Type: MovementCashIn, Amount: 0.2}},
+			counted:   0.3,
+			cashIn:    0.3,
+			expected:  0.3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDrawer(tt.float, tt.sales, tt.refunds, tt.movements)
+			if d.CashIn != tt.cashIn || d.CashOut != tt.cashOut {
+				t.Errorf("cash in/out = %v/%v, want %v/%v", d.CashIn, d.CashOut, tt.cashIn, tt.cashOut)
+			}
+			if got := d.Expected(); got != tt.expected {
+				t.Errorf("Expected() = %v, want %v", got, tt.expected)
+			}
+			if got := d.Variance(tt.counted); got != tt.variance {
+				t.Errorf("Variance() = %v, want %v", got, tt.variance)
+			}
+		})
+	}
+}
+
+func TestValidateMovement(t *testing.T) {
+	tests := []struct {
+		name     string
+		movement Movement
+		err      error
+	}{
+		{"cash in", Movement{Type: MovementCashIn, Amount: 50000}, nil},
+		{"cash out", Movement{Type: MovementCashOut, Amount: 50000}, nil},
+		{"zero amount", Movement{Type: MovementCashOut, Amount: 0}, ErrInvalidAmount},
+		{"unknown type", Movement{Type: "sale", Amount: 50000}, ErrInvalidMovementType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateMovement(tt.movement); !errors.Is(err, tt.err) {
+				t.Errorf("ValidateMovement() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
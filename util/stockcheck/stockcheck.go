@@ -0,0 +1,39 @@
+package stockcheck
+
+import (
+	"fmt"
+	"time"
+)
+
+// Reason adalah keterangan product history untuk koreksi hasil pemeriksaan stok
+const Reason = "Stock consistency repair"
+
+// Balance adalah stok produk dibandingkan dengan jumlah product history-nya
+type Balance struct {
+	ProductID int64
+	Name      string
+	Stock     int32
+	Expected  int64
+}
+
+// Difference adalah selisih stok produk terhadap product history. Nilai positif
+// berarti stok lebih besar dari yang tercatat di product history.
+func (b Balance) Difference() int64 {
+	return int64(b.Stock) - b.Expected
+}
+
+// Mismatches mengembalikan produk yang stoknya tidak sama dengan product history
+func Mismatches(balances []Balance) []Balance {
+	mismatches := make([]Balance, 0)
+	for _, balance := range balances {
+		if balance.Difference() != 0 {
+			mismatches = append(mismatches, balance)
+		}
+	}
+	return mismatches
+}
+
+// GenerateNumber membuat referensi koreksi stok, contoh SC-20240131150405
+func GenerateNumber(now time.Time) string {
+	return fmt.Sprintf("SC-%s", now.Format("20060102150405"))
+}
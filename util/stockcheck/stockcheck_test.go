@@ -0,0 +1,34 @@
+package stockcheck
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMismatches(t *testing.T) {
+	balances := []Balance{
+		{ProductID: 1, Stock: 10, Expected: 10},
+		{ProductID: 2, Stock: 12, Expected: 10},
+		{ProductID: 3, Stock: 0, Expected: 4},
+		{ProductID: 4, Stock: 0, Expected: 0},
+	}
+
+	got := Mismatches(balances)
+	if want := []Balance{balances[1], balances[2]}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Mismatches() = %+v, want %+v", got, want)
+	}
+	if got[0].Difference() != 2 || got[1].Difference() != -4 {
+		t.Errorf("Difference() = %d, %d, want 2, -4", got[0].Difference(), got[1].Difference())
+	}
+	if got := Mismatches(nil); got == nil || len(got) != 0 {
+		t.Errorf("Mismatches(nil) = %v, want an empty slice", got)
+	}
+}
+
+func TestGenerateNumber(t *testing.T) {
+	now := time.Date(2024, 1, 31, 15, 4, 5, 0, time.UTC)
+	if got := GenerateNumber(now); got != "SC-20240131150405" {
+		t.Errorf("GenerateNumber() = %q, want SC-20240131150405", got)
+	}
+}
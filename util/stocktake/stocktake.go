@@ -0,0 +1,79 @@
+package stocktake
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"pos-api/util/movement"
+)
+
+// Status sesi stock take
+const (
+	StatusCounting  = "counting"
+	StatusPosted    = "posted"
+	StatusCancelled = "cancelled"
+)
+
+// Cakupan produk yang dihitung
+const (
+	ScopeFull     = "full"
+	ScopeCategory = "category"
+)
+
+// Kode alasan selisih stok yang dicatat saat posting, bagian dari kode alasan adjustment
+const (
+	ReasonShrinkage  = movement.ReasonShrinkage  // hilang tanpa penyebab yang diketahui
+	ReasonDamaged    = movement.ReasonDamaged    // rusak
+	ReasonExpired    = movement.ReasonExpired    // kedaluwarsa
+	ReasonTheft      = movement.ReasonTheft      // dicuri
+	ReasonCountError = movement.ReasonCountError // salah hitung atau salah catat sebelumnya
+	ReasonFound      = movement.ReasonFound      // barang ditemukan, stok fisik lebih banyak
+)
+
+var (
+	ErrNotCounting   = errors.New("stock take is no longer open for counting")
+	ErrNotInSession  = errors.New("product is not part of this stock take")
+	ErrUnknownReason = errors.New("reason_code must be one of shrinkage, damaged, expired, theft, count_error or found")
+)
+
+// ValidateReason memeriksa kode alasan selisih
+func ValidateReason(code string) error {
+	switch code {
+	case ReasonShrinkage, ReasonDamaged, ReasonExpired, ReasonTheft, ReasonCountError, ReasonFound:
+		return nil
+	}
+	return ErrUnknownReason
+}
+
+// Line adalah satu produk pada sesi stock take
+type Line struct {
+	Expected int32  // stok saat sesi dimulai
+	Counted  *int32 // nil jika belum dihitung
+}
+
+// Variance adalah selisih hitungan fisik terhadap stok saat sesi dimulai.
+// Produk yang belum dihitung dianggap nol jika zeroUncounted, selain itu tidak ada selisih.
+func (l Line) Variance(zeroUncounted bool) int32 {
+	if l.Counted == nil {
+		if zeroUncounted {
+			return -l.Expected
+		}
+		return 0
+	}
+	return *l.Counted - l.Expected
+}
+
+// DefaultReason adalah kode alasan jika tidak diisi: barang lebih dianggap
+// ditemukan, barang kurang dianggap susut
+func DefaultReason(variance int32) string {
+	if variance > 0 {
+		return ReasonFound
+	}
+	return ReasonShrinkage
+}
+
+// GenerateNumber membuat nomor sesi stock take, contoh ST-20240131150405-7
+func GenerateNumber(userID int64, now time.Time) string {
+	return fmt.Sprintf("ST-%s-%d", now.Format("20060102150405"), userID)
+}
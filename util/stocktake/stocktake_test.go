@@ -0,0 +1,67 @@
+package stocktake
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVariance(t *testing.T) {
+	count := func(n int32) *int32 { return &n }
+
+	tests := []struct {
+		name          string
+		line          Line
+		zeroUncounted bool
+		want          int32
+	}{
+		{"counted less", Line{Expected: 10, Counted: count(7)}, false, -3},
+		{"counted more", Line{Expected: 10, Counted: count(12)}, false, 2},
+		{"counted zero", Line{Expected: 10, Counted: count(0)}, false, -10},
+		{"uncounted is skipped", Line{Expected: 10}, false, 0},
+		{"uncounted is treated as zero", Line{Expected: 10}, true, -10},
+		{"uncounted with negative stock", Line{Expected: -2}, true, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.line.Variance(tt.zeroUncounted); got != tt.want {
+				t.Errorf("Variance() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultReason(t *testing.T) {
+	tests := []struct {
+		variance int32
+		want     string
+	}{
+		{3, ReasonFound},
+		{-3, ReasonShrinkage},
+		{0, ReasonShrinkage},
+	}
+
+	for _, tt := range tests {
+		if got := DefaultReason(tt.variance); got != tt.want {
+			t.Errorf("DefaultReason(%d) = %q, want %q", tt.variance, got, tt.want)
+		}
+	}
+}
+
+func TestValidateReason(t *testing.T) {
+	for _, code := range []string{ReasonShrinkage, ReasonDamaged, ReasonExpired, ReasonTheft, ReasonCountError, ReasonFound} {
+		if err := ValidateReason(code); err != nil {
+			t.Errorf("ValidateReason(%q) error = %v", code, err)
+		}
+	}
+	if err := ValidateReason("correction"); err != ErrUnknownReason {
+		t.Errorf("ValidateReason(correction) error = %v, want %v", err, ErrUnknownReason)
+	}
+}
+
+func TestGenerateNumber(t *testing.T) {
+	now := time.Date(2024, 1, 31, 15, 4, 5, 0, time.UTC)
+	if got, want := GenerateNumber(7, now), "ST-20240131150405-7"; got != want {
+		t.Errorf("GenerateNumber() = %q, want %q", got, want)
+	}
+}
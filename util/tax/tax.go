@@ -0,0 +1,65 @@
+package tax
+
+import (
+	"errors"
+	"math"
+)
+
+// Rate adalah tarif pajak yang berlaku untuk satu baris item
+type Rate struct {
+	ID        int64
+	Name      string
+	Rate      float64 // dalam persen, contoh 11 untuk PPN 11%
+	Inclusive bool    // harga jual sudah termasuk pajak
+}
+
+// Line adalah hasil perhitungan pajak untuk satu baris item
+type Line struct {
+	Rate      Rate
+	Amount    float64 // nilai baris setelah potongan
+	TaxAmount float64
+}
+
+// Validate memeriksa tarif pajak sebelum disimpan
+func Validate(rate Rate) error {
+	if rate.Name == "" {
+		return errors.New("name is required")
+	}
+	if rate.Rate < 0 || rate.Rate > 100 {
+		return errors.New("rate must be between 0 and 100")
+	}
+	return nil
+}
+
+// Compute menghitung pajak dari nilai baris setelah potongan.
+// Untuk harga inclusive pajak diambil dari dalam harga (DPP = amount - pajak),
+// sedangkan untuk harga exclusive pajak ditambahkan di atas harga.
+func Compute(amount float64, rate Rate) Line {
+	line := Line{Rate: rate, Amount: amount}
+	if amount <= 0 || rate.Rate <= 0 {
+		return line
+	}
+
+	if rate.Inclusive {
+		line.TaxAmount = round(amount * rate.Rate / (100 + rate.Rate))
+	} else {
+		line.TaxAmount = round(amount * rate.Rate / 100)
+	}
+	return line
+}
+
+// Totals menjumlahkan pajak seluruh baris. Exclusive adalah pajak yang
+// harus ditambahkan ke total order karena belum termasuk di harga.
+func Totals(lines []Line) (total float64, exclusive float64) {
+	for _, line := range lines {
+		total += line.TaxAmount
+		if !line.Rate.Inclusive {
+			exclusive += line.TaxAmount
+		}
+	}
+	return round(total), round(exclusive)
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,98 @@
+package tax
+
+import "testing"
+
+func TestCompute(t *testing.T) {
+	ppn := Rate{ID: 1, Name: "PPN", Rate: 11}
+	ppnInclusive := Rate{ID: 2, Name: "PPN", Rate: 11, Inclusive: true}
+
+	tests := []struct {
+		name   string
+		amount float64
+		rate   Rate
+		want   float64
+	}{
+		{"exclusive is added on top", 1000, ppn, 110},
+		{"inclusive is taken from the price", 1110, ppnInclusive, 110},
+		{"inclusive rounds to 2 decimals", 10000, ppnInclusive, 990.99},
+		{"exclusive rounds half up", 999.99, Rate{Rate: 12.5}, 125},
+		{"zero rate", 1000, Rate{Rate: 0}, 0},
+		{"no amount after discount", 0, ppn, 0},
+		{"negative amount", -500, ppn, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line := Compute(tt.amount, tt.rate)
+			if line.TaxAmount != tt.want {
+				t.Errorf("Compute(%v).TaxAmount = %v, want %v", tt.amount, line.TaxAmount, tt.want)
+			}
+			if line.Amount != tt.amount {
+				t.Errorf("Compute(%v).Amount = %v, want %v", tt.amount, line.Amount, tt.amount)
+			}
+		})
+	}
+}
+
+func TestTotals(t *testing.T) {
+	tests := []struct {
+		name      string
+		lines     []Line
+		total     float64
+		exclusive float64
+	}{
+		{
+			name:  "empty",
+			lines: nil,
+		},
+		{
+			name: "only exclusive tax is added to the order",
+			lines: []Line{
+				{Rate: Rate{Rate: 11}, TaxAmount: 110},
+				{Rate: Rate{Rate: 11, Inclusive: true}, TaxAmount: 99.1},
+			},
+			total:     209.1,
+			exclusive: 110,
+		},
+		{
+			name: "sum is rounded",
+			lines: []Line{
+				{Rate: Rate{Rate: 10}, TaxAmount: 0.1},
+				{Rate: Rate{Rate: 10}, TaxAmount: 0.2},
+			},
+			total:     0.3,
+			exclusive: 0.3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, exclusive := Totals(tt.lines)
+			if total != tt.total || exclusive != tt.exclusive {
+				t.Errorf("Totals() = (%v, %v), want (%v, %v)", total, exclusive, tt.total, tt.exclusive)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rate    Rate
+		wantErr bool
+	}{
+		{"valid", Rate{Name: "PPN", Rate: 11}, false},
+		{"zero rate is allowed", Rate{Name: "Bebas Pajak", Rate: 0}, false},
+		{"missing name", Rate{Rate: 11}, true},
+		{"negative rate", Rate{Name: "PPN", Rate: -1}, true},
+		{"above 100", Rate{Name: "PPN", Rate: 101}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(tt.rate); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package tier
+
+import (
+	"math"
+
+	"pos-api/util/promotion"
+)
+
+// TypeMemberPrice adalah jenis potongan harga member yang dicatat bersama promosi order
+const TypeMemberPrice = "member_tier"
+
+// Tier adalah tingkatan pelanggan beserta harga khusus membernya
+type Tier struct {
+	ID              int64
+	Code            string
+	Name            string
+	MinSpend        float64
+	DiscountPercent float64
+	Prices          map[int64]float64 // harga khusus per produk, menggantikan diskon persentase
+}
+
+// Assign memilih tier tertinggi yang minimal belanjanya terpenuhi oleh total belanja
+func Assign(tiers []Tier, spend float64) (Tier, bool) {
+	best, found := Tier{}, false
+	for _, t := range tiers {
+		if spend+0.005 < t.MinSpend {
+			continue
+		}
+		if !found || t.MinSpend > best.MinSpend {
+			best, found = t, true
+		}
+	}
+	return best, found
+}
+
+// Next mengembalikan tier berikutnya di atas tier saat ini
+func Next(tiers []Tier, current Tier) (Tier, bool) {
+	next, found := Tier{}, false
+	for _, t := range tiers {
+		if t.MinSpend <= current.MinSpend || t.ID == current.ID {
+			continue
+		}
+		if !found || t.MinSpend < next.MinSpend {
+			next, found = t, true
+		}
+	}
+	return next, found
+}
+
+// Price mengembalikan harga member sebuah produk
+func (t Tier) Price(productID int64, unitPrice float64) float64 {
+	if price, ok := t.Prices[productID]; ok && price < unitPrice {
+		return price
+	}
+	return round(unitPrice * (1 - t.DiscountPercent/100))
+}
+
+// Discounts menghitung potongan harga member per baris keranjang. Potongan
+// dibatasi agar total potongan baris (termasuk promosi) tidak melebihi harga baris.
+func (t Tier) Discounts(lines []promotion.Line, existing []float64) ([]float64, float64) {
+	discounts := make([]float64, len(lines))
+	total := 0.0
+	for i, l := range lines {
+		lineTotal := l.UnitPrice * float64(l.Quantity)
+		discount := (l.UnitPrice - t.Price(l.ProductID, l.UnitPrice)) * float64(l.Quantity)
+
+		available := lineTotal
+		if i < len(existing) {
+			available -= existing[i]
+		}
+		discount = round(math.Max(0, math.Min(discount, available)))
+
+		discounts[i] = discount
+		total += discount
+	}
+	return discounts, round(total)
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
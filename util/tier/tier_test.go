@@ -0,0 +1,111 @@
+package tier
+
+import (
+	"reflect"
+	"testing"
+
+	"pos-api/util/promotion"
+)
+
+var tiers = []Tier{
+	{ID: 1, Code: "silver", MinSpend: 0, DiscountPercent: 0},
+	{ID: 3, Code: "platinum", MinSpend: 10000000, DiscountPercent: 10},
+	{ID: 2, Code: "gold", MinSpend: 2500000, DiscountPercent: 5},
+}
+
+func TestAssign(t *testing.T) {
+	tests := []struct {
+		name  string
+		tiers []Tier
+		spend float64
+		want  string
+		found bool
+	}{
+		{"no spend gets the base tier", tiers, 0, "silver", true},
+		{"just below the gold threshold", tiers, 2499999.99, "silver", true},
+		{"exactly the gold threshold", tiers, 2500000, "gold", true},
+		{"rounding error below the threshold", tiers, 2499999.996, "gold", true},
+		{"above the highest threshold", tiers, 25000000, "platinum", true},
+		{"no tier without a zero threshold", tiers[1:], 1000, "", false},
+		{"no tiers", nil, 1000, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := Assign(tt.tiers, tt.spend)
+			if found != tt.found || got.Code != tt.want {
+				t.Errorf("Assign(%v) = %q, %v, want %q, %v", tt.spend, got.Code, found, tt.want, tt.found)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	tests := []struct {
+		name    string
+		current Tier
+		want    string
+		found   bool
+	}{
+		{"customer without a tier", Tier{MinSpend: -1}, "silver", true},
+		{"silver", tiers[0], "gold", true},
+		{"gold", tiers[2], "platinum", true},
+		{"highest tier", tiers[1], "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := Next(tiers, tt.current)
+			if found != tt.found || got.Code != tt.want {
+				t.Errorf("Next(%q) = %q, %v, want %q, %v", tt.current.Code, got.Code, found, tt.want, tt.found)
+			}
+		})
+	}
+}
+
+func TestPrice(t *testing.T) {
+	gold := Tier{DiscountPercent: 5, Prices: map[int64]float64{1: 9000, 2: 12000}}
+
+	tests := []struct {
+		name      string
+		productID int64
+		unitPrice float64
+		want      float64
+	}{
+		{"percentage discount", 3, 10000, 9500},
+		{"special price replaces the percentage", 1, 10000, 9000},
+		{"special price above the unit price is ignored", 2, 10000, 9500},
+		{"rounded to cents", 3, 3333, 3166.35},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gold.Price(tt.productID, tt.unitPrice); got != tt.want {
+				t.Errorf("Price(%d, %v) = %v, want %v", tt.productID, tt.unitPrice, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiscounts(t *testing.T) {
+	gold := Tier{DiscountPercent: 10, Prices: map[int64]float64{2: 5000}}
+	lines := []promotion.Line{
+		{ProductID: 1, UnitPrice: 10000, Quantity: 2},
+		{ProductID: 2, UnitPrice: 8000, Quantity: 1},
+		{ProductID: 3, UnitPrice: 20000, Quantity: 1},
+	}
+
+	// baris kedua dan ketiga sudah dipotong promosi, potongan member dibatasi sisa harga baris
+	discounts, total := gold.Discounts(lines, []float64{0, 6000, 20000})
+	if want := []float64{2000, 2000, 0}; !reflect.DeepEqual(discounts, want) {
+		t.Errorf("Discounts() = %v, want %v", discounts, want)
+	}
+	if total != 4000 {
+		t.Errorf("Discounts() total = %v, want 4000", total)
+	}
+
+	discounts, total = gold.Discounts(lines, nil)
+	if want := []float64{2000, 3000, 2000}; !reflect.DeepEqual(discounts, want) || total != 7000 {
+		t.Errorf("Discounts() without promotions = %v, %v, want %v, 7000", discounts, total, want)
+	}
+}
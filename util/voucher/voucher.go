@@ -0,0 +1,108 @@
+package voucher
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+)
+
+// Jenis nilai voucher
+const (
+	ValuePercentage = "percentage"
+	ValueFixed      = "fixed"
+)
+
+var (
+	ErrInactive         = errors.New("voucher is not active")
+	ErrNotStarted       = errors.New("voucher is not valid yet")
+	ErrExpired          = errors.New("voucher has expired")
+	ErrUsageLimit       = errors.New("voucher usage limit reached")
+	ErrCustomerLimit    = errors.New("voucher usage limit for this customer reached")
+	ErrCustomerRequired = errors.New("voucher can only be used by a registered customer")
+	ErrMinSpend         = errors.New("order does not reach voucher minimum spend")
+)
+
+// Voucher adalah data voucher yang sudah dimuat dari database
+type Voucher struct {
+	Code             string
+	ValueType        string
+	Value            float64
+	MaxDiscount      float64 // 0 = tanpa batas
+	MinSpend         float64
+	UsageLimit       int32 // 0 = tanpa batas
+	UsagePerCustomer int32 // 0 = tanpa batas
+	UsedCount        int32
+	IsActive         bool
+	StartAt          time.Time
+	EndAt            time.Time
+}
+
+// Usage adalah konteks pemakaian voucher pada satu order
+type Usage struct {
+	CustomerID    int64
+	CustomerUsage int64
+	Amount        float64
+}
+
+// NormalizeCode menyeragamkan penulisan kode voucher
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Validate memeriksa kelengkapan field voucher sebelum disimpan
+func Validate(v Voucher) error {
+	if NormalizeCode(v.Code) == "" {
+		return errors.New("code is required")
+	}
+	if v.Value <= 0 {
+		return errors.New("value must be greater than 0")
+	}
+	if v.ValueType == ValuePercentage && v.Value > 100 {
+		return errors.New("percentage value must be between 0 and 100")
+	}
+	if v.MaxDiscount < 0 || v.MinSpend < 0 || v.UsageLimit < 0 || v.UsagePerCustomer < 0 {
+		return errors.New("limits must be positive numbers")
+	}
+	if !v.StartAt.IsZero() && !v.EndAt.IsZero() && v.EndAt.Before(v.StartAt) {
+		return errors.New("end_at must be after start_at")
+	}
+	return nil
+}
+
+// Check memastikan voucher bisa dipakai dan mengembalikan besar potongannya
+func Check(v Voucher, usage Usage, now time.Time) (float64, error) {
+	if !v.IsActive {
+		return 0, ErrInactive
+	}
+	if !v.StartAt.IsZero() && now.Before(v.StartAt) {
+		return 0, ErrNotStarted
+	}
+	if !v.EndAt.IsZero() && now.After(v.EndAt) {
+		return 0, ErrExpired
+	}
+	if v.UsageLimit > 0 && v.UsedCount >= v.UsageLimit {
+		return 0, ErrUsageLimit
+	}
+	if v.UsagePerCustomer > 0 {
+		if usage.CustomerID == 0 {
+			return 0, ErrCustomerRequired
+		}
+		if usage.CustomerUsage >= int64(v.UsagePerCustomer) {
+			return 0, ErrCustomerLimit
+		}
+	}
+	if usage.Amount <= 0 || usage.Amount < v.MinSpend {
+		return 0, ErrMinSpend
+	}
+
+	discount := v.Value
+	if v.ValueType == ValuePercentage {
+		discount = usage.Amount * v.Value / 100
+	}
+	if v.MaxDiscount > 0 {
+		discount = math.Min(discount, v.MaxDiscount)
+	}
+	discount = math.Min(discount, usage.Amount)
+	return math.Round(discount*100) / 100, nil
+}
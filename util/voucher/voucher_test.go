@@ -0,0 +1,71 @@
+package voucher
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCheck(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	base := Voucher{Code: "HEMAT10", ValueType: ValuePercentage, Value: 10, IsActive: true}
+
+	tests := []struct {
+		name    string
+		voucher func(v Voucher) Voucher
+		usage   Usage
+		want    float64
+		err     error
+	}{
+		{"percentage", nil, Usage{Amount: 50000}, 5000, nil},
+		{"percentage capped by max discount", func(v Voucher) Voucher { v.MaxDiscount = 3000; return v }, Usage{Amount: 50000}, 3000, nil},
+		{"fixed value capped by the amount", func(v Voucher) Voucher { v.ValueType, v.Value = ValueFixed, 20000; return v }, Usage{Amount: 15000}, 15000, nil},
+		{"inactive", func(v Voucher) Voucher { v.IsActive = false; return v }, Usage{Amount: 50000}, 0, ErrInactive},
+		{"not started", func(v Voucher) Voucher { v.StartAt = now.Add(time.Hour); return v }, Usage{Amount: 50000}, 0, ErrNotStarted},
+		{"expired", func(v Voucher) Voucher { v.EndAt = now.Add(-time.Hour); return v }, Usage{Amount: 50000}, 0, ErrExpired},
+		{"usage limit reached", func(v Voucher) Voucher { v.UsageLimit, v.UsedCount = 5, 5; return v }, Usage{Amount: 50000}, 0, ErrUsageLimit},
+		{"per customer limit needs a customer", func(v Voucher) Voucher { v.UsagePerCustomer = 1; return v }, Usage{Amount: 50000}, 0, ErrCustomerRequired},
+		{"per customer limit reached", func(v Voucher) Voucher { v.UsagePerCustomer = 1; return v }, Usage{CustomerID: 7, CustomerUsage: 1, Amount: 50000}, 0, ErrCustomerLimit},
+		{"per customer limit not reached", func(v Voucher) Voucher { v.UsagePerCustomer = 2; return v }, Usage{CustomerID: 7, CustomerUsage: 1, Amount: 50000}, 5000, nil},
+		{"below minimum spend", func(v Voucher) Voucher { v.MinSpend = 100000; return v }, Usage{Amount: 50000}, 0, ErrMinSpend},
+		{"zero amount", nil, Usage{Amount: 0}, 0, ErrMinSpend},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := base
+			if tt.voucher != nil {
+				v = tt.voucher(v)
+			}
+			got, err := Check(v, tt.usage, now)
+			if !errors.Is(err, tt.err) || got != tt.want {
+				t.Errorf("Check() = %v, %v, want %v, %v", got, err, tt.want, tt.err)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := Voucher{Code: " hemat10 ", ValueType: ValuePercentage, Value: 10}
+	if err := Validate(valid); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	now := time.Now()
+	invalid := []Voucher{
+		{Code: " ", ValueType: ValueFixed, Value: 1000},
+		{Code: "ZERO", ValueType: ValueFixed, Value: 0},
+		{Code: "MUCH", ValueType: ValuePercentage, Value: 101},
+		{Code: "LIMIT", ValueType: ValueFixed, Value: 1000, UsageLimit: -1},
+		{Code: "DATES", ValueType: ValueFixed, Value: 1000, StartAt: now, EndAt: now.Add(-time.Hour)},
+	}
+	for _, v := range invalid {
+		if err := Validate(v); err == nil {
+			t.Errorf("Validate(%+v) error = nil", v)
+		}
+	}
+
+	if got := NormalizeCode(" hemat10 "); got != "HEMAT10" {
+		t.Errorf("NormalizeCode() = %q, want HEMAT10", got)
+	}
+}